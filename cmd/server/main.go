@@ -3,22 +3,39 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
+	_ "time/tzdata" // user time zones must resolve even on minimal images
 
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/mailer"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/notifier"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/persistence"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/push"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/storage"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/webhook"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/router"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/worker"
 	"github.com/changhyeonkim/pray-together/go-api-server/pkg/server"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/tracing"
 )
 
 func main() {
 	// Parse command line flags
 	var env string
 	flag.StringVar(&env, "env", "local", "Environment (local|dev|prod)")
+	flag.Usage = usage
 	flag.Parse()
 
 	// Initialize structured logger
@@ -31,24 +48,134 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Subcommands run instead of the server
+	switch flag.Arg(0) {
+	case "migrate":
+		os.Exit(runMigrate(cfg, flag.Args()[1:]))
+	case "seed":
+		os.Exit(runSeed(cfg, flag.Args()[1:]))
+	}
+
+	// Trace requests and queries; spans are only exported when a collector is configured
+	shutdownTracing := setupTracing(cfg)
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", "error", err)
+		}
+	}()
+
 	// Connect to database
 	db, err := database.New(cfg)
 	if err != nil {
 		slog.Error("Failed to connect to database", "error", err)
 		os.Exit(1)
 	}
+	// Bring the schema up to date, or check that a release step already did
+	if err := migrateOnStart(cfg, db); err != nil {
+		slog.Error("Failed to migrate database", "error", err)
+		os.Exit(1)
+	}
 	defer func() {
 		if err := db.Close(); err != nil {
 			slog.Error("Failed to close database", "error", err)
 		}
 	}()
 
+	// Watch database connectivity so the pool recovers after an outage without restart
+	monitorCtx, stopMonitor := context.WithCancel(context.Background())
+	defer stopMonitor()
+	db.StartHealthMonitor(monitorCtx, cfg.Database.HealthInterval)
+
+	// Background jobs run until shutdown, which lets the runs in progress finish or checkpoint
+	// Stopped once the server has, and before the database they write to is closed
+	jobs := worker.NewLifecycle()
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownJobTimeout)
+		defer cancel()
+		jobs.Stop(ctx)
+	}()
+
+	// Hard-delete accounts whose deletion grace period has ended
+	purgeUC := account.NewPurgeDeletedAccountsUseCase(persistence.NewUserRepository(db))
+	worker.StartPeriodic(jobs, "account_purge", cfg.Auth.AccountPurgeInterval, purgeUC.Execute)
+
+	// Re-post recurring prayer topics as they fall due
+	recurUC := prayer.NewRecurTopicsUseCase(persistence.NewPrayerTopicRepository(db), persistence.NewRoomMemberRepository(db))
+	worker.StartPeriodic(jobs, "prayer_recurrence", cfg.Prayer.RecurrenceInterval, recurUC.Execute)
+
+	// Remove records left behind by rooms that are gone
+	cleanupUC := room.NewCleanupOrphansUseCase(persistence.NewRoomRepository(db))
+	worker.StartPeriodic(jobs, "room_orphan_cleanup", cfg.Prayer.CleanupInterval, cleanupUC.Execute)
+
+	userRepo := persistence.NewUserRepository(db)
+	notificationRepo := persistence.NewNotificationRepository(db)
+	deviceRepo := persistence.NewDeviceRepository(db)
+	pushRetryRepo := persistence.NewPushRetryRepository(db)
+	pusher := push.New(cfg)
+	notify := notifier.New(mailer.New(cfg), pusher, userRepo, deviceRepo, notificationRepo, pushRetryRepo)
+
+	// Generate queued room exports and delete expired ones; exports are private files, which
+	// need the storage signing key
+	if cfg.Storage.PrivateFilesEnabled() {
+		exportUC := prayer.NewRunExportsUseCase(
+			persistence.NewRoomExportRepository(db),
+			persistence.NewRoomRepository(db),
+			persistence.NewRoomMemberRepository(db),
+			persistence.NewPrayerTopicRepository(db),
+			persistence.NewPrayerContentRepository(db),
+			userRepo,
+			storage.New(cfg),
+			notify,
+		)
+		worker.StartPeriodic(jobs, "room_export", cfg.Prayer.ExportInterval, exportUC.Execute)
+	} else {
+		slog.Warn("Storage signing key not configured, room exports are disabled")
+	}
+
+	// Send daily prayer reminders at each room's reminder time, on one instance at a time
+	scheduler := worker.NewScheduler(persistence.NewJobLockRepository(db))
+	remindUC := prayer.NewSendRemindersUseCase(persistence.NewRoomMemberRepository(db), notify)
+	scheduler.Start(jobs, "prayer_reminder", cfg.Prayer.ReminderInterval, prayer.ReminderMaxDelay, remindUC.Execute)
+
+	// Summarise room activity for users on a digest, per room
+	digestUC := room.NewSendDigestsUseCase(notificationRepo, persistence.NewRoomRepository(db), notify)
+	scheduler.Start(jobs, "notification_digest", cfg.Push.DigestInterval, cfg.Push.DigestInterval, digestUC.Execute)
+
+	// Resend pushes that failed temporarily, dead-lettering those that keep failing
+	retrier := notifier.NewRetrier(pusher, deviceRepo, pushRetryRepo)
+	scheduler.Start(jobs, "push_retry", cfg.Push.RetryInterval, cfg.Push.RetryInterval, retrier.Execute)
+
+	// Publish the notifications that usecases wrote to the outbox along with their changes
+	relayUC := room.NewRelayOutboxUseCase(persistence.NewOutboxRepository(db), persistence.NewRoomMemberRepository(db), notify, webhook.New(cfg), persistence.NewTransactor(db))
+	scheduler.Start(jobs, "outbox_relay", cfg.Push.OutboxInterval, cfg.Push.OutboxInterval, relayUC.Execute)
+
+	// Forget idempotency keys once their responses are no longer replayed
+	idempotencyRepo := persistence.NewIdempotencyRepository(db)
+	worker.StartPeriodic(jobs, "idempotency_cleanup", time.Hour, func(ctx context.Context) error {
+		return idempotencyRepo.DeleteExpired(ctx, time.Now())
+	})
+
 	// Bootstrap server with common setup (Clean Architecture: no DB in bootstrap)
 	bootstrap := server.NewBootstrap(cfg)
-	ginRouter := bootstrap.SetupEngine()
+	ginRouter, err := bootstrap.SetupEngine()
+	if err != nil {
+		slog.Error("Failed to set up the HTTP engine", "error", err)
+		os.Exit(1)
+	}
+
+	// Readiness is flipped on shutdown before the server stops accepting connections
+	readiness := server.NewReadiness()
+
+	// Custom binding rules must be in place before the first request is bound
+	if err := handler.RegisterValidators(); err != nil {
+		slog.Error("Failed to register request validators", "error", err)
+		os.Exit(1)
+	}
 
 	// Setup application-specific routes
-	router.Setup(ginRouter, cfg, db)
+	router.Setup(ginRouter, cfg, db, readiness)
 
 	// Create and start server
 	srv := server.New(cfg, ginRouter)
@@ -77,6 +204,9 @@ func main() {
 	case sig := <-quit:
 		// Received shutdown signal
 		slog.Info("Shutting down server", "signal", sig.String())
+
+		// Fail readiness first and keep serving so the load balancer stops routing to us
+		readiness.Drain(cfg.Server.ShutdownPreDrainDelay)
 	}
 
 	// Graceful shutdown with timeout (only if we received a signal)
@@ -92,6 +222,45 @@ func main() {
 	slog.Info("Server shutdown complete")
 }
 
+// usage documents the flags and subcommands
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: %s [flags] [command]\n\n", os.Args[0])
+	fmt.Fprintln(out, "Without a command the API server runs. Commands:")
+	fmt.Fprintln(out, "  migrate up           apply pending migrations")
+	fmt.Fprintln(out, "  migrate down [n]     revert the last n applied migrations (default 1)")
+	fmt.Fprintln(out, "  migrate status       list migrations and when they were applied")
+	fmt.Fprintln(out, "  seed [dir]           create demo data from the fixture files in dir, or the built-in ones")
+	fmt.Fprintln(out, "\nFlags:")
+	flag.PrintDefaults()
+}
+
+// setupTracing installs OpenTelemetry from the OTEL_* configuration and returns its shutdown
+// A tracer that cannot be set up only costs the traces, so the server starts without it
+func setupTracing(cfg *config.Config) func(context.Context) error {
+	headers := make(map[string]string, len(cfg.Tracing.Headers))
+	for _, header := range cfg.Tracing.Headers {
+		key, value, _ := strings.Cut(header, "=")
+		headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{
+		ServiceName: cfg.TracingServiceName(),
+		Environment: cfg.App.Env,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Headers:     headers,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		slog.Error("Failed to set up tracing", "error", err)
+		return func(context.Context) error { return nil }
+	}
+	if cfg.Tracing.Endpoint != "" {
+		slog.Info("Exporting traces", "endpoint", cfg.Tracing.Endpoint, "sample_ratio", cfg.Tracing.SampleRatio)
+	}
+	return shutdown
+}
+
 // setupLogger configures the global slog logger based on environment
 func setupLogger(env string) {
 	var handler slog.Handler
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/persistence"
+)
+
+// newMigrator reads the migrations of the configured database driver
+func newMigrator(cfg *config.Config, db *database.DB) (*database.Migrator, error) {
+	files, err := persistence.Migrations(cfg.Database.Driver)
+	if err != nil {
+		return nil, err
+	}
+	return database.NewMigrator(db, files)
+}
+
+// migrateOnStart applies pending migrations, or fails if there are any when that is left to the migrate command
+func migrateOnStart(cfg *config.Config, db *database.DB) error {
+	migrator, err := newMigrator(cfg, db)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	if !cfg.Database.MigrateOnStart {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			return fmt.Errorf("%w: %d, run the migrate up command", database.ErrPendingMigrations, pending)
+		}
+		return nil
+	}
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		return err
+	}
+	slog.Info("Database migrations up to date", "applied", applied)
+	return nil
+}
+
+// runMigrate runs the migrate command and returns the process exit code
+func runMigrate(cfg *config.Config, args []string) int {
+	if len(args) == 0 {
+		usage()
+		return 2
+	}
+
+	db, err := database.New(cfg)
+	if err != nil {
+		slog.Error("Failed to connect to database", "error", err)
+		return 1
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			slog.Error("Failed to close database", "error", err)
+		}
+	}()
+
+	migrator, err := newMigrator(cfg, db)
+	if err != nil {
+		slog.Error("Failed to read migrations", "error", err)
+		return 1
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			slog.Error("Migration failed", "applied", applied, "error", err)
+			return 1
+		}
+		fmt.Printf("Applied %d migration(s)\n", applied)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				fmt.Fprintf(os.Stderr, "invalid number of migrations to revert: %s\n", args[1])
+				return 2
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		if err != nil {
+			slog.Error("Reverting migrations failed", "reverted", reverted, "error", err)
+			return 1
+		}
+		fmt.Printf("Reverted %d migration(s)\n", reverted)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			slog.Error("Failed to read migration status", "error", err)
+			return 1
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+		for _, status := range statuses {
+			applied := "pending"
+			if status.AppliedAt != nil {
+				applied = status.AppliedAt.UTC().Format(time.RFC3339)
+				if status.Modified {
+					applied += " (file modified since)"
+				}
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", status.Version, status.Name, applied)
+		}
+		w.Flush()
+	default:
+		usage()
+		return 2
+	}
+	return 0
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/persistence"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/seed"
+)
+
+// runSeed runs the seed command and returns the process exit code
+// The schema is migrated first, so a fresh database is ready for the app in one step
+func runSeed(cfg *config.Config, args []string) int {
+	if cfg.IsProduction() {
+		fmt.Fprintln(os.Stderr, "seeding demo data is disabled in production")
+		return 2
+	}
+	if len(args) > 1 {
+		usage()
+		return 2
+	}
+
+	fixtures, err := loadFixtures(args)
+	if err != nil {
+		slog.Error("Failed to load fixtures", "error", err)
+		return 1
+	}
+
+	db, err := database.New(cfg)
+	if err != nil {
+		slog.Error("Failed to connect to database", "error", err)
+		return 1
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			slog.Error("Failed to close database", "error", err)
+		}
+	}()
+	if err := migrateOnStart(cfg, db); err != nil {
+		slog.Error("Failed to migrate database", "error", err)
+		return 1
+	}
+
+	seedUC := seed.NewSeedUseCase(
+		persistence.NewUserRepository(db),
+		persistence.NewRoomRepository(db),
+		persistence.NewRoomMemberRepository(db),
+		persistence.NewRoomInviteRepository(db),
+		persistence.NewPrayerTopicRepository(db),
+		persistence.NewPrayerContentRepository(db),
+		persistence.NewTransactor(db),
+	)
+	summary, err := seedUC.Execute(context.Background(), fixtures)
+	if err != nil {
+		slog.Error("Seeding failed", "error", err)
+		return 1
+	}
+	fmt.Printf("Seeded %d users, %d rooms, %d invitations, %d members and %d prayers\n",
+		summary.Users, summary.Rooms, summary.Invitations, summary.Members, summary.Prayers)
+	return 0
+}
+
+// loadFixtures reads the fixtures in the directory given, or the built-in ones
+func loadFixtures(args []string) (*seed.Fixtures, error) {
+	if len(args) == 0 {
+		return seed.DefaultFixtures()
+	}
+	return seed.LoadFixtures(os.DirFS(args[0]))
+}
@@ -1,47 +1,90 @@
 package config
 
 import (
+	"crypto"
+	"crypto/rsa"
 	"fmt"
 	"log/slog"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/secrets"
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	App      AppConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	CORS     CORSConfig
-	Log      LogConfig
-	Server   ServerConfig
+	App        AppConfig
+	Database   DatabaseConfig
+	JWT        JWTConfig
+	CORS       CORSConfig
+	Log        LogConfig
+	Server     ServerConfig
+	RateLimit  RateLimitConfig
+	Features   FeaturesConfig
+	OAuth      OAuthConfig
+	Auth       AuthConfig
+	Mail       MailConfig
+	Storage    StorageConfig
+	Push       PushConfig
+	Prayer     PrayerConfig
+	Onboarding OnboardingConfig
+	Discovery  DiscoveryConfig
+	Presence   PresenceConfig
+	Cache      CacheConfig
+	Tracing    TracingConfig
+	TLS        TLSConfig
+	Secrets    SecretsConfig
 }
 
 type AppConfig struct {
-	Name string
-	Env  string
-	Port int
+	Name   string
+	Env    string
+	Port   int
+	WebURL string // base URL used for links in emails
 }
 
 type DatabaseConfig struct {
+	// Driver selects the database: oracle (production), postgres, mysql or sqlite
+	Driver          string
 	Host            string
 	Port            int
-	Service         string
+	Service         string // Oracle service name, or the database name for postgres and mysql
+	Path            string // sqlite database file
+	SSLMode         string // postgres sslmode
 	User            string
 	Password        string
 	MaxIdleConns    int
 	MaxOpenConns    int
 	ConnMaxLifetime time.Duration
+	HealthInterval  time.Duration
+	// MigrateOnStart applies pending migrations at startup; when off the server refuses to start
+	// with pending migrations, which are then applied by the migrate command as a release step
+	MigrateOnStart bool
 }
 
 type JWTConfig struct {
-	Secret        string
+	Secret        string   // current signing secret (Secrets[0])
+	Secrets       []string // first entry signs, all entries verify, for rotation
+	Algorithms    []string // first entry signs new tokens, all entries are accepted
 	Expiry        time.Duration
 	RefreshExpiry time.Duration
+	// SlidingRefresh gives every rotated refresh token a full RefreshExpiry; when false the
+	// session ends RefreshExpiry after login however often it is refreshed
+	SlidingRefresh bool
+
+	// Asymmetric signing (RS256/ES256)
+	PrivateKeyPath string
+	KeyID          string
+	PrivateKey     crypto.Signer               // loaded from PrivateKeyPath
+	PublicKeys     map[string]crypto.PublicKey // verification keys by kid, published via JWKS
+
+	// Shared-secret signing (HS*), derived from Secrets
+	HMACKeyID string            // kid of the signing secret
+	HMACKeys  map[string][]byte // verification secrets by kid
 }
 
 type CORSConfig struct {
@@ -62,33 +105,261 @@ type ServerConfig struct {
 	WriteTimeout    time.Duration
 	IdleTimeout     time.Duration
 	GracefulTimeout time.Duration
+	// ShutdownPreDrainDelay keeps serving with /ready failing so the LB stops routing first
+	ShutdownPreDrainDelay time.Duration
+	// ShutdownJobTimeout is how long background job runs may take to finish once the server has stopped
+	ShutdownJobTimeout time.Duration
+	HealthAuthToken    string
+	// Request URI limits (0 = unlimited)
+	MaxURILength        int
+	MaxQueryParamLength int
+	// Request body limits in bytes (0 = unlimited); upload routes allow the larger one
+	MaxBodySize       int64
+	MaxUploadBodySize int64
+	// IdempotencyTTL is how long the response to a POST sent with an Idempotency-Key is replayed to retries
+	IdempotencyTTL time.Duration
+	// HTTP2 serves HTTP/2 to TLS clients that negotiate it
+	HTTP2 bool
+	// H2C serves cleartext HTTP/2 (prior knowledge) alongside HTTP/1.1, for in-cluster traffic
+	// behind a proxy that terminates TLS
+	H2C bool
+	// HTTP2MaxConcurrentStreams bounds the requests multiplexed on one HTTP/2 connection
+	HTTP2MaxConcurrentStreams int
+}
+
+// RateLimitConfig bounds request rates per instance (0 = unlimited)
+type RateLimitConfig struct {
+	// LoginAttempts per client IP within LoginWindow, across password, social and 2FA login
+	LoginAttempts int
+	LoginWindow   time.Duration
+	// Signups per client IP within SignupWindow, across email signup and guest accounts
+	Signups      int
+	SignupWindow time.Duration
+	// UserRequests per signed-in user within UserWindow
+	UserRequests int
+	UserWindow   time.Duration
+	// ResetEmails per address within ResetEmailWindow, beyond which reset requests send nothing
+	ResetEmails      int
+	ResetEmailWindow time.Duration
+	// MaxConcurrent requests in progress, beyond which requests are turned away
+	MaxConcurrent int
+}
+
+// OAuthConfig lists the accepted ID token audiences per social provider
+// A provider with no client IDs is disabled
+type OAuthConfig struct {
+	GoogleClientIDs []string
+	AppleClientIDs  []string // bundle ID and/or services ID
+	KakaoAppKeys    []string
+}
+
+type AuthConfig struct {
+	PasswordResetTTL     time.Duration
+	EmailVerificationTTL time.Duration
+	// Deleted accounts can be restored until the grace period ends, then they are purged
+	AccountDeletionGracePeriod time.Duration
+	AccountPurgeInterval       time.Duration
+	// Two-factor authentication
+	TOTPIssuer  string        // shown in authenticator apps
+	MFATokenTTL time.Duration // how long the second login step may take
+}
+
+// MailConfig configures the SMTP relay; an empty Host logs emails instead of sending
+type MailConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// StorageConfig configures where uploaded files are kept
+// Files are served under /uploads; point PublicURL at a CDN in front of it in production
+type StorageConfig struct {
+	Dir       string
+	PublicURL string
+	// SigningKey signs the temporary URLs of private files such as exports
+	// Without one no private files are written or served, so room exports are turned off
+	SigningKey string
+}
+
+// PrivateFilesEnabled reports whether private files such as exports can be stored and served
+func (c StorageConfig) PrivateFilesEnabled() bool {
+	return c.SigningKey != ""
+}
+
+// PushConfig configures push notifications through Firebase Cloud Messaging
+// An empty CredentialsFile logs push notifications instead of sending them
+type PushConfig struct {
+	CredentialsFile string // service account key downloaded from the Firebase console
+	// DigestInterval is how often due notification digests are sent
+	DigestInterval time.Duration
+	// RetryInterval is how often failed pushes that are due are retried
+	RetryInterval time.Duration
+	// OutboxInterval is how often notifications written to the outbox are published
+	OutboxInterval time.Duration
+	// WebhookURL receives the outbox events as signed JSON posts as well; empty = no webhook
+	WebhookURL string
+	// WebhookSecret signs the webhook posts
+	WebhookSecret string
+
+	// Loaded from CredentialsFile
+	ProjectID   string
+	ClientEmail string
+	TokenURL    string
+	PrivateKey  *rsa.PrivateKey
+}
+
+type PrayerConfig struct {
+	// RecurrenceInterval is how often due recurring topics are re-posted
+	RecurrenceInterval time.Duration
+	// ExportInterval is how often queued room exports are generated
+	ExportInterval time.Duration
+	// ReminderInterval is the granularity of daily reminders; they go out on multiples of it
+	ReminderInterval time.Duration
+	// CleanupInterval is how often prayers, comments and memberships left without their room are removed
+	CleanupInterval time.Duration
+	// MaxPinnedTopics is how many topics moderators may pin in each room
+	MaxPinnedTopics int
+	// MaxRevisions is how many earlier versions of each topic's title and entries are kept
+	MaxRevisions int
+	// ReactionMode decides whether a member praying for a topic again is counted:
+	// "once" never, "window" after ReactionWindow has passed since their last counted prayer, "count" always
+	ReactionMode string
+	// ReactionWindow is the session within which repeated prayers count once, for the "window" mode
+	ReactionWindow time.Duration
+}
+
+// OnboardingConfig configures what newly registered users start with
+type OnboardingConfig struct {
+	// DefaultRoomID is a room every new user joins on registration; empty leaves them in no room
+	DefaultRoomID string
+}
+
+// DiscoveryConfig configures how users find rooms to join
+type DiscoveryConfig struct {
+	// TrendingWindow is how far back activity counts toward a room trending
+	TrendingWindow time.Duration
+}
+
+// PresenceConfig configures who is shown as active in a room
+type PresenceConfig struct {
+	// ActiveWindow is how recently a member must have opened a room to be listed as active
+	ActiveWindow time.Duration
+}
+
+// CacheConfig configures the cache of hot reads such as room lookups
+// An empty RedisURL keeps the cache in each instance's memory
+type CacheConfig struct {
+	RedisURL string // redis:// or rediss:// URL, e.g. redis://:password@localhost:6379/0
+	// TTL bounds how stale an entry may be; writes through this instance invalidate sooner
+	TTL time.Duration
+	// MaxEntries bounds the in-memory cache
+	MaxEntries int
+}
+
+// TracingConfig configures OpenTelemetry tracing, read from the standard OTEL_* variables
+// An empty Endpoint disables exporting; incoming trace context is still passed on
+type TracingConfig struct {
+	Endpoint    string // OTLP/HTTP collector base URL, e.g. http://localhost:4318
+	Headers     []string
+	ServiceName string
+	// SampleRatio is the share of new traces recorded; requests joining a trace follow its caller's decision
+	SampleRatio float64
+}
+
+// TLSConfig lets the server terminate TLS itself, for deployments without a load balancer
+// Certificates come either from files or from Let's Encrypt; with neither the server speaks plain HTTP
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	// AutocertHosts are the only host names certificates are requested for
+	AutocertHosts    []string
+	AutocertEmail    string
+	AutocertCacheDir string
+	// RedirectPort serves redirects to HTTPS, and the ACME HTTP challenge, on this port (0 = off)
+	RedirectPort int
+}
+
+// Enabled reports whether the server terminates TLS
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || len(c.AutocertHosts) > 0
+}
+
+// SecretsConfig fetches DB_PASSWORD and JWT_SECRET from a secret manager instead of the environment
+// An empty Provider reads them from the environment as usual
+type SecretsConfig struct {
+	Provider string // aws or oci
+	// CacheTTL is how long fetched values are reused; rotated values are picked up after it
+	CacheTTL time.Duration
+	// Names of the secrets, or their ARN/OCID; an empty name leaves that value to the environment
+	DBPasswordName string
+	JWTSecretName  string
+
+	// AWS Secrets Manager, with credentials from the standard AWS_* variables
+	AWSRegion          string
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	AWSSessionToken    string
+
+	// OCI Vault, with an API signing key
+	OCIRegion         string
+	OCITenancyOCID    string
+	OCIUserOCID       string
+	OCIFingerprint    string
+	OCIPrivateKeyFile string
+	OCIVaultID        string // looked up by name in this vault
+
+	// Built from the above, nil when Provider is empty
+	Store *secrets.Cache
+}
+
+type FeaturesConfig struct {
+	Enabled []string
 }
 
 func Load(env string) (*Config, error) {
 	if err := loadEnvFile(env); err != nil {
 		return nil, fmt.Errorf("failed to load env file: %w", err)
 	}
+	// Loaded after the env file so its values win too
+	if err := loadConfigFile(env); err != nil {
+		return nil, fmt.Errorf("failed to load config file: %w", err)
+	}
+
+	dbDriver := getEnv("DB_DRIVER", "oracle")
 
 	cfg := &Config{
 		App: AppConfig{
-			Name: getEnv("APP_NAME", "pray-together-api"),
-			Env:  env,
-			Port: getEnvAsInt("APP_PORT", 8080),
+			Name:   getEnv("APP_NAME", "pray-together-api"),
+			Env:    env,
+			Port:   getEnvAsInt("APP_PORT", 8080),
+			WebURL: getEnv("APP_WEB_URL", "http://localhost:3000"),
 		},
 		Database: DatabaseConfig{
+			Driver:          dbDriver,
 			Host:            getEnv("DB_HOST", ""),
-			Port:            getEnvAsInt("DB_PORT", 1521),
+			Port:            getEnvAsInt("DB_PORT", defaultDBPorts[dbDriver]),
 			Service:         getEnv("DB_SERVICE", ""),
+			Path:            getEnv("DB_PATH", "pray-together.db"),
+			SSLMode:         getEnv("DB_SSL_MODE", "prefer"),
 			User:            getEnv("DB_USER", ""),
 			Password:        getEnv("DB_PASSWORD", ""),
 			MaxIdleConns:    getEnvAsInt("DB_MAX_IDLE_CONNS", 10),
 			MaxOpenConns:    getEnvAsInt("DB_MAX_OPEN_CONNS", 100),
 			ConnMaxLifetime: getEnvAsDuration("DB_CONN_MAX_LIFETIME", "1h"),
+			HealthInterval:  getEnvAsDuration("DB_HEALTH_INTERVAL", "10s"), // 0 = disabled
+			MigrateOnStart:  getEnvAsBool("DB_MIGRATE_ON_START", true),
 		},
 		JWT: JWTConfig{
-			Secret:        getEnv("JWT_SECRET", ""),
-			Expiry:        getEnvAsDuration("JWT_EXPIRY", "24h"),
-			RefreshExpiry: getEnvAsDuration("JWT_REFRESH_EXPIRY", "168h"),
+			Secret:         getEnv("JWT_SECRET", ""),
+			Secrets:        getEnvAsSlice("JWT_SECRETS", []string{}),
+			Algorithms:     getEnvAsSlice("JWT_ALGORITHMS", []string{"HS256"}),
+			Expiry:         getEnvAsDuration("JWT_EXPIRY", "24h"),
+			RefreshExpiry:  getEnvAsDuration("JWT_REFRESH_EXPIRY", "168h"),
+			SlidingRefresh: getEnvAsBool("JWT_SLIDING_REFRESH", true),
+			PrivateKeyPath: getEnv("JWT_PRIVATE_KEY_PATH", ""),
+			KeyID:          getEnv("JWT_KEY_ID", "default"),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins:   getEnvAsSlice("CORS_ALLOWED_ORIGINS", []string{"*"}),
@@ -102,13 +373,136 @@ func Load(env string) (*Config, error) {
 			Format: getEnv("LOG_FORMAT", "json"), // text
 		},
 		Server: ServerConfig{
-			ReadTimeout:     getEnvAsDuration("SERVER_READ_TIMEOUT", "15s"),
-			WriteTimeout:    getEnvAsDuration("SERVER_WRITE_TIMEOUT", "15s"),
-			IdleTimeout:     getEnvAsDuration("SERVER_IDLE_TIMEOUT", "60s"),
-			GracefulTimeout: getEnvAsDuration("GRACEFUL_TIMEOUT", "30s"),
+			ReadTimeout:               getEnvAsDuration("SERVER_READ_TIMEOUT", "15s"),
+			WriteTimeout:              getEnvAsDuration("SERVER_WRITE_TIMEOUT", "15s"),
+			IdleTimeout:               getEnvAsDuration("SERVER_IDLE_TIMEOUT", "60s"),
+			GracefulTimeout:           getEnvAsDuration("GRACEFUL_TIMEOUT", "30s"),
+			ShutdownPreDrainDelay:     getEnvAsDuration("SERVER_SHUTDOWN_PRE_DRAIN_DELAY", "0s"),
+			ShutdownJobTimeout:        getEnvAsDuration("SERVER_SHUTDOWN_JOB_TIMEOUT", "20s"),
+			HealthAuthToken:           getEnv("SERVER_HEALTH_AUTH_TOKEN", ""), // empty = open
+			MaxURILength:              getEnvAsInt("SERVER_MAX_URI_LENGTH", 4096),
+			MaxQueryParamLength:       getEnvAsInt("SERVER_MAX_QUERY_PARAM_LENGTH", 1024),
+			MaxBodySize:               int64(getEnvAsInt("SERVER_MAX_BODY_SIZE", 1<<20)),        // 1 MiB
+			MaxUploadBodySize:         int64(getEnvAsInt("SERVER_MAX_UPLOAD_BODY_SIZE", 6<<20)), // 5 MiB cover image plus multipart framing
+			IdempotencyTTL:            getEnvAsDuration("SERVER_IDEMPOTENCY_TTL", "24h"),
+			HTTP2:                     getEnvAsBool("SERVER_HTTP2_ENABLED", true),
+			H2C:                       getEnvAsBool("SERVER_H2C_ENABLED", false),
+			HTTP2MaxConcurrentStreams: getEnvAsInt("SERVER_HTTP2_MAX_CONCURRENT_STREAMS", 250),
+		},
+		RateLimit: RateLimitConfig{
+			LoginAttempts:    getEnvAsInt("RATE_LIMIT_LOGIN_ATTEMPTS", 10),
+			LoginWindow:      getEnvAsDuration("RATE_LIMIT_LOGIN_WINDOW", "15m"),
+			Signups:          getEnvAsInt("RATE_LIMIT_SIGNUPS", 10),
+			SignupWindow:     getEnvAsDuration("RATE_LIMIT_SIGNUP_WINDOW", "1h"),
+			UserRequests:     getEnvAsInt("RATE_LIMIT_USER_REQUESTS", 600),
+			UserWindow:       getEnvAsDuration("RATE_LIMIT_USER_WINDOW", "1m"),
+			ResetEmails:      getEnvAsInt("RATE_LIMIT_RESET_EMAILS", 3),
+			ResetEmailWindow: getEnvAsDuration("RATE_LIMIT_RESET_EMAIL_WINDOW", "1h"),
+			MaxConcurrent:    getEnvAsInt("RATE_LIMIT_MAX_CONCURRENT", 500),
+		},
+		Features: FeaturesConfig{
+			Enabled: getEnvAsSlice("FEATURES_ENABLED", []string{}),
+		},
+		OAuth: OAuthConfig{
+			GoogleClientIDs: getEnvAsSlice("OAUTH_GOOGLE_CLIENT_IDS", []string{}),
+			AppleClientIDs:  getEnvAsSlice("OAUTH_APPLE_CLIENT_IDS", []string{}),
+			KakaoAppKeys:    getEnvAsSlice("OAUTH_KAKAO_APP_KEYS", []string{}),
+		},
+		Auth: AuthConfig{
+			PasswordResetTTL:           getEnvAsDuration("AUTH_PASSWORD_RESET_TTL", "30m"),
+			EmailVerificationTTL:       getEnvAsDuration("AUTH_EMAIL_VERIFICATION_TTL", "24h"),
+			AccountDeletionGracePeriod: getEnvAsDuration("AUTH_ACCOUNT_DELETION_GRACE_PERIOD", "720h"),
+			AccountPurgeInterval:       getEnvAsDuration("AUTH_ACCOUNT_PURGE_INTERVAL", "1h"), // 0 = disabled
+			TOTPIssuer:                 getEnv("AUTH_TOTP_ISSUER", "PrayTogether"),
+			MFATokenTTL:                getEnvAsDuration("AUTH_MFA_TOKEN_TTL", "5m"),
+		},
+		Mail: MailConfig{
+			Host:     getEnv("SMTP_HOST", ""),
+			Port:     getEnvAsInt("SMTP_PORT", 587),
+			Username: getEnv("SMTP_USERNAME", ""),
+			Password: getEnv("SMTP_PASSWORD", ""),
+			From:     getEnv("SMTP_FROM", "PrayTogether <no-reply@praytogether.app>"),
+		},
+		Storage: StorageConfig{
+			Dir:        getEnv("STORAGE_DIR", "uploads"),
+			PublicURL:  strings.TrimSuffix(getEnv("STORAGE_PUBLIC_URL", "http://localhost:8080/uploads"), "/"),
+			SigningKey: getEnv("STORAGE_SIGNING_KEY", ""), // empty = room exports disabled
+		},
+		Push: PushConfig{
+			CredentialsFile: getEnv("FCM_CREDENTIALS_FILE", ""),
+			DigestInterval:  getEnvAsDuration("NOTIFICATION_DIGEST_INTERVAL", "5m"), // 0 = disabled
+			RetryInterval:   getEnvAsDuration("PUSH_RETRY_INTERVAL", "30s"),         // 0 = disabled
+			OutboxInterval:  getEnvAsDuration("NOTIFICATION_OUTBOX_INTERVAL", "5s"), // 0 = disabled
+			WebhookURL:      getEnv("NOTIFICATION_WEBHOOK_URL", ""),
+			WebhookSecret:   getEnv("NOTIFICATION_WEBHOOK_SECRET", ""),
+		},
+		Prayer: PrayerConfig{
+			RecurrenceInterval: getEnvAsDuration("PRAYER_RECURRENCE_INTERVAL", "5m"), // 0 = disabled
+			ExportInterval:     getEnvAsDuration("PRAYER_EXPORT_INTERVAL", "30s"),    // 0 = disabled
+			ReminderInterval:   getEnvAsDuration("PRAYER_REMINDER_INTERVAL", "1m"),   // 0 = disabled
+			CleanupInterval:    getEnvAsDuration("PRAYER_CLEANUP_INTERVAL", "24h"),   // 0 = disabled
+			MaxPinnedTopics:    getEnvAsInt("PRAYER_MAX_PINNED_TOPICS", 3),           // 0 = pinning disabled
+			MaxRevisions:       getEnvAsInt("PRAYER_MAX_REVISIONS", 20),              // 0 = edit history disabled
+			ReactionMode:       getEnv("PRAYER_REACTION_MODE", "once"),               // once, window or count
+			ReactionWindow:     getEnvAsDuration("PRAYER_REACTION_WINDOW", "3h"),
+		},
+		Onboarding: OnboardingConfig{
+			DefaultRoomID: getEnv("ONBOARDING_DEFAULT_ROOM_ID", ""), // empty = disabled
+		},
+		Discovery: DiscoveryConfig{
+			TrendingWindow: getEnvAsDuration("DISCOVERY_TRENDING_WINDOW", "168h"),
+		},
+		Presence: PresenceConfig{
+			ActiveWindow: getEnvAsDuration("PRESENCE_ACTIVE_WINDOW", "10m"),
+		},
+		Cache: CacheConfig{
+			RedisURL:   getEnv("CACHE_REDIS_URL", ""),
+			TTL:        getEnvAsDuration("CACHE_TTL", "30s"), // 0 = disabled
+			MaxEntries: getEnvAsInt("CACHE_MAX_ENTRIES", 10000),
+		},
+		Tracing: TracingConfig{
+			Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", ""),               // empty = disabled
+			Headers:     getEnvAsSlice("OTEL_EXPORTER_OTLP_HEADERS", []string{}), // key=value pairs, e.g. for collector auth
+			ServiceName: getEnv("OTEL_SERVICE_NAME", ""),                         // empty = APP_NAME
+			SampleRatio: getEnvAsFloat("OTEL_TRACES_SAMPLER_ARG", 1.0),
+		},
+		TLS: TLSConfig{
+			CertFile:         getEnv("TLS_CERT_FILE", ""),
+			KeyFile:          getEnv("TLS_KEY_FILE", ""),
+			AutocertHosts:    getEnvAsSlice("TLS_AUTOCERT_HOSTS", []string{}), // empty = no Let's Encrypt
+			AutocertEmail:    getEnv("TLS_AUTOCERT_EMAIL", ""),
+			AutocertCacheDir: getEnv("TLS_AUTOCERT_CACHE_DIR", "certs"),
+			RedirectPort:     getEnvAsInt("TLS_REDIRECT_PORT", 0), // usually 80
+		},
+		Secrets: SecretsConfig{
+			Provider:           getEnv("SECRETS_PROVIDER", ""), // empty = environment only
+			CacheTTL:           getEnvAsDuration("SECRETS_CACHE_TTL", "5m"),
+			DBPasswordName:     getEnv("SECRETS_DB_PASSWORD_NAME", ""),
+			JWTSecretName:      getEnv("SECRETS_JWT_SECRET_NAME", ""),
+			AWSRegion:          getEnv("AWS_REGION", ""),
+			AWSAccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
+			AWSSecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
+			AWSSessionToken:    getEnv("AWS_SESSION_TOKEN", ""),
+			OCIRegion:          getEnv("OCI_REGION", ""),
+			OCITenancyOCID:     getEnv("OCI_TENANCY_OCID", ""),
+			OCIUserOCID:        getEnv("OCI_USER_OCID", ""),
+			OCIFingerprint:     getEnv("OCI_FINGERPRINT", ""),
+			OCIPrivateKeyFile:  getEnv("OCI_PRIVATE_KEY_FILE", ""),
+			OCIVaultID:         getEnv("OCI_VAULT_ID", ""),
 		},
 	}
 
+	// Fetched before the JWT keys are derived from the secrets
+	if err := loadSecrets(cfg); err != nil {
+		return nil, err
+	}
+	if err := loadJWTKeys(&cfg.JWT); err != nil {
+		return nil, err
+	}
+	if err := loadPushCredentials(&cfg.Push); err != nil {
+		return nil, err
+	}
+
 	if err := cfg.Validate(); err != nil {
 		return nil, fmt.Errorf("config validation failed: %w", err)
 	}
@@ -134,6 +528,31 @@ func loadEnvFile(env string) error {
 	return nil
 }
 
+// supportedJWTAlgorithms lists the signing algorithms the server can verify
+// "none" is intentionally absent and can never be configured
+var supportedJWTAlgorithms = map[string]bool{
+	"HS256": true,
+	"HS384": true,
+	"HS512": true,
+	"RS256": true,
+	"ES256": true,
+}
+
+// defaultDBPorts is the port each network database driver listens on by default
+var defaultDBPorts = map[string]int{
+	"oracle":   1521,
+	"postgres": 5432,
+	"mysql":    3306,
+}
+
+// supportedDBDrivers lists the values DB_DRIVER accepts
+var supportedDBDrivers = map[string]bool{
+	"oracle":   true,
+	"postgres": true,
+	"mysql":    true,
+	"sqlite":   true,
+}
+
 func (c *Config) Validate() error {
 	var errors []string
 
@@ -143,25 +562,154 @@ func (c *Config) Validate() error {
 	}
 
 	// Database validation
-	if c.Database.Host == "" {
-		errors = append(errors, "database host is required")
+	switch {
+	case !supportedDBDrivers[c.Database.Driver]:
+		errors = append(errors, fmt.Sprintf("unsupported database driver: %s", c.Database.Driver))
+	case c.Database.Driver == "sqlite":
+		if c.Database.Path == "" {
+			errors = append(errors, "database path is required")
+		}
+	default:
+		if c.Database.Host == "" {
+			errors = append(errors, "database host is required")
+		}
+		if c.Database.Service == "" {
+			errors = append(errors, "database service is required")
+		}
+		if c.Database.User == "" {
+			errors = append(errors, "database user is required")
+		}
+		if c.Database.Password == "" {
+			errors = append(errors, "database password is required")
+		}
 	}
-	if c.Database.Service == "" {
-		errors = append(errors, "database service is required")
+
+	// JWT validation
+	if len(c.JWT.Algorithms) == 0 {
+		errors = append(errors, "at least one JWT algorithm is required")
 	}
-	if c.Database.User == "" {
-		errors = append(errors, "database user is required")
+	needsSecret := false
+	for _, alg := range c.JWT.Algorithms {
+		if !supportedJWTAlgorithms[alg] {
+			errors = append(errors, fmt.Sprintf("unsupported JWT algorithm: %s", alg))
+			continue
+		}
+		if isHMACAlgorithm(alg) {
+			needsSecret = true
+			continue
+		}
+		if c.JWT.PrivateKey == nil {
+			errors = append(errors, fmt.Sprintf("JWT private key is required for %s", alg))
+			continue
+		}
+		if err := validateJWTKey(alg, c.JWT.PrivateKey); err != nil {
+			errors = append(errors, err.Error())
+		}
 	}
-	if c.Database.Password == "" {
-		errors = append(errors, "database password is required")
+	if needsSecret {
+		if len(c.JWT.Secrets) == 0 {
+			errors = append(errors, "JWT secret is required")
+		}
+		for i, secret := range c.JWT.Secrets {
+			if len(secret) < 32 {
+				errors = append(errors, fmt.Sprintf("JWT secret #%d must be at least 32 characters", i+1))
+			}
+		}
 	}
 
-	// JWT validation
-	if c.JWT.Secret == "" {
-		errors = append(errors, "JWT secret is required")
+	// Storage validation
+	if c.Storage.PrivateFilesEnabled() && len(c.Storage.SigningKey) < 32 {
+		errors = append(errors, "storage signing key must be at least 32 characters")
+	}
+
+	// Prayer validation
+	if c.Prayer.MaxPinnedTopics < 0 {
+		errors = append(errors, "max pinned prayer topics must not be negative")
+	}
+	if c.Prayer.MaxRevisions < 0 {
+		errors = append(errors, "max prayer revisions must not be negative")
+	}
+	switch c.Prayer.ReactionMode {
+	case "once", "count":
+	case "window":
+		if c.Prayer.ReactionWindow <= 0 {
+			errors = append(errors, "prayer reaction window must be positive")
+		}
+	default:
+		errors = append(errors, "prayer reaction mode must be once, window or count")
+	}
+
+	// Notification webhook validation
+	if c.Push.WebhookURL != "" {
+		if u, err := url.Parse(c.Push.WebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, "notification webhook URL must be an http:// or https:// URL")
+		}
+		if len(c.Push.WebhookSecret) < 32 {
+			errors = append(errors, "notification webhook secret must be at least 32 characters")
+		}
+	}
+
+	// Rate limit validation
+	if c.RateLimit.LoginAttempts > 0 && c.RateLimit.LoginWindow <= 0 {
+		errors = append(errors, "login rate limit window must be positive")
+	}
+	if c.RateLimit.Signups > 0 && c.RateLimit.SignupWindow <= 0 {
+		errors = append(errors, "signup rate limit window must be positive")
+	}
+	if c.RateLimit.UserRequests > 0 && c.RateLimit.UserWindow <= 0 {
+		errors = append(errors, "user rate limit window must be positive")
+	}
+	if c.RateLimit.ResetEmails > 0 && c.RateLimit.ResetEmailWindow <= 0 {
+		errors = append(errors, "password reset email rate limit window must be positive")
 	}
-	if len(c.JWT.Secret) < 32 {
-		errors = append(errors, "JWT secret must be at least 32 characters")
+
+	// Cache validation
+	if c.Cache.RedisURL != "" {
+		if u, err := url.Parse(c.Cache.RedisURL); err != nil || (u.Scheme != "redis" && u.Scheme != "rediss") || u.Host == "" {
+			errors = append(errors, "cache Redis URL must be a redis:// or rediss:// URL")
+		}
+	}
+
+	// Tracing validation
+	if c.Tracing.Endpoint != "" {
+		if u, err := url.Parse(c.Tracing.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, "OTLP endpoint must be an http:// or https:// URL")
+		}
+	}
+	for _, header := range c.Tracing.Headers {
+		if key, _, ok := strings.Cut(header, "="); !ok || strings.TrimSpace(key) == "" {
+			errors = append(errors, fmt.Sprintf("OTLP header %q must be key=value", header))
+		}
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errors = append(errors, "trace sample ratio must be between 0 and 1")
+	}
+
+	// HTTP/2 validation
+	if c.Server.H2C && c.TLS.Enabled() {
+		errors = append(errors, "h2c is cleartext HTTP/2 and cannot be enabled when the server terminates TLS")
+	}
+	if c.Server.HTTP2MaxConcurrentStreams < 1 {
+		errors = append(errors, "HTTP/2 max concurrent streams must be at least 1")
+	}
+
+	// TLS validation
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		errors = append(errors, "TLS certificate and key files must be set together")
+	}
+	if c.TLS.CertFile != "" && len(c.TLS.AutocertHosts) > 0 {
+		errors = append(errors, "TLS certificate files and autocert hosts are mutually exclusive")
+	}
+	if len(c.TLS.AutocertHosts) > 0 && c.TLS.AutocertCacheDir == "" {
+		errors = append(errors, "autocert cache directory is required")
+	}
+	if c.TLS.RedirectPort != 0 {
+		switch {
+		case !c.TLS.Enabled():
+			errors = append(errors, "TLS redirect port requires TLS to be enabled")
+		case c.TLS.RedirectPort < 1 || c.TLS.RedirectPort > 65535 || c.TLS.RedirectPort == c.App.Port:
+			errors = append(errors, "TLS redirect port must be a valid port other than the app port")
+		}
 	}
 
 	// Log validation
@@ -198,6 +746,24 @@ func (c *Config) IsProduction() bool {
 	return c.App.Env == "prod"
 }
 
+// TracingServiceName is the service name spans are reported under, APP_NAME unless OTEL_SERVICE_NAME is set
+func (c *Config) TracingServiceName() string {
+	if c.Tracing.ServiceName != "" {
+		return c.Tracing.ServiceName
+	}
+	return c.App.Name
+}
+
+// IsFeatureEnabled reports whether the named feature flag is turned on
+func (c *Config) IsFeatureEnabled(name string) bool {
+	for _, feature := range c.Features.Enabled {
+		if strings.TrimSpace(feature) == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Config) GetDSN() string {
 	return fmt.Sprintf("oracle://%s:%s@%s:%d/%s",
 		c.Database.User,
@@ -232,6 +798,14 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	valueStr := getEnv(key, "")
+	if value, err := strconv.ParseFloat(valueStr, 64); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 func getEnvAsSlice(key string, defaultValue []string) []string {
 	valueStr := getEnv(key, "")
 	if valueStr == "" {
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// loadConfigFile reads config.{env}.yaml, or the file named by CONFIG_FILE, into the environment
+// Nested keys are joined with underscores into the variable they set, so
+//
+//	server:
+//	  max_body_size: 2097152
+//	tls:
+//	  autocert_hosts:
+//	    - api.praytogether.app
+//
+// sets SERVER_MAX_BODY_SIZE and TLS_AUTOCERT_HOSTS; lists become the comma-separated values
+// list variables take. Variables that are already set win over the file
+func loadConfigFile(env string) error {
+	path, explicit := os.LookupEnv("CONFIG_FILE")
+	if !explicit {
+		path = fmt.Sprintf("config.%s.yaml", env)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !explicit {
+			return nil
+		}
+		return err
+	}
+
+	var doc map[string]any
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	values := make(map[string]string)
+	if err := flattenConfig("", doc, values); err != nil {
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	for key, value := range values {
+		if _, exists := os.LookupEnv(key); exists {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+
+	absPath, _ := filepath.Abs(path)
+	slog.Info("Config file loaded", "file", absPath, "settings", len(values))
+	return nil
+}
+
+// flattenConfig collects the scalar and list values under node by variable name
+func flattenConfig(prefix string, node any, values map[string]string) error {
+	switch v := node.(type) {
+	case map[string]any:
+		for key, child := range v {
+			name := strings.ToUpper(key)
+			if prefix != "" {
+				name = prefix + "_" + name
+			}
+			if err := flattenConfig(name, child, values); err != nil {
+				return err
+			}
+		}
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := scalarString(item)
+			if !ok {
+				return fmt.Errorf("%s: list items must be plain values", prefix)
+			}
+			if strings.Contains(s, ",") {
+				return fmt.Errorf("%s: list item %q must not contain a comma", prefix, s)
+			}
+			items = append(items, s)
+		}
+		values[prefix] = strings.Join(items, ",")
+	default:
+		s, ok := scalarString(v)
+		if !ok {
+			return fmt.Errorf("%s: unsupported value", prefix)
+		}
+		values[prefix] = s
+	}
+	return nil
+}
+
+// scalarString formats a YAML scalar the way it would be written in an environment variable
+// An empty value (key with nothing after it) is the empty string
+func scalarString(v any) (string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return "", true
+	case string:
+		return v, true
+	case bool, int, int64, uint64, float64:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// serviceAccountKey is the part of a Google service account key file needed to call FCM
+type serviceAccountKey struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// loadPushCredentials parses the FCM service account key file, if one is configured
+func loadPushCredentials(cfg *PushConfig) error {
+	if cfg.CredentialsFile == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(cfg.CredentialsFile)
+	if err != nil {
+		return fmt.Errorf("failed to read FCM credentials: %w", err)
+	}
+
+	var key serviceAccountKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return fmt.Errorf("failed to parse FCM credentials: %w", err)
+	}
+	if key.ProjectID == "" || key.ClientEmail == "" || key.TokenURI == "" {
+		return errors.New("FCM credentials must include project_id, client_email and token_uri")
+	}
+
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	if block == nil {
+		return errors.New("failed to decode FCM private key: no PEM block found")
+	}
+	signer, err := parsePrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse FCM private key: %w", err)
+	}
+	rsaKey, ok := signer.(*rsa.PrivateKey)
+	if !ok {
+		return errors.New("FCM private key must be an RSA key")
+	}
+
+	cfg.ProjectID = key.ProjectID
+	cfg.ClientEmail = key.ClientEmail
+	cfg.TokenURL = key.TokenURI
+	cfg.PrivateKey = rsaKey
+	return nil
+}
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/secrets"
+)
+
+// secretsLoadTimeout bounds fetching the secrets at boot
+const secretsLoadTimeout = 30 * time.Second
+
+// loadSecrets fetches the database password and JWT secret from the configured secret manager
+// The previous version of the JWT secret is kept for verification, so tokens signed before a
+// rotation stay valid until they expire
+func loadSecrets(cfg *Config) error {
+	if cfg.Secrets.Provider == "" {
+		return nil
+	}
+
+	provider, err := newSecretsProvider(cfg.Secrets)
+	if err != nil {
+		return err
+	}
+	cfg.Secrets.Store = secrets.NewCache(provider, cfg.Secrets.CacheTTL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), secretsLoadTimeout)
+	defer cancel()
+
+	if name := cfg.Secrets.DBPasswordName; name != "" {
+		password, err := cfg.Secrets.Store.Get(ctx, name, secrets.Current)
+		if err != nil {
+			return fmt.Errorf("failed to fetch database password: %w", err)
+		}
+		cfg.Database.Password = password
+	}
+
+	if name := cfg.Secrets.JWTSecretName; name != "" {
+		current, err := cfg.Secrets.Store.Get(ctx, name, secrets.Current)
+		if err != nil {
+			return fmt.Errorf("failed to fetch JWT secret: %w", err)
+		}
+		jwtSecrets := []string{current}
+		previous, err := cfg.Secrets.Store.Get(ctx, name, secrets.Previous)
+		switch {
+		case err == nil:
+			if previous != current {
+				jwtSecrets = append(jwtSecrets, previous)
+			}
+		case errors.Is(err, secrets.ErrNotFound):
+			// Never rotated
+		default:
+			return fmt.Errorf("failed to fetch previous JWT secret: %w", err)
+		}
+		cfg.JWT.Secret = current
+		cfg.JWT.Secrets = jwtSecrets
+	}
+
+	slog.Info("Secrets loaded", "provider", cfg.Secrets.Provider)
+	return nil
+}
+
+// newSecretsProvider builds the provider named by cfg.Provider from its credentials
+func newSecretsProvider(cfg SecretsConfig) (secrets.Provider, error) {
+	switch cfg.Provider {
+	case "aws":
+		if cfg.AWSRegion == "" || cfg.AWSAccessKeyID == "" || cfg.AWSSecretAccessKey == "" {
+			return nil, errors.New("AWS Secrets Manager requires AWS_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+		}
+		return secrets.NewAWS(secrets.AWSOptions{
+			Region:          cfg.AWSRegion,
+			AccessKeyID:     cfg.AWSAccessKeyID,
+			SecretAccessKey: cfg.AWSSecretAccessKey,
+			SessionToken:    cfg.AWSSessionToken,
+		}), nil
+	case "oci":
+		if cfg.OCIRegion == "" || cfg.OCITenancyOCID == "" || cfg.OCIUserOCID == "" || cfg.OCIFingerprint == "" || cfg.OCIPrivateKeyFile == "" {
+			return nil, errors.New("OCI Vault requires OCI_REGION, OCI_TENANCY_OCID, OCI_USER_OCID, OCI_FINGERPRINT and OCI_PRIVATE_KEY_FILE")
+		}
+		for _, name := range []string{cfg.DBPasswordName, cfg.JWTSecretName} {
+			if name != "" && !strings.HasPrefix(name, "ocid1.") && cfg.OCIVaultID == "" {
+				return nil, errors.New("OCI_VAULT_ID is required to look up secrets by name")
+			}
+		}
+		key, err := loadOCIPrivateKey(cfg.OCIPrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return secrets.NewOCI(secrets.OCIOptions{
+			Region:      cfg.OCIRegion,
+			TenancyOCID: cfg.OCITenancyOCID,
+			UserOCID:    cfg.OCIUserOCID,
+			Fingerprint: cfg.OCIFingerprint,
+			PrivateKey:  key,
+			VaultID:     cfg.OCIVaultID,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported secrets provider: %s", cfg.Provider)
+	}
+}
+
+// loadOCIPrivateKey parses the PEM API signing key downloaded from the OCI console
+func loadOCIPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI private key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode OCI private key: no PEM block found")
+	}
+	signer, err := parsePrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OCI private key: %w", err)
+	}
+	rsaKey, ok := signer.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("OCI private key must be an RSA key")
+	}
+	return rsaKey, nil
+}
@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// AuditAction names a sensitive operation kept in the audit log
+type AuditAction string
+
+const (
+	AuditLogin             AuditAction = "auth.login"
+	AuditLoginFailed       AuditAction = "auth.login_failed"
+	AuditSocialLogin       AuditAction = "auth.social_login"
+	AuditUserSuspended     AuditAction = "user.suspended"
+	AuditUserReinstated    AuditAction = "user.reinstated"
+	AuditMemberRoleChanged AuditAction = "room.member_role_changed"
+	AuditRoomDeleted       AuditAction = "room.deleted"
+	AuditExportRequested   AuditAction = "room.export_requested"
+)
+
+// AuditTargetType names the kind of resource an audited operation acted on
+type AuditTargetType string
+
+const (
+	AuditTargetUser AuditTargetType = "user"
+	AuditTargetRoom AuditTargetType = "room"
+)
+
+// AuditEntry records who did what to which resource
+// Entries are never changed or deleted, not even when the actor's account is purged
+type AuditEntry struct {
+	ID     string
+	Action AuditAction
+	// ActorID is the user who acted, empty when nobody is signed in, e.g. a failed login
+	ActorID    string
+	TargetType AuditTargetType
+	TargetID   string
+	// Details holds what else is needed to understand the entry, such as the new role
+	Details   map[string]string
+	RequestID string
+	IP        string
+	CreatedAt time.Time
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrCannotBlockSelf = errors.New("cannot block yourself")
+
+// Block hides everything BlockedID posts or sends from BlockerID
+// Blocking is one-directional and invisible to the blocked user
+type Block struct {
+	BlockerID string
+	BlockedID string
+	CreatedAt time.Time
+}
+
+// NewBlock creates a block of another user
+func NewBlock(blockerID, blockedID string) (*Block, error) {
+	if blockerID == blockedID {
+		return nil, ErrCannotBlockSelf
+	}
+	return &Block{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+		CreatedAt: time.Now(),
+	}, nil
+}
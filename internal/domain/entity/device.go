@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	MaxDeviceIDLength    = 100
+	MaxDeviceTokenLength = 512
+	MaxAppVersionLength  = 20
+	// MaxDevicesPerUser bounds how many devices receive a user's push notifications;
+	// registering another one drops the least recently registered
+	MaxDevicesPerUser = 20
+)
+
+var (
+	ErrDeviceNotFound     = errors.New("device not found")
+	ErrInvalidDeviceID    = errors.New("device ID must be between 1 and 100 characters")
+	ErrInvalidDeviceToken = errors.New("device token must be between 1 and 512 characters")
+	ErrInvalidPlatform    = errors.New("platform must be ios, android or web")
+	ErrInvalidAppVersion  = errors.New("app version must be at most 20 characters")
+)
+
+// DevicePlatform is the operating system a device token belongs to
+type DevicePlatform string
+
+const (
+	PlatformIOS     DevicePlatform = "ios"
+	PlatformAndroid DevicePlatform = "android"
+	PlatformWeb     DevicePlatform = "web"
+)
+
+// IsValid reports whether p is a known platform
+func (p DevicePlatform) IsValid() bool {
+	return p == PlatformIOS || p == PlatformAndroid || p == PlatformWeb
+}
+
+// Device is an app installation that receives push notifications through FCM
+// DeviceID is chosen by the app and stays the same when FCM rotates the token,
+// so a user has at most one registration per installation
+type Device struct {
+	ID         string
+	UserID     string
+	DeviceID   string
+	Token      string
+	Platform   DevicePlatform
+	AppVersion string
+	// Locale is the BCP 47 language of the installation; empty uses the user's locale
+	Locale    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewDevice validates the input and creates a device registration for the user; appVersion and locale are optional
+func NewDevice(userID, deviceID, token string, platform DevicePlatform, appVersion, locale string) (*Device, error) {
+	deviceID = strings.TrimSpace(deviceID)
+	if n := utf8.RuneCountInString(deviceID); n < 1 || n > MaxDeviceIDLength {
+		return nil, ErrInvalidDeviceID
+	}
+	token = strings.TrimSpace(token)
+	if n := len(token); n < 1 || n > MaxDeviceTokenLength {
+		return nil, ErrInvalidDeviceToken
+	}
+	if !platform.IsValid() {
+		return nil, ErrInvalidPlatform
+	}
+	appVersion = strings.TrimSpace(appVersion)
+	if utf8.RuneCountInString(appVersion) > MaxAppVersionLength {
+		return nil, ErrInvalidAppVersion
+	}
+	if locale = strings.TrimSpace(locale); locale != "" {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return nil, ErrInvalidLocale
+		}
+		locale = tag.String()
+	}
+
+	now := time.Now()
+	return &Device{
+		UserID:     userID,
+		DeviceID:   deviceID,
+		Token:      token,
+		Platform:   platform,
+		AppVersion: appVersion,
+		Locale:     locale,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
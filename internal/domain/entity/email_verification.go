@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrEmailVerificationTokenNotFound = errors.New("email verification token not found")
+	ErrEmailNotVerified               = errors.New("email is not verified")
+)
+
+// EmailVerificationToken is a single-use token emailed to confirm address ownership
+// Only the SHA-256 hash of the token is stored
+type EmailVerificationToken struct {
+	ID        string
+	UserID    string
+	Email     string // the address the token was sent to
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// IsUsable reports whether the token is unused and not expired
+func (t *EmailVerificationToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// IdempotentRequest is a request a client tagged with an Idempotency-Key, kept with its response
+// so a retry of the same request gets the original response instead of running it again
+type IdempotentRequest struct {
+	UserID string
+	Key    string
+	// Fingerprint identifies the request the key was first used for; the key cannot be reused for another
+	Fingerprint string
+	// Status is 0 while the original request is still running
+	Status      int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// Completed reports whether the original request has finished and its response was stored
+func (r *IdempotentRequest) Completed() bool {
+	return r.Status != 0
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// JobLock lets one server instance at a time run a scheduled job
+type JobLock struct {
+	Name string
+	// Owner identifies the instance holding the lock until LockedUntil; empty when free
+	Owner       string
+	LockedUntil *time.Time
+	// LastRunAt is the end of the last window the job handled successfully; nil before the first run
+	LastRunAt *time.Time
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const MaxJoinRequestMessageLength = 200
+
+var (
+	ErrJoinRequestNotFound       = errors.New("join request not found")
+	ErrJoinRequestAlreadyPending = errors.New("a join request for this room is already pending")
+	ErrJoinRequestNotPending     = errors.New("join request has already been decided")
+	ErrInvalidJoinRequestMessage = errors.New("join request message must be at most 200 characters")
+)
+
+// JoinRequestStatus is the state of a join request
+type JoinRequestStatus string
+
+const (
+	JoinRequestPending  JoinRequestStatus = "pending"
+	JoinRequestApproved JoinRequestStatus = "approved"
+	JoinRequestRejected JoinRequestStatus = "rejected"
+)
+
+// JoinRequest is a user's request to become a member of a private room
+type JoinRequest struct {
+	ID      string
+	RoomID  string
+	UserID  string
+	Message string // optional note to the owner
+	Status  JoinRequestStatus
+	// DecidedBy and DecidedAt are set once the request is approved or rejected;
+	// DecidedBy stays empty when a public room admitted the user on its own
+	DecidedBy string
+	DecidedAt *time.Time
+	CreatedAt time.Time
+}
+
+// NewJoinRequest validates the message and creates a pending request
+func NewJoinRequest(roomID, userID, message string) (*JoinRequest, error) {
+	message = strings.TrimSpace(message)
+	if utf8.RuneCountInString(message) > MaxJoinRequestMessageLength {
+		return nil, ErrInvalidJoinRequestMessage
+	}
+
+	return &JoinRequest{
+		RoomID:    roomID,
+		UserID:    userID,
+		Message:   message,
+		Status:    JoinRequestPending,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// IsPending reports whether the request still awaits a decision
+func (r *JoinRequest) IsPending() bool {
+	return r.Status == JoinRequestPending
+}
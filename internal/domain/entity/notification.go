@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// NotificationType identifies what a notification is about, so clients can route taps
+type NotificationType string
+
+const (
+	NotificationJoinRequested     NotificationType = "room.join_requested"
+	NotificationJoinApproved      NotificationType = "room.join_approved"
+	NotificationJoinRejected      NotificationType = "room.join_rejected"
+	NotificationRoomInvited       NotificationType = "room.invited"
+	NotificationAnnouncement      NotificationType = "room.announcement"
+	NotificationPrayerAnswered    NotificationType = "prayer.answered"
+	NotificationPrayerPinned      NotificationType = "prayer.pinned"
+	NotificationPrayerUnpinned    NotificationType = "prayer.unpinned" // room activity for the notification webhook only
+	NotificationCommentMention    NotificationType = "prayer.comment_mention"
+	NotificationReactionMilestone NotificationType = "prayer.reaction_milestone"
+	NotificationExportReady       NotificationType = "room.export_ready"
+	NotificationExportFailed      NotificationType = "room.export_failed"
+	NotificationPrayerReminder    NotificationType = "room.prayer_reminder"
+	NotificationDigest            NotificationType = "room.digest"
+	NotificationRoomWelcome       NotificationType = "room.welcome"
+)
+
+// Emailed reports whether notifications of type t are emailed as well as pushed
+// Only join requests and the exports a user asked for are emailed; room activity is pushed
+// and kept in the inbox, so a busy room does not fill its members' mailboxes
+func (t NotificationType) Emailed() bool {
+	switch t {
+	case NotificationJoinRequested, NotificationJoinApproved, NotificationJoinRejected,
+		NotificationExportReady, NotificationExportFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// TemplateAnsweredWithTestimony is the variant of NotificationPrayerAnswered for topics answered with a testimony
+const TemplateAnsweredWithTestimony = "prayer.answered.testimony"
+
+// Notification is a message for one or more users, delivered by service.Notifier
+// Senders set Params; the notifier renders Title and Body from the message template of
+// Type, or of Template when set, in each recipient's language
+type Notification struct {
+	Type NotificationType
+	// Template picks a variant when a type has more than one message
+	Template string
+	// Params fill the {name} placeholders of the message template
+	Params map[string]string
+	Title  string
+	Body   string
+	// Data carries identifiers such as room_id for deep linking
+	Data map[string]string
+}
+
+// InboxNotification is a notification kept in a user's inbox, so it can be read even if delivery was missed
+type InboxNotification struct {
+	ID     string
+	UserID string
+	Notification
+	// DigestPending is set while the notification waits to be summarised in the user's next digest
+	DigestPending bool
+	ReadAt        *time.Time
+	CreatedAt     time.Time
+}
+
+// NewInboxNotification creates an unread inbox entry of the notification for the user
+func NewInboxNotification(userID string, n Notification, at time.Time) *InboxNotification {
+	return &InboxNotification{
+		UserID:       userID,
+		Notification: n,
+		CreatedAt:    at,
+	}
+}
+
+// IsRead reports whether the user has read the notification
+func (n *InboxNotification) IsRead() bool {
+	return n.ReadAt != nil
+}
@@ -0,0 +1,166 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidDigestFrequency = errors.New("digest frequency must be off, hourly or daily")
+	ErrInvalidSnooze          = errors.New("snooze must end in the future and within 7 days")
+)
+
+// MaxNotificationSnooze is the longest a user can hold back their pushes at once
+const MaxNotificationSnooze = 7 * 24 * time.Hour
+
+// DigestFrequency is how often a user's room activity is summarised instead of pushed one by one
+type DigestFrequency string
+
+const (
+	// DigestOff delivers every notification immediately (default)
+	DigestOff    DigestFrequency = "off"
+	DigestHourly DigestFrequency = "hourly"
+	DigestDaily  DigestFrequency = "daily"
+)
+
+// DigestFrequencies lists every frequency, for jobs that visit each in turn
+var DigestFrequencies = []DigestFrequency{DigestOff, DigestHourly, DigestDaily}
+
+// IsValid reports whether f is a known frequency
+func (f DigestFrequency) IsValid() bool {
+	return f == DigestOff || f == DigestHourly || f == DigestDaily
+}
+
+// Window is how long activity collects before it is summarised; zero for DigestOff
+func (f DigestFrequency) Window() time.Duration {
+	switch f {
+	case DigestHourly:
+		return time.Hour
+	case DigestDaily:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// NotificationSettings turn groups of notifications on or off for one user
+// Notifications outside these groups, such as export results the user asked for, are always sent
+type NotificationSettings struct {
+	// Invites covers join requests to the user's rooms, the answers to their own requests and
+	// invitations to rooms
+	Invites bool
+	// PrayerAnswered covers prayers answered in the user's rooms
+	PrayerAnswered bool
+	// Comments covers mentions in comments and reactions on the user's prayers
+	Comments bool
+	// Reminders covers the daily prayer reminders of the user's rooms
+	Reminders bool
+	// Announcements covers room announcements and prayers pinned by moderators
+	Announcements bool
+	// Digest collects room activity into one summary per room instead of a push per event
+	Digest DigestFrequency
+	// SnoozedUntil holds back every push until then; notifications still reach the inbox
+	SnoozedUntil *time.Time
+}
+
+// DefaultNotificationSettings are the settings of a new user: everything on, delivered immediately
+func DefaultNotificationSettings() NotificationSettings {
+	return NotificationSettings{
+		Invites:        true,
+		PrayerAnswered: true,
+		Comments:       true,
+		Reminders:      true,
+		Announcements:  true,
+		Digest:         DigestOff,
+	}
+}
+
+// Allows reports whether a notification of type t may be sent under the settings
+func (s NotificationSettings) Allows(t NotificationType) bool {
+	switch t {
+	case NotificationJoinRequested, NotificationJoinApproved, NotificationJoinRejected, NotificationRoomInvited:
+		return s.Invites
+	case NotificationPrayerAnswered:
+		return s.PrayerAnswered
+	case NotificationCommentMention, NotificationReactionMilestone:
+		return s.Comments
+	case NotificationAnnouncement, NotificationPrayerPinned:
+		return s.Announcements
+	case NotificationPrayerReminder:
+		return s.Reminders
+	default:
+		return true
+	}
+}
+
+// Digests reports whether a notification of type t waits for the user's digest rather than being pushed now
+// Only routine room activity does; requests, reminders and export results stay immediate
+func (s NotificationSettings) Digests(t NotificationType) bool {
+	if s.Digest == DigestOff || s.Digest == "" {
+		return false
+	}
+	switch t {
+	case NotificationPrayerAnswered, NotificationCommentMention, NotificationReactionMilestone, NotificationAnnouncement, NotificationPrayerPinned:
+		return true
+	default:
+		return false
+	}
+}
+
+// Snoozed reports whether pushes are held back at now
+func (s NotificationSettings) Snoozed(now time.Time) bool {
+	return s.SnoozedUntil != nil && now.Before(*s.SnoozedUntil)
+}
+
+// NotificationSettingsUpdate is a partial settings change; nil fields are left unchanged
+type NotificationSettingsUpdate struct {
+	Invites        *bool
+	PrayerAnswered *bool
+	Comments       *bool
+	Reminders      *bool
+	Announcements  *bool
+	Digest         *DigestFrequency
+}
+
+// UpdateNotificationSettings validates and applies the change
+func (u *User) UpdateNotificationSettings(update NotificationSettingsUpdate) error {
+	if update.Digest != nil && !update.Digest.IsValid() {
+		return ErrInvalidDigestFrequency
+	}
+	if update.Invites != nil {
+		u.NotificationSettings.Invites = *update.Invites
+	}
+	if update.PrayerAnswered != nil {
+		u.NotificationSettings.PrayerAnswered = *update.PrayerAnswered
+	}
+	if update.Comments != nil {
+		u.NotificationSettings.Comments = *update.Comments
+	}
+	if update.Reminders != nil {
+		u.NotificationSettings.Reminders = *update.Reminders
+	}
+	if update.Announcements != nil {
+		u.NotificationSettings.Announcements = *update.Announcements
+	}
+	if update.Digest != nil {
+		u.NotificationSettings.Digest = *update.Digest
+	}
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// SnoozeNotifications holds back the user's pushes until the given time
+func (u *User) SnoozeNotifications(until, now time.Time) error {
+	if !until.After(now) || until.After(now.Add(MaxNotificationSnooze)) {
+		return ErrInvalidSnooze
+	}
+	u.NotificationSettings.SnoozedUntil = &until
+	u.UpdatedAt = now
+	return nil
+}
+
+// UnsnoozeNotifications lets the user's pushes through again
+func (u *User) UnsnoozeNotifications() {
+	u.NotificationSettings.SnoozedUntil = nil
+	u.UpdatedAt = time.Now()
+}
@@ -0,0 +1,85 @@
+package entity
+
+import "time"
+
+const (
+	// MaxOutboxAttempts is how many times publishing an event is tried before it is dead-lettered
+	MaxOutboxAttempts = 8
+	// outboxRetryBaseDelay is the wait after the first failure; it doubles with every further one
+	outboxRetryBaseDelay = 15 * time.Second
+	outboxRetryMaxDelay  = time.Hour
+)
+
+// OutboxDestination is where the relay publishes an event
+type OutboxDestination string
+
+const (
+	// OutboxToMembers notifies the members of the event's room: inbox, email and push
+	OutboxToMembers OutboxDestination = "members"
+	// OutboxToWebhook posts the event to the notification webhook
+	OutboxToWebhook OutboxDestination = "webhook"
+)
+
+// OutboxEvent is a notification about a change, stored in the transaction that makes the change
+// The outbox relay publishes it once that transaction has committed, so it is neither lost when
+// the request fails after the change nor sent for a change that was rolled back
+type OutboxEvent struct {
+	ID           string
+	Destination  OutboxDestination
+	Notification Notification
+	// RoomID and ActorID address the room's members other than the actor who have not muted it,
+	// as they are when the event is published
+	RoomID  string
+	ActorID string
+	// Attempts counts the failed publishes so far
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+// NewRoomOutboxEvent creates an event for the members of the room, due right away
+func NewRoomOutboxEvent(roomID, actorID string, n Notification, now time.Time) *OutboxEvent {
+	return &OutboxEvent{
+		Destination:   OutboxToMembers,
+		Notification:  n,
+		RoomID:        roomID,
+		ActorID:       actorID,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// NewWebhookOutboxEvent creates an event for the notification webhook alone, due right away
+// It suits room activity that is not worth notifying the members about; the relay drops it when
+// no webhook is configured
+func NewWebhookOutboxEvent(roomID, actorID string, n Notification, now time.Time) *OutboxEvent {
+	return &OutboxEvent{
+		Destination:   OutboxToWebhook,
+		Notification:  n,
+		RoomID:        roomID,
+		ActorID:       actorID,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// ForWebhook copies the event for the notification webhook, due right away with no failed attempts
+// The copy needs an ID of its own; the webhook receives that ID to recognise redeliveries
+func (e *OutboxEvent) ForWebhook(now time.Time) *OutboxEvent {
+	copied := NewWebhookOutboxEvent(e.RoomID, e.ActorID, e.Notification, now)
+	copied.CreatedAt = e.CreatedAt
+	return copied
+}
+
+// Fail records a failed publish and schedules the next one with exponential backoff
+func (e *OutboxEvent) Fail(reason string, now time.Time) {
+	e.Attempts++
+	e.LastError = reason
+	e.NextAttemptAt = now.Add(min(outboxRetryBaseDelay<<(e.Attempts-1), outboxRetryMaxDelay))
+}
+
+// Exhausted reports whether the event has used all its attempts
+func (e *OutboxEvent) Exhausted() bool {
+	return e.Attempts >= MaxOutboxAttempts
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrPasswordResetTokenNotFound = errors.New("password reset token not found")
+
+// PasswordResetToken is a single-use token emailed to reset a password
+// Only the SHA-256 hash of the token is stored
+type PasswordResetToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// IsUsable reports whether the token is unused and not expired
+func (t *PasswordResetToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxPrayerCommentLength = 500
+	// MaxCommentMentions caps how many people one comment can notify
+	MaxCommentMentions = 10
+)
+
+var (
+	ErrPrayerCommentNotFound = errors.New("prayer comment not found")
+	ErrInvalidPrayerComment  = errors.New("comment must be between 1 and 500 characters")
+	ErrInvalidCommentParent  = errors.New("replies can only be made to top-level comments of the same prayer")
+)
+
+// mentionPattern matches "@nickname"; the mention ends at whitespace or punctuation other than _ . -
+// The @ must not follow a nickname character, so email addresses are not mentions
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.\-])@([\p{L}\p{N}_.\-]+)`)
+
+// PrayerComment 엔티티 - 기도제목에 달린 댓글
+// Threads are one level deep: a reply's ParentID is always a top-level comment
+type PrayerComment struct {
+	ID      string
+	TopicID string
+	// RoomID is copied from the topic so room-wide cleanup needs no join
+	RoomID string
+	// ParentID is empty for top-level comments
+	ParentID string
+	AuthorID string
+	Body     string
+	// MentionIDs are the room members mentioned in Body, resolved when the comment is saved
+	MentionIDs []string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewPrayerComment validates the body and creates a comment on the topic
+// parent is nil for a top-level comment
+func NewPrayerComment(topic *PrayerTopic, parent *PrayerComment, authorID, body string) (*PrayerComment, error) {
+	now := time.Now()
+	c := &PrayerComment{
+		TopicID:   topic.ID,
+		RoomID:    topic.RoomID,
+		AuthorID:  authorID,
+		CreatedAt: now,
+	}
+	if parent != nil {
+		if parent.IsReply() || parent.TopicID != topic.ID {
+			return nil, ErrInvalidCommentParent
+		}
+		c.ParentID = parent.ID
+	}
+	if err := c.Edit(body); err != nil {
+		return nil, err
+	}
+	c.UpdatedAt = now
+	return c, nil
+}
+
+// Edit validates and replaces the body
+// MentionIDs are left to the caller, which resolves Mentions against the room
+func (c *PrayerComment) Edit(body string) error {
+	body = strings.TrimSpace(body)
+	if n := utf8.RuneCountInString(body); n < 1 || n > MaxPrayerCommentLength {
+		return ErrInvalidPrayerComment
+	}
+	c.Body = body
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// Mentions returns the distinct nicknames mentioned in the body, in order of appearance,
+// up to MaxCommentMentions
+func (c *PrayerComment) Mentions() []string {
+	var nicknames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(c.Body, -1) {
+		// Trailing dots usually end a sentence rather than the nickname
+		nickname := strings.TrimRight(match[1], ".")
+		key := NormalizeNickname(nickname)
+		if ValidateNickname(nickname) != nil || seen[key] {
+			continue
+		}
+		seen[key] = true
+		nicknames = append(nicknames, nickname)
+		if len(nicknames) == MaxCommentMentions {
+			break
+		}
+	}
+	return nicknames
+}
+
+// IsReply reports whether the comment answers another comment
+func (c *PrayerComment) IsReply() bool {
+	return c.ParentID != ""
+}
+
+// IsAuthoredBy reports whether userID wrote the comment
+func (c *PrayerComment) IsAuthoredBy(userID string) bool {
+	return c.AuthorID == userID
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const MaxPrayerContentLength = 1000
+
+var (
+	ErrPrayerContentNotFound = errors.New("prayer content not found")
+	ErrInvalidPrayerContent  = errors.New("prayer content must be between 1 and 1000 characters")
+)
+
+// PrayerContent 엔티티 - 기도제목 아래에 쌓이는 기도 내용
+type PrayerContent struct {
+	ID      string
+	TopicID string
+	// RoomID is copied from the topic so room-wide queries and cleanup need no join
+	RoomID    string
+	AuthorID  string
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewPrayerContent validates the body and creates an entry under the topic
+func NewPrayerContent(topic *PrayerTopic, authorID, body string) (*PrayerContent, error) {
+	now := time.Now()
+	c := &PrayerContent{
+		TopicID:   topic.ID,
+		RoomID:    topic.RoomID,
+		AuthorID:  authorID,
+		CreatedAt: now,
+	}
+	if err := c.Edit(body); err != nil {
+		return nil, err
+	}
+	c.UpdatedAt = now
+	return c, nil
+}
+
+// Edit validates and replaces the body
+func (c *PrayerContent) Edit(body string) error {
+	body = strings.TrimSpace(body)
+	if n := utf8.RuneCountInString(body); n < 1 || n > MaxPrayerContentLength {
+		return ErrInvalidPrayerContent
+	}
+	c.Body = body
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsAuthoredBy reports whether userID wrote the entry
+func (c *PrayerContent) IsAuthoredBy(userID string) bool {
+	return c.AuthorID == userID
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+var ErrAlreadyReacted = errors.New("already prayed for this topic")
+
+// PrayerReactionMilestones are the reaction counts at which the topic's author is notified
+var PrayerReactionMilestones = []int{10, 50, 100, 500, 1000}
+
+// PrayerReaction records that a member prayed for a topic ("함께 기도")
+// A member has at most one reaction per topic; praying again, when the PrayerReactionPolicy counts
+// it, raises Count rather than adding a reaction
+type PrayerReaction struct {
+	TopicID string
+	// RoomID is copied from the topic so room-wide cleanup needs no join
+	RoomID string
+	UserID string
+	// Count is how many of the member's prayers for the topic were counted
+	Count int
+	// PrayedAt is when the last counted prayer was made
+	PrayedAt time.Time
+	// Anonymous keeps the member's name out of the topic's list of who prayed; they are still counted
+	// It is chosen with the member's first prayer for the topic
+	Anonymous bool
+	CreatedAt time.Time
+}
+
+// NewPrayerReaction creates the user's reaction to the topic
+func NewPrayerReaction(topic *PrayerTopic, userID string, anonymous bool) *PrayerReaction {
+	now := time.Now()
+	return &PrayerReaction{
+		TopicID:   topic.ID,
+		RoomID:    topic.RoomID,
+		UserID:    userID,
+		Count:     1,
+		PrayedAt:  now,
+		Anonymous: anonymous,
+		CreatedAt: now,
+	}
+}
+
+// PrayerReactionMode says whether a member who already prayed for a topic is counted again
+type PrayerReactionMode string
+
+const (
+	// PrayerReactOnce counts each member once per topic; praying again changes nothing (default)
+	PrayerReactOnce PrayerReactionMode = "once"
+	// PrayerReactPerWindow counts a member again once the policy's window has passed since their
+	// last counted prayer, so praying twice in one session counts once
+	PrayerReactPerWindow PrayerReactionMode = "window"
+	// PrayerReactEveryTime counts every prayer
+	PrayerReactEveryTime PrayerReactionMode = "count"
+)
+
+// PrayerReactionPolicy decides which repeated prayers count
+// Topics' reaction counts always count members; repeats only raise the member's own Count
+type PrayerReactionPolicy struct {
+	Mode PrayerReactionMode
+	// Window is how long after a counted prayer another one is ignored, for PrayerReactPerWindow
+	Window time.Duration
+}
+
+// RepeatCutoff returns the time a member's last counted prayer must be at or before for a prayer
+// made at now to count again; ok is false when repeats never count
+func (p PrayerReactionPolicy) RepeatCutoff(now time.Time) (cutoff time.Time, ok bool) {
+	switch p.Mode {
+	case PrayerReactPerWindow:
+		return now.Add(-p.Window), true
+	case PrayerReactEveryTime:
+		return now, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// IsReactionMilestone reports whether reaching count deserves telling the author
+func IsReactionMilestone(count int) bool {
+	return slices.Contains(PrayerReactionMilestones, count)
+}
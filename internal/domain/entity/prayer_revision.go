@@ -0,0 +1,50 @@
+package entity
+
+import "time"
+
+// PrayerRevisionField names what an edit changed
+type PrayerRevisionField string
+
+const (
+	PrayerRevisionTitle   PrayerRevisionField = "title"
+	PrayerRevisionContent PrayerRevisionField = "content"
+)
+
+// PrayerRevision keeps the value a topic's title or one of its entries had before an edit
+type PrayerRevision struct {
+	ID      string
+	TopicID string
+	// RoomID is copied from the topic so room-wide cleanup needs no join
+	RoomID string
+	// ContentID is the edited entry; empty for title edits
+	ContentID string
+	Field     PrayerRevisionField
+	Previous  string
+	EditorID  string
+	CreatedAt time.Time
+}
+
+// NewTitleRevision records the title a topic had before editorID changed it
+func NewTitleRevision(topic *PrayerTopic, editorID string) *PrayerRevision {
+	return &PrayerRevision{
+		TopicID:   topic.ID,
+		RoomID:    topic.RoomID,
+		Field:     PrayerRevisionTitle,
+		Previous:  topic.Title,
+		EditorID:  editorID,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewContentRevision records the body an entry had before editorID changed it
+func NewContentRevision(content *PrayerContent, editorID string) *PrayerRevision {
+	return &PrayerRevision{
+		TopicID:   content.TopicID,
+		RoomID:    content.RoomID,
+		ContentID: content.ID,
+		Field:     PrayerRevisionContent,
+		Previous:  content.Body,
+		EditorID:  editorID,
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,311 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxPrayerTopicTitleLength = 100
+	MaxPrayerTags             = 5
+	MaxPrayerTagLength        = 20
+	MaxTestimonyLength        = 1000
+)
+
+// SuggestedPrayerTags are offered by the app when tagging; any other tag is allowed too
+var SuggestedPrayerTags = []string{"감사", "치유", "인도", "회복", "가정", "진로", "건강", "선교"}
+
+var (
+	ErrPrayerTopicNotFound     = errors.New("prayer topic not found")
+	ErrPrayerTopicNotDeleted   = errors.New("prayer topic is not deleted")
+	ErrPrayerTopicAnswered     = errors.New("prayer topic is already answered")
+	ErrPrayerTopicNotAnswered  = errors.New("prayer topic is not answered")
+	ErrInvalidTestimony        = errors.New("testimony must be at most 1000 characters")
+	ErrInvalidPrayerTopicTitle = errors.New("prayer topic title must be between 1 and 100 characters")
+	ErrInvalidPrayerTags       = errors.New("a prayer topic can have at most 5 tags of 1 to 20 characters")
+	ErrInvalidPrayerRecurrence = errors.New("prayer recurrence must be weekly or monthly")
+	ErrPrayerTopicNotRecurring = errors.New("prayer topic does not recur")
+	ErrPrayerTopicPinned       = errors.New("prayer topic is already pinned")
+	ErrPrayerTopicNotPinned    = errors.New("prayer topic is not pinned")
+	ErrPrivateTopicPin         = errors.New("private prayer topics cannot be pinned")
+	ErrPinLimitReached         = errors.New("the room already has as many pinned prayer topics as allowed")
+	ErrInvalidPinOrder         = errors.New("pin order must list every pinned prayer topic of the room once")
+	ErrInvalidPrayerOrder      = errors.New("prayer order must list only your own prayer topics, each once")
+)
+
+// PrayerRecurrence re-posts a topic on a schedule, such as a weekly family worship prayer
+type PrayerRecurrence string
+
+const (
+	RecurWeekly  PrayerRecurrence = "weekly"
+	RecurMonthly PrayerRecurrence = "monthly"
+)
+
+// IsValid reports whether r is a known recurrence
+func (r PrayerRecurrence) IsValid() bool {
+	return r == RecurWeekly || r == RecurMonthly
+}
+
+// after returns the occurrence following t
+// Monthly occurrences keep the day of month, rolling over as time.AddDate does for short months
+func (r PrayerRecurrence) after(t time.Time) time.Time {
+	if r == RecurMonthly {
+		return t.AddDate(0, 1, 0)
+	}
+	return t.AddDate(0, 0, 7)
+}
+
+// nextAfter returns the first occurrence from t that is later than now
+func (r PrayerRecurrence) nextAfter(t, now time.Time) time.Time {
+	for !t.After(now) {
+		t = r.after(t)
+	}
+	return t
+}
+
+// PrayerTopic 엔티티 - 기도방에 올라온 기도제목
+type PrayerTopic struct {
+	ID       string
+	RoomID   string
+	AuthorID string
+	Title    string
+	// Tags classify the topic, such as 감사 or 치유; normalized like room tags
+	Tags []string
+	// Private topics are visible only to their author, even to the room's moderators (개인 기도)
+	Private bool
+	// Recurrence re-posts the topic as a new one at NextRecurrenceAt; the rule then moves to the copy
+	// Empty when the topic does not recur; RecurrencePaused holds it back without losing the rule
+	Recurrence       PrayerRecurrence
+	RecurrencePaused bool
+	NextRecurrenceAt *time.Time
+	// AnsweredAt is set once the topic is marked answered (기도 응답)
+	AnsweredAt *time.Time
+	// Testimony tells how the prayer was answered (응답 간증); empty when none was shared
+	Testimony string
+	// CommentCount and ReactionCount are kept in step by their repositories so feeds need no extra query
+	CommentCount  int
+	ReactionCount int
+	// PinnedAt and PinnedBy are set while a moderator keeps the topic at the top of the room's feed
+	// PinPosition orders the pinned topics of a room, lowest first; the repository assigns it
+	PinnedAt    *time.Time
+	PinnedBy    string
+	PinPosition int
+	// UserOrder places the topic in its author's "my prayers" list, lowest first; the repository assigns it
+	// Topics with none follow the ordered ones, newest first
+	UserOrder int
+	// DeletedAt and DeletedBy are set while the topic is soft-deleted, so it can be audited and restored
+	DeletedAt *time.Time
+	DeletedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewPrayerTopic validates the input and creates a topic in the room; tags and recurrence are optional
+func NewPrayerTopic(roomID, authorID, title string, tags []string, private bool, recurrence PrayerRecurrence) (*PrayerTopic, error) {
+	now := time.Now()
+	t := &PrayerTopic{
+		RoomID:    roomID,
+		AuthorID:  authorID,
+		Private:   private,
+		CreatedAt: now,
+	}
+	if err := t.Update(PrayerTopicUpdate{Title: &title, Tags: &tags, Recurrence: &recurrence}); err != nil {
+		return nil, err
+	}
+	t.UpdatedAt = now
+	return t, nil
+}
+
+// NormalizePrayerTags normalizes topic tags the way NormalizeRoomTags does for rooms
+func NormalizePrayerTags(tags []string) ([]string, error) {
+	return normalizeTags(tags, MaxPrayerTags, MaxPrayerTagLength, ErrInvalidPrayerTags)
+}
+
+// NormalizeTestimony trims a testimony and checks its length; it may be empty
+func NormalizeTestimony(testimony string) (string, error) {
+	testimony = strings.TrimSpace(testimony)
+	if utf8.RuneCountInString(testimony) > MaxTestimonyLength {
+		return "", ErrInvalidTestimony
+	}
+	return testimony, nil
+}
+
+// PrayerTopicUpdate is a partial topic change; nil fields are left unchanged
+type PrayerTopicUpdate struct {
+	Title *string
+	// Tags replaces the whole tag list
+	Tags *[]string
+	// Private moves the topic into or out of the author's private journal
+	Private *bool
+	// Recurrence may point to an empty recurrence to stop recurring
+	// Changing the rule restarts the schedule from now; repeating the current rule keeps it
+	Recurrence *PrayerRecurrence
+	// Testimony may only be set once the topic is answered; an empty one removes it
+	Testimony *string
+}
+
+// Update validates and applies the change
+// Nothing is modified when any field is invalid
+func (t *PrayerTopic) Update(u PrayerTopicUpdate) error {
+	next := *t
+
+	if u.Title != nil {
+		title := strings.TrimSpace(*u.Title)
+		if n := utf8.RuneCountInString(title); n < 1 || n > MaxPrayerTopicTitleLength {
+			return ErrInvalidPrayerTopicTitle
+		}
+		next.Title = title
+	}
+
+	if u.Tags != nil {
+		tags, err := NormalizePrayerTags(*u.Tags)
+		if err != nil {
+			return err
+		}
+		next.Tags = tags
+	}
+
+	if u.Private != nil {
+		next.Private = *u.Private
+		// Nobody else could see it pinned
+		if next.Private {
+			next.clearPin()
+		}
+	}
+
+	if u.Testimony != nil {
+		if !next.IsAnswered() {
+			return ErrPrayerTopicNotAnswered
+		}
+		testimony, err := NormalizeTestimony(*u.Testimony)
+		if err != nil {
+			return err
+		}
+		next.Testimony = testimony
+	}
+
+	now := time.Now()
+	if u.Recurrence != nil && *u.Recurrence != next.Recurrence {
+		if *u.Recurrence != "" && !u.Recurrence.IsValid() {
+			return ErrInvalidPrayerRecurrence
+		}
+		next.Recurrence = *u.Recurrence
+		next.RecurrencePaused = false
+		next.NextRecurrenceAt = nil
+		if next.Recurrence != "" {
+			at := next.Recurrence.after(now)
+			next.NextRecurrenceAt = &at
+		}
+	}
+
+	next.UpdatedAt = now
+	*t = next
+	return nil
+}
+
+// IsRecurring reports whether the topic has a recurrence rule, paused or not
+func (t *PrayerTopic) IsRecurring() bool {
+	return t.Recurrence != ""
+}
+
+// PauseRecurrence holds back or resumes the recurrence
+// A resumed schedule skips the occurrences missed while paused
+func (t *PrayerTopic) PauseRecurrence(paused bool, now time.Time) error {
+	if !t.IsRecurring() {
+		return ErrPrayerTopicNotRecurring
+	}
+	t.RecurrencePaused = paused
+	if !paused && t.NextRecurrenceAt != nil {
+		at := t.Recurrence.nextAfter(*t.NextRecurrenceAt, now)
+		t.NextRecurrenceAt = &at
+	}
+	t.UpdatedAt = now
+	return nil
+}
+
+// CancelRecurrence removes the recurrence rule
+func (t *PrayerTopic) CancelRecurrence(now time.Time) error {
+	if !t.IsRecurring() {
+		return ErrPrayerTopicNotRecurring
+	}
+	t.Recurrence = ""
+	t.RecurrencePaused = false
+	t.NextRecurrenceAt = nil
+	t.UpdatedAt = now
+	return nil
+}
+
+// Recur returns the next occurrence of a due recurring topic: a fresh copy of its title, tags
+// and visibility that takes over the recurrence rule
+// Occurrences missed while the room was archived are skipped rather than posted at once
+func (t *PrayerTopic) Recur(now time.Time) *PrayerTopic {
+	at := t.Recurrence.nextAfter(*t.NextRecurrenceAt, now)
+	return &PrayerTopic{
+		RoomID:           t.RoomID,
+		AuthorID:         t.AuthorID,
+		Title:            t.Title,
+		Tags:             t.Tags,
+		Private:          t.Private,
+		Recurrence:       t.Recurrence,
+		NextRecurrenceAt: &at,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+}
+
+// IsPinned reports whether the topic is pinned to the top of its room's feed
+func (t *PrayerTopic) IsPinned() bool {
+	return t.PinnedAt != nil
+}
+
+// Pin pins a live topic for the members of its room; private topics cannot be pinned
+func (t *PrayerTopic) Pin(by string, now time.Time) error {
+	switch {
+	case t.IsDeleted():
+		return ErrPrayerTopicNotFound
+	case t.Private:
+		return ErrPrivateTopicPin
+	case t.IsPinned():
+		return ErrPrayerTopicPinned
+	}
+	t.PinnedAt = &now
+	t.PinnedBy = by
+	return nil
+}
+
+// Unpin returns a pinned topic to its place in the feed
+func (t *PrayerTopic) Unpin() error {
+	if !t.IsPinned() {
+		return ErrPrayerTopicNotPinned
+	}
+	t.clearPin()
+	return nil
+}
+
+func (t *PrayerTopic) clearPin() {
+	t.PinnedAt = nil
+	t.PinnedBy = ""
+	t.PinPosition = 0
+}
+
+// IsDeleted reports whether the topic is soft-deleted
+func (t *PrayerTopic) IsDeleted() bool {
+	return t.DeletedAt != nil
+}
+
+// IsAnswered reports whether the topic was marked answered
+func (t *PrayerTopic) IsAnswered() bool {
+	return t.AnsweredAt != nil
+}
+
+// IsAuthoredBy reports whether userID wrote the topic
+func (t *PrayerTopic) IsAuthoredBy(userID string) bool {
+	return t.AuthorID == userID
+}
+
+// IsVisibleTo reports whether userID may see the topic at all; room access is checked separately
+func (t *PrayerTopic) IsVisibleTo(userID string) bool {
+	return !t.Private || t.IsAuthoredBy(userID)
+}
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	MaxBioLength             = 200
+	MaxProfileImageURLLength = 500
+
+	DefaultTimezone = "Asia/Seoul"
+	DefaultLocale   = "ko-KR"
+)
+
+var (
+	ErrInvalidBio             = errors.New("bio must be at most 200 characters")
+	ErrInvalidProfileImageURL = errors.New("profile image URL must be an https URL of at most 500 characters")
+	ErrInvalidTimezone        = errors.New("timezone must be an IANA time zone such as Asia/Seoul")
+	ErrInvalidLocale          = errors.New("locale must be a BCP 47 language tag such as ko-KR")
+)
+
+// ProfileUpdate is a partial profile change; nil fields are left unchanged
+// An empty Bio or ProfileImageURL clears the field
+type ProfileUpdate struct {
+	Nickname        *string
+	Bio             *string
+	ProfileImageURL *string
+	Timezone        *string
+	Locale          *string
+	// HiddenFromSearch opts the user out of (or back into) user search
+	HiddenFromSearch *bool
+}
+
+// UpdateProfile validates and applies the change
+// Nothing is modified when any field is invalid
+func (u *User) UpdateProfile(p ProfileUpdate) error {
+	next := *u
+
+	if p.Nickname != nil {
+		nickname := strings.TrimSpace(*p.Nickname)
+		if err := ValidateNickname(nickname); err != nil {
+			return err
+		}
+		next.Nickname = nickname
+	}
+
+	if p.Bio != nil {
+		bio := strings.TrimSpace(*p.Bio)
+		if utf8.RuneCountInString(bio) > MaxBioLength {
+			return ErrInvalidBio
+		}
+		next.Bio = bio
+	}
+
+	if p.ProfileImageURL != nil {
+		raw := strings.TrimSpace(*p.ProfileImageURL)
+		if raw != "" && !isValidImageURL(raw) {
+			return ErrInvalidProfileImageURL
+		}
+		next.ProfileImageURL = raw
+	}
+
+	if p.Timezone != nil {
+		tz := strings.TrimSpace(*p.Timezone)
+		// LoadLocation accepts "" and "Local", which are not portable zone names
+		if tz == "" || tz == "Local" {
+			return ErrInvalidTimezone
+		}
+		if _, err := time.LoadLocation(tz); err != nil {
+			return ErrInvalidTimezone
+		}
+		next.Timezone = tz
+	}
+
+	if p.Locale != nil {
+		tag, err := language.Parse(strings.TrimSpace(*p.Locale))
+		if err != nil {
+			return ErrInvalidLocale
+		}
+		next.Locale = tag.String()
+	}
+
+	if p.HiddenFromSearch != nil {
+		next.HiddenFromSearch = *p.HiddenFromSearch
+	}
+
+	next.UpdatedAt = time.Now()
+	*u = next
+	return nil
+}
+
+func isValidImageURL(raw string) bool {
+	if len(raw) > MaxProfileImageURLLength {
+		return false
+	}
+	parsed, err := url.Parse(raw)
+	return err == nil && parsed.Scheme == "https" && parsed.Host != ""
+}
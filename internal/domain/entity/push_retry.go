@@ -0,0 +1,58 @@
+package entity
+
+import "time"
+
+const (
+	// MaxPushAttempts is how many times a push to a device is tried before it is dead-lettered
+	MaxPushAttempts = 6
+	// pushRetryBaseDelay is the wait after the first failure; it doubles with every further one
+	pushRetryBaseDelay = 30 * time.Second
+	pushRetryMaxDelay  = time.Hour
+)
+
+// PushRetry is a push to one device that failed and waits to be sent again
+// Once out of attempts it is kept as a dead letter for inspection
+type PushRetry struct {
+	ID           string
+	Token        string
+	Notification Notification
+	// Attempts counts the sends tried so far, including the original one
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+// NewPushRetry records the failed first attempt of a push to the token and schedules the next one
+func NewPushRetry(token string, n Notification, reason string, now time.Time) *PushRetry {
+	r := &PushRetry{
+		Token:        token,
+		Notification: n,
+		CreatedAt:    now,
+	}
+	r.Fail(reason, now)
+	return r
+}
+
+// NewQueuedPush queues a push to the token that has not been tried yet, due at dueAt
+// Whoever queued it sends it right away; the retrier only picks it up if that never settled it
+func NewQueuedPush(token string, n Notification, dueAt time.Time, now time.Time) *PushRetry {
+	return &PushRetry{
+		Token:         token,
+		Notification:  n,
+		NextAttemptAt: dueAt,
+		CreatedAt:     now,
+	}
+}
+
+// Fail records another failed attempt and schedules the next one with exponential backoff
+func (r *PushRetry) Fail(reason string, now time.Time) {
+	r.Attempts++
+	r.LastError = reason
+	r.NextAttemptAt = now.Add(min(pushRetryBaseDelay<<(r.Attempts-1), pushRetryMaxDelay))
+}
+
+// Exhausted reports whether the push has used all its attempts
+func (r *PushRetry) Exhausted() bool {
+	return r.Attempts >= MaxPushAttempts
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrRefreshTokenNotFound = errors.New("refresh token not found")
+
+// RefreshToken is the server-side record of an issued refresh token
+// Tokens rotated from the same login share a FamilyID; replaying a used
+// token revokes the whole family
+type RefreshToken struct {
+	ID        string // jti claim
+	FamilyID  string
+	UserID    string
+	DeviceID  string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// IsUsed reports whether the token was already exchanged
+func (t *RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsRevoked reports whether the token's family was revoked
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired reports whether the token is past its expiry
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,320 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxRoomNameLength        = 50
+	MaxRoomDescriptionLength = 500
+	MaxRoomTags              = 5
+	MaxRoomTagLength         = 20
+)
+
+var (
+	ErrRoomNotFound           = errors.New("room not found")
+	ErrNotRoomMember          = errors.New("not a member of this room")
+	ErrRoomPermissionDenied   = errors.New("your room role does not allow this")
+	ErrRoomArchived           = errors.New("room is archived")
+	ErrRoomNotArchived        = errors.New("room is not archived")
+	ErrInvalidRoomRole        = errors.New("room role must be moderator or member")
+	ErrInvalidRoomName        = errors.New("room name must be between 1 and 50 characters")
+	ErrInvalidRoomDescription = errors.New("room description must be at most 500 characters")
+	ErrInvalidRoomVisibility  = errors.New("room visibility must be public or private")
+	ErrInvalidRoomCategory    = errors.New("unknown room category")
+	ErrInvalidRoomTags        = errors.New("a room can have at most 5 tags of 1 to 20 characters")
+	ErrInvalidCoverImage      = errors.New("cover image must be a JPEG or PNG of at most 5 MB and 4096x4096 pixels")
+)
+
+// RoomVisibility controls who can find and read a room
+type RoomVisibility string
+
+const (
+	// RoomPrivate rooms are only visible to their members
+	RoomPrivate RoomVisibility = "private"
+	// RoomPublic rooms can be read by any signed-in user
+	RoomPublic RoomVisibility = "public"
+)
+
+// IsValid reports whether v is a known visibility
+func (v RoomVisibility) IsValid() bool {
+	return v == RoomPrivate || v == RoomPublic
+}
+
+// RoomCategory is the fixed classification of a room, chosen from RoomCategories
+type RoomCategory string
+
+const (
+	RoomCategoryFamily  RoomCategory = "family"
+	RoomCategoryChurch  RoomCategory = "church"
+	RoomCategoryMission RoomCategory = "mission"
+	RoomCategoryCell    RoomCategory = "cell"
+	RoomCategoryFriends RoomCategory = "friends"
+	RoomCategoryOther   RoomCategory = "other"
+)
+
+// RoomCategories lists every category in display order
+var RoomCategories = []RoomCategory{
+	RoomCategoryFamily,
+	RoomCategoryChurch,
+	RoomCategoryMission,
+	RoomCategoryCell,
+	RoomCategoryFriends,
+	RoomCategoryOther,
+}
+
+// roomCategoryLabels are the names shown in the app
+var roomCategoryLabels = map[RoomCategory]string{
+	RoomCategoryFamily:  "가족",
+	RoomCategoryChurch:  "교회",
+	RoomCategoryMission: "선교",
+	RoomCategoryCell:    "구역/셀",
+	RoomCategoryFriends: "친구",
+	RoomCategoryOther:   "기타",
+}
+
+// Label returns the display name of the category
+func (c RoomCategory) Label() string {
+	return roomCategoryLabels[c]
+}
+
+// IsValid reports whether c is a known category
+func (c RoomCategory) IsValid() bool {
+	for _, known := range RoomCategories {
+		if c == known {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeRoomTags trims, lower-cases, de-duplicates and sorts free-form tags
+// A leading '#' is dropped so "#기도" and "기도" are the same tag
+func NormalizeRoomTags(tags []string) ([]string, error) {
+	return normalizeTags(tags, MaxRoomTags, MaxRoomTagLength, ErrInvalidRoomTags)
+}
+
+// normalizeTags implements tag normalization for rooms and prayer topics,
+// failing with invalid when a tag is empty or too long or there are more than maxTags
+func normalizeTags(tags []string, maxTags, maxLength int, invalid error) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(tag), "#")))
+		if n := utf8.RuneCountInString(tag); n < 1 || n > maxLength {
+			return nil, invalid
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > maxTags {
+		return nil, invalid
+	}
+	slices.Sort(normalized)
+	return normalized, nil
+}
+
+// Room 엔티티 - 함께 기도하는 기도방
+type Room struct {
+	ID          string
+	Name        string
+	Description string
+	Visibility  RoomVisibility
+	// Category is empty for uncategorized rooms
+	Category RoomCategory
+	Tags     []string
+	OwnerID  string
+	Settings RoomSettings
+	// CoverImageURL is empty when no cover is set; CoverImageKey locates the file in storage
+	CoverImageURL string
+	CoverImageKey string
+	// ArchivedAt is set while the room is archived and read-only
+	ArchivedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// RoomMember is a user's membership in a room
+type RoomMember struct {
+	RoomID string
+	UserID string
+	Role   RoomRole
+	// InvitedBy is the user whose invite the member accepted; empty for the creator
+	InvitedBy string
+	// Muted members are left out of room-wide notifications
+	Muted    bool
+	JoinedAt time.Time
+	// LastSeenAt is when the member last opened the room; nil until they do
+	LastSeenAt *time.Time
+}
+
+// RoomRole is a member's role inside one room, independent of the application-wide Role
+type RoomRole string
+
+const (
+	RoomRoleOwner     RoomRole = "owner"
+	RoomRoleModerator RoomRole = "moderator"
+	RoomRoleMember    RoomRole = "member"
+)
+
+// IsValid reports whether r is a known room role
+func (r RoomRole) IsValid() bool {
+	switch r {
+	case RoomRoleOwner, RoomRoleModerator, RoomRoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// RoomPermission is an action inside a room that depends on the member's role
+type RoomPermission string
+
+const (
+	PermPostPrayer    RoomPermission = "prayer:post"
+	PermPinPrayer     RoomPermission = "prayer:pin"
+	PermRemovePrayer  RoomPermission = "prayer:remove" // someone else's prayer
+	PermInviteMembers RoomPermission = "members:invite"
+	PermAnnounce      RoomPermission = "room:announce"
+	PermManageMembers RoomPermission = "members:manage"
+	PermUpdateRoom    RoomPermission = "room:update"
+	PermArchiveRoom   RoomPermission = "room:archive"
+	PermDeleteRoom    RoomPermission = "room:delete"
+)
+
+// roomRolePermissions lists what each role may do; higher roles repeat the lower ones
+var roomRolePermissions = map[RoomRole][]RoomPermission{
+	RoomRoleMember:    {PermPostPrayer},
+	RoomRoleModerator: {PermPostPrayer, PermPinPrayer, PermRemovePrayer, PermInviteMembers, PermAnnounce},
+	RoomRoleOwner:     {PermPostPrayer, PermPinPrayer, PermRemovePrayer, PermInviteMembers, PermAnnounce, PermManageMembers, PermUpdateRoom, PermArchiveRoom, PermDeleteRoom},
+}
+
+// AllowedWhenArchived reports whether the permission may be used in an archived room
+// Everything else changes the room's content, which is frozen while archived
+func (p RoomPermission) AllowedWhenArchived() bool {
+	return p == PermArchiveRoom || p == PermDeleteRoom
+}
+
+// Can reports whether the role grants the permission
+func (r RoomRole) Can(p RoomPermission) bool {
+	for _, granted := range roomRolePermissions[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Can reports whether the member's role grants the permission
+func (m *RoomMember) Can(p RoomPermission) bool {
+	return m.Role.Can(p)
+}
+
+// NewRoom validates the input and creates a room owned by ownerID
+// An empty visibility defaults to private; category and tags are optional
+func NewRoom(ownerID, name, description string, visibility RoomVisibility, category RoomCategory, tags []string) (*Room, error) {
+	if visibility == "" {
+		visibility = RoomPrivate
+	}
+
+	now := time.Now()
+	room := &Room{
+		OwnerID:   ownerID,
+		Settings:  DefaultRoomSettings(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	update := RoomUpdate{
+		Name:        &name,
+		Description: &description,
+		Visibility:  &visibility,
+		Category:    &category,
+		Tags:        &tags,
+	}
+	if err := room.Update(update); err != nil {
+		return nil, err
+	}
+	room.UpdatedAt = now
+	return room, nil
+}
+
+// RoomUpdate is a partial room change; nil fields are left unchanged
+type RoomUpdate struct {
+	Name        *string
+	Description *string
+	Visibility  *RoomVisibility
+	// Category may point to an empty category to clear it
+	Category *RoomCategory
+	// Tags replaces the whole tag list
+	Tags *[]string
+}
+
+// Update validates and applies the change
+// Nothing is modified when any field is invalid
+func (r *Room) Update(u RoomUpdate) error {
+	next := *r
+
+	if u.Name != nil {
+		name := strings.TrimSpace(*u.Name)
+		if n := utf8.RuneCountInString(name); n < 1 || n > MaxRoomNameLength {
+			return ErrInvalidRoomName
+		}
+		next.Name = name
+	}
+
+	if u.Description != nil {
+		description := strings.TrimSpace(*u.Description)
+		if utf8.RuneCountInString(description) > MaxRoomDescriptionLength {
+			return ErrInvalidRoomDescription
+		}
+		next.Description = description
+	}
+
+	if u.Visibility != nil {
+		if !u.Visibility.IsValid() {
+			return ErrInvalidRoomVisibility
+		}
+		next.Visibility = *u.Visibility
+	}
+
+	if u.Category != nil {
+		if *u.Category != "" && !u.Category.IsValid() {
+			return ErrInvalidRoomCategory
+		}
+		next.Category = *u.Category
+	}
+
+	if u.Tags != nil {
+		tags, err := NormalizeRoomTags(*u.Tags)
+		if err != nil {
+			return err
+		}
+		next.Tags = tags
+	}
+
+	next.UpdatedAt = time.Now()
+	*r = next
+	return nil
+}
+
+// IsOwnedBy reports whether userID owns the room
+func (r *Room) IsOwnedBy(userID string) bool {
+	return r.OwnerID == userID
+}
+
+// IsArchived reports whether the room is archived
+func (r *Room) IsArchived() bool {
+	return r.ArchivedAt != nil
+}
+
+// IsPublic reports whether non-members can read the room
+func (r *Room) IsPublic() bool {
+	return r.Visibility == RoomPublic
+}
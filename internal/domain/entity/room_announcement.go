@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const MaxAnnouncementLength = 1000
+
+var (
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+	ErrInvalidAnnouncement  = errors.New("announcement must be between 1 and 1000 characters")
+)
+
+// RoomAnnouncement is a notice posted to every member of a room (공지사항)
+type RoomAnnouncement struct {
+	ID        string
+	RoomID    string
+	AuthorID  string
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewRoomAnnouncement validates the body and creates an announcement
+func NewRoomAnnouncement(roomID, authorID, body string) (*RoomAnnouncement, error) {
+	now := time.Now()
+	a := &RoomAnnouncement{
+		RoomID:    roomID,
+		AuthorID:  authorID,
+		CreatedAt: now,
+	}
+	if err := a.Edit(body); err != nil {
+		return nil, err
+	}
+	a.UpdatedAt = now
+	return a, nil
+}
+
+// Edit validates and replaces the body
+func (a *RoomAnnouncement) Edit(body string) error {
+	body = strings.TrimSpace(body)
+	if n := utf8.RuneCountInString(body); n < 1 || n > MaxAnnouncementLength {
+		return ErrInvalidAnnouncement
+	}
+	a.Body = body
+	a.UpdatedAt = time.Now()
+	return nil
+}
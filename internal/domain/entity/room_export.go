@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrRoomExportNotFound  = errors.New("export not found")
+	ErrInvalidExportFormat = errors.New("export format must be csv or pdf")
+)
+
+// ExportFormat is the file type of a room export
+type ExportFormat string
+
+const (
+	ExportCSV ExportFormat = "csv"
+	ExportPDF ExportFormat = "pdf"
+)
+
+// IsValid reports whether f is a known format
+func (f ExportFormat) IsValid() bool {
+	return f == ExportCSV || f == ExportPDF
+}
+
+// ContentType returns the MIME type of files in the format
+func (f ExportFormat) ContentType() string {
+	if f == ExportPDF {
+		return "application/pdf"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// ExportStatus is the state of a room export
+type ExportStatus string
+
+const (
+	ExportPending ExportStatus = "pending"
+	ExportRunning ExportStatus = "running"
+	ExportDone    ExportStatus = "done"
+	ExportFailed  ExportStatus = "failed"
+)
+
+// RoomExport is a requested file of a room's prayer history, generated in the background
+type RoomExport struct {
+	ID          string
+	RoomID      string
+	RequestedBy string
+	Format      ExportFormat
+	Status      ExportStatus
+	// FileKey locates the generated file in storage once the export is done
+	FileKey string
+	// StartedAt is set when a run picks the export up, so runs that died can be retried
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+}
+
+// NewRoomExport creates a pending export of the room for the user; an empty format means CSV
+func NewRoomExport(roomID, requestedBy string, format ExportFormat) (*RoomExport, error) {
+	if format == "" {
+		format = ExportCSV
+	}
+	if !format.IsValid() {
+		return nil, ErrInvalidExportFormat
+	}
+
+	return &RoomExport{
+		RoomID:      roomID,
+		RequestedBy: requestedBy,
+		Format:      format,
+		Status:      ExportPending,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// IsFinished reports whether the export is done or failed
+func (e *RoomExport) IsFinished() bool {
+	return e.Status == ExportDone || e.Status == ExportFailed
+}
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// RoomInvitationTTL is how long an invitation can be answered
+const RoomInvitationTTL = 14 * 24 * time.Hour
+
+var (
+	ErrInvitationNotFound       = errors.New("invitation not found")
+	ErrInvitationAlreadyPending = errors.New("the user already has a pending invitation to this room")
+	ErrInvitationNotPending     = errors.New("invitation has already been answered or has expired")
+	ErrInvalidInvitationStatus  = errors.New("invitation status must be pending, accepted, rejected or expired")
+	ErrCannotInviteSelf         = errors.New("cannot invite yourself")
+)
+
+// InvitationStatus is the state of a room invitation
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationRejected InvitationStatus = "rejected"
+	// InvitationExpired is never stored: a pending invitation past its expiry reads as expired
+	InvitationExpired InvitationStatus = "expired"
+)
+
+// ParseInvitationStatus validates a status filter; an empty value means pending
+func ParseInvitationStatus(s string) (InvitationStatus, error) {
+	switch status := InvitationStatus(s); status {
+	case "":
+		return InvitationPending, nil
+	case InvitationPending, InvitationAccepted, InvitationRejected, InvitationExpired:
+		return status, nil
+	default:
+		return "", ErrInvalidInvitationStatus
+	}
+}
+
+// RoomInvitation invites one user to a room; unlike a RoomInvite code it is addressed to them
+// and waits in their invitation inbox until they answer it or it expires
+type RoomInvitation struct {
+	ID        string
+	RoomID    string
+	InviterID string
+	InviteeID string
+	// Status is pending, accepted or rejected as stored; see StatusAt for expiry
+	Status      InvitationStatus
+	ExpiresAt   time.Time
+	RespondedAt *time.Time
+	CreatedAt   time.Time
+}
+
+// NewRoomInvitation creates a pending invitation that expires after RoomInvitationTTL
+func NewRoomInvitation(roomID, inviterID, inviteeID string) (*RoomInvitation, error) {
+	if inviterID == inviteeID {
+		return nil, ErrCannotInviteSelf
+	}
+
+	now := time.Now()
+	return &RoomInvitation{
+		RoomID:    roomID,
+		InviterID: inviterID,
+		InviteeID: inviteeID,
+		Status:    InvitationPending,
+		ExpiresAt: now.Add(RoomInvitationTTL),
+		CreatedAt: now,
+	}, nil
+}
+
+// StatusAt returns the status at now, reporting unanswered invitations past their expiry as expired
+func (i *RoomInvitation) StatusAt(now time.Time) InvitationStatus {
+	if i.Status == InvitationPending && !now.Before(i.ExpiresAt) {
+		return InvitationExpired
+	}
+	return i.Status
+}
+
+// IsPending reports whether the invitation can still be answered at now
+func (i *RoomInvitation) IsPending(now time.Time) bool {
+	return i.StatusAt(now) == InvitationPending
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	MaxInviteUses = 100
+	MaxInviteTTL  = 30 * 24 * time.Hour
+)
+
+var (
+	ErrInviteNotFound    = errors.New("invite not found")
+	ErrInviteExpired     = errors.New("invite has expired or has been used up")
+	ErrInvalidInvite     = errors.New("invite must allow at most 100 uses and expire within 30 days")
+	ErrAlreadyRoomMember = errors.New("already a member of this room")
+	// ErrInviteCodeTaken is returned by the repository when a generated code collides
+	ErrInviteCodeTaken = errors.New("invite code already exists")
+)
+
+// RoomInvite is a shareable code that lets people join a room
+type RoomInvite struct {
+	ID        string
+	RoomID    string
+	Code      string
+	CreatedBy string
+	MaxUses   int // 0 means unlimited
+	UseCount  int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// NewRoomInvite validates the limits and creates an invite without a code
+func NewRoomInvite(roomID, createdBy string, maxUses int, ttl time.Duration) (*RoomInvite, error) {
+	if maxUses < 0 || maxUses > MaxInviteUses || ttl <= 0 || ttl > MaxInviteTTL {
+		return nil, ErrInvalidInvite
+	}
+
+	now := time.Now()
+	return &RoomInvite{
+		RoomID:    roomID,
+		CreatedBy: createdBy,
+		MaxUses:   maxUses,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, nil
+}
+
+// InviteRejection says why an invite code cannot be accepted
+type InviteRejection string
+
+const (
+	InviteRejectionNotFound  InviteRejection = "not_found"
+	InviteRejectionExpired   InviteRejection = "expired"
+	InviteRejectionExhausted InviteRejection = "exhausted"
+	InviteRejectionArchived  InviteRejection = "room_archived"
+)
+
+// IsUsable reports whether the invite can still be accepted at now
+func (i *RoomInvite) IsUsable(now time.Time) bool {
+	return i.Rejection(now) == ""
+}
+
+// Rejection returns why the invite cannot be accepted at now, or "" when it can
+// An invite that has both expired and been used up is reported as expired
+func (i *RoomInvite) Rejection(now time.Time) InviteRejection {
+	if !now.Before(i.ExpiresAt) {
+		return InviteRejectionExpired
+	}
+	if i.MaxUses != 0 && i.UseCount >= i.MaxUses {
+		return InviteRejectionExhausted
+	}
+	return ""
+}
+
+// RemainingUses returns how many more people can join with the invite; ok is false for unlimited invites
+func (i *RoomInvite) RemainingUses() (remaining int, ok bool) {
+	if i.MaxUses == 0 {
+		return 0, false
+	}
+	return max(i.MaxUses-i.UseCount, 0), true
+}
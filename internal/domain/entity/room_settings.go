@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+const MaxRoomMemberCap = 1000
+
+var (
+	ErrRoomFull              = errors.New("room has reached its member cap")
+	ErrInvalidMemberCap      = errors.New("member cap must be 0 (unlimited) or between 2 and 1000")
+	ErrInvalidReminderTime   = errors.New("reminder time must be HH:MM in 24-hour format")
+	ErrInvalidRoomPostPolicy = errors.New("post policy must be members or moderators")
+)
+
+// RoomPostPolicy decides which members may post prayers in a room
+type RoomPostPolicy string
+
+const (
+	// PostByMembers lets every member post (default)
+	PostByMembers RoomPostPolicy = "members"
+	// PostByModerators limits posting to moderators and the owner
+	PostByModerators RoomPostPolicy = "moderators"
+)
+
+// IsValid reports whether p is a known post policy
+func (p RoomPostPolicy) IsValid() bool {
+	return p == PostByMembers || p == PostByModerators
+}
+
+// RoomSettings are the owner-controlled rules of a room
+type RoomSettings struct {
+	// MemberCap limits the number of members; 0 means unlimited
+	MemberCap int
+	// ReminderTime is the default daily reminder as "HH:MM" in the member's local time; empty for none
+	ReminderTime string
+	PostPolicy   RoomPostPolicy
+}
+
+// DefaultRoomSettings are the settings of a new room
+func DefaultRoomSettings() RoomSettings {
+	return RoomSettings{PostPolicy: PostByMembers}
+}
+
+// Permits applies the settings on top of the role permissions
+// It only narrows what roles grant, never widens it
+func (s RoomSettings) Permits(role RoomRole, p RoomPermission) bool {
+	if p == PermPostPrayer && s.PostPolicy == PostByModerators {
+		return role == RoomRoleOwner || role == RoomRoleModerator
+	}
+	return true
+}
+
+// RoomSettingsUpdate is a partial settings change; nil fields are left unchanged
+// Visibility is the room's privacy level, also editable through the room itself
+type RoomSettingsUpdate struct {
+	Visibility   *RoomVisibility
+	MemberCap    *int
+	ReminderTime *string
+	PostPolicy   *RoomPostPolicy
+}
+
+// UpdateSettings validates and applies the change
+// Nothing is modified when any field is invalid
+// Lowering the cap below the current member count keeps everyone but blocks new joins
+func (r *Room) UpdateSettings(u RoomSettingsUpdate) error {
+	next := *r
+
+	if u.Visibility != nil {
+		if !u.Visibility.IsValid() {
+			return ErrInvalidRoomVisibility
+		}
+		next.Visibility = *u.Visibility
+	}
+
+	if u.MemberCap != nil {
+		// A cap of 1 would leave room for nobody but the owner
+		if c := *u.MemberCap; c != 0 && (c < 2 || c > MaxRoomMemberCap) {
+			return ErrInvalidMemberCap
+		}
+		next.Settings.MemberCap = *u.MemberCap
+	}
+
+	if u.ReminderTime != nil {
+		reminderTime := *u.ReminderTime
+		if reminderTime != "" {
+			t, err := time.Parse("15:04", reminderTime)
+			if err != nil {
+				return ErrInvalidReminderTime
+			}
+			// Stored zero-padded, so "7:30" becomes "07:30"
+			reminderTime = t.Format("15:04")
+		}
+		next.Settings.ReminderTime = reminderTime
+	}
+
+	if u.PostPolicy != nil {
+		if !u.PostPolicy.IsValid() {
+			return ErrInvalidRoomPostPolicy
+		}
+		next.Settings.PostPolicy = *u.PostPolicy
+	}
+
+	next.UpdatedAt = time.Now()
+	*r = next
+	return nil
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// Session is one signed-in device, backed by a refresh token family
+// ID is the family ID, which stays stable across token rotations
+type Session struct {
+	ID           string
+	DeviceID     string
+	SignedInAt   time.Time // first token of the family
+	LastActiveAt time.Time // latest rotation
+	ExpiresAt    time.Time
+}
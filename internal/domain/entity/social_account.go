@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// SocialProvider identifies an external identity provider
+type SocialProvider string
+
+const (
+	ProviderKakao  SocialProvider = "kakao"
+	ProviderGoogle SocialProvider = "google"
+	ProviderApple  SocialProvider = "apple"
+)
+
+var (
+	ErrUnsupportedProvider        = errors.New("unsupported social provider")
+	ErrSocialAccountAlreadyLinked = errors.New("social account is already linked to another user")
+	ErrSocialAccountNotFound      = errors.New("social account not found")
+	// ErrSocialLinkRequired means an account with an unverified email holds the provider's email
+	ErrSocialLinkRequired = errors.New("an account with this email exists, sign in to it to link the provider")
+)
+
+// ParseSocialProvider validates a provider name from user input
+func ParseSocialProvider(name string) (SocialProvider, error) {
+	switch p := SocialProvider(name); p {
+	case ProviderKakao, ProviderGoogle, ProviderApple:
+		return p, nil
+	default:
+		return "", ErrUnsupportedProvider
+	}
+}
+
+// SocialAccount links an external provider identity to a user
+// A user may link several providers, but each provider identity belongs to one user
+type SocialAccount struct {
+	ID             string
+	UserID         string
+	Provider       SocialProvider
+	ProviderUserID string
+	Email          string
+	CreatedAt      time.Time
+}
+
+// SocialIdentity is the identity asserted by a verified provider ID token
+type SocialIdentity struct {
+	Provider      SocialProvider
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrTwoFactorNotEnrolled    = errors.New("two-factor authentication is not enrolled")
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrTwoFactorLocked         = errors.New("too many invalid two-factor codes, try again later")
+)
+
+// TwoFactor is a user's TOTP enrollment
+// It is pending until the first code is confirmed (EnabledAt set)
+type TwoFactor struct {
+	UserID         string
+	Secret         string // base32 TOTP secret
+	EnabledAt      *time.Time
+	LastUsedStep   int64 // last accepted time step, rejects replayed codes
+	FailedAttempts int
+	LockedUntil    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// IsEnabled reports whether the enrollment was confirmed
+func (t *TwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// IsLocked reports whether verification is blocked after repeated failures
+func (t *TwoFactor) IsLocked(now time.Time) bool {
+	return t.LockedUntil != nil && now.Before(*t.LockedUntil)
+}
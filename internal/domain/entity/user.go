@@ -0,0 +1,226 @@
+package entity
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72 // bcrypt ignores anything beyond 72 bytes
+	MinNicknameLength = 2
+	MaxNicknameLength = 20
+
+	MaxSuspensionReasonLength = 500
+)
+
+var (
+	ErrInvalidEmail       = errors.New("invalid email")
+	ErrWeakPassword       = errors.New("password must be between 8 and 72 characters")
+	ErrInvalidNickname    = errors.New("nickname must be between 2 and 20 characters")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrEmailAlreadyExists = errors.New("email already exists")
+	ErrNicknameTaken      = errors.New("nickname is already taken")
+
+	ErrAccountDeletionNotScheduled = errors.New("account deletion is not scheduled")
+	ErrNotGuest                    = errors.New("account is not a guest account")
+	ErrAccountSuspended            = errors.New("account is suspended")
+	ErrCannotSuspendSelf           = errors.New("cannot suspend your own account")
+	ErrInvalidSuspensionReason     = errors.New("suspension reason must be between 1 and 500 characters")
+)
+
+// Role is a user's application-wide role
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+	// RoleGuest is an anonymous trial user without credentials
+	RoleGuest Role = "guest"
+)
+
+// IsValid reports whether r is a known role
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleUser, RoleModerator, RoleAdmin, RoleGuest:
+		return true
+	default:
+		return false
+	}
+}
+
+// User 엔티티 - 외부 의존성 없음
+type User struct {
+	ID           string
+	Email        string
+	Nickname     string
+	PasswordHash string
+	Role         Role
+	// Profile
+	Bio             string
+	ProfileImageURL string
+	Timezone        string // IANA zone, used for reminder times
+	Locale          string // BCP 47 tag
+	// HiddenFromSearch opts the user out of user search
+	HiddenFromSearch bool
+	// EmailVerifiedAt is nil until the user proves ownership of Email
+	EmailVerifiedAt *time.Time
+	// PurgeAt is set while the account is deleted but still inside its grace period
+	PurgeAt *time.Time
+	// SuspendedAt is set while an operator has locked the account; the reason is internal
+	SuspendedAt      *time.Time
+	SuspensionReason string
+	// NotificationSettings say which notifications the user wants
+	NotificationSettings NotificationSettings
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// NewUser validates signup input and creates a user
+// The password is only validated here; hashing is the usecase's responsibility
+func NewUser(email, nickname, password string) (*User, error) {
+	email = NormalizeEmail(email)
+	if !isValidEmail(email) {
+		return nil, ErrInvalidEmail
+	}
+
+	nickname = strings.TrimSpace(nickname)
+	if err := ValidateNickname(nickname); err != nil {
+		return nil, err
+	}
+
+	if err := ValidatePassword(password); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &User{
+		Email:                email,
+		Nickname:             nickname,
+		Role:                 RoleUser,
+		Timezone:             DefaultTimezone,
+		Locale:               DefaultLocale,
+		NotificationSettings: DefaultNotificationSettings(),
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}, nil
+}
+
+// NewSocialUser creates a user authenticated only through a social provider
+// Email is optional (providers may withhold it) and no password is set
+// A provided email is already verified by the provider, so it is marked verified
+func NewSocialUser(email, nickname string) (*User, error) {
+	email = NormalizeEmail(email)
+	if email != "" && !isValidEmail(email) {
+		return nil, ErrInvalidEmail
+	}
+
+	nickname = strings.TrimSpace(nickname)
+	if err := ValidateNickname(nickname); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user := &User{
+		Email:                email,
+		Nickname:             nickname,
+		Role:                 RoleUser,
+		Timezone:             DefaultTimezone,
+		Locale:               DefaultLocale,
+		NotificationSettings: DefaultNotificationSettings(),
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+	if email != "" {
+		user.EmailVerifiedAt = &now
+	}
+	return user, nil
+}
+
+// NewGuestUser creates an anonymous user for trying the app before signing up
+func NewGuestUser(nicknameSuffix string) *User {
+	now := time.Now()
+	return &User{
+		Nickname:             "게스트-" + nicknameSuffix,
+		Role:                 RoleGuest,
+		Timezone:             DefaultTimezone,
+		Locale:               DefaultLocale,
+		NotificationSettings: DefaultNotificationSettings(),
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+}
+
+// IsGuest reports whether the user is an anonymous guest
+func (u *User) IsGuest() bool {
+	return u.Role == RoleGuest
+}
+
+// IsEmailVerified reports whether the user has verified their email
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// IsDeletionScheduled reports whether the account is waiting to be purged
+func (u *User) IsDeletionScheduled() bool {
+	return u.PurgeAt != nil
+}
+
+// IsSuspended reports whether an operator has locked the account
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// ValidateSuspensionReason checks the reason an operator gives for a suspension
+func ValidateSuspensionReason(reason string) error {
+	if n := utf8.RuneCountInString(strings.TrimSpace(reason)); n < 1 || n > MaxSuspensionReasonLength {
+		return ErrInvalidSuspensionReason
+	}
+	return nil
+}
+
+// HasPassword reports whether the user can log in with email and password
+func (u *User) HasPassword() bool {
+	return u.PasswordHash != ""
+}
+
+// ValidatePassword checks the password policy
+func ValidatePassword(password string) error {
+	if len(password) < MinPasswordLength || len(password) > MaxPasswordLength {
+		return ErrWeakPassword
+	}
+	return nil
+}
+
+// NormalizeNickname folds a nickname for uniqueness checks
+// NFKC maps full-width and compatibility characters to their plain form, then case is ignored
+func NormalizeNickname(nickname string) string {
+	return strings.ToLower(norm.NFKC.String(strings.TrimSpace(nickname)))
+}
+
+// ValidateNickname checks the nickname length after trimming
+func ValidateNickname(nickname string) error {
+	if n := utf8.RuneCountInString(strings.TrimSpace(nickname)); n < MinNicknameLength || n > MaxNicknameLength {
+		return ErrInvalidNickname
+	}
+	return nil
+}
+
+// NormalizeEmail lowercases and trims an email so lookups are case-insensitive
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func isValidEmail(email string) bool {
+	if email == "" {
+		return false
+	}
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// APIKeyRepository persists service account API keys and users' personal keys
+// Lookups return entity.ErrAPIKeyNotFound when no key matches
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entity.APIKey) error
+	GetByID(ctx context.Context, id string) (*entity.APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+	// List returns the service keys, newest first, including revoked ones
+	List(ctx context.Context) ([]*entity.APIKey, error)
+	// ListByUser returns the user's personal keys, newest first, including revoked ones
+	ListByUser(ctx context.Context, userID string) ([]*entity.APIKey, error)
+	// Rotate replaces the secret of an unrevoked key
+	Rotate(ctx context.Context, id, prefix, keyHash string) error
+	Revoke(ctx context.Context, id string) error
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
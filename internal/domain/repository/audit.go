@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// AuditLogRepository persists the audit log; it is append-only, so there is no way to change or remove entries
+type AuditLogRepository interface {
+	// Append stores the entry
+	Append(ctx context.Context, entry *entity.AuditEntry) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// BlockRepository persists user blocks
+// List queries of other repositories leave out users the viewer blocked
+type BlockRepository interface {
+	// Create stores the block; blocking an already blocked user is a no-op
+	Create(ctx context.Context, block *entity.Block) error
+	// Delete removes the block if there is one
+	Delete(ctx context.Context, blockerID, blockedID string) error
+	// List returns the user's blocks, most recent first
+	List(ctx context.Context, blockerID string) ([]*entity.Block, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// DeviceRepository persists the devices that receive push notifications
+type DeviceRepository interface {
+	// Register stores the device, replacing the user's registration of the same installation and
+	// any registration of the same token, which another user left behind when signing out there
+	// The user's least recently registered devices beyond entity.MaxDevicesPerUser are dropped
+	Register(ctx context.Context, device *entity.Device) error
+	// Delete removes the user's registration of an installation; returns entity.ErrDeviceNotFound if there is none
+	Delete(ctx context.Context, userID, deviceID string) error
+	// ListByUsers returns the devices of the users
+	ListByUsers(ctx context.Context, userIDs []string) ([]*entity.Device, error)
+	// DeleteTokens removes the registrations of tokens that no longer reach a device
+	DeleteTokens(ctx context.Context, tokens []string) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// EmailVerificationRepository persists email verification tokens
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, token *entity.EmailVerificationToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.EmailVerificationToken, error)
+	// MarkUsed atomically consumes an unused token, returning false if it was already used
+	MarkUsed(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// IdempotencyRepository remembers requests sent with an Idempotency-Key, per user
+type IdempotencyRepository interface {
+	// Begin records req as running unless the user already used its key
+	// Returns the earlier request instead, nil when req was recorded; an expired earlier request is replaced
+	Begin(ctx context.Context, req *entity.IdempotentRequest) (*entity.IdempotentRequest, error)
+	// Complete stores the response of a running request
+	Complete(ctx context.Context, userID, key string, status int, contentType string, body []byte) error
+	// Abandon forgets a request that did not complete, so a retry runs it again
+	Abandon(ctx context.Context, userID, key string) error
+	// DeleteExpired removes the requests whose key expired before the given time
+	DeleteExpired(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// JobLockRepository coordinates scheduled jobs across server instances
+type JobLockRepository interface {
+	// Acquire locks the job for owner until the given time unless another owner holds an unexpired lock
+	// Returns false when the job is locked elsewhere
+	Acquire(ctx context.Context, name, owner string, now, until time.Time) (*entity.JobLock, bool, error)
+	// Release frees owner's lock; a non-nil lastRunAt records the end of the window the run handled
+	Release(ctx context.Context, name, owner string, lastRunAt *time.Time) error
+}
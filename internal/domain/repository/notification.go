@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// NotificationRepository persists users' notification inboxes
+type NotificationRepository interface {
+	// CreateMany stores the notifications
+	CreateMany(ctx context.Context, notifications []*entity.InboxNotification) error
+	// List returns up to limit of the user's notifications, newest first, starting after the key
+	// With unreadOnly, notifications already read are left out
+	List(ctx context.Context, userID string, unreadOnly bool, after *pagination.TimeKey, limit int) ([]*entity.InboxNotification, error)
+	// CountUnread returns how many of the user's notifications are unread
+	CountUnread(ctx context.Context, userID string) (int64, error)
+	// CountUnreadByRoom returns how many of the user's unread notifications each room has
+	// Rooms without unread notifications, and notifications about no room, are left out
+	CountUnreadByRoom(ctx context.Context, userID string) (map[string]int64, error)
+	// MarkRead marks one of the user's notifications read, keeping the time it was first read
+	// Returns entity.ErrNotificationNotFound if the user has no such notification
+	MarkRead(ctx context.Context, userID, id string, at time.Time) error
+	// MarkAllRead marks all of the user's unread notifications read
+	MarkAllRead(ctx context.Context, userID string, at time.Time) error
+	// ListDueDigests returns up to limit users on the digest frequency with a notification
+	// that has been waiting for a digest since before the given time
+	ListDueDigests(ctx context.Context, frequency entity.DigestFrequency, before time.Time, limit int) ([]string, error)
+	// TakeDigest returns the user's notifications waiting for a digest, oldest first, and clears their wait
+	TakeDigest(ctx context.Context, userID string) ([]*entity.InboxNotification, error)
+}
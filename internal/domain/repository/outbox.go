@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// OutboxRepository persists the events waiting to be published by the outbox relay
+type OutboxRepository interface {
+	// Append stores the event; call it in the transaction of the change the event is about
+	Append(ctx context.Context, event *entity.OutboxEvent) error
+	// ListDue returns up to limit live events due at now, longest due first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error)
+	// Claim removes the event and reports whether it was still there; claiming in the transaction
+	// that publishes the event makes sure it is published once
+	Claim(ctx context.Context, id string) (bool, error)
+	// Reschedule saves the attempts, last error and next attempt time of the event
+	Reschedule(ctx context.Context, event *entity.OutboxEvent) error
+	// DeadLetter keeps an event that ran out of attempts for inspection, out of ListDue
+	DeadLetter(ctx context.Context, event *entity.OutboxEvent, at time.Time) error
+}
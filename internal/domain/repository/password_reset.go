@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// PasswordResetRepository persists password reset tokens
+type PasswordResetRepository interface {
+	Create(ctx context.Context, token *entity.PasswordResetToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error)
+	// MarkUsed atomically consumes an unused token, returning false if it was already used
+	MarkUsed(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// PrayerTopicFilter narrows a room's topic list
+type PrayerTopicFilter struct {
+	// ViewerID hides topics by authors the viewer blocked and other people's private topics
+	ViewerID string
+	// Deleted lists soft-deleted topics instead of live ones
+	Deleted bool
+	// Tag keeps topics carrying this normalized tag
+	Tag string
+	// Active leaves out answered topics, which ListAnswered archives separately
+	Active bool
+	// Unpinned leaves out pinned topics, which ListPinned puts at the top of the feed
+	Unpinned bool
+}
+
+// PrayerSearch is a full-text query over a room's live topics
+type PrayerSearch struct {
+	// Terms must all appear in the topic's title or in one of its entries
+	Terms []string
+	// ViewerID hides topics by authors the viewer blocked and other people's private topics
+	ViewerID string
+}
+
+// PrayerSearchHit is a topic found by a search
+type PrayerSearchHit struct {
+	Topic *entity.PrayerTopic
+	// Content is the newest matching entry; nil when only the title matched
+	Content *entity.PrayerContent
+}
+
+// PrayerTagCount is one entry of a room's tag cloud
+type PrayerTagCount struct {
+	Tag   string
+	Count int
+}
+
+// PrayerTopicRepository persists prayer topics
+// Lookups return entity.ErrPrayerTopicNotFound when no topic matches
+type PrayerTopicRepository interface {
+	Create(ctx context.Context, topic *entity.PrayerTopic) error
+	// GetByID also returns soft-deleted topics; callers decide who may see them
+	GetByID(ctx context.Context, id string) (*entity.PrayerTopic, error)
+	// List returns up to limit topics of the room, newest first, starting after the key
+	List(ctx context.Context, roomID string, filter PrayerTopicFilter, after *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error)
+	// Search returns up to limit topics of the room matching the search, newest first, starting after the key
+	Search(ctx context.Context, roomID string, search PrayerSearch, after *pagination.TimeKey, limit int) ([]PrayerSearchHit, error)
+	// ListAnswered returns up to limit live answered topics of the room visible to viewerID,
+	// most recently answered first, starting after the key on the answer time
+	ListAnswered(ctx context.Context, roomID, viewerID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error)
+	// ListPrivate returns up to limit of the author's live private topics in the rooms they belong to,
+	// newest first, starting after the key
+	ListPrivate(ctx context.Context, authorID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error)
+	// ListOrderedByAuthor returns the author's live topics in the rooms they belong to that they put
+	// in order, lowest UserOrder first
+	ListOrderedByAuthor(ctx context.Context, authorID string) ([]*entity.PrayerTopic, error)
+	// ListUnorderedByAuthor returns up to limit of the author's other live topics in the rooms they
+	// belong to, newest first, starting after the key
+	ListUnorderedByAuthor(ctx context.Context, authorID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error)
+	// ReorderByAuthor numbers the given topics in order for their author and clears the order of the rest
+	// Returns entity.ErrInvalidPrayerOrder unless each listed topic is one of the author's live topics
+	// in a room they belong to, listed once
+	ReorderByAuthor(ctx context.Context, authorID string, topicIDs []string) error
+	// TagCloud returns up to limit tags of the room's live topics visible to viewerID, most used first
+	TagCloud(ctx context.Context, roomID, viewerID string, limit int) ([]PrayerTagCount, error)
+	// Update saves the editable fields, the testimony and the recurrence of a live topic
+	Update(ctx context.Context, topic *entity.PrayerTopic) error
+	// ListDueRecurrences returns up to limit live topics outside archived rooms whose unpaused
+	// recurrence was due before now
+	ListDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*entity.PrayerTopic, error)
+	// Recur posts next and moves the recurrence rule to it from topic in one transaction
+	// Returns false when topic's occurrence was already posted or its rule changed meanwhile
+	Recur(ctx context.Context, topic, next *entity.PrayerTopic) (bool, error)
+	// MarkAnswered marks a live topic answered with an optional testimony
+	// Returns entity.ErrPrayerTopicAnswered if it already is
+	MarkAnswered(ctx context.Context, id, testimony string, at time.Time) error
+	// ListPinned returns the room's live pinned topics in pin order, applying the filter's viewer and tag
+	ListPinned(ctx context.Context, roomID string, filter PrayerTopicFilter) ([]*entity.PrayerTopic, error)
+	// Pin saves topic's pin after the room's other pinned topics and sets its PinPosition
+	// Returns entity.ErrPinLimitReached when the room already has max pinned topics
+	// and entity.ErrPrayerTopicPinned if the topic already is
+	Pin(ctx context.Context, topic *entity.PrayerTopic, max int) error
+	// Unpin clears the pin of a live topic; returns entity.ErrPrayerTopicNotPinned if it has none
+	Unpin(ctx context.Context, id string) error
+	// ReorderPins renumbers the room's pinned topics in the given order
+	// Returns entity.ErrInvalidPinOrder unless topicIDs lists every pinned topic exactly once
+	ReorderPins(ctx context.Context, roomID string, topicIDs []string) error
+	// SoftDelete marks a live topic deleted and clears its pin
+	SoftDelete(ctx context.Context, id, deletedBy string, at time.Time) error
+	// Restore brings back a soft-deleted topic; returns entity.ErrPrayerTopicNotDeleted if it is live
+	Restore(ctx context.Context, id string, at time.Time) error
+}
+
+// PrayerContentRepository persists the entries written under prayer topics
+// Lookups return entity.ErrPrayerContentNotFound when no entry matches
+type PrayerContentRepository interface {
+	Create(ctx context.Context, content *entity.PrayerContent) error
+	GetByID(ctx context.Context, id string) (*entity.PrayerContent, error)
+	// List returns up to limit entries of the topic, newest first, starting after the key
+	// Entries by authors viewerID blocked are left out
+	List(ctx context.Context, topicID, viewerID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerContent, error)
+	// Update saves the body of an entry
+	Update(ctx context.Context, content *entity.PrayerContent) error
+	Delete(ctx context.Context, id string) error
+}
+
+// PrayerRevisionRepository persists the edit history of prayer topics
+type PrayerRevisionRepository interface {
+	// Create stores the revision and drops the topic's oldest revisions beyond keep
+	Create(ctx context.Context, revision *entity.PrayerRevision, keep int) error
+	// ListByTopic returns the topic's revisions, newest first
+	ListByTopic(ctx context.Context, topicID string) ([]*entity.PrayerRevision, error)
+}
+
+// PrayerCommentFilter selects one level of a topic's comment thread
+type PrayerCommentFilter struct {
+	// ParentID lists the replies to that comment; empty lists top-level comments
+	ParentID string
+	// ViewerID hides comments by authors the viewer blocked
+	ViewerID string
+}
+
+// PrayerCommentRepository persists comments on prayer topics together with their mentions
+// It keeps the topic's comment count in step with every insert and delete
+// Lookups return entity.ErrPrayerCommentNotFound when no comment matches
+type PrayerCommentRepository interface {
+	Create(ctx context.Context, comment *entity.PrayerComment) error
+	GetByID(ctx context.Context, id string) (*entity.PrayerComment, error)
+	// List returns up to limit comments of the topic, newest first, starting after the key
+	List(ctx context.Context, topicID string, filter PrayerCommentFilter, after *pagination.TimeKey, limit int) ([]*entity.PrayerComment, error)
+	// Update saves the body and mentions of the comment
+	Update(ctx context.Context, comment *entity.PrayerComment) error
+	// Delete removes the comment with its replies
+	Delete(ctx context.Context, id string) error
+}
+
+// PrayerReactionRepository persists "함께 기도" reactions
+// It keeps the topic's reaction count in step with every insert and delete
+type PrayerReactionRepository interface {
+	// Add stores the reaction, or counts it as another prayer of the member's reaction when the
+	// policy allows, and returns the topic's reaction count; reaction.Count is set to the member's total
+	// Returns entity.ErrAlreadyReacted if the user already reacted to the topic and the prayer does
+	// not count again
+	Add(ctx context.Context, reaction *entity.PrayerReaction, policy entity.PrayerReactionPolicy) (int, error)
+	// Remove deletes the user's reaction, if any, and returns the topic's reaction count
+	Remove(ctx context.Context, topicID, userID string) (int, error)
+	// Reacted reports which of the topics the user reacted to
+	Reacted(ctx context.Context, userID string, topicIDs []string) (map[string]bool, error)
+	// ListByTopic returns a page of the topic's reactions, newest first, keyed by creation time and user ID
+	ListByTopic(ctx context.Context, topicID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerReaction, error)
+}
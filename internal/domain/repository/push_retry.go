@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// PushRetryRepository persists the queue of failed pushes and the dead letters of those that ran out of attempts
+type PushRetryRepository interface {
+	// Enqueue stores the retries
+	Enqueue(ctx context.Context, retries []*entity.PushRetry) error
+	// ListDue returns up to limit retries due at now, longest due first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.PushRetry, error)
+	// Reschedule saves the attempts, last error and next attempt time of a queued retry
+	Reschedule(ctx context.Context, retry *entity.PushRetry) error
+	// Delete removes queued retries that were delivered or whose device is gone
+	Delete(ctx context.Context, ids ...string) error
+	// DeadLetter stores the retries as dead letters and removes any of them from the queue, in one transaction
+	DeadLetter(ctx context.Context, retries []*entity.PushRetry, at time.Time) error
+}
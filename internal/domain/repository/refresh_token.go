@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// RefreshTokenRepository persists issued refresh tokens grouped in rotation families
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+	GetByID(ctx context.Context, id string) (*entity.RefreshToken, error)
+	// MarkUsed atomically marks an unused, unrevoked token as used
+	// Returns false when another request already used or revoked it
+	MarkUsed(ctx context.Context, id string) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	// RevokeAllForUser signs the user out of every device
+	RevokeAllForUser(ctx context.Context, userID string) error
+	// ListSessions returns the user's families that still hold a usable token, newest activity first
+	ListSessions(ctx context.Context, userID string, now time.Time) ([]*entity.Session, error)
+	// RevokeSession revokes one of the user's families, returning false if it has no active token
+	RevokeSession(ctx context.Context, userID, familyID string) (bool, error)
+	// IsSessionRevoked reports whether the family was signed out, which ends its access tokens too
+	IsSessionRevoked(ctx context.Context, familyID string) (bool, error)
+}
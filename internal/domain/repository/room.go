@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// RoomFilter narrows room lists; zero fields do not filter
+type RoomFilter struct {
+	Category entity.RoomCategory
+	// Tags matches rooms carrying every one of the tags, which must be normalized
+	Tags []string
+	// Archived lists archived rooms instead of active ones
+	Archived bool
+}
+
+// RoomSearch is a room discovery query
+type RoomSearch struct {
+	// Query is matched case-insensitively anywhere in the name or description
+	Query string
+	// ViewerID sees public rooms and the private rooms they belong to
+	ViewerID string
+	Filter   RoomFilter
+}
+
+// TrendingRoom is a public room ranked by its recent activity
+type TrendingRoom struct {
+	RoomID string
+	// Score counts one point for each topic posted, member praying and member joining in the window
+	Score int64
+}
+
+// RoomRepository persists prayer rooms
+// Lookups return entity.ErrRoomNotFound when no room matches
+type RoomRepository interface {
+	// Create stores the room and its tags together with its owner's membership
+	Create(ctx context.Context, room *entity.Room, owner *entity.RoomMember) error
+	GetByID(ctx context.Context, id string) (*entity.Room, error)
+	// ListByMember returns up to limit rooms the user belongs to, newest first, starting after the key
+	ListByMember(ctx context.Context, userID string, filter RoomFilter, after *pagination.TimeKey, limit int) ([]*entity.Room, error)
+	// Search returns up to limit rooms matching the search, newest first, starting after the key
+	// Rooms owned by users the viewer blocked are left out
+	Search(ctx context.Context, search RoomSearch, after *pagination.TimeKey, limit int) ([]*entity.Room, error)
+	// ListTrending returns up to limit active public rooms with activity in the last window,
+	// highest score first, ties broken by room ID
+	ListTrending(ctx context.Context, window time.Duration, limit int) ([]TrendingRoom, error)
+	// Update saves the editable fields of the room, replacing its tags
+	Update(ctx context.Context, room *entity.Room) error
+	// UpdateSettings saves the room's settings and visibility
+	UpdateSettings(ctx context.Context, room *entity.Room) error
+	// SetCoverImage replaces the room's cover image; empty values remove it
+	SetCoverImage(ctx context.Context, id, key, url string, at time.Time) error
+	// Archive marks the room archived; returns entity.ErrRoomArchived if it already is
+	Archive(ctx context.Context, id string, at time.Time) error
+	// Unarchive restores an archived room; returns entity.ErrRoomNotArchived if it is not archived
+	Unarchive(ctx context.Context, id string, at time.Time) error
+	// Delete removes the room and everything in it
+	Delete(ctx context.Context, id string) error
+	// DeleteOrphans removes the prayers, comments, memberships and other records whose room no
+	// longer exists, and returns how many it removed per table
+	DeleteOrphans(ctx context.Context) (map[string]int64, error)
+}
+
+// ReminderRecipient is a member due a room's daily reminder
+type ReminderRecipient struct {
+	RoomID   string
+	RoomName string
+	// ReminderTime is "HH:MM" in the recipient's timezone
+	ReminderTime string
+	UserID       string
+	Timezone     string
+}
+
+// RoomMemberRepository persists room memberships
+// Lookups return entity.ErrNotRoomMember when the user is not a member
+type RoomMemberRepository interface {
+	Get(ctx context.Context, roomID, userID string) (*entity.RoomMember, error)
+	// List returns the room's members in join order, so the creator comes first
+	List(ctx context.Context, roomID string) ([]*entity.RoomMember, error)
+	// ListActive returns the members last seen in the room at or after since, most recently seen first
+	ListActive(ctx context.Context, roomID string, since time.Time) ([]*entity.RoomMember, error)
+	// TouchLastSeen records that the member opened the room at the given time
+	TouchLastSeen(ctx context.Context, roomID, userID string, at time.Time) error
+	// ListRoomIDs returns the IDs of every room the user belongs to
+	ListRoomIDs(ctx context.Context, userID string) ([]string, error)
+	// Add makes the user a member, respecting the room's member cap
+	// Returns entity.ErrRoomNotFound, entity.ErrAlreadyRoomMember or entity.ErrRoomFull without adding
+	Add(ctx context.Context, member *entity.RoomMember) error
+	// UpdateRole changes the role of an existing member
+	UpdateRole(ctx context.Context, roomID, userID string, role entity.RoomRole) error
+	// SetMuted changes whether the member receives room-wide notifications
+	SetMuted(ctx context.Context, roomID, userID string, muted bool) error
+	// ListReminderRecipients returns up to limit members of active rooms with a reminder time,
+	// ordered by room and user, starting after the given pair
+	// Members who muted the room and users who turned reminders off or are pending deletion are left out
+	ListReminderRecipients(ctx context.Context, afterRoomID, afterUserID string, limit int) ([]ReminderRecipient, error)
+}
+
+// RoomInviteRepository persists room invites
+// Lookups return entity.ErrInviteNotFound when no invite matches
+type RoomInviteRepository interface {
+	// Create stores the invite; returns entity.ErrInviteCodeTaken if the code collides
+	Create(ctx context.Context, invite *entity.RoomInvite) error
+	GetByCode(ctx context.Context, code string) (*entity.RoomInvite, error)
+	// Redeem counts a use of the invite and adds the member in one transaction
+	// Returns entity.ErrInviteExpired when the invite ran out at now, and
+	// entity.ErrAlreadyRoomMember or entity.ErrRoomFull without counting a use
+	Redeem(ctx context.Context, inviteID string, member *entity.RoomMember, now time.Time) error
+}
+
+// AnnouncementRepository persists room announcements
+// Lookups return entity.ErrAnnouncementNotFound when no announcement matches
+type AnnouncementRepository interface {
+	Create(ctx context.Context, announcement *entity.RoomAnnouncement) error
+	GetByID(ctx context.Context, id string) (*entity.RoomAnnouncement, error)
+	// Latest returns the room's newest announcement
+	Latest(ctx context.Context, roomID string) (*entity.RoomAnnouncement, error)
+	// List returns up to limit announcements of the room, newest first, starting after the key
+	List(ctx context.Context, roomID string, after *pagination.TimeKey, limit int) ([]*entity.RoomAnnouncement, error)
+	// Update saves the body of the announcement
+	Update(ctx context.Context, announcement *entity.RoomAnnouncement) error
+}
+
+// JoinRequestRepository persists requests to join private rooms
+// Lookups return entity.ErrJoinRequestNotFound when no request matches
+type JoinRequestRepository interface {
+	Create(ctx context.Context, request *entity.JoinRequest) error
+	GetByID(ctx context.Context, id string) (*entity.JoinRequest, error)
+	// HasPending reports whether the user already waits for a decision on the room
+	HasPending(ctx context.Context, roomID, userID string) (bool, error)
+	// ListPending returns the room's pending requests, oldest first
+	ListPending(ctx context.Context, roomID string) ([]*entity.JoinRequest, error)
+	// Approve marks a pending request approved and adds the member in one transaction
+	// A user who joined in the meantime (e.g. through an invite) keeps their membership
+	// Returns entity.ErrJoinRequestNotPending when the request was already decided,
+	// and entity.ErrRoomFull, leaving the request pending, when the room is at its member cap
+	Approve(ctx context.Context, id, decidedBy string, member *entity.RoomMember, at time.Time) error
+	// Reject marks a pending request rejected
+	// Returns entity.ErrJoinRequestNotPending when the request was already decided
+	Reject(ctx context.Context, id, decidedBy string, at time.Time) error
+}
+
+// RoomInvitationRepository persists invitations addressed to users
+// Lookups return entity.ErrInvitationNotFound when no invitation matches
+// Methods taking now treat pending invitations that expired by then as expired
+type RoomInvitationRepository interface {
+	Create(ctx context.Context, invitation *entity.RoomInvitation) error
+	GetByID(ctx context.Context, id string) (*entity.RoomInvitation, error)
+	// HasPending reports whether the user already has an unanswered invitation to the room
+	HasPending(ctx context.Context, roomID, inviteeID string, now time.Time) (bool, error)
+	// ListByInvitee returns up to limit of the user's invitations in the status, newest first, starting after the key
+	ListByInvitee(ctx context.Context, inviteeID string, status entity.InvitationStatus, now time.Time, after *pagination.TimeKey, limit int) ([]*entity.RoomInvitation, error)
+	// CountPending returns how many invitations the user can still answer
+	CountPending(ctx context.Context, inviteeID string, now time.Time) (int64, error)
+	// Accept marks a pending invitation accepted and adds the member in one transaction
+	// Returns entity.ErrInvitationNotPending when it was already answered or expired, and
+	// entity.ErrAlreadyRoomMember or entity.ErrRoomFull leaving the invitation pending
+	Accept(ctx context.Context, id string, member *entity.RoomMember, now time.Time) error
+	// Reject marks a pending invitation rejected
+	// Returns entity.ErrInvitationNotPending when it was already answered or expired
+	Reject(ctx context.Context, id string, now time.Time) error
+}
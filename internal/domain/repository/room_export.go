@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// RoomExportRepository persists room export requests, which double as the export job queue
+// Lookups return entity.ErrRoomExportNotFound when no export matches
+type RoomExportRepository interface {
+	Create(ctx context.Context, export *entity.RoomExport) error
+	// GetUnfinished returns the user's pending or running export of the room
+	GetUnfinished(ctx context.Context, roomID, userID string) (*entity.RoomExport, error)
+	// ListRunnable returns up to limit exports, oldest first, that are pending or whose run
+	// started before staleBefore and never finished
+	ListRunnable(ctx context.Context, staleBefore time.Time, limit int) ([]*entity.RoomExport, error)
+	// Claim marks a runnable export as running from now
+	// Returns false when another run claimed it first
+	Claim(ctx context.Context, export *entity.RoomExport, now time.Time) (bool, error)
+	// Finish saves the status, file and completion time of a run
+	Finish(ctx context.Context, export *entity.RoomExport) error
+	// ListExpired returns up to limit exports created before cutoff
+	ListExpired(ctx context.Context, cutoff time.Time, limit int) ([]*entity.RoomExport, error)
+	Delete(ctx context.Context, id string) error
+}
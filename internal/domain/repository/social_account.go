@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// SocialAccountRepository persists provider identities linked to users
+type SocialAccountRepository interface {
+	Create(ctx context.Context, account *entity.SocialAccount) error
+	// CreateWithUser atomically creates a new user and its first linked account
+	CreateWithUser(ctx context.Context, user *entity.User, account *entity.SocialAccount) error
+	GetByProviderUserID(ctx context.Context, provider entity.SocialProvider, providerUserID string) (*entity.SocialAccount, error)
+	ListByUserID(ctx context.Context, userID string) ([]*entity.SocialAccount, error)
+}
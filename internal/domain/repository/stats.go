@@ -0,0 +1,28 @@
+package repository
+
+import "context"
+
+// RoomStats counts the activity of a room's live, non-private prayer topics
+type RoomStats struct {
+	PrayersCreated  int
+	PrayersAnswered int
+	Reactions       int
+	// ActiveDays is the number of days (UTC) with a topic, entry, comment or reaction posted
+	ActiveDays int
+}
+
+// UserStats counts the activity of one user across all rooms
+type UserStats struct {
+	PrayersCreated    int
+	PrayersAnswered   int
+	ReactionsGiven    int
+	ReactionsReceived int
+	// ActiveDays is the number of days (UTC) the user posted a topic, entry, comment or reaction
+	ActiveDays int
+}
+
+// StatsRepository computes activity statistics with aggregate queries
+type StatsRepository interface {
+	RoomStats(ctx context.Context, roomID string) (*RoomStats, error)
+	UserStats(ctx context.Context, userID string) (*UserStats, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// TokenBlacklistRepository stores access tokens revoked before their expiry
+type TokenBlacklistRepository interface {
+	// Revoke blacklists the token until it would have expired anyway
+	Revoke(ctx context.Context, tokenID, userID string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import "context"
+
+// Transactor makes the changes of several repositories atomic
+// Repositories called with the context passed to fn run in the transaction, so usecases
+// never handle the database connection themselves
+type Transactor interface {
+	// WithinTransaction commits when fn returns nil and rolls back otherwise
+	// A nested call joins the outer transaction
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
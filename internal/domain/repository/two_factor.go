@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// TwoFactorRepository persists TOTP enrollments and their backup codes
+// Get returns entity.ErrTwoFactorNotEnrolled when the user has no enrollment
+type TwoFactorRepository interface {
+	Get(ctx context.Context, userID string) (*entity.TwoFactor, error)
+	// SavePending creates or replaces an unconfirmed enrollment
+	SavePending(ctx context.Context, tf *entity.TwoFactor) error
+	// Enable confirms the enrollment and replaces the backup codes in one transaction
+	Enable(ctx context.Context, userID string, step int64, backupCodeHashes []string) error
+	// Delete removes the enrollment and its backup codes
+	Delete(ctx context.Context, userID string) error
+
+	// AcceptStep records a successful TOTP code, returning false if the step was already used
+	AcceptStep(ctx context.Context, userID string, step int64) (bool, error)
+	// UseBackupCode consumes an unused backup code, returning false if none matched
+	UseBackupCode(ctx context.Context, userID, codeHash string) (bool, error)
+	// RecordFailure counts a failed code and locks verification until lockUntil after maxAttempts
+	RecordFailure(ctx context.Context, userID string, maxAttempts int, lockUntil time.Time) error
+}
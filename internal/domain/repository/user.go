@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// UserRepository persists users
+// Lookups return entity.ErrUserNotFound when no user matches
+type UserRepository interface {
+	Create(ctx context.Context, user *entity.User) error
+	GetByID(ctx context.Context, id string) (*entity.User, error)
+	GetByEmail(ctx context.Context, email string) (*entity.User, error)
+	// GetByIDs returns the users that exist among ids, in no particular order
+	GetByIDs(ctx context.Context, ids []string) ([]*entity.User, error)
+	// GetByNicknames returns the users whose normalized nickname matches one of nicknames
+	GetByNicknames(ctx context.Context, nicknames []string) ([]*entity.User, error)
+	UpdatePassword(ctx context.Context, id, passwordHash string) error
+	MarkEmailVerified(ctx context.Context, id string) error
+	// UpdateProfile saves the nickname and profile fields of the user
+	UpdateProfile(ctx context.Context, user *entity.User) error
+	// UpdateNotificationSettings saves which notifications the user wants
+	UpdateNotificationSettings(ctx context.Context, user *entity.User) error
+	// IsNicknameTaken checks the normalized nickname; the unique index is the final guarantee
+	IsNicknameTaken(ctx context.Context, nickname string) (bool, error)
+	// Search returns users matching the query in nickname order
+	// Guests, suspended accounts, accounts pending deletion, users hidden from search
+	// and users the viewer blocked are never returned
+	Search(ctx context.Context, query UserSearch) ([]*entity.User, error)
+	// UpgradeGuest turns a guest into a regular user with credentials, keeping its ID and data
+	UpgradeGuest(ctx context.Context, user *entity.User) error
+
+	// List returns users matching the filter, newest first, for administration
+	// Unlike Search it includes guests, hidden, suspended and deleted accounts
+	List(ctx context.Context, filter UserFilter) ([]*entity.User, error)
+	// Suspend locks the account with the reason, keeping the time of an earlier suspension
+	Suspend(ctx context.Context, id, reason string, at time.Time) error
+	// Reinstate lifts a suspension if there is one
+	Reinstate(ctx context.Context, id string) error
+
+	// ScheduleDeletion soft-deletes the user until purgeAt, keeping an earlier schedule
+	ScheduleDeletion(ctx context.Context, id string, purgeAt time.Time) error
+	// CancelDeletion restores a soft-deleted user, returning false if none was scheduled
+	CancelDeletion(ctx context.Context, id string) (bool, error)
+	// ListDueForPurge returns up to limit user IDs whose grace period ended before now
+	ListDueForPurge(ctx context.Context, now time.Time, limit int) ([]string, error)
+	// Purge hard-deletes a user still due at now together with all of the user's data
+	// Returns false when the deletion was cancelled in the meantime
+	Purge(ctx context.Context, id string, now time.Time) (bool, error)
+}
+
+// UserSearch selects users for Search
+type UserSearch struct {
+	// NicknamePrefix matches the start of the normalized nickname
+	NicknamePrefix string
+	// EmailPrefix matches the start of the email; empty disables email matching
+	EmailPrefix string
+	// ViewerID is the searching user, who is left out of the results
+	ViewerID string
+	Offset   int
+	Limit    int
+}
+
+// UserFilter selects users for List
+type UserFilter struct {
+	// Query matches an exact ID or the start of the nickname or email; empty matches everyone
+	Query string
+	// Role limits the results to one role; empty means any role
+	Role entity.Role
+	// Suspended limits the results to suspended (true) or active (false) users when set
+	Suspended *bool
+	Offset    int
+	Limit     int
+}
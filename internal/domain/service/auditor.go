@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// Auditor keeps the audit log of sensitive operations
+// Recording never fails the caller: the operation has already happened, so errors are logged instead
+type Auditor interface {
+	// Record appends the entry, stamped with the ID and IP address of the request in ctx
+	Record(ctx context.Context, entry entity.AuditEntry)
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// Cache keeps short-lived copies of hot reads, shared by server instances when backed by Redis
+// Entries are opaque bytes; callers own the encoding and the key scheme
+type Cache interface {
+	// Get returns the value stored under key; ok is false on a miss
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set stores the value under key for ttl
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the keys; missing keys are ignored
+	Delete(ctx context.Context, keys ...string) error
+}
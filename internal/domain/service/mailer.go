@@ -0,0 +1,15 @@
+package service
+
+import "context"
+
+// Email is a plain-text email message
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers transactional emails
+type Mailer interface {
+	Send(ctx context.Context, email Email) error
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// Notifier delivers notifications to users
+// Delivery is best effort: callers log failures instead of failing the request
+type Notifier interface {
+	// Notify keeps the notification in the users' inboxes and delivers it as their settings allow
+	// It is Record followed by an immediate email and push
+	Notify(ctx context.Context, userIDs []string, n entity.Notification) error
+	// Record keeps the notification in the users' inboxes and returns those to deliver it to now,
+	// as their settings allow; it only writes to the database, so it can join a transaction whose
+	// commit DeliverTo waits for
+	// When writing the inbox fails the recipients are still returned along with the error
+	Record(ctx context.Context, userIDs []string, n entity.Notification) ([]*entity.User, error)
+	// QueuePushes writes the pushes of the notification to the devices of recipients returned by
+	// Record to the push retry queue and returns them; like Record it only writes to the database,
+	// so a push queued in a transaction that commits is sent even if its sender crashes
+	QueuePushes(ctx context.Context, users []*entity.User, n entity.Notification) ([]*entity.PushRetry, error)
+	// DeliverTo emails the notification to recipients returned by Record and sends the pushes
+	// QueuePushes queued for them, settling each in the queue
+	DeliverTo(ctx context.Context, users []*entity.User, pushes []*entity.PushRetry, n entity.Notification) error
+	// Deliver emails and pushes the notification to one user without touching the inbox or the
+	// user's settings, though a snooze still holds the push back; digests use it to send
+	// summaries of notifications already in the inbox
+	Deliver(ctx context.Context, userID string, n entity.Notification) error
+}
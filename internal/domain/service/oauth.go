@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+var ErrInvalidIDToken = errors.New("invalid provider ID token")
+
+// IDTokenVerifier verifies an ID token issued by a social provider
+// Returns entity.ErrUnsupportedProvider when the provider is not configured
+type IDTokenVerifier interface {
+	Verify(ctx context.Context, provider entity.SocialProvider, idToken string) (*entity.SocialIdentity, error)
+}
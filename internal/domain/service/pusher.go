@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// Pusher delivers push notifications to app installations by device token
+type Pusher interface {
+	// Push sends the notification to every token and reports the tokens it could not reach
+	Push(ctx context.Context, tokens []string, n entity.Notification) PushResult
+}
+
+// PushResult is the outcome of a push for the tokens that were not delivered to
+type PushResult struct {
+	// Invalid tokens were reported as no longer registered, so they can be pruned
+	Invalid []string
+	// Failed tokens could not be delivered to for another reason
+	Failed []PushFailure
+}
+
+// PushFailure is a token a push could not be delivered to
+type PushFailure struct {
+	Token string
+	Err   error
+	// Temporary failures, such as throttling or an outage of the push service, may succeed on retry
+	Temporary bool
+}
+
+// TopicSubscriber manages which push topics app installations are subscribed to
+type TopicSubscriber interface {
+	// Subscribe adds the device tokens to the topic
+	Subscribe(ctx context.Context, topic string, tokens []string) error
+	// Unsubscribe removes the device tokens from the topic
+	Unsubscribe(ctx context.Context, topic string, tokens []string) error
+}
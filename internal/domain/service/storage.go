@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// PrivateKeyPrefix starts the keys of files that are not public, such as room exports
+// They are only served through SignedURL
+const PrivateKeyPrefix = "private/"
+
+// Storage keeps uploaded files and serves them at public URLs
+type Storage interface {
+	// Put stores data under key, replacing any file there, and returns its public URL
+	// The URL of a private key serves nothing; use SignedURL instead
+	Put(ctx context.Context, key, contentType string, data []byte) (string, error)
+	// Delete removes the file under key; a missing file is not an error
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL that serves the file under key until expiresAt
+	SignedURL(key string, expiresAt time.Time) string
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// WebhookPublisher posts notification events to an external endpoint, such as a church's own systems
+type WebhookPublisher interface {
+	// Publish delivers the event; an event may be delivered more than once, so receivers
+	// should drop those whose ID they have seen
+	Publish(ctx context.Context, event *entity.OutboxEvent) error
+}
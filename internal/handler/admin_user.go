@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/admin"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminUserHandler lets operators manage accounts (admin only)
+type AdminUserHandler struct {
+	listUC      *admin.ListUsersUseCase
+	suspendUC   *admin.SuspendUserUseCase
+	reinstateUC *admin.ReinstateUserUseCase
+	logoutUC    *admin.ForceLogoutUseCase
+}
+
+func NewAdminUserHandler(
+	listUC *admin.ListUsersUseCase,
+	suspendUC *admin.SuspendUserUseCase,
+	reinstateUC *admin.ReinstateUserUseCase,
+	logoutUC *admin.ForceLogoutUseCase,
+) *AdminUserHandler {
+	return &AdminUserHandler{
+		listUC:      listUC,
+		suspendUC:   suspendUC,
+		reinstateUC: reinstateUC,
+		logoutUC:    logoutUC,
+	}
+}
+
+// List handles GET /api/v1/admin/users?q=&role=&suspended=&offset=&limit=
+func (h *AdminUserHandler) List(c *gin.Context) {
+	var req dto.AdminUserListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	users, hasMore, err := h.listUC.Execute(c.Request.Context(), req.ToUserFilter())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := dto.AdminUserListResponse{
+		Users: make([]dto.AdminUserResponse, 0, len(users)),
+	}
+	for _, u := range users {
+		resp.Users = append(resp.Users, dto.NewAdminUserResponse(u))
+	}
+	if hasMore {
+		next := req.Offset + len(users)
+		resp.NextOffset = &next
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// Suspend handles POST /api/v1/admin/users/:id/suspend
+func (h *AdminUserHandler) Suspend(c *gin.Context) {
+	var req dto.SuspendUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	adminID, _ := middleware.GetUserID(c)
+
+	user, err := h.suspendUC.Execute(c.Request.Context(), adminID, c.Param("id"), req.Reason)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewAdminUserResponse(user))
+}
+
+// Reinstate handles POST /api/v1/admin/users/:id/reinstate
+func (h *AdminUserHandler) Reinstate(c *gin.Context) {
+	adminID, _ := middleware.GetUserID(c)
+
+	user, err := h.reinstateUC.Execute(c.Request.Context(), adminID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewAdminUserResponse(user))
+}
+
+// ForceLogout handles POST /api/v1/admin/users/:id/logout
+func (h *AdminUserHandler) ForceLogout(c *gin.Context) {
+	adminID, _ := middleware.GetUserID(c)
+
+	if err := h.logoutUC.Execute(c.Request.Context(), adminID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler manages service account keys (admin only)
+type APIKeyHandler struct {
+	createUC *auth.CreateAPIKeyUseCase
+	listUC   *auth.ListAPIKeysUseCase
+	rotateUC *auth.RotateAPIKeyUseCase
+	revokeUC *auth.RevokeAPIKeyUseCase
+}
+
+func NewAPIKeyHandler(
+	createUC *auth.CreateAPIKeyUseCase,
+	listUC *auth.ListAPIKeysUseCase,
+	rotateUC *auth.RotateAPIKeyUseCase,
+	revokeUC *auth.RevokeAPIKeyUseCase,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createUC: createUC,
+		listUC:   listUC,
+		rotateUC: rotateUC,
+		revokeUC: revokeUC,
+	}
+}
+
+// Create handles POST /api/v1/admin/api-keys
+func (h *APIKeyHandler) Create(c *gin.Context) {
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	adminID, _ := middleware.GetUserID(c)
+
+	key, raw, err := h.createUC.Execute(c.Request.Context(), req.Name, adminID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.APIKeySecretResponse{
+		APIKeyResponse: dto.NewAPIKeyResponse(key),
+		Key:            raw,
+	})
+}
+
+// List handles GET /api/v1/admin/api-keys
+func (h *APIKeyHandler) List(c *gin.Context) {
+	keys, err := h.listUC.Execute(c.Request.Context())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		resp = append(resp, dto.NewAPIKeyResponse(k))
+	}
+	c.JSON(http.StatusOK, dto.APIKeyListResponse{APIKeys: resp})
+}
+
+// Rotate handles POST /api/v1/admin/api-keys/:id/rotate
+func (h *APIKeyHandler) Rotate(c *gin.Context) {
+	key, raw, err := h.rotateUC.Execute(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIKeySecretResponse{
+		APIKeyResponse: dto.NewAPIKeyResponse(key),
+		Key:            raw,
+	})
+}
+
+// Revoke handles DELETE /api/v1/admin/api-keys/:id
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	if err := h.revokeUC.Execute(c.Request.Context(), c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/auth"
+	"github.com/gin-gonic/gin"
+)
+
+type AuthHandler struct {
+	signupUC  *auth.SignupUseCase
+	loginUC   *auth.LoginUseCase
+	mfaUC     *auth.BeginTwoFactorLoginUseCase
+	issueUC   *auth.IssueTokensUseCase
+	refreshUC *auth.RefreshTokenUseCase
+	logoutUC  *auth.LogoutUseCase
+	forgotUC  *auth.RequestPasswordResetUseCase
+	resetUC   *auth.ResetPasswordUseCase
+	sendVerUC *auth.SendEmailVerificationUseCase
+	verifyUC  *auth.VerifyEmailUseCase
+}
+
+func NewAuthHandler(
+	signupUC *auth.SignupUseCase,
+	loginUC *auth.LoginUseCase,
+	mfaUC *auth.BeginTwoFactorLoginUseCase,
+	issueUC *auth.IssueTokensUseCase,
+	refreshUC *auth.RefreshTokenUseCase,
+	logoutUC *auth.LogoutUseCase,
+	forgotUC *auth.RequestPasswordResetUseCase,
+	resetUC *auth.ResetPasswordUseCase,
+	sendVerUC *auth.SendEmailVerificationUseCase,
+	verifyUC *auth.VerifyEmailUseCase,
+) *AuthHandler {
+	return &AuthHandler{
+		signupUC:  signupUC,
+		loginUC:   loginUC,
+		mfaUC:     mfaUC,
+		issueUC:   issueUC,
+		refreshUC: refreshUC,
+		logoutUC:  logoutUC,
+		forgotUC:  forgotUC,
+		resetUC:   resetUC,
+		sendVerUC: sendVerUC,
+		verifyUC:  verifyUC,
+	}
+}
+
+// Signup handles POST /api/v1/auth/signup
+func (h *AuthHandler) Signup(c *gin.Context) {
+	var req dto.SignupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user, err := h.signupUC.Execute(c.Request.Context(), req.Email, req.Nickname, req.Password)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondWithTokens(c, h.issueUC, http.StatusCreated, user, req.DeviceID)
+}
+
+// Login handles POST /api/v1/auth/login
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req dto.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user, err := h.loginUC.Execute(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondWithLogin(c, h.mfaUC, h.issueUC, user, req.DeviceID)
+}
+
+// Refresh handles POST /api/v1/auth/refresh
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req dto.RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	pair, err := h.refreshUC.Execute(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewTokenResponse(pair))
+}
+
+// Logout handles POST /api/v1/auth/logout (authenticated)
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req dto.LogoutRequest
+	// Body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			respondBadRequest(c, err)
+			return
+		}
+	}
+
+	claims, ok := middleware.GetClaims(c)
+	if !ok {
+		respondError(c, middleware.ErrMissingToken)
+		return
+	}
+
+	var expiresAt time.Time
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+
+	if err := h.logoutUC.Execute(c.Request.Context(), claims.UserID, claims.ID, expiresAt, req.RefreshToken); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ForgotPassword handles POST /api/v1/auth/password/forgot
+// Always answers 202 so callers cannot tell whether the email is registered
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req dto.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	if err := h.forgotUC.Execute(c.Request.Context(), req.Email); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// ResetPassword handles POST /api/v1/auth/password/reset
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	if err := h.resetUC.Execute(c.Request.Context(), req.Token, req.NewPassword); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// VerifyEmail handles POST /api/v1/auth/verify-email
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var req dto.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	if err := h.verifyUC.Execute(c.Request.Context(), req.Token); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ResendVerificationEmail handles POST /api/v1/auth/verify-email/resend
+func (h *AuthHandler) ResendVerificationEmail(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.sendVerUC.Execute(c.Request.Context(), userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// respondWithLogin finishes a first-factor login: users with 2FA get an MFA challenge,
+// everyone else gets tokens right away
+func respondWithLogin(c *gin.Context, mfaUC *auth.BeginTwoFactorLoginUseCase, issueUC *auth.IssueTokensUseCase, user *entity.User, deviceID string) {
+	mfaToken, err := mfaUC.Execute(c.Request.Context(), user, deviceID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	if mfaToken != "" {
+		c.JSON(http.StatusOK, dto.MFAChallengeResponse{
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		})
+		return
+	}
+
+	respondWithTokens(c, issueUC, http.StatusOK, user, deviceID)
+}
+
+// respondWithTokens starts a new token family for the user's device and writes the auth response
+func respondWithTokens(c *gin.Context, issueUC *auth.IssueTokensUseCase, status int, user *entity.User, deviceID string) {
+	pair, err := issueUC.Execute(c.Request.Context(), user, deviceID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(status, dto.AuthResponse{
+		User:  dto.NewUserResponse(user),
+		Token: dto.NewTokenResponse(pair),
+	})
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+	"github.com/gin-gonic/gin"
+)
+
+// BlockHandler manages the signed-in user's block list
+type BlockHandler struct {
+	blockUC   *account.BlockUserUseCase
+	unblockUC *account.UnblockUserUseCase
+	listUC    *account.ListBlockedUsersUseCase
+}
+
+func NewBlockHandler(
+	blockUC *account.BlockUserUseCase,
+	unblockUC *account.UnblockUserUseCase,
+	listUC *account.ListBlockedUsersUseCase,
+) *BlockHandler {
+	return &BlockHandler{
+		blockUC:   blockUC,
+		unblockUC: unblockUC,
+		listUC:    listUC,
+	}
+}
+
+// List handles GET /api/v1/users/me/blocks
+func (h *BlockHandler) List(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	blocked, err := h.listUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.BlockedUserResponse, 0, len(blocked))
+	for _, b := range blocked {
+		resp = append(resp, dto.NewBlockedUserResponse(b))
+	}
+	c.JSON(http.StatusOK, dto.BlockedUserListResponse{Users: resp})
+}
+
+// Block handles PUT /api/v1/users/me/blocks/:id
+func (h *BlockHandler) Block(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.blockUC.Execute(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Unblock handles DELETE /api/v1/users/me/blocks/:id
+func (h *BlockHandler) Unblock(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.unblockUC.Execute(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
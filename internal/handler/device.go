@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+	"github.com/gin-gonic/gin"
+)
+
+// DeviceHandler manages the devices that receive the signed-in user's push notifications
+type DeviceHandler struct {
+	registerUC   *account.RegisterDeviceUseCase
+	unregisterUC *account.UnregisterDeviceUseCase
+}
+
+func NewDeviceHandler(registerUC *account.RegisterDeviceUseCase, unregisterUC *account.UnregisterDeviceUseCase) *DeviceHandler {
+	return &DeviceHandler{
+		registerUC:   registerUC,
+		unregisterUC: unregisterUC,
+	}
+}
+
+// Register handles POST /api/v1/users/me/devices
+func (h *DeviceHandler) Register(c *gin.Context) {
+	var req dto.RegisterDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	device, err := h.registerUC.Execute(c.Request.Context(), userID, req.DeviceID, req.Token, req.Platform, req.AppVersion, req.Locale)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewDeviceResponse(device))
+}
+
+// Unregister handles DELETE /api/v1/users/me/devices/:deviceId
+func (h *DeviceHandler) Unregister(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.unregisterUC.Execute(c.Request.Context(), userID, c.Param("deviceId")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
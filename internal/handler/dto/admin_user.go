@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+type AdminUserListRequest struct {
+	Query     string `form:"q"`
+	Role      string `form:"role" binding:"omitempty,oneof=user moderator admin guest"`
+	Suspended *bool  `form:"suspended"`
+	Offset    int    `form:"offset" binding:"min=0"`
+	Limit     int    `form:"limit" binding:"min=0"` // 0 uses the default page size
+}
+
+// ToUserFilter converts the request into the repository filter
+func (r AdminUserListRequest) ToUserFilter() repository.UserFilter {
+	return repository.UserFilter{
+		Query:     r.Query,
+		Role:      entity.Role(r.Role),
+		Suspended: r.Suspended,
+		Offset:    r.Offset,
+		Limit:     r.Limit,
+	}
+}
+
+type SuspendUserRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// AdminUserResponse is the operator's view of an account
+type AdminUserResponse struct {
+	UserResponse
+	Role             string     `json:"role"`
+	SuspendedAt      *Timestamp `json:"suspendedAt,omitempty"`
+	SuspensionReason string     `json:"suspensionReason,omitempty"`
+}
+
+// NewAdminUserResponse converts a domain user into its admin response DTO
+func NewAdminUserResponse(u *entity.User) AdminUserResponse {
+	return AdminUserResponse{
+		UserResponse:     NewUserResponse(u),
+		Role:             string(u.Role),
+		SuspendedAt:      NewOptionalTimestamp(u.SuspendedAt),
+		SuspensionReason: u.SuspensionReason,
+	}
+}
+
+type AdminUserListResponse struct {
+	Users []AdminUserResponse `json:"users"`
+	// NextOffset is omitted on the last page
+	NextOffset *int `json:"nextOffset,omitempty"`
+}
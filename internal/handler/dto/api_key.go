@@ -0,0 +1,51 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+
+type CreateAPIKeyRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+type CreateUserAPIKeyRequest struct {
+	Name   string   `json:"name" binding:"required,max=100"`
+	Scopes []string `json:"scopes" binding:"required,min=1,max=2,dive,oneof=read write"`
+}
+
+type APIKeyResponse struct {
+	ID         ID         `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	CreatedBy  ID         `json:"createdBy"`
+	Scopes     []string   `json:"scopes,omitempty"`
+	LastUsedAt *Timestamp `json:"lastUsedAt,omitempty"`
+	RevokedAt  *Timestamp `json:"revokedAt,omitempty"`
+	CreatedAt  Timestamp  `json:"createdAt"`
+}
+
+// APIKeySecretResponse includes the plaintext key, returned only on create and rotate
+type APIKeySecretResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+type APIKeyListResponse struct {
+	APIKeys []APIKeyResponse `json:"apiKeys"`
+}
+
+// NewAPIKeyResponse converts an API key into its response DTO
+func NewAPIKeyResponse(k *entity.APIKey) APIKeyResponse {
+	var scopes []string
+	for _, scope := range k.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	return APIKeyResponse{
+		ID:         ID(k.ID),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		CreatedBy:  ID(k.CreatedBy),
+		Scopes:     scopes,
+		LastUsedAt: NewOptionalTimestamp(k.LastUsedAt),
+		RevokedAt:  NewOptionalTimestamp(k.RevokedAt),
+		CreatedAt:  NewTimestamp(k.CreatedAt),
+	}
+}
@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/auth"
+)
+
+type SignupRequest struct {
+	Email    string `json:"email" binding:"required,max=254"`
+	Password string `json:"password" binding:"required"`
+	Nickname string `json:"nickname" binding:"required,max=20,noprofanity"`
+	DeviceID string `json:"deviceId" binding:"required,max=100"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+	DeviceID string `json:"deviceId" binding:"required,max=100"`
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	TokenType    string `json:"tokenType"`
+	ExpiresIn    int64  `json:"expiresIn"`
+}
+
+// NewTokenResponse converts an issued token pair into its response DTO
+func NewTokenResponse(pair *auth.TokenPair) TokenResponse {
+	return TokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		TokenType:    middleware.BearerScheme,
+		ExpiresIn:    int64(pair.ExpiresIn.Seconds()),
+	}
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refreshToken"` // optional, revokes this device's session too
+}
+
+type GuestLoginRequest struct {
+	DeviceID string `json:"deviceId" binding:"required,max=100"`
+}
+
+// UpgradeGuestRequest turns the current guest into a full account
+type UpgradeGuestRequest struct {
+	Email    string `json:"email" binding:"required,max=254"`
+	Password string `json:"password" binding:"required"`
+	Nickname string `json:"nickname" binding:"required,max=20,noprofanity"`
+	DeviceID string `json:"deviceId" binding:"required,max=100"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"newPassword" binding:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
+type AuthResponse struct {
+	User  UserResponse  `json:"user"`
+	Token TokenResponse `json:"token"`
+}
+
+type SocialLoginRequest struct {
+	IDToken  string `json:"idToken" binding:"required"`
+	DeviceID string `json:"deviceId" binding:"required,max=100"`
+	Nickname string `json:"nickname"` // optional, e.g. Apple only shares the name on first sign-in
+}
+
+type LinkSocialAccountRequest struct {
+	IDToken string `json:"idToken" binding:"required"`
+}
+
+type SocialAccountResponse struct {
+	Provider string    `json:"provider"`
+	Email    string    `json:"email,omitempty"`
+	LinkedAt Timestamp `json:"linkedAt"`
+}
+
+// NewSocialAccountResponse converts a linked account into its response DTO
+func NewSocialAccountResponse(a *entity.SocialAccount) SocialAccountResponse {
+	return SocialAccountResponse{
+		Provider: string(a.Provider),
+		Email:    a.Email,
+		LinkedAt: NewTimestamp(a.CreatedAt),
+	}
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+
+type BlockedUserResponse struct {
+	User      PublicUserResponse `json:"user"`
+	BlockedAt Timestamp          `json:"blockedAt"`
+}
+
+type BlockedUserListResponse struct {
+	Users []BlockedUserResponse `json:"users"`
+}
+
+// NewBlockedUserResponse converts a blocked user into its response DTO
+func NewBlockedUserResponse(b account.BlockedUser) BlockedUserResponse {
+	return BlockedUserResponse{
+		User:      NewPublicUserResponse(b.User),
+		BlockedAt: NewTimestamp(b.BlockedAt),
+	}
+}
@@ -0,0 +1,30 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+
+type RegisterDeviceRequest struct {
+	DeviceID   string                `json:"deviceId" binding:"required,max=100"` // stable per app installation
+	Token      string                `json:"token" binding:"required,max=512"`    // FCM registration token
+	Platform   entity.DevicePlatform `json:"platform" binding:"required,enum"`
+	AppVersion string                `json:"appVersion" binding:"max=20"`
+	Locale     string                `json:"locale" binding:"max=35"` // BCP 47; empty uses the user's locale
+}
+
+type DeviceResponse struct {
+	DeviceID   string                `json:"deviceId"`
+	Platform   entity.DevicePlatform `json:"platform"`
+	AppVersion string                `json:"appVersion,omitempty"`
+	Locale     string                `json:"locale,omitempty"`
+	CreatedAt  Timestamp             `json:"createdAt"`
+}
+
+// NewDeviceResponse converts a device registration into the response DTO
+func NewDeviceResponse(d *entity.Device) DeviceResponse {
+	return DeviceResponse{
+		DeviceID:   d.DeviceID,
+		Platform:   d.Platform,
+		AppVersion: d.AppVersion,
+		Locale:     d.Locale,
+		CreatedAt:  NewTimestamp(d.CreatedAt),
+	}
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+
+// Inbox item types
+const (
+	InboxItemInvitation   = "invitation"
+	InboxItemNotification = "notification"
+)
+
+// InboxRequest is the query of GET /api/v1/inbox
+type InboxRequest struct {
+	CursorRequest
+	// Unread leaves out notifications already read
+	Unread bool `form:"unread"`
+}
+
+// InboxItemResponse is one entry of the combined inbox; Type names the field that is set
+type InboxItemResponse struct {
+	Type         string                `json:"type"`
+	Invitation   *InvitationResponse   `json:"invitation,omitempty"`
+	Notification *NotificationResponse `json:"notification,omitempty"`
+	CreatedAt    Timestamp             `json:"createdAt"`
+}
+
+type InboxResponse struct {
+	Items []InboxItemResponse `json:"items"`
+	// UnreadCount counts every unread notification and pending invitation, not only those on this page
+	UnreadCount Count           `json:"unreadCount"`
+	Page        pagination.Meta `json:"page"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+
+type CreateJoinRequestRequest struct {
+	Message string `json:"message" binding:"max=200"`
+}
+
+type JoinRequestResponse struct {
+	ID        ID         `json:"id"`
+	RoomID    ID         `json:"roomId"`
+	Message   string     `json:"message,omitempty"`
+	Status    string     `json:"status"`
+	DecidedAt *Timestamp `json:"decidedAt,omitempty"`
+	CreatedAt Timestamp  `json:"createdAt"`
+}
+
+// NewJoinRequestResponse converts a join request into the response DTO
+func NewJoinRequestResponse(r *entity.JoinRequest) JoinRequestResponse {
+	return JoinRequestResponse{
+		ID:        ID(r.ID),
+		RoomID:    ID(r.RoomID),
+		Message:   r.Message,
+		Status:    string(r.Status),
+		DecidedAt: NewOptionalTimestamp(r.DecidedAt),
+		CreatedAt: NewTimestamp(r.CreatedAt),
+	}
+}
+
+// PendingJoinRequestResponse is a join request as shown to the room's managers
+type PendingJoinRequestResponse struct {
+	JoinRequestResponse
+	User PublicUserResponse `json:"user"`
+}
+
+type PendingJoinRequestListResponse struct {
+	Requests []PendingJoinRequestResponse `json:"requests"`
+}
@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// MaxSafeInteger is the largest integer JavaScript clients can represent exactly (2^53 - 1)
+const MaxSafeInteger = 1<<53 - 1
+
+// ID is an identifier that is always rendered as a JSON string
+// Clients must treat IDs as opaque strings, even when the underlying key is numeric
+type ID string
+
+// NewInt64ID creates an ID from a numeric key
+func NewInt64ID(id int64) ID {
+	return ID(strconv.FormatInt(id, 10))
+}
+
+// MarshalJSON renders the ID as a JSON string
+func (id ID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(id))
+}
+
+// UnmarshalJSON accepts both string and numeric IDs for compatibility with older clients
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = ID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*id = ID(n.String())
+	return nil
+}
+
+// Count is a non-negative counter rendered as a JSON number
+// Values above MaxSafeInteger are clamped so JavaScript clients never lose precision
+type Count int64
+
+// MarshalJSON renders the count as a JSON number within the safe integer range
+func (c Count) MarshalJSON() ([]byte, error) {
+	v := int64(c)
+	if v > MaxSafeInteger {
+		v = MaxSafeInteger
+	}
+	if v < 0 {
+		v = 0
+	}
+	return []byte(strconv.FormatInt(v, 10)), nil
+}
+
+// Timestamp is rendered as an RFC 3339 string in UTC
+type Timestamp time.Time
+
+// NewTimestamp converts a time.Time into a Timestamp
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp(t)
+}
+
+// NewOptionalTimestamp converts an optional time, keeping nil so omitempty drops it
+func NewOptionalTimestamp(t *time.Time) *Timestamp {
+	if t == nil {
+		return nil
+	}
+	ts := Timestamp(*t)
+	return &ts
+}
+
+// MarshalJSON renders the timestamp in UTC with second precision
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(t).UTC().Format(time.RFC3339))
+}
+
+// UnmarshalJSON parses an RFC 3339 timestamp
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return err
+	}
+	*t = Timestamp(parsed)
+	return nil
+}
@@ -0,0 +1,183 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+func TestIDMarshalsAsString(t *testing.T) {
+	tests := []struct {
+		id   ID
+		want string
+	}{
+		{id: "01HZX3K5", want: `"01HZX3K5"`},
+		{id: NewInt64ID(9007199254740993), want: `"9007199254740993"`},
+		{id: "", want: `""`},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.id)
+		if err != nil {
+			t.Fatalf("Marshal(%q): %v", tt.id, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Marshal(%q) = %s, want %s", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestIDUnmarshalsStringsAndNumbers(t *testing.T) {
+	tests := []struct {
+		data string
+		want ID
+	}{
+		{data: `"42"`, want: "42"},
+		{data: `42`, want: "42"},
+		// Beyond MaxSafeInteger a number must not pass through a float
+		{data: `9007199254740993`, want: "9007199254740993"},
+		{data: `null`, want: ""},
+	}
+	for _, tt := range tests {
+		var id ID
+		if err := json.Unmarshal([]byte(tt.data), &id); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", tt.data, err)
+		}
+		if id != tt.want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", tt.data, id, tt.want)
+		}
+	}
+
+	var id ID
+	if err := json.Unmarshal([]byte(`true`), &id); err == nil {
+		t.Error("Unmarshal(true) succeeded, want an error")
+	}
+}
+
+func TestCountMarshalsAsSafeNumber(t *testing.T) {
+	tests := []struct {
+		count Count
+		want  string
+	}{
+		{count: 0, want: `0`},
+		{count: 12, want: `12`},
+		{count: MaxSafeInteger, want: `9007199254740991`},
+		{count: MaxSafeInteger + 2, want: `9007199254740991`},
+		{count: -1, want: `0`},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.count)
+		if err != nil {
+			t.Fatalf("Marshal(%d): %v", tt.count, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Marshal(%d) = %s, want %s", tt.count, got, tt.want)
+		}
+	}
+}
+
+func TestTimestampRoundTrip(t *testing.T) {
+	seoul := time.FixedZone("KST", 9*60*60)
+	at := time.Date(2026, 3, 1, 6, 30, 15, 500, seoul)
+
+	data, err := json.Marshal(NewTimestamp(at))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `"2026-02-28T21:30:15Z"` {
+		t.Errorf("Marshal = %s, want UTC with second precision", data)
+	}
+
+	var ts Timestamp
+	if err := json.Unmarshal(data, &ts); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !time.Time(ts).Equal(at.Truncate(time.Second)) {
+		t.Errorf("Unmarshal = %v, want %v", time.Time(ts), at.Truncate(time.Second))
+	}
+	if err := json.Unmarshal([]byte(`"yesterday"`), &ts); err == nil {
+		t.Error("Unmarshal of a non RFC 3339 string succeeded")
+	}
+}
+
+// TestResponseWireTypes checks that responses render IDs as strings and counts as numbers
+func TestResponseWireTypes(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		resp    any
+		strings []string
+		numbers []string
+	}{
+		{
+			name: "prayer topic",
+			resp: NewPrayerTopicResponse(&entity.PrayerTopic{
+				ID: "1", RoomID: "2", AuthorID: "3", CommentCount: 4, ReactionCount: 5,
+				CreatedAt: now, UpdatedAt: now,
+			}),
+			strings: []string{"id", "roomId", "authorId", "createdAt", "updatedAt"},
+			numbers: []string{"commentCount", "reactionCount"},
+		},
+		{
+			name: "prayer comment",
+			resp: NewPrayerCommentResponse(&entity.PrayerComment{
+				ID: "1", TopicID: "2", ParentID: "3", AuthorID: "4", CreatedAt: now, UpdatedAt: now,
+			}),
+			strings: []string{"id", "topicId", "parentId", "authorId"},
+		},
+		{
+			name:    "reaction",
+			resp:    PrayerReactionResponse{ReactionCount: 7, Reacted: true},
+			numbers: []string{"reactionCount"},
+		},
+		{
+			name:    "invite",
+			resp:    NewInviteResponse(&entity.RoomInvite{RoomID: "9", MaxUses: 10, UseCount: 3, ExpiresAt: now}, ""),
+			strings: []string{"roomId", "expiresAt"},
+			numbers: []string{"useCount"},
+		},
+		{
+			name: "invite validation",
+			resp: NewInviteValidationResponse(
+				&entity.RoomInvite{RoomID: "9", MaxUses: 10, UseCount: 3, ExpiresAt: now},
+				&entity.Room{ID: "9", Name: "새벽기도"}, "",
+			),
+			strings: []string{"roomId", "roomName", "expiresAt"},
+			numbers: []string{"remainingUses"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.resp)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			var fields map[string]any
+			if err := json.Unmarshal(data, &fields); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			for _, key := range tt.strings {
+				if _, ok := fields[key].(string); !ok {
+					t.Errorf("%s = %#v, want a string", key, fields[key])
+				}
+			}
+			for _, key := range tt.numbers {
+				if _, ok := fields[key].(float64); !ok {
+					t.Errorf("%s = %#v, want a number", key, fields[key])
+				}
+			}
+		})
+	}
+}
+
+func TestCommentRequestAcceptsNumericParentID(t *testing.T) {
+	var req PrayerCommentRequest
+	if err := json.Unmarshal([]byte(`{"body":"아멘","parentId":42}`), &req); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if req.ParentID != "42" {
+		t.Errorf("ParentID = %q, want 42", req.ParentID)
+	}
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// NotificationResponse is an inbox entry; Type and Data route a tap the same way as a push
+type NotificationResponse struct {
+	ID        ID                `json:"id"`
+	Type      string            `json:"type"`
+	Title     string            `json:"title"`
+	Body      string            `json:"body"`
+	Data      map[string]string `json:"data,omitempty"`
+	ReadAt    *Timestamp        `json:"readAt,omitempty"`
+	CreatedAt Timestamp         `json:"createdAt"`
+}
+
+// NewNotificationResponse converts an inbox notification into the response DTO
+func NewNotificationResponse(n *entity.InboxNotification) NotificationResponse {
+	return NotificationResponse{
+		ID:        ID(n.ID),
+		Type:      string(n.Type),
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data,
+		ReadAt:    NewOptionalTimestamp(n.ReadAt),
+		CreatedAt: NewTimestamp(n.CreatedAt),
+	}
+}
+
+type NotificationListResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	// UnreadCount counts every unread notification, not only those on this page
+	UnreadCount Count           `json:"unreadCount"`
+	Page        pagination.Meta `json:"page"`
+}
+
+// UnreadCountsResponse maps room IDs to the caller's unread notifications in them
+// Rooms without unread notifications are left out
+type UnreadCountsResponse struct {
+	Rooms map[string]Count `json:"rooms"`
+}
+
+// NewUnreadCountsResponse converts unread counts by room into the response DTO
+func NewUnreadCountsResponse(counts map[string]int64) UnreadCountsResponse {
+	rooms := make(map[string]Count, len(counts))
+	for roomID, count := range counts {
+		rooms[roomID] = Count(count)
+	}
+	return UnreadCountsResponse{Rooms: rooms}
+}
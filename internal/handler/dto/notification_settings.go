@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+type NotificationSettingsResponse struct {
+	Invites        bool `json:"invites"`
+	PrayerAnswered bool `json:"prayerAnswered"`
+	Comments       bool `json:"comments"`
+	Reminders      bool `json:"reminders"`
+	Announcements  bool `json:"announcements"`
+	// Digest is off, hourly or daily
+	Digest entity.DigestFrequency `json:"digest"`
+	// SnoozedUntil is set while pushes are held back
+	SnoozedUntil *Timestamp `json:"snoozedUntil,omitempty"`
+}
+
+// NewNotificationSettingsResponse converts notification settings into the response DTO
+// A snooze that has already ended is left out
+func NewNotificationSettingsResponse(s entity.NotificationSettings) NotificationSettingsResponse {
+	var snoozedUntil *Timestamp
+	if s.Snoozed(time.Now()) {
+		snoozedUntil = NewOptionalTimestamp(s.SnoozedUntil)
+	}
+	return NotificationSettingsResponse{
+		Invites:        s.Invites,
+		PrayerAnswered: s.PrayerAnswered,
+		Comments:       s.Comments,
+		Reminders:      s.Reminders,
+		Announcements:  s.Announcements,
+		Digest:         s.Digest,
+		SnoozedUntil:   snoozedUntil,
+	}
+}
+
+// UpdateNotificationSettingsRequest is a partial update; omitted groups are left unchanged
+type UpdateNotificationSettingsRequest struct {
+	Invites        *bool                   `json:"invites"`
+	PrayerAnswered *bool                   `json:"prayerAnswered"`
+	Comments       *bool                   `json:"comments"`
+	Reminders      *bool                   `json:"reminders"`
+	Announcements  *bool                   `json:"announcements"`
+	Digest         *entity.DigestFrequency `json:"digest" binding:"omitempty,enum"`
+}
+
+// ToNotificationSettingsUpdate converts the request into the domain update
+func (r UpdateNotificationSettingsRequest) ToNotificationSettingsUpdate() entity.NotificationSettingsUpdate {
+	return entity.NotificationSettingsUpdate{
+		Invites:        r.Invites,
+		PrayerAnswered: r.PrayerAnswered,
+		Comments:       r.Comments,
+		Reminders:      r.Reminders,
+		Announcements:  r.Announcements,
+		Digest:         r.Digest,
+	}
+}
+
+// SnoozeNotificationsRequest holds back pushes for a number of minutes or until a point in time
+type SnoozeNotificationsRequest struct {
+	Minutes int        `json:"minutes" binding:"omitempty,min=1,excluded_with=Until"`
+	Until   *Timestamp `json:"until"`
+}
+
+// SnoozedUntil returns when the requested snooze ends; a request with neither field ends it at now,
+// which the domain rejects
+func (r SnoozeNotificationsRequest) SnoozedUntil(now time.Time) time.Time {
+	if r.Until != nil {
+		return time.Time(*r.Until)
+	}
+	return now.Add(time.Duration(r.Minutes) * time.Minute)
+}
@@ -0,0 +1,7 @@
+package dto
+
+// CursorRequest is the query of cursor-paginated list endpoints
+type CursorRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"min=0"` // 0 uses the default page size
+}
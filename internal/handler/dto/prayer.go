@@ -0,0 +1,350 @@
+package dto
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+type PrayerTopicRequest struct {
+	Title string   `json:"title" binding:"required,max=100"`
+	Tags  []string `json:"tags" binding:"max=5,dive,max=20"`
+	// Private keeps the topic to its author's journal (개인 기도)
+	Private bool `json:"private"`
+	// Recurrence is "weekly" or "monthly" to post the topic again on schedule
+	Recurrence entity.PrayerRecurrence `json:"recurrence" binding:"enum"`
+}
+
+// UpdatePrayerTopicRequest is a partial update; omitted fields are left unchanged
+type UpdatePrayerTopicRequest struct {
+	Title      *string                  `json:"title" binding:"omitempty,max=100"`
+	Tags       *[]string                `json:"tags" binding:"omitempty,max=5,dive,max=20"` // replaces all tags
+	Private    *bool                    `json:"private"`
+	Recurrence *entity.PrayerRecurrence `json:"recurrence" binding:"omitempty,enum"`    // "" stops recurring
+	Testimony  *string                  `json:"testimony" binding:"omitempty,max=1000"` // answered topics only; "" removes it
+}
+
+// ToPrayerTopicUpdate converts the request into the domain update
+func (r UpdatePrayerTopicRequest) ToPrayerTopicUpdate() entity.PrayerTopicUpdate {
+	return entity.PrayerTopicUpdate{
+		Title:      r.Title,
+		Tags:       r.Tags,
+		Private:    r.Private,
+		Recurrence: r.Recurrence,
+		Testimony:  r.Testimony,
+	}
+}
+
+// CompletePrayerTopicRequest optionally shares how the prayer was answered
+type CompletePrayerTopicRequest struct {
+	Testimony string `json:"testimony" binding:"max=1000"` // 응답 간증
+}
+
+// PauseRecurrenceRequest pauses or resumes a topic's recurrence
+type PauseRecurrenceRequest struct {
+	Paused *bool `json:"paused" binding:"required"`
+}
+
+// PrayerTopicListRequest is the query of a room's topic list
+type PrayerTopicListRequest struct {
+	CursorRequest
+	Deleted bool   `form:"deleted"` // list soft-deleted topics for audit
+	Tag     string `form:"tag"`
+}
+
+// ToPrayerTopicFilter converts the query into the repository filter
+// The feed leaves answered topics to the answered archive; the audit list shows every deleted topic
+func (r PrayerTopicListRequest) ToPrayerTopicFilter() repository.PrayerTopicFilter {
+	return repository.PrayerTopicFilter{Deleted: r.Deleted, Tag: r.Tag, Active: !r.Deleted}
+}
+
+type PrayerTopicResponse struct {
+	ID       ID       `json:"id"`
+	RoomID   ID       `json:"roomId"`
+	AuthorID ID       `json:"authorId"`
+	Title    string   `json:"title"`
+	Tags     []string `json:"tags"`
+	Private  bool     `json:"private"`
+	// Recurrence is omitted for topics that do not recur
+	Recurrence       entity.PrayerRecurrence `json:"recurrence,omitempty"`
+	RecurrencePaused bool                    `json:"recurrencePaused,omitempty"`
+	NextRecurrenceAt *Timestamp              `json:"nextRecurrenceAt,omitempty"`
+	AnsweredAt       *Timestamp              `json:"answeredAt,omitempty"`
+	Testimony        string                  `json:"testimony,omitempty"`
+	CommentCount     Count                   `json:"commentCount"`
+	ReactionCount    Count                   `json:"reactionCount"`
+	// PinnedAt and PinPosition are omitted for topics that are not pinned
+	PinnedAt    *Timestamp `json:"pinnedAt,omitempty"`
+	PinPosition int        `json:"pinPosition,omitempty"`
+	// Reacted is only set where the response is personal to the caller
+	Reacted   *bool      `json:"reacted,omitempty"`
+	DeletedAt *Timestamp `json:"deletedAt,omitempty"`
+	DeletedBy ID         `json:"deletedBy,omitempty"`
+	CreatedAt Timestamp  `json:"createdAt"`
+	UpdatedAt Timestamp  `json:"updatedAt"`
+}
+
+// NewPrayerTopicResponse converts a prayer topic into the response DTO
+func NewPrayerTopicResponse(t *entity.PrayerTopic) PrayerTopicResponse {
+	return PrayerTopicResponse{
+		ID:               ID(t.ID),
+		RoomID:           ID(t.RoomID),
+		AuthorID:         ID(t.AuthorID),
+		Title:            t.Title,
+		Tags:             t.Tags,
+		Private:          t.Private,
+		Recurrence:       t.Recurrence,
+		RecurrencePaused: t.RecurrencePaused,
+		NextRecurrenceAt: NewOptionalTimestamp(t.NextRecurrenceAt),
+		AnsweredAt:       NewOptionalTimestamp(t.AnsweredAt),
+		Testimony:        t.Testimony,
+		CommentCount:     Count(t.CommentCount),
+		ReactionCount:    Count(t.ReactionCount),
+		PinnedAt:         NewOptionalTimestamp(t.PinnedAt),
+		PinPosition:      t.PinPosition,
+		DeletedAt:        NewOptionalTimestamp(t.DeletedAt),
+		DeletedBy:        ID(t.DeletedBy),
+		CreatedAt:        NewTimestamp(t.CreatedAt),
+		UpdatedAt:        NewTimestamp(t.UpdatedAt),
+	}
+}
+
+// NewPrayerTopicViewResponse converts a topic together with whether the caller reacted to it
+func NewPrayerTopicViewResponse(t *entity.PrayerTopic, reacted bool) PrayerTopicResponse {
+	resp := NewPrayerTopicResponse(t)
+	resp.Reacted = &reacted
+	return resp
+}
+
+type PrayerTopicListResponse struct {
+	Prayers []PrayerTopicResponse `json:"prayers"`
+	Page    pagination.Meta       `json:"page"`
+}
+
+// ReorderPinsRequest lists every pinned topic of a room in the order they should appear
+type ReorderPinsRequest struct {
+	PrayerIDs []string `json:"prayerIds" binding:"required,max=100,dive,max=36"`
+}
+
+type PinnedPrayerListResponse struct {
+	Prayers []PrayerTopicResponse `json:"prayers"`
+}
+
+// ReorderMyPrayersRequest lists the caller's own topics in the order they should head "my prayers"
+// Topics left out follow newest first; an empty list drops the custom order
+type ReorderMyPrayersRequest struct {
+	PrayerIDs []string `json:"prayerIds" binding:"max=100,dive,max=36"`
+}
+
+// OrderedPrayerListResponse lists the topics the caller put in order, in that order
+type OrderedPrayerListResponse struct {
+	Prayers []PrayerTopicResponse `json:"prayers"`
+}
+
+type PrayerContentRequest struct {
+	Body string `json:"body" binding:"required,max=1000"`
+}
+
+type PrayerContentResponse struct {
+	ID        ID        `json:"id"`
+	TopicID   ID        `json:"topicId"`
+	AuthorID  ID        `json:"authorId"`
+	Body      string    `json:"body"`
+	CreatedAt Timestamp `json:"createdAt"`
+	UpdatedAt Timestamp `json:"updatedAt"`
+}
+
+// NewPrayerContentResponse converts a prayer content entry into the response DTO
+func NewPrayerContentResponse(c *entity.PrayerContent) PrayerContentResponse {
+	return PrayerContentResponse{
+		ID:        ID(c.ID),
+		TopicID:   ID(c.TopicID),
+		AuthorID:  ID(c.AuthorID),
+		Body:      c.Body,
+		CreatedAt: NewTimestamp(c.CreatedAt),
+		UpdatedAt: NewTimestamp(c.UpdatedAt),
+	}
+}
+
+// PrayerRevisionResponse is an earlier version of a topic's title or of one of its entries
+type PrayerRevisionResponse struct {
+	ID        ID        `json:"id"`
+	Field     string    `json:"field"`
+	ContentID ID        `json:"contentId,omitempty"`
+	Previous  string    `json:"previous"`
+	EditorID  ID        `json:"editorId"`
+	EditedAt  Timestamp `json:"editedAt"`
+}
+
+// NewPrayerRevisionResponse converts a revision into the response DTO
+func NewPrayerRevisionResponse(r *entity.PrayerRevision) PrayerRevisionResponse {
+	return PrayerRevisionResponse{
+		ID:        ID(r.ID),
+		Field:     string(r.Field),
+		ContentID: ID(r.ContentID),
+		Previous:  r.Previous,
+		EditorID:  ID(r.EditorID),
+		EditedAt:  NewTimestamp(r.CreatedAt),
+	}
+}
+
+// PrayerParticipantResponse is a member who prayed for a topic; User is null for anonymous members
+type PrayerParticipantResponse struct {
+	User        *PublicUserResponse `json:"user"`
+	Anonymous   bool                `json:"anonymous"`
+	PrayedCount Count               `json:"prayedCount"`
+	PrayedAt    Timestamp           `json:"prayedAt"`
+}
+
+// NewPrayerParticipantResponse converts a reaction and its member's account, nil when hidden, into the response DTO
+func NewPrayerParticipantResponse(r *entity.PrayerReaction, u *entity.User) PrayerParticipantResponse {
+	resp := PrayerParticipantResponse{
+		Anonymous:   r.Anonymous,
+		PrayedCount: Count(r.Count),
+		PrayedAt:    NewTimestamp(r.PrayedAt),
+	}
+	if u != nil {
+		user := NewPublicUserResponse(u)
+		resp.User = &user
+	}
+	return resp
+}
+
+type PrayerParticipantListResponse struct {
+	// Total counts every member who prayed, anonymous ones included
+	Total        Count                       `json:"total"`
+	Participants []PrayerParticipantResponse `json:"participants"`
+	Page         pagination.Meta             `json:"page"`
+}
+
+type PrayerRevisionListResponse struct {
+	Revisions []PrayerRevisionResponse `json:"revisions"`
+}
+
+type PrayerContentListResponse struct {
+	Contents []PrayerContentResponse `json:"contents"`
+	Page     pagination.Meta         `json:"page"`
+}
+
+type PrayerCommentRequest struct {
+	Body string `json:"body" binding:"required,max=500"`
+	// ParentID makes the comment a reply to a top-level comment
+	ParentID ID `json:"parentId"`
+}
+
+type PrayerCommentUpdateRequest struct {
+	Body string `json:"body" binding:"required,max=500"`
+}
+
+// PrayerCommentListRequest is the query of a topic's comments
+type PrayerCommentListRequest struct {
+	CursorRequest
+	ParentID ID `form:"parentId"` // list the replies to this comment
+}
+
+type PrayerCommentResponse struct {
+	ID         ID        `json:"id"`
+	TopicID    ID        `json:"topicId"`
+	ParentID   ID        `json:"parentId,omitempty"`
+	AuthorID   ID        `json:"authorId"`
+	Body       string    `json:"body"`
+	MentionIDs []ID      `json:"mentionIds"`
+	CreatedAt  Timestamp `json:"createdAt"`
+	UpdatedAt  Timestamp `json:"updatedAt"`
+}
+
+// NewPrayerCommentResponse converts a prayer comment into the response DTO
+func NewPrayerCommentResponse(c *entity.PrayerComment) PrayerCommentResponse {
+	mentions := make([]ID, 0, len(c.MentionIDs))
+	for _, id := range c.MentionIDs {
+		mentions = append(mentions, ID(id))
+	}
+	return PrayerCommentResponse{
+		ID:         ID(c.ID),
+		TopicID:    ID(c.TopicID),
+		ParentID:   ID(c.ParentID),
+		AuthorID:   ID(c.AuthorID),
+		Body:       c.Body,
+		MentionIDs: mentions,
+		CreatedAt:  NewTimestamp(c.CreatedAt),
+		UpdatedAt:  NewTimestamp(c.UpdatedAt),
+	}
+}
+
+type PrayerCommentListResponse struct {
+	Comments []PrayerCommentResponse `json:"comments"`
+	Page     pagination.Meta         `json:"page"`
+}
+
+// PrayerReactionRequest optionally keeps the member's name out of the list of who prayed
+type PrayerReactionRequest struct {
+	Anonymous bool `json:"anonymous"`
+}
+
+type PrayerReactionResponse struct {
+	// ReactionCount is how many members prayed for the topic
+	ReactionCount Count `json:"reactionCount"`
+	Reacted       bool  `json:"reacted"`
+	// PrayedCount is how many of the caller's prayers for the topic were counted; 0 once withdrawn
+	PrayedCount Count `json:"prayedCount"`
+}
+
+type PrayerTagCountResponse struct {
+	Tag   string `json:"tag"`
+	Count Count  `json:"count"`
+}
+
+type PrayerTagCloudResponse struct {
+	Tags []PrayerTagCountResponse `json:"tags"`
+	// Suggested are the tags the app offers when tagging
+	Suggested []string `json:"suggested"`
+}
+
+// NewPrayerTagCloudResponse converts a room's tag counts into the response DTO
+func NewPrayerTagCloudResponse(counts []repository.PrayerTagCount) PrayerTagCloudResponse {
+	tags := make([]PrayerTagCountResponse, 0, len(counts))
+	for _, c := range counts {
+		tags = append(tags, PrayerTagCountResponse{Tag: c.Tag, Count: Count(c.Count)})
+	}
+	return PrayerTagCloudResponse{Tags: tags, Suggested: entity.SuggestedPrayerTags}
+}
+
+// PrayerSearchRequest is the query of a room's prayer search
+type PrayerSearchRequest struct {
+	CursorRequest
+	Q string `form:"q" binding:"required"`
+}
+
+type HighlightResponse struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type PrayerSearchHitResponse struct {
+	Prayer PrayerTopicResponse `json:"prayer"`
+	// ContentID is the entry the snippet was taken from; omitted when it is the title
+	ContentID ID     `json:"contentId,omitempty"`
+	Snippet   string `json:"snippet"`
+	// Highlights are character offsets of the matches within the snippet
+	Highlights []HighlightResponse `json:"highlights"`
+}
+
+// NewPrayerSearchHitResponse converts a search hit into the response DTO
+func NewPrayerSearchHitResponse(h prayer.SearchHit) PrayerSearchHitResponse {
+	highlights := make([]HighlightResponse, 0, len(h.Highlights))
+	for _, hl := range h.Highlights {
+		highlights = append(highlights, HighlightResponse{Start: hl.Start, End: hl.End})
+	}
+	return PrayerSearchHitResponse{
+		Prayer:     NewPrayerTopicResponse(h.Topic),
+		ContentID:  ID(h.ContentID),
+		Snippet:    h.Snippet,
+		Highlights: highlights,
+	}
+}
+
+type PrayerSearchResponse struct {
+	Results []PrayerSearchHitResponse `json:"results"`
+	Page    pagination.Meta           `json:"page"`
+}
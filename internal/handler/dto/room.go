@@ -0,0 +1,160 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+type CreateRoomRequest struct {
+	Name        string   `json:"name" binding:"required,max=50,noprofanity"`
+	Description string   `json:"description" binding:"max=500,noprofanity"`
+	Visibility  string   `json:"visibility" binding:"omitempty,oneof=public private"` // "public" or "private" (default)
+	Category    string   `json:"category"`                                            // optional, one of the room categories
+	Tags        []string `json:"tags" binding:"max=5,dive,max=20"`
+}
+
+// UpdateRoomRequest is a partial update; omitted fields are left unchanged
+type UpdateRoomRequest struct {
+	Name        *string   `json:"name" binding:"omitempty,max=50,noprofanity"`
+	Description *string   `json:"description" binding:"omitempty,max=500,noprofanity"`
+	Visibility  *string   `json:"visibility" binding:"omitempty,oneof=public private"`
+	Category    *string   `json:"category"`                                   // "" clears the category
+	Tags        *[]string `json:"tags" binding:"omitempty,max=5,dive,max=20"` // replaces all tags
+}
+
+// ToRoomUpdate converts the request into the domain update
+func (r UpdateRoomRequest) ToRoomUpdate() entity.RoomUpdate {
+	update := entity.RoomUpdate{
+		Name:        r.Name,
+		Description: r.Description,
+	}
+	if r.Visibility != nil {
+		visibility := entity.RoomVisibility(*r.Visibility)
+		update.Visibility = &visibility
+	}
+	if r.Category != nil {
+		category := entity.RoomCategory(*r.Category)
+		update.Category = &category
+	}
+	update.Tags = r.Tags
+	return update
+}
+
+type RoomResponse struct {
+	ID            ID         `json:"id"`
+	Name          string     `json:"name"`
+	Description   string     `json:"description"`
+	Visibility    string     `json:"visibility"`
+	Category      string     `json:"category,omitempty"`
+	Tags          []string   `json:"tags"`
+	OwnerID       ID         `json:"ownerId"`
+	CoverImageURL string     `json:"coverImageUrl,omitempty"`
+	ArchivedAt    *Timestamp `json:"archivedAt,omitempty"`
+	CreatedAt     Timestamp  `json:"createdAt"`
+	UpdatedAt     Timestamp  `json:"updatedAt"`
+}
+
+// NewRoomResponse converts a domain room into its response DTO
+func NewRoomResponse(r *entity.Room) RoomResponse {
+	return RoomResponse{
+		ID:            ID(r.ID),
+		Name:          r.Name,
+		Description:   r.Description,
+		Visibility:    string(r.Visibility),
+		Category:      string(r.Category),
+		Tags:          r.Tags,
+		OwnerID:       ID(r.OwnerID),
+		CoverImageURL: r.CoverImageURL,
+		ArchivedAt:    NewOptionalTimestamp(r.ArchivedAt),
+		CreatedAt:     NewTimestamp(r.CreatedAt),
+		UpdatedAt:     NewTimestamp(r.UpdatedAt),
+	}
+}
+
+// RoomDetailResponse is a single room with what its detail screen shows on top
+type RoomDetailResponse struct {
+	RoomResponse
+	LatestAnnouncement *AnnouncementResponse `json:"latestAnnouncement,omitempty"`
+}
+
+// RoomListRequest is the query of the room list
+type RoomListRequest struct {
+	CursorRequest
+	Category string `form:"category"`
+	Tags     string `form:"tags"`     // comma-separated; rooms must carry all of them
+	Archived bool   `form:"archived"` // list archived rooms instead of active ones
+}
+
+// ToRoomFilter converts the request into the repository filter
+func (r RoomListRequest) ToRoomFilter() repository.RoomFilter {
+	filter := repository.RoomFilter{
+		Category: entity.RoomCategory(r.Category),
+		Archived: r.Archived,
+	}
+	if r.Tags != "" {
+		filter.Tags = strings.Split(r.Tags, ",")
+	}
+	return filter
+}
+
+// RoomSearchRequest is the query of the room search; filters work as in the room list
+type RoomSearchRequest struct {
+	RoomListRequest
+	Query string `form:"q" binding:"required"`
+}
+
+type RoomListResponse struct {
+	Rooms []RoomResponse  `json:"rooms"`
+	Page  pagination.Meta `json:"page"`
+}
+
+type RoomMemberResponse struct {
+	User       PublicUserResponse `json:"user"`
+	Role       string             `json:"role"`
+	JoinedAt   Timestamp          `json:"joinedAt"`
+	LastSeenAt *Timestamp         `json:"lastSeenAt,omitempty"` // last time the member opened the room
+}
+
+// NewRoomMemberResponse converts a membership and its account into the response DTO
+func NewRoomMemberResponse(m *entity.RoomMember, u *entity.User) RoomMemberResponse {
+	return RoomMemberResponse{
+		User:       NewPublicUserResponse(u),
+		Role:       string(m.Role),
+		JoinedAt:   NewTimestamp(m.JoinedAt),
+		LastSeenAt: NewOptionalTimestamp(m.LastSeenAt),
+	}
+}
+
+type RoomMemberListResponse struct {
+	Members []RoomMemberResponse `json:"members"`
+}
+
+type ChangeMemberRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=owner moderator member"`
+}
+
+type RoomMemberRoleResponse struct {
+	UserID ID     `json:"userId"`
+	Role   string `json:"role"`
+}
+
+type RoomCategoryResponse struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+}
+
+type RoomCategoryListResponse struct {
+	Categories []RoomCategoryResponse `json:"categories"`
+}
+
+type MuteRoomRequest struct {
+	Muted *bool `json:"muted" binding:"required"`
+}
+
+type RoomMuteResponse struct {
+	RoomID ID   `json:"roomId"`
+	Muted  bool `json:"muted"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+type AnnouncementRequest struct {
+	Body string `json:"body" binding:"required,max=1000"`
+}
+
+type AnnouncementResponse struct {
+	ID        ID        `json:"id"`
+	RoomID    ID        `json:"roomId"`
+	AuthorID  ID        `json:"authorId"`
+	Body      string    `json:"body"`
+	CreatedAt Timestamp `json:"createdAt"`
+	UpdatedAt Timestamp `json:"updatedAt"`
+}
+
+// NewAnnouncementResponse converts an announcement into the response DTO
+func NewAnnouncementResponse(a *entity.RoomAnnouncement) AnnouncementResponse {
+	return AnnouncementResponse{
+		ID:        ID(a.ID),
+		RoomID:    ID(a.RoomID),
+		AuthorID:  ID(a.AuthorID),
+		Body:      a.Body,
+		CreatedAt: NewTimestamp(a.CreatedAt),
+		UpdatedAt: NewTimestamp(a.UpdatedAt),
+	}
+}
+
+type AnnouncementListResponse struct {
+	Announcements []AnnouncementResponse `json:"announcements"`
+	Page          pagination.Meta        `json:"page"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+
+type CreateExportRequest struct {
+	Format string `json:"format" binding:"omitempty,oneof=csv pdf"` // csv or pdf; empty means csv
+}
+
+// RoomExportResponse describes a queued export; the file itself is delivered by notification
+type RoomExportResponse struct {
+	ID        ID         `json:"id"`
+	RoomID    ID         `json:"roomId"`
+	Format    string     `json:"format"`
+	Status    string     `json:"status"`
+	CreatedAt Timestamp  `json:"createdAt"`
+	StartedAt *Timestamp `json:"startedAt,omitempty"`
+}
+
+// NewRoomExportResponse converts an export into the response DTO
+func NewRoomExportResponse(e *entity.RoomExport) RoomExportResponse {
+	return RoomExportResponse{
+		ID:        ID(e.ID),
+		RoomID:    ID(e.RoomID),
+		Format:    string(e.Format),
+		Status:    string(e.Status),
+		CreatedAt: NewTimestamp(e.CreatedAt),
+		StartedAt: NewOptionalTimestamp(e.StartedAt),
+	}
+}
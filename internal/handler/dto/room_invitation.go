@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+type CreateInvitationRequest struct {
+	UserID ID `json:"userId" binding:"required"`
+}
+
+// InvitationListRequest is the query of GET /api/v1/invitations
+type InvitationListRequest struct {
+	CursorRequest
+	// Status is pending (the default), accepted, rejected or expired
+	Status string `form:"status"`
+}
+
+type InvitationResponse struct {
+	ID          ID         `json:"id"`
+	RoomID      ID         `json:"roomId"`
+	InviterID   ID         `json:"inviterId"`
+	Status      string     `json:"status"`
+	ExpiresAt   Timestamp  `json:"expiresAt"`
+	RespondedAt *Timestamp `json:"respondedAt,omitempty"`
+	CreatedAt   Timestamp  `json:"createdAt"`
+}
+
+// NewInvitationResponse converts an invitation into the response DTO, with its status at now
+func NewInvitationResponse(i *entity.RoomInvitation, now time.Time) InvitationResponse {
+	return InvitationResponse{
+		ID:          ID(i.ID),
+		RoomID:      ID(i.RoomID),
+		InviterID:   ID(i.InviterID),
+		Status:      string(i.StatusAt(now)),
+		ExpiresAt:   NewTimestamp(i.ExpiresAt),
+		RespondedAt: NewOptionalTimestamp(i.RespondedAt),
+		CreatedAt:   NewTimestamp(i.CreatedAt),
+	}
+}
+
+type InvitationListResponse struct {
+	Invitations []InvitationResponse `json:"invitations"`
+	Page        pagination.Meta      `json:"page"`
+}
+
+// InvitationCountResponse is the pending invitation count shown on the inbox badge
+type InvitationCountResponse struct {
+	Count Count `json:"count"`
+}
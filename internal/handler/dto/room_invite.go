@@ -0,0 +1,63 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+
+type CreateInviteRequest struct {
+	MaxUses        int `json:"maxUses" binding:"min=0"`        // 0 means unlimited
+	ExpiresInHours int `json:"expiresInHours" binding:"min=0"` // 0 uses the default of 7 days
+}
+
+type InviteResponse struct {
+	Code      string    `json:"code"`
+	Link      string    `json:"link"`
+	RoomID    ID        `json:"roomId"`
+	MaxUses   int       `json:"maxUses"`
+	UseCount  Count     `json:"useCount"`
+	ExpiresAt Timestamp `json:"expiresAt"`
+}
+
+// NewInviteResponse converts an invite and its deep link into the response DTO
+func NewInviteResponse(i *entity.RoomInvite, link string) InviteResponse {
+	return InviteResponse{
+		Code:      i.Code,
+		Link:      link,
+		RoomID:    ID(i.RoomID),
+		MaxUses:   i.MaxUses,
+		UseCount:  Count(i.UseCount),
+		ExpiresAt: NewTimestamp(i.ExpiresAt),
+	}
+}
+
+// InvitePreviewResponse shows where an invite leads before it is accepted
+type InvitePreviewResponse struct {
+	Room      RoomResponse `json:"room"`
+	ExpiresAt Timestamp    `json:"expiresAt"`
+}
+
+// InviteValidationResponse tells whether an invite code can be accepted
+// Room fields are only set for valid codes; expiry and remaining uses are set for every known code
+type InviteValidationResponse struct {
+	Valid    bool   `json:"valid"`
+	Reason   string `json:"reason,omitempty"` // not_found, expired, exhausted or room_archived
+	RoomID   ID     `json:"roomId,omitempty"`
+	RoomName string `json:"roomName,omitempty"`
+	// RemainingUses is null for invites without a use limit
+	RemainingUses *int       `json:"remainingUses"`
+	ExpiresAt     *Timestamp `json:"expiresAt,omitempty"`
+}
+
+// NewInviteValidationResponse converts the outcome of validating an invite code into the response DTO
+func NewInviteValidationResponse(invite *entity.RoomInvite, room *entity.Room, rejection entity.InviteRejection) InviteValidationResponse {
+	resp := InviteValidationResponse{Valid: rejection == "", Reason: string(rejection)}
+	if room != nil {
+		resp.RoomID = ID(room.ID)
+		resp.RoomName = room.Name
+	}
+	if invite != nil {
+		if remaining, ok := invite.RemainingUses(); ok {
+			resp.RemainingUses = &remaining
+		}
+		resp.ExpiresAt = NewOptionalTimestamp(&invite.ExpiresAt)
+	}
+	return resp
+}
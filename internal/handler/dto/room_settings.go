@@ -0,0 +1,45 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+
+type RoomSettingsResponse struct {
+	Visibility   string `json:"visibility"`
+	MemberCap    int    `json:"memberCap"` // 0 means unlimited
+	ReminderTime string `json:"reminderTime,omitempty"`
+	PostPolicy   string `json:"postPolicy"`
+}
+
+// NewRoomSettingsResponse converts a room's settings into the response DTO
+func NewRoomSettingsResponse(r *entity.Room) RoomSettingsResponse {
+	return RoomSettingsResponse{
+		Visibility:   string(r.Visibility),
+		MemberCap:    r.Settings.MemberCap,
+		ReminderTime: r.Settings.ReminderTime,
+		PostPolicy:   string(r.Settings.PostPolicy),
+	}
+}
+
+// UpdateRoomSettingsRequest is a partial update; omitted fields are left unchanged
+type UpdateRoomSettingsRequest struct {
+	Visibility   *string `json:"visibility" binding:"omitempty,oneof=public private"`
+	MemberCap    *int    `json:"memberCap" binding:"omitempty,min=0,max=1000"`
+	ReminderTime *string `json:"reminderTime"`                                            // "HH:MM", or "" to clear
+	PostPolicy   *string `json:"postPolicy" binding:"omitempty,oneof=members moderators"` // "members" or "moderators"
+}
+
+// ToRoomSettingsUpdate converts the request into the domain update
+func (r UpdateRoomSettingsRequest) ToRoomSettingsUpdate() entity.RoomSettingsUpdate {
+	update := entity.RoomSettingsUpdate{
+		MemberCap:    r.MemberCap,
+		ReminderTime: r.ReminderTime,
+	}
+	if r.Visibility != nil {
+		visibility := entity.RoomVisibility(*r.Visibility)
+		update.Visibility = &visibility
+	}
+	if r.PostPolicy != nil {
+		policy := entity.RoomPostPolicy(*r.PostPolicy)
+		update.PostPolicy = &policy
+	}
+	return update
+}
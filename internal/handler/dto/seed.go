@@ -0,0 +1,23 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/seed"
+
+// SeedResponse counts the demo data that was created
+type SeedResponse struct {
+	Users       Count `json:"users"`
+	Rooms       Count `json:"rooms"`
+	Invitations Count `json:"invitations"`
+	Members     Count `json:"members"`
+	Prayers     Count `json:"prayers"`
+}
+
+// NewSeedResponse converts a seeding summary into the response DTO
+func NewSeedResponse(s *seed.Summary) SeedResponse {
+	return SeedResponse{
+		Users:       Count(s.Users),
+		Rooms:       Count(s.Rooms),
+		Invitations: Count(s.Invitations),
+		Members:     Count(s.Members),
+		Prayers:     Count(s.Prayers),
+	}
+}
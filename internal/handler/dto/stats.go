@@ -0,0 +1,39 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+
+type RoomStatsResponse struct {
+	PrayersCreated  Count `json:"prayersCreated"`
+	PrayersAnswered Count `json:"prayersAnswered"`
+	Reactions       Count `json:"reactions"`
+	ActiveDays      Count `json:"activeDays"`
+}
+
+// NewRoomStatsResponse converts room statistics into the response DTO
+func NewRoomStatsResponse(s *repository.RoomStats) RoomStatsResponse {
+	return RoomStatsResponse{
+		PrayersCreated:  Count(s.PrayersCreated),
+		PrayersAnswered: Count(s.PrayersAnswered),
+		Reactions:       Count(s.Reactions),
+		ActiveDays:      Count(s.ActiveDays),
+	}
+}
+
+type UserStatsResponse struct {
+	PrayersCreated    Count `json:"prayersCreated"`
+	PrayersAnswered   Count `json:"prayersAnswered"`
+	ReactionsGiven    Count `json:"reactionsGiven"`
+	ReactionsReceived Count `json:"reactionsReceived"`
+	ActiveDays        Count `json:"activeDays"`
+}
+
+// NewUserStatsResponse converts user statistics into the response DTO
+func NewUserStatsResponse(s *repository.UserStats) UserStatsResponse {
+	return UserStatsResponse{
+		PrayersCreated:    Count(s.PrayersCreated),
+		PrayersAnswered:   Count(s.PrayersAnswered),
+		ReactionsGiven:    Count(s.ReactionsGiven),
+		ReactionsReceived: Count(s.ReactionsReceived),
+		ActiveDays:        Count(s.ActiveDays),
+	}
+}
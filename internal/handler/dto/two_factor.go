@@ -0,0 +1,26 @@
+package dto
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type VerifyTwoFactorRequest struct {
+	MFAToken string `json:"mfaToken" binding:"required"`
+	Code     string `json:"code" binding:"required"` // TOTP code or backup code
+}
+
+type TwoFactorEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauthUri"`
+}
+
+type BackupCodesResponse struct {
+	BackupCodes []string `json:"backupCodes"`
+}
+
+// MFAChallengeResponse is returned by login instead of tokens when 2FA is enabled
+// The client completes the login with POST /api/v1/auth/2fa/verify
+type MFAChallengeResponse struct {
+	MFARequired bool   `json:"mfaRequired"`
+	MFAToken    string `json:"mfaToken"`
+}
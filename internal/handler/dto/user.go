@@ -0,0 +1,124 @@
+package dto
+
+import "github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+
+// UserResponse is the signed-in user's own view of their account
+type UserResponse struct {
+	ID               ID     `json:"id"`
+	Email            string `json:"email"`
+	Nickname         string `json:"nickname"`
+	Bio              string `json:"bio"`
+	ProfileImageURL  string `json:"profileImageUrl,omitempty"`
+	Timezone         string `json:"timezone"`
+	Locale           string `json:"locale"`
+	HiddenFromSearch bool   `json:"hiddenFromSearch"`
+	EmailVerified    bool   `json:"emailVerified"`
+	Guest            bool   `json:"guest"`
+	// PurgeAt is set while a deleted account can still be restored
+	PurgeAt   *Timestamp `json:"purgeAt,omitempty"`
+	CreatedAt Timestamp  `json:"createdAt"`
+}
+
+// NewUserResponse converts a domain user into its response DTO
+func NewUserResponse(u *entity.User) UserResponse {
+	return UserResponse{
+		ID:               ID(u.ID),
+		Email:            u.Email,
+		Nickname:         u.Nickname,
+		Bio:              u.Bio,
+		ProfileImageURL:  u.ProfileImageURL,
+		Timezone:         u.Timezone,
+		Locale:           u.Locale,
+		HiddenFromSearch: u.HiddenFromSearch,
+		EmailVerified:    u.IsEmailVerified(),
+		Guest:            u.IsGuest(),
+		PurgeAt:          NewOptionalTimestamp(u.PurgeAt),
+		CreatedAt:        NewTimestamp(u.CreatedAt),
+	}
+}
+
+// PublicUserResponse is what other users can see
+type PublicUserResponse struct {
+	ID              ID     `json:"id"`
+	Nickname        string `json:"nickname"`
+	Bio             string `json:"bio"`
+	ProfileImageURL string `json:"profileImageUrl,omitempty"`
+}
+
+// NewPublicUserResponse converts a domain user into its public response DTO
+func NewPublicUserResponse(u *entity.User) PublicUserResponse {
+	return PublicUserResponse{
+		ID:              ID(u.ID),
+		Nickname:        u.Nickname,
+		Bio:             u.Bio,
+		ProfileImageURL: u.ProfileImageURL,
+	}
+}
+
+// UpdateProfileRequest is a partial update; omitted fields are left unchanged
+type UpdateProfileRequest struct {
+	Nickname         *string `json:"nickname" binding:"omitempty,max=20,noprofanity"`
+	Bio              *string `json:"bio" binding:"omitempty,max=200"`
+	ProfileImageURL  *string `json:"profileImageUrl" binding:"omitempty,max=500"`
+	Timezone         *string `json:"timezone" binding:"omitempty,max=64"`
+	Locale           *string `json:"locale" binding:"omitempty,max=35"`
+	HiddenFromSearch *bool   `json:"hiddenFromSearch"`
+}
+
+// ToProfileUpdate converts the request into the domain update
+func (r UpdateProfileRequest) ToProfileUpdate() entity.ProfileUpdate {
+	return entity.ProfileUpdate{
+		Nickname:         r.Nickname,
+		Bio:              r.Bio,
+		ProfileImageURL:  r.ProfileImageURL,
+		Timezone:         r.Timezone,
+		Locale:           r.Locale,
+		HiddenFromSearch: r.HiddenFromSearch,
+	}
+}
+
+type NicknameCheckResponse struct {
+	Nickname  string `json:"nickname"`
+	Available bool   `json:"available"`
+}
+
+type UserSearchRequest struct {
+	Query  string `form:"q"`
+	Offset int    `form:"offset" binding:"min=0"`
+	Limit  int    `form:"limit" binding:"min=0"` // 0 uses the default page size
+}
+
+type UserSearchResponse struct {
+	Users []PublicUserResponse `json:"users"`
+	// NextOffset is omitted on the last page
+	NextOffset *int `json:"nextOffset,omitempty"`
+}
+
+type AccountDeletionResponse struct {
+	PurgeAt Timestamp `json:"purgeAt"`
+}
+
+type SessionResponse struct {
+	ID           ID        `json:"id"`
+	DeviceID     string    `json:"deviceId"`
+	SignedInAt   Timestamp `json:"signedInAt"`
+	LastActiveAt Timestamp `json:"lastActiveAt"`
+	ExpiresAt    Timestamp `json:"expiresAt"`
+	Current      bool      `json:"current"` // the session making this request
+}
+
+type SessionListResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// NewSessionResponse converts a session into its response DTO
+func NewSessionResponse(s *entity.Session, currentSessionID string) SessionResponse {
+	return SessionResponse{
+		ID:           ID(s.ID),
+		DeviceID:     s.DeviceID,
+		SignedInAt:   NewTimestamp(s.SignedInAt),
+		LastActiveAt: NewTimestamp(s.LastActiveAt),
+		ExpiresAt:    NewTimestamp(s.ExpiresAt),
+		Current:      s.ID == currentSessionID,
+	}
+}
@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/auth"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/seed"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/gin-gonic/gin"
+)
+
+// errorCodes maps usecase, repository and middleware errors to the status and code they are returned with
+// The first match wins; errors not listed are internal errors whose message is hidden from the client
+var errorCodes = []struct {
+	err    error
+	status int
+	code   apierror.Code
+}{
+	// Request errors
+	{pagination.ErrInvalidCursor, http.StatusBadRequest, apierror.CodeInvalidCursor},
+	{entity.ErrWeakPassword, http.StatusBadRequest, apierror.CodeWeakPassword},
+	{entity.ErrUnsupportedProvider, http.StatusBadRequest, apierror.CodeUnsupportedProvider},
+	{auth.ErrInvalidResetToken, http.StatusBadRequest, apierror.CodeInvalidResetToken},
+	{auth.ErrInvalidVerificationToken, http.StatusBadRequest, apierror.CodeInvalidVerificationToken},
+	{entity.ErrInvalidInvite, http.StatusBadRequest, apierror.CodeInvalidInvite},
+	{entity.ErrCannotBlockSelf, http.StatusBadRequest, apierror.CodeCannotBlockSelf},
+	{entity.ErrCannotSuspendSelf, http.StatusBadRequest, apierror.CodeCannotSuspendSelf},
+	{entity.ErrCannotInviteSelf, http.StatusBadRequest, apierror.CodeCannotInviteSelf},
+
+	// Field validation errors; the message names the field
+	{entity.ErrInvalidEmail, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidNickname, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidAPIKeyName, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidAPIKeyScopes, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidBio, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidProfileImageURL, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidTimezone, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidLocale, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{account.ErrInvalidSearchQuery, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{room.ErrInvalidSearchQuery, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidSuspensionReason, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidRoomName, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidRoomDescription, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidRoomVisibility, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidRoomCategory, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidRoomTags, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidMemberCap, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidReminderTime, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidRoomPostPolicy, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidAnnouncement, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidPrayerTopicTitle, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidPrayerTags, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidPrayerRecurrence, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidTestimony, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidPrayerContent, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidPrayerComment, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidCommentParent, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidCoverImage, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidRoomRole, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidJoinRequestMessage, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidInvitationStatus, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidExportFormat, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidDeviceID, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidDeviceToken, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidPlatform, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidAppVersion, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidDigestFrequency, http.StatusBadRequest, apierror.CodeValidationFailed},
+	{entity.ErrInvalidSnooze, http.StatusBadRequest, apierror.CodeValidationFailed},
+
+	// Authentication errors
+	{auth.ErrInvalidCredentials, http.StatusUnauthorized, apierror.CodeInvalidCredentials},
+	{service.ErrInvalidIDToken, http.StatusUnauthorized, apierror.CodeInvalidIDToken},
+	{auth.ErrInvalidRefreshToken, http.StatusUnauthorized, apierror.CodeInvalidRefreshToken},
+	{auth.ErrRefreshTokenReused, http.StatusUnauthorized, apierror.CodeRefreshTokenReused},
+	{middleware.ErrMissingToken, http.StatusUnauthorized, apierror.CodeMissingToken},
+	{middleware.ErrInvalidToken, http.StatusUnauthorized, apierror.CodeInvalidToken},
+	{middleware.ErrExpiredToken, http.StatusUnauthorized, apierror.CodeTokenExpired},
+	{middleware.ErrInvalidClaims, http.StatusUnauthorized, apierror.CodeInvalidToken},
+	{middleware.ErrRevokedToken, http.StatusUnauthorized, apierror.CodeTokenRevoked},
+	{entity.ErrInvalidAPIKey, http.StatusUnauthorized, apierror.CodeInvalidAPIKey},
+	{entity.ErrInvalidTwoFactorCode, http.StatusUnauthorized, apierror.CodeInvalidTwoFactorCode},
+
+	// Authorization errors
+	{entity.ErrEmailNotVerified, http.StatusForbidden, apierror.CodeEmailNotVerified},
+	{entity.ErrAccountSuspended, http.StatusForbidden, apierror.CodeAccountSuspended},
+	{middleware.ErrInsufficientRole, http.StatusForbidden, apierror.CodeInsufficientRole},
+	{middleware.ErrGuestReadOnly, http.StatusForbidden, apierror.CodeGuestReadOnly},
+	{entity.ErrNotRoomMember, http.StatusForbidden, apierror.CodeNotRoomMember},
+	{entity.ErrRoomPermissionDenied, http.StatusForbidden, apierror.CodeRoomPermissionDenied},
+
+	// Lookup errors
+	{entity.ErrUserNotFound, http.StatusNotFound, apierror.CodeUserNotFound},
+	{entity.ErrSessionNotFound, http.StatusNotFound, apierror.CodeSessionNotFound},
+	{entity.ErrAPIKeyNotFound, http.StatusNotFound, apierror.CodeAPIKeyNotFound},
+	{entity.ErrRoomNotFound, http.StatusNotFound, apierror.CodeRoomNotFound},
+	{entity.ErrInviteNotFound, http.StatusNotFound, apierror.CodeInviteNotFound},
+	{entity.ErrJoinRequestNotFound, http.StatusNotFound, apierror.CodeJoinRequestNotFound},
+	{entity.ErrInvitationNotFound, http.StatusNotFound, apierror.CodeInvitationNotFound},
+	{entity.ErrAnnouncementNotFound, http.StatusNotFound, apierror.CodeAnnouncementNotFound},
+	{entity.ErrPrayerTopicNotFound, http.StatusNotFound, apierror.CodePrayerTopicNotFound},
+	{entity.ErrPrayerContentNotFound, http.StatusNotFound, apierror.CodePrayerContentNotFound},
+	{entity.ErrPrayerCommentNotFound, http.StatusNotFound, apierror.CodePrayerCommentNotFound},
+	{entity.ErrRoomExportNotFound, http.StatusNotFound, apierror.CodeExportNotFound},
+	{entity.ErrDeviceNotFound, http.StatusNotFound, apierror.CodeDeviceNotFound},
+	{entity.ErrNotificationNotFound, http.StatusNotFound, apierror.CodeNotificationNotFound},
+
+	// Expired resources
+	{entity.ErrInviteExpired, http.StatusGone, apierror.CodeInviteExpired},
+
+	// Conflict errors
+	{entity.ErrEmailAlreadyExists, http.StatusConflict, apierror.CodeEmailTaken},
+	{entity.ErrSocialAccountAlreadyLinked, http.StatusConflict, apierror.CodeSocialAccountLinked},
+	{entity.ErrSocialLinkRequired, http.StatusConflict, apierror.CodeSocialLinkRequired},
+	{auth.ErrEmailAlreadyVerified, http.StatusConflict, apierror.CodeEmailAlreadyVerified},
+	{entity.ErrAccountDeletionNotScheduled, http.StatusConflict, apierror.CodeDeletionNotScheduled},
+	{entity.ErrTwoFactorNotEnrolled, http.StatusConflict, apierror.CodeTwoFactorNotEnrolled},
+	{entity.ErrTwoFactorNotEnabled, http.StatusConflict, apierror.CodeTwoFactorNotEnabled},
+	{entity.ErrTwoFactorAlreadyEnabled, http.StatusConflict, apierror.CodeTwoFactorEnabled},
+	{entity.ErrNotGuest, http.StatusConflict, apierror.CodeNotGuest},
+	{entity.ErrNicknameTaken, http.StatusConflict, apierror.CodeNicknameTaken},
+	{entity.ErrAlreadyRoomMember, http.StatusConflict, apierror.CodeAlreadyRoomMember},
+	{entity.ErrJoinRequestAlreadyPending, http.StatusConflict, apierror.CodeJoinRequestPending},
+	{entity.ErrJoinRequestNotPending, http.StatusConflict, apierror.CodeJoinRequestNotPending},
+	{entity.ErrInvitationAlreadyPending, http.StatusConflict, apierror.CodeInvitationPending},
+	{entity.ErrInvitationNotPending, http.StatusConflict, apierror.CodeInvitationNotPending},
+	{entity.ErrRoomArchived, http.StatusConflict, apierror.CodeRoomArchived},
+	{entity.ErrRoomNotArchived, http.StatusConflict, apierror.CodeRoomNotArchived},
+	{entity.ErrRoomFull, http.StatusConflict, apierror.CodeRoomFull},
+	{entity.ErrPrayerTopicNotDeleted, http.StatusConflict, apierror.CodePrayerTopicNotDeleted},
+	{entity.ErrPrayerTopicAnswered, http.StatusConflict, apierror.CodePrayerTopicAnswered},
+	{entity.ErrPrayerTopicNotAnswered, http.StatusConflict, apierror.CodePrayerTopicNotAnswered},
+	{entity.ErrPrayerTopicNotRecurring, http.StatusConflict, apierror.CodePrayerTopicNotRecurring},
+	{entity.ErrPrayerTopicPinned, http.StatusConflict, apierror.CodePrayerTopicPinned},
+	{entity.ErrPrayerTopicNotPinned, http.StatusConflict, apierror.CodePrayerTopicNotPinned},
+	{entity.ErrPrivateTopicPin, http.StatusConflict, apierror.CodePrayerTopicPrivate},
+	{entity.ErrPinLimitReached, http.StatusConflict, apierror.CodePinLimitReached},
+	{entity.ErrInvalidPinOrder, http.StatusConflict, apierror.CodePinOrderMismatch},
+	{seed.ErrAlreadySeeded, http.StatusConflict, apierror.CodeConflict},
+
+	// Throttling errors
+	{entity.ErrTwoFactorLocked, http.StatusTooManyRequests, apierror.CodeTwoFactorLocked},
+}
+
+// validationMessages names the message of each field validation error in apierror.Messages,
+// as they all share one code
+var validationMessages = map[error]string{
+	entity.ErrInvalidEmail:              "VALIDATION_FAILED.email",
+	entity.ErrInvalidNickname:           "VALIDATION_FAILED.nickname",
+	entity.ErrInvalidAPIKeyName:         "VALIDATION_FAILED.api_key_name",
+	entity.ErrInvalidAPIKeyScopes:       "VALIDATION_FAILED.api_key_scopes",
+	entity.ErrInvalidBio:                "VALIDATION_FAILED.bio",
+	entity.ErrInvalidProfileImageURL:    "VALIDATION_FAILED.profile_image_url",
+	entity.ErrInvalidTimezone:           "VALIDATION_FAILED.timezone",
+	entity.ErrInvalidLocale:             "VALIDATION_FAILED.locale",
+	account.ErrInvalidSearchQuery:       "VALIDATION_FAILED.search_query",
+	room.ErrInvalidSearchQuery:          "VALIDATION_FAILED.search_query",
+	entity.ErrInvalidSuspensionReason:   "VALIDATION_FAILED.suspension_reason",
+	entity.ErrInvalidRoomName:           "VALIDATION_FAILED.room_name",
+	entity.ErrInvalidRoomDescription:    "VALIDATION_FAILED.room_description",
+	entity.ErrInvalidRoomVisibility:     "VALIDATION_FAILED.room_visibility",
+	entity.ErrInvalidRoomCategory:       "VALIDATION_FAILED.room_category",
+	entity.ErrInvalidRoomTags:           "VALIDATION_FAILED.room_tags",
+	entity.ErrInvalidMemberCap:          "VALIDATION_FAILED.member_cap",
+	entity.ErrInvalidReminderTime:       "VALIDATION_FAILED.reminder_time",
+	entity.ErrInvalidRoomPostPolicy:     "VALIDATION_FAILED.post_policy",
+	entity.ErrInvalidAnnouncement:       "VALIDATION_FAILED.announcement",
+	entity.ErrInvalidPrayerTopicTitle:   "VALIDATION_FAILED.prayer_topic_title",
+	entity.ErrInvalidPrayerTags:         "VALIDATION_FAILED.prayer_tags",
+	entity.ErrInvalidPrayerRecurrence:   "VALIDATION_FAILED.prayer_recurrence",
+	entity.ErrInvalidTestimony:          "VALIDATION_FAILED.testimony",
+	entity.ErrInvalidPrayerContent:      "VALIDATION_FAILED.prayer_content",
+	entity.ErrInvalidPrayerComment:      "VALIDATION_FAILED.prayer_comment",
+	entity.ErrInvalidCommentParent:      "VALIDATION_FAILED.comment_parent",
+	entity.ErrInvalidCoverImage:         "VALIDATION_FAILED.cover_image",
+	entity.ErrInvalidRoomRole:           "VALIDATION_FAILED.room_role",
+	entity.ErrInvalidJoinRequestMessage: "VALIDATION_FAILED.join_request_message",
+	entity.ErrInvalidInvitationStatus:   "VALIDATION_FAILED.invitation_status",
+	entity.ErrInvalidExportFormat:       "VALIDATION_FAILED.export_format",
+	entity.ErrInvalidDeviceID:           "VALIDATION_FAILED.device_id",
+	entity.ErrInvalidDeviceToken:        "VALIDATION_FAILED.device_token",
+	entity.ErrInvalidPlatform:           "VALIDATION_FAILED.platform",
+	entity.ErrInvalidAppVersion:         "VALIDATION_FAILED.app_version",
+	entity.ErrInvalidDigestFrequency:    "VALIDATION_FAILED.digest_frequency",
+	entity.ErrInvalidSnooze:             "VALIDATION_FAILED.snooze",
+	entity.ErrInvalidPrayerOrder:        "VALIDATION_FAILED.prayer_order",
+}
+
+// apiError translates an error returned by a usecase/repository into the error returned to the client
+func apiError(err error) *apierror.Error {
+	var apiErr *apierror.Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		// Timeout middleware cancelled the query: the server is overloaded, not broken
+		return &apierror.Error{Status: http.StatusServiceUnavailable, Code: apierror.CodeRequestTimeout, Message: "Request timed out", Err: err}
+	case errors.Is(err, context.Canceled):
+		// Client went away, nobody is waiting for the response
+		return &apierror.Error{Status: apierror.StatusClientClosedRequest, Code: apierror.CodeClientClosed, Message: "Request cancelled", Err: err}
+	}
+
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			apiErr = apierror.Wrap(err, e.status, e.code)
+			if key, ok := validationMessages[e.err]; ok {
+				apiErr.Key = key
+			}
+			return apiErr
+		}
+	}
+	return apierror.Internal(err)
+}
+
+// respondError aborts the request with err; the apierror middleware writes the response
+// and the access logger records err
+func respondError(c *gin.Context, err error) {
+	apierror.Abort(c, apiError(err))
+}
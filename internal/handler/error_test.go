@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+func TestAPIError(t *testing.T) {
+	conflict := apierror.New(http.StatusConflict, apierror.CodeConflict, "already seeded")
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   apierror.Code
+		wantKey    string
+	}{
+		{
+			name:       "deadline exceeded",
+			err:        context.DeadlineExceeded,
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   apierror.CodeRequestTimeout,
+		},
+		{
+			name:       "wrapped deadline exceeded",
+			err:        fmt.Errorf("failed to list rooms: %w", context.DeadlineExceeded),
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   apierror.CodeRequestTimeout,
+		},
+		{
+			name:       "joined deadline exceeded",
+			err:        errors.Join(errors.New("rollback failed"), fmt.Errorf("query: %w", context.DeadlineExceeded)),
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   apierror.CodeRequestTimeout,
+		},
+		{
+			name:       "canceled",
+			err:        context.Canceled,
+			wantStatus: apierror.StatusClientClosedRequest,
+			wantCode:   apierror.CodeClientClosed,
+		},
+		{
+			name:       "wrapped canceled",
+			err:        fmt.Errorf("failed to load user: %w", fmt.Errorf("query: %w", context.Canceled)),
+			wantStatus: apierror.StatusClientClosedRequest,
+			wantCode:   apierror.CodeClientClosed,
+		},
+		{
+			name:       "domain error",
+			err:        entity.ErrPrayerTopicNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   apierror.CodePrayerTopicNotFound,
+		},
+		{
+			name:       "wrapped domain error",
+			err:        fmt.Errorf("failed to pin: %w", entity.ErrPinLimitReached),
+			wantStatus: http.StatusConflict,
+			wantCode:   apierror.CodePinLimitReached,
+		},
+		{
+			name:       "validation error",
+			err:        fmt.Errorf("failed to create topic: %w", entity.ErrInvalidPrayerTopicTitle),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   apierror.CodeValidationFailed,
+			wantKey:    "VALIDATION_FAILED.prayer_topic_title",
+		},
+		{
+			name:       "api error",
+			err:        fmt.Errorf("seed: %w", conflict),
+			wantStatus: http.StatusConflict,
+			wantCode:   apierror.CodeConflict,
+		},
+		{
+			name:       "unknown error",
+			err:        errors.New("ORA-00942: table or view does not exist"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   apierror.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := apiError(tt.err)
+			if got.Status != tt.wantStatus || got.Code != tt.wantCode {
+				t.Errorf("apiError = %d %s, want %d %s", got.Status, got.Code, tt.wantStatus, tt.wantCode)
+			}
+			if got.Key != tt.wantKey {
+				t.Errorf("key = %q, want %q", got.Key, tt.wantKey)
+			}
+			if !errors.Is(got, tt.err) && !errors.Is(tt.err, got) {
+				t.Errorf("apiError lost the cause %v", tt.err)
+			}
+		})
+	}
+}
+
+func TestRespondErrorForContextErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantBody   bool
+	}{
+		{name: "timed out", err: fmt.Errorf("failed to list prayers: %w", context.DeadlineExceeded), wantStatus: http.StatusServiceUnavailable, wantBody: true},
+		{name: "client gone", err: fmt.Errorf("failed to list prayers: %w", context.Canceled), wantStatus: apierror.StatusClientClosedRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(apierror.Middleware(middleware.GetRequestID))
+			router.GET("/", func(c *gin.Context) { respondError(c, tt.err) })
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if !tt.wantBody {
+				if rec.Body.Len() != 0 {
+					t.Errorf("body = %s, want none for a client that went away", rec.Body.String())
+				}
+				return
+			}
+			var body apierror.Response
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			if body.Code != apierror.CodeRequestTimeout {
+				t.Errorf("code = %s, want %s", body.Code, apierror.CodeRequestTimeout)
+			}
+		})
+	}
+}
+
+func TestRespondErrorLocalizesMessage(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		wantLanguage   string
+		wantMessage    string
+	}{
+		{acceptLanguage: "", wantLanguage: "ko", wantMessage: "방을 찾을 수 없습니다"},
+		{acceptLanguage: "ko-KR,ko;q=0.9", wantLanguage: "ko", wantMessage: "방을 찾을 수 없습니다"},
+		{acceptLanguage: "en-US,en;q=0.9,ko;q=0.8", wantLanguage: "en", wantMessage: "room not found"},
+		{acceptLanguage: "fr-FR", wantLanguage: "ko", wantMessage: "방을 찾을 수 없습니다"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.acceptLanguage, func(t *testing.T) {
+			router := gin.New()
+			router.Use(apierror.Middleware(middleware.GetRequestID))
+			router.GET("/", func(c *gin.Context) { respondError(c, entity.ErrRoomNotFound) })
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			var body apierror.Response
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			if body.Code != apierror.CodeRoomNotFound {
+				t.Errorf("code = %s, want %s in every language", body.Code, apierror.CodeRoomNotFound)
+			}
+			if body.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", body.Message, tt.wantMessage)
+			}
+			if got := rec.Header().Get("Content-Language"); got != tt.wantLanguage {
+				t.Errorf("Content-Language = %q, want %q", got, tt.wantLanguage)
+			}
+		})
+	}
+}
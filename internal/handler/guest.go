@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// GuestHandler lets people try the app before signing up
+type GuestHandler struct {
+	guestUC   *auth.GuestLoginUseCase
+	upgradeUC *auth.UpgradeGuestUseCase
+	issueUC   *auth.IssueTokensUseCase
+}
+
+func NewGuestHandler(guestUC *auth.GuestLoginUseCase, upgradeUC *auth.UpgradeGuestUseCase, issueUC *auth.IssueTokensUseCase) *GuestHandler {
+	return &GuestHandler{
+		guestUC:   guestUC,
+		upgradeUC: upgradeUC,
+		issueUC:   issueUC,
+	}
+}
+
+// Login handles POST /api/v1/auth/guest
+func (h *GuestHandler) Login(c *gin.Context) {
+	var req dto.GuestLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user, err := h.guestUC.Execute(c.Request.Context())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondWithTokens(c, h.issueUC, http.StatusCreated, user, req.DeviceID)
+}
+
+// Upgrade handles POST /api/v1/auth/guest/upgrade (authenticated guest)
+func (h *GuestHandler) Upgrade(c *gin.Context) {
+	var req dto.UpgradeGuestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	user, err := h.upgradeUC.Execute(c.Request.Context(), userID, req.Email, req.Nickname, req.Password)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondWithTokens(c, h.issueUC, http.StatusOK, user, req.DeviceID)
+}
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/buildinfo"
+	"github.com/gin-gonic/gin"
+)
+
+const readinessCheckTimeout = 2 * time.Second
+
+// HealthChecker is implemented by dependencies that can report their health
+type HealthChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
+// DrainState reports whether the server is draining before shutdown
+type DrainState interface {
+	IsDraining() bool
+}
+
+// Dependency is a service the readiness probe checks
+type Dependency struct {
+	Name string
+	// Checker is nil when the dependency is not configured and the server runs without it
+	Checker HealthChecker
+	// Critical dependencies make the server not ready when down; the others only degrade it
+	Critical bool
+}
+
+// Readiness statuses, overall and per dependency
+const (
+	statusReady    = "ready"
+	statusDegraded = "degraded"
+	statusNotReady = "not ready"
+	statusDraining = "draining"
+
+	dependencyUp       = "up"
+	dependencyDown     = "down"
+	dependencyDisabled = "disabled"
+)
+
+// dependencyStatus is the result of checking one dependency
+type dependencyStatus struct {
+	Status    string `json:"status"`
+	Critical  bool   `json:"critical"`
+	LatencyMs int64  `json:"latencyMs"`
+}
+
+// HealthHandler serves liveness and readiness probes
+type HealthHandler struct {
+	dependencies []Dependency
+	drain        DrainState
+}
+
+// NewHealthHandler creates a new health handler checking the dependencies on readiness
+func NewHealthHandler(drain DrainState, dependencies ...Dependency) *HealthHandler {
+	return &HealthHandler{
+		dependencies: dependencies,
+		drain:        drain,
+	}
+}
+
+// Health handles GET /health (liveness), reporting the running build
+func (h *HealthHandler) Health(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status": "ok",
+		"build":  buildinfo.Get(),
+	})
+}
+
+// Ready handles GET /ready (readiness)
+// Dependencies are checked concurrently; a critical one being down fails the probe with a 503,
+// any other one being down reports the server degraded but still ready for traffic
+func (h *HealthHandler) Ready(c *gin.Context) {
+	if h.drain.IsDraining() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status": statusDraining,
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessCheckTimeout)
+	defer cancel()
+
+	checks := make(map[string]dependencyStatus, len(h.dependencies))
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, dep := range h.dependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := checkDependency(ctx, dep)
+			mu.Lock()
+			checks[dep.Name] = result
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	status, code := statusReady, http.StatusOK
+	for _, check := range checks {
+		if check.Status != dependencyDown {
+			continue
+		}
+		if check.Critical {
+			status, code = statusNotReady, http.StatusServiceUnavailable
+			break
+		}
+		status = statusDegraded
+	}
+
+	c.JSON(code, gin.H{
+		"status": status,
+		"checks": checks,
+	})
+}
+
+func checkDependency(ctx context.Context, dep Dependency) dependencyStatus {
+	result := dependencyStatus{Status: dependencyDisabled, Critical: dep.Critical}
+	if dep.Checker == nil {
+		return result
+	}
+
+	start := time.Now()
+	err := dep.Checker.HealthCheck(ctx)
+	result.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		// Logged rather than returned, as errors can name hosts and other internals
+		slog.WarnContext(ctx, "Readiness check failed", "dependency", dep.Name, "error", err)
+		result.Status = dependencyDown
+		return result
+	}
+	result.Status = dependencyUp
+	return result
+}
+
+// Metrics handles GET /metrics, exposing the numeric expvar counters in the Prometheus text format
+// A counter published as "push" with the key "sent" becomes push_sent; other values are skipped
+func (h *HealthHandler) Metrics(c *gin.Context) {
+	var b strings.Builder
+	expvar.Do(func(kv expvar.KeyValue) {
+		writeMetric(&b, kv.Key, kv.Value)
+	})
+	fmt.Fprintf(&b, "go_goroutines %d\n", runtime.NumGoroutine())
+
+	c.Data(http.StatusOK, "text/plain; version=0.0.4; charset=utf-8", []byte(b.String()))
+}
+
+// writeMetric writes v as one sample, or one per key for a map
+func writeMetric(b *strings.Builder, name string, v expvar.Var) {
+	name = metricName(name)
+	switch v := v.(type) {
+	case *expvar.Int:
+		fmt.Fprintf(b, "%s %d\n", name, v.Value())
+	case *expvar.Float:
+		fmt.Fprintf(b, "%s %g\n", name, v.Value())
+	case *expvar.Map:
+		v.Do(func(kv expvar.KeyValue) {
+			writeMetric(b, name+"_"+kv.Key, kv.Value)
+		})
+	case expvar.Func:
+		switch value := v.Value().(type) {
+		case int:
+			fmt.Fprintf(b, "%s %d\n", name, value)
+		case int64:
+			fmt.Fprintf(b, "%s %d\n", name, value)
+		case float64:
+			fmt.Fprintf(b, "%s %g\n", name, value)
+		}
+	}
+}
+
+// metricName replaces the characters Prometheus does not allow in metric names
+func metricName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+type checkerFunc func(ctx context.Context) error
+
+func (f checkerFunc) HealthCheck(ctx context.Context) error {
+	return f(ctx)
+}
+
+type drainState bool
+
+func (d drainState) IsDraining() bool {
+	return bool(d)
+}
+
+func TestReadyHidesDependencyErrors(t *testing.T) {
+	down := checkerFunc(func(context.Context) error {
+		return errors.New("dial tcp db.internal:1521: connection refused")
+	})
+	up := checkerFunc(func(context.Context) error { return nil })
+
+	tests := []struct {
+		name       string
+		deps       []Dependency
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "critical dependency down",
+			deps:       []Dependency{{Name: "database", Checker: down, Critical: true}},
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   statusNotReady,
+		},
+		{
+			name:       "optional dependency down",
+			deps:       []Dependency{{Name: "database", Checker: up, Critical: true}, {Name: "redis", Checker: down}},
+			wantStatus: http.StatusOK,
+			wantBody:   statusDegraded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHealthHandler(drainState(false), tt.deps...)
+			router := gin.New()
+			router.GET("/ready", h.Ready)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			var body struct {
+				Status string `json:"status"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			if body.Status != tt.wantBody {
+				t.Errorf("status = %q, want %q", body.Status, tt.wantBody)
+			}
+			if strings.Contains(rec.Body.String(), "db.internal") || strings.Contains(rec.Body.String(), "refused") {
+				t.Errorf("body leaks the dependency error: %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	counters := expvar.NewMap("metrics_test")
+	counters.Add("sent", 3)
+	counters.Set("success-rate", expvar.Func(func() any { return 0.75 }))
+	counters.Set("label", expvar.Func(func() any { return "not a number" }))
+
+	h := NewHealthHandler(drainState(false))
+	router := gin.New()
+	router.GET("/metrics", h.Metrics)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Errorf("Content-Type = %s, want the Prometheus text format", rec.Header().Get("Content-Type"))
+	}
+	body := rec.Body.String()
+	for _, want := range []string{"metrics_test_sent 3\n", "metrics_test_success_rate 0.75\n", "go_goroutines "} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics missing %q:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, "metrics_test_label") {
+		t.Errorf("metrics include a non-numeric value:\n%s", body)
+	}
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+	"github.com/gin-gonic/gin"
+)
+
+// InboxHandler serves the signed-in user's invitations and notifications as one feed
+type InboxHandler struct {
+	listUC *account.ListInboxUseCase
+}
+
+func NewInboxHandler(listUC *account.ListInboxUseCase) *InboxHandler {
+	return &InboxHandler{
+		listUC: listUC,
+	}
+}
+
+// List handles GET /api/v1/inbox
+func (h *InboxHandler) List(c *gin.Context) {
+	var req dto.InboxRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	feed, err := h.listUC.Execute(c.Request.Context(), userID, req.Unread, req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	now := time.Now()
+	items := make([]dto.InboxItemResponse, 0, len(feed.Items))
+	for _, item := range feed.Items {
+		if item.Invitation != nil {
+			invitation := dto.NewInvitationResponse(item.Invitation, now)
+			items = append(items, dto.InboxItemResponse{
+				Type:       dto.InboxItemInvitation,
+				Invitation: &invitation,
+				CreatedAt:  invitation.CreatedAt,
+			})
+			continue
+		}
+		notification := dto.NewNotificationResponse(item.Notification)
+		items = append(items, dto.InboxItemResponse{
+			Type:         dto.InboxItemNotification,
+			Notification: &notification,
+			CreatedAt:    notification.CreatedAt,
+		})
+	}
+	c.JSON(http.StatusOK, dto.InboxResponse{
+		Items:       items,
+		UnreadCount: dto.Count(feed.UnreadCount),
+		Page:        feed.Page,
+	})
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/gin-gonic/gin"
+)
+
+// JoinRequestHandler serves requests to join private rooms and their approval
+type JoinRequestHandler struct {
+	requestUC *room.RequestToJoinUseCase
+	listUC    *room.ListJoinRequestsUseCase
+	decideUC  *room.DecideJoinRequestUseCase
+}
+
+func NewJoinRequestHandler(
+	requestUC *room.RequestToJoinUseCase,
+	listUC *room.ListJoinRequestsUseCase,
+	decideUC *room.DecideJoinRequestUseCase,
+) *JoinRequestHandler {
+	return &JoinRequestHandler{
+		requestUC: requestUC,
+		listUC:    listUC,
+		decideUC:  decideUC,
+	}
+}
+
+// Create handles POST /api/v1/rooms/:id/join-requests
+func (h *JoinRequestHandler) Create(c *gin.Context) {
+	var req dto.CreateJoinRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	request, err := h.requestUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Message)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewJoinRequestResponse(request))
+}
+
+// List handles GET /api/v1/rooms/:id/join-requests
+func (h *JoinRequestHandler) List(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	profiles, err := h.listUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := dto.PendingJoinRequestListResponse{
+		Requests: make([]dto.PendingJoinRequestResponse, 0, len(profiles)),
+	}
+	for _, p := range profiles {
+		resp.Requests = append(resp.Requests, dto.PendingJoinRequestResponse{
+			JoinRequestResponse: dto.NewJoinRequestResponse(p.Request),
+			User:                dto.NewPublicUserResponse(p.User),
+		})
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// Approve handles POST /api/v1/rooms/:id/join-requests/:requestId/approve
+func (h *JoinRequestHandler) Approve(c *gin.Context) {
+	h.decide(c, true)
+}
+
+// Reject handles POST /api/v1/rooms/:id/join-requests/:requestId/reject
+func (h *JoinRequestHandler) Reject(c *gin.Context) {
+	h.decide(c, false)
+}
+
+func (h *JoinRequestHandler) decide(c *gin.Context, approve bool) {
+	userID, _ := middleware.GetUserID(c)
+
+	request, err := h.decideUC.Execute(c.Request.Context(), userID, c.Param("id"), c.Param("requestId"), approve)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewJoinRequestResponse(request))
+}
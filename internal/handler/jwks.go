@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"sort"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// JWKSHandler publishes the public keys used to verify our tokens
+// Other services verify RS256/ES256 tokens with these keys instead of sharing the secret
+type JWKSHandler struct {
+	keys []jsonWebKey
+}
+
+// NewJWKSHandler encodes the configured public keys once at startup
+func NewJWKSHandler(cfg *config.Config) *JWKSHandler {
+	kids := make([]string, 0, len(cfg.JWT.PublicKeys))
+	for kid := range cfg.JWT.PublicKeys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	keys := make([]jsonWebKey, 0, len(kids))
+	for _, kid := range kids {
+		if jwk, ok := encodeJWK(kid, cfg.JWT.PublicKeys[kid]); ok {
+			keys = append(keys, jwk)
+		}
+	}
+
+	return &JWKSHandler{keys: keys}
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKS handles GET /.well-known/jwks.json
+func (h *JWKSHandler) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.JSON(http.StatusOK, gin.H{
+		"keys": h.keys,
+	})
+}
+
+func encodeJWK(kid string, key interface{}) (jsonWebKey, bool) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return jsonWebKey{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}, true
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return jsonWebKey{
+			Kty: "EC",
+			Kid: kid,
+			Use: "sig",
+			Alg: "ES256",
+			Crv: k.Curve.Params().Name,
+			X:   base64.RawURLEncoding.EncodeToString(k.X.FillBytes(make([]byte, size))),
+			Y:   base64.RawURLEncoding.EncodeToString(k.Y.FillBytes(make([]byte, size))),
+		}, true
+	default:
+		return jsonWebKey{}, false
+	}
+}
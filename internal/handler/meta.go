@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/gin-gonic/gin"
+)
+
+// MetaHandler exposes server metadata so clients can adapt to the running server
+type MetaHandler struct {
+	cfg *config.Config
+}
+
+// NewMetaHandler creates a new meta handler
+func NewMetaHandler(cfg *config.Config) *MetaHandler {
+	return &MetaHandler{
+		cfg: cfg,
+	}
+}
+
+// CapabilitiesResponse describes what the running server supports
+type CapabilitiesResponse struct {
+	APIVersion string `json:"apiVersion"`
+	// Deprecation is set when the API version the request used is deprecated
+	Deprecation *DeprecationInfo       `json:"deprecation,omitempty"`
+	Features    map[string]bool        `json:"features"`
+	Pagination  PaginationCapabilities `json:"pagination"`
+	Auth        AuthCapabilities       `json:"auth"`
+}
+
+// PaginationCapabilities describes how lists are paged
+// Feeds use opaque cursors; searches and admin lists use offsets
+type PaginationCapabilities struct {
+	Styles          []string `json:"styles"`
+	DefaultPageSize int      `json:"defaultPageSize"`
+	MaxPageSize     int      `json:"maxPageSize"`
+}
+
+// DeprecationInfo tells an app that its API version is going away and where to read about upgrading
+type DeprecationInfo struct {
+	DeprecatedAt time.Time  `json:"deprecatedAt"`
+	SunsetAt     *time.Time `json:"sunsetAt,omitempty"`
+	Link         string     `json:"link,omitempty"`
+}
+
+// AuthCapabilities describes the token features available to clients
+type AuthCapabilities struct {
+	Refresh bool `json:"refresh"`
+	// SlidingExpiry reports whether each refresh extends the session by the refresh token TTL
+	SlidingExpiry       bool  `json:"slidingExpiry"`
+	AccessTokenTTLSecs  int64 `json:"accessTokenTtlSeconds"`
+	RefreshTokenTTLSecs int64 `json:"refreshTokenTtlSeconds"`
+}
+
+// Capabilities handles GET /api/v{n}/meta/capabilities
+func (h *MetaHandler) Capabilities(c *gin.Context) {
+	features := make(map[string]bool, len(h.cfg.Features.Enabled))
+	for _, feature := range h.cfg.Features.Enabled {
+		if name := strings.TrimSpace(feature); name != "" {
+			features[name] = true
+		}
+	}
+
+	var deprecation *DeprecationInfo
+	if d, ok := middleware.GetDeprecation(c); ok {
+		deprecation = &DeprecationInfo{DeprecatedAt: d.Since, Link: d.Link}
+		if !d.Sunset.IsZero() {
+			deprecation.SunsetAt = &d.Sunset
+		}
+	}
+
+	c.JSON(http.StatusOK, CapabilitiesResponse{
+		APIVersion:  "v" + strconv.Itoa(middleware.GetAPIVersion(c)),
+		Deprecation: deprecation,
+		Features:    features,
+		Pagination: PaginationCapabilities{
+			Styles:          []string{"cursor", "offset"},
+			DefaultPageSize: pagination.DefaultLimit,
+			MaxPageSize:     pagination.MaxLimit,
+		},
+		Auth: AuthCapabilities{
+			Refresh:             h.cfg.JWT.RefreshExpiry > 0,
+			SlidingExpiry:       h.cfg.JWT.RefreshExpiry > 0 && h.cfg.JWT.SlidingRefresh,
+			AccessTokenTTLSecs:  int64(h.cfg.JWT.Expiry.Seconds()),
+			RefreshTokenTTLSecs: int64(h.cfg.JWT.RefreshExpiry.Seconds()),
+		},
+	})
+}
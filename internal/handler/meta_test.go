@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/gin-gonic/gin"
+)
+
+func TestCapabilitiesReflectConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		features     []string
+		jwt          config.JWTConfig
+		wantFeatures []string
+		wantRefresh  bool
+		wantSliding  bool
+	}{
+		{
+			name:         "sliding refresh",
+			features:     []string{"prayer_export", " reminders ", ""},
+			jwt:          config.JWTConfig{Expiry: 15 * time.Minute, RefreshExpiry: 168 * time.Hour, SlidingRefresh: true},
+			wantFeatures: []string{"prayer_export", "reminders"},
+			wantRefresh:  true,
+			wantSliding:  true,
+		},
+		{
+			name:        "fixed refresh window",
+			jwt:         config.JWTConfig{Expiry: 15 * time.Minute, RefreshExpiry: 168 * time.Hour},
+			wantRefresh: true,
+		},
+		{
+			name: "no refresh tokens",
+			jwt:  config.JWTConfig{Expiry: time.Hour, SlidingRefresh: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Features: config.FeaturesConfig{Enabled: tt.features}, JWT: tt.jwt}
+			router := gin.New()
+			router.GET("/api/v2/meta/capabilities", middleware.APIVersion(2), NewMetaHandler(cfg).Capabilities)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v2/meta/capabilities", nil))
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200", rec.Code)
+			}
+			var body CapabilitiesResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+
+			if body.APIVersion != "v2" {
+				t.Errorf("apiVersion = %q, want v2", body.APIVersion)
+			}
+			if body.Deprecation != nil {
+				t.Errorf("deprecation = %+v, want none", body.Deprecation)
+			}
+			if len(body.Features) != len(tt.wantFeatures) {
+				t.Errorf("features = %v, want %v", body.Features, tt.wantFeatures)
+			}
+			for _, feature := range tt.wantFeatures {
+				if !body.Features[feature] {
+					t.Errorf("feature %q is not enabled in %v", feature, body.Features)
+				}
+			}
+
+			if !slices.Equal(body.Pagination.Styles, []string{"cursor", "offset"}) {
+				t.Errorf("pagination styles = %v", body.Pagination.Styles)
+			}
+			if body.Pagination.DefaultPageSize != pagination.DefaultLimit || body.Pagination.MaxPageSize != pagination.MaxLimit {
+				t.Errorf("page sizes = %d/%d, want %d/%d", body.Pagination.DefaultPageSize, body.Pagination.MaxPageSize, pagination.DefaultLimit, pagination.MaxLimit)
+			}
+
+			auth := body.Auth
+			if auth.Refresh != tt.wantRefresh || auth.SlidingExpiry != tt.wantSliding {
+				t.Errorf("refresh = %v, sliding = %v, want %v, %v", auth.Refresh, auth.SlidingExpiry, tt.wantRefresh, tt.wantSliding)
+			}
+			if auth.AccessTokenTTLSecs != int64(tt.jwt.Expiry.Seconds()) || auth.RefreshTokenTTLSecs != int64(tt.jwt.RefreshExpiry.Seconds()) {
+				t.Errorf("TTLs = %d/%d, want the configured expiries", auth.AccessTokenTTLSecs, auth.RefreshTokenTTLSecs)
+			}
+		})
+	}
+}
+
+func TestCapabilitiesReportDeprecation(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	router := gin.New()
+	router.GET("/api/v1/meta/capabilities",
+		middleware.Deprecated(middleware.Deprecation{Since: since, Link: "https://example.com/upgrade"}),
+		NewMetaHandler(&config.Config{}).Capabilities,
+	)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/meta/capabilities", nil))
+
+	var body CapabilitiesResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body.Deprecation == nil || !body.Deprecation.DeprecatedAt.Equal(since) || body.Deprecation.SunsetAt != nil {
+		t.Fatalf("deprecation = %+v, want deprecated since %v with no sunset", body.Deprecation, since)
+	}
+	if body.Deprecation.Link != "https://example.com/upgrade" {
+		t.Errorf("link = %q", body.Deprecation.Link)
+	}
+}
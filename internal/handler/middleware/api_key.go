@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	APIKeyHeader = "X-API-Key"
+	APIKeyScheme = "ApiKey"
+	APIKeyIDKey  = "api_key_id"
+)
+
+var (
+	ErrAPIKeyScopeDenied = errors.New("api key scopes do not allow this request")
+	ErrAPIKeyNotAllowed  = errors.New("this endpoint requires signing in, api keys are not accepted")
+)
+
+// APIKeyAuthenticator resolves a raw API key to its active record
+// It returns entity.ErrInvalidAPIKey for unknown or revoked keys
+type APIKeyAuthenticator interface {
+	Execute(ctx context.Context, rawKey string) (*entity.APIKey, error)
+}
+
+// UserAPIKeyAuthenticator resolves a raw personal key to its active record and owner
+// It returns entity.ErrInvalidAPIKey for unknown, revoked and service keys
+type UserAPIKeyAuthenticator interface {
+	Execute(ctx context.Context, rawKey string) (*entity.APIKey, *entity.User, error)
+}
+
+// APIKey authenticates service accounts by the X-API-Key header
+// Unknown and revoked keys get the same 401 so keys cannot be probed
+func APIKey(authenticator APIKeyAuthenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		raw := c.GetHeader(APIKeyHeader)
+		if raw == "" {
+			apierror.Respond(c, apierror.New(http.StatusUnauthorized, apierror.CodeMissingAPIKey, "missing api key"), GetRequestID(c))
+			return
+		}
+
+		key, err := authenticator.Execute(c.Request.Context(), raw)
+		if err != nil {
+			if errors.Is(err, entity.ErrInvalidAPIKey) {
+				apierror.Respond(c, apierror.Wrap(err, http.StatusUnauthorized, apierror.CodeInvalidAPIKey), GetRequestID(c))
+				return
+			}
+
+			slog.Error("Failed to verify api key",
+				"error", err,
+				"request_id", GetRequestID(c),
+			)
+			apierror.Respond(c, apierror.New(http.StatusServiceUnavailable, apierror.CodeServiceUnavailable, "unable to verify api key"), GetRequestID(c))
+			return
+		}
+
+		c.Set(APIKeyIDKey, key.ID)
+		c.Next()
+	}
+}
+
+// APIKeyAuth authenticates users by a personal key in "Authorization: ApiKey <key>" and hands
+// every other Authorization header to bearer, so either credential works on the same routes
+// Reads (GET/HEAD/OPTIONS) need the read scope and anything else the write scope
+func APIKeyAuth(authenticator UserAPIKeyAuthenticator, bearer gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parts := strings.SplitN(c.GetHeader(AuthorizationHeader), " ", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], APIKeyScheme) {
+			bearer(c)
+			return
+		}
+
+		key, user, err := authenticator.Execute(c.Request.Context(), parts[1])
+		if err != nil {
+			if errors.Is(err, entity.ErrInvalidAPIKey) {
+				apierror.Respond(c, apierror.Wrap(err, http.StatusUnauthorized, apierror.CodeInvalidAPIKey), GetRequestID(c))
+				return
+			}
+
+			slog.Error("Failed to verify api key",
+				"error", err,
+				"request_id", GetRequestID(c),
+			)
+			apierror.Respond(c, apierror.New(http.StatusServiceUnavailable, apierror.CodeServiceUnavailable, "unable to verify api key"), GetRequestID(c))
+			return
+		}
+
+		scope := entity.APIKeyScopeWrite
+		if isSafeMethod(c.Request.Method) {
+			scope = entity.APIKeyScopeRead
+		}
+		if !key.Allows(scope) {
+			apierror.Respond(c, apierror.Wrap(ErrAPIKeyScopeDenied, http.StatusForbidden, apierror.CodeAPIKeyScopeDenied), GetRequestID(c))
+			return
+		}
+
+		c.Set(UserIDKey, user.ID)
+		c.Set(UserEmailKey, user.Email)
+		c.Set(UserRoleKey, string(user.Role))
+		c.Set(EmailVerifiedKey, user.IsEmailVerified())
+		c.Set(APIKeyIDKey, key.ID)
+		c.Next()
+	}
+}
+
+// RejectAPIKey keeps requests authenticated by an API key out of routes that need a signed-in
+// session, such as managing keys or admin work, so a leaked key cannot widen its own reach
+// Must be registered after APIKeyAuth
+func RejectAPIKey() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := GetAPIKeyID(c); ok {
+			apierror.Respond(c, apierror.Wrap(ErrAPIKeyNotAllowed, http.StatusForbidden, apierror.CodeAPIKeyNotAllowed), GetRequestID(c))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// GetAPIKeyID returns the ID of the API key that authenticated the request
+func GetAPIKeyID(c *gin.Context) (string, bool) {
+	id, exists := c.Get(APIKeyIDKey)
+	if !exists {
+		return "", false
+	}
+
+	s, ok := id.(string)
+	return s, ok
+}
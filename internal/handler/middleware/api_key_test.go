@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+// personalKeys authenticates the raw keys it maps to a key of user u1
+type personalKeys map[string]*entity.APIKey
+
+func (f personalKeys) Execute(_ context.Context, rawKey string) (*entity.APIKey, *entity.User, error) {
+	key, ok := f[rawKey]
+	if !ok {
+		return nil, nil, entity.ErrInvalidAPIKey
+	}
+	return key, &entity.User{ID: key.UserID, Email: "u1@example.com", Role: entity.RoleUser}, nil
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	cfg := newTestConfig()
+	token, err := GenerateToken("u1", "u1@example.com", "user", true, "", cfg)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	keys := personalKeys{
+		"ptk_read":  {ID: "k1", UserID: "u1", Scopes: []entity.APIKeyScope{entity.APIKeyScopeRead}},
+		"ptk_write": {ID: "k2", UserID: "u1", Scopes: []entity.APIKeyScope{entity.APIKeyScopeWrite}},
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		wantStatus    int
+		wantCode      apierror.Code
+	}{
+		{name: "read key reads", method: http.MethodGet, authorization: "ApiKey ptk_read", wantStatus: http.StatusOK},
+		{name: "read key writes", method: http.MethodPost, authorization: "ApiKey ptk_read", wantStatus: http.StatusForbidden, wantCode: apierror.CodeAPIKeyScopeDenied},
+		{name: "write key writes", method: http.MethodPost, authorization: "ApiKey ptk_write", wantStatus: http.StatusOK},
+		{name: "write key reads", method: http.MethodGet, authorization: "apikey ptk_write", wantStatus: http.StatusOK},
+		{name: "unknown key", method: http.MethodGet, authorization: "ApiKey ptk_guess", wantStatus: http.StatusUnauthorized, wantCode: apierror.CodeInvalidAPIKey},
+		{name: "bearer token", method: http.MethodPost, authorization: BearerScheme + " " + token, wantStatus: http.StatusOK},
+		{name: "no credentials", method: http.MethodGet, wantStatus: http.StatusUnauthorized, wantCode: apierror.CodeMissingToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Handle(tt.method, "/me", APIKeyAuth(keys, JWT(cfg, &revocations{}, &revocations{})), func(c *gin.Context) {
+				if userID, _ := GetUserID(c); userID != "u1" {
+					t.Errorf("user = %q, want u1", userID)
+				}
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, "/me", nil)
+			if tt.authorization != "" {
+				req.Header.Set(AuthorizationHeader, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantCode == "" {
+				return
+			}
+			var body apierror.Response
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode error body: %v", err)
+			}
+			if body.Code != tt.wantCode {
+				t.Errorf("code = %s, want %s", body.Code, tt.wantCode)
+			}
+		})
+	}
+}
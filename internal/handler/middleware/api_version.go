@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	APIVersionKey  = "api_version"
+	DeprecationKey = "deprecation"
+)
+
+// APIVersion marks the requests of a versioned route group with its version, see GetAPIVersion
+func APIVersion(version int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(APIVersionKey, version)
+		c.Next()
+	}
+}
+
+// GetAPIVersion returns the API version the request was made against, 1 outside a versioned group
+func GetAPIVersion(c *gin.Context) int {
+	if version, ok := c.Get(APIVersionKey); ok {
+		if v, ok := version.(int); ok {
+			return v
+		}
+	}
+	return 1
+}
+
+// Deprecation announces that an API version or route is going away
+type Deprecation struct {
+	// Since is when it was deprecated, sent in the Deprecation header (RFC 9745)
+	Since time.Time
+	// Sunset is when it stops working, sent in the Sunset header (RFC 8594); zero while undecided
+	Sunset time.Time
+	// Link points to the migration guide, sent as a Link with rel="deprecation"; optional
+	Link string
+}
+
+// Deprecated adds the deprecation headers to every response of the routes it is registered on,
+// so old app versions can warn their users before the routes go away
+func Deprecated(d Deprecation) gin.HandlerFunc {
+	deprecation := "@" + strconv.FormatInt(d.Since.Unix(), 10)
+	sunset := ""
+	if !d.Sunset.IsZero() {
+		sunset = d.Sunset.UTC().Format(http.TimeFormat)
+	}
+	link := ""
+	if d.Link != "" {
+		link = "<" + d.Link + `>; rel="deprecation"; type="text/html"`
+	}
+
+	return func(c *gin.Context) {
+		c.Set(DeprecationKey, d)
+		c.Header("Deprecation", deprecation)
+		if sunset != "" {
+			c.Header("Sunset", sunset)
+		}
+		if link != "" {
+			c.Writer.Header().Add("Link", link)
+		}
+		c.Next()
+	}
+}
+
+// GetDeprecation returns the deprecation of the route the request matched, if it is deprecated
+func GetDeprecation(c *gin.Context) (Deprecation, bool) {
+	if value, ok := c.Get(DeprecationKey); ok {
+		d, ok := value.(Deprecation)
+		return d, ok
+	}
+	return Deprecation{}, false
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+// rawBodyKey keeps the unlimited request body so a route can raise the global limit
+const rawBodyKey = "raw_body"
+
+// BodyLimit caps request bodies at limit bytes (0 = unlimited)
+// Reading past the limit fails with *http.MaxBytesError, which handlers report through PayloadTooLarge,
+// so an oversized body is never read into memory; one whose Content-Length is already too large
+// fails on the first read, before any of it is read
+// Registered globally with the default limit; upload routes register it again with a larger one,
+// which replaces the global limit as long as nothing read the body in between
+func BodyLimit(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if stored, ok := c.Get(rawBodyKey); ok {
+			c.Request.Body, _ = stored.(io.ReadCloser)
+		} else {
+			c.Set(rawBodyKey, c.Request.Body)
+		}
+		if limit <= 0 || c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength > limit {
+			c.Request.Body = tooLargeBody{limit: limit}
+		} else {
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		}
+
+		c.Next()
+	}
+}
+
+// tooLargeBody stands in for a body declared larger than the limit, failing like http.MaxBytesReader would
+type tooLargeBody struct {
+	limit int64
+}
+
+func (b tooLargeBody) Read([]byte) (int, error) {
+	return 0, &http.MaxBytesError{Limit: b.limit}
+}
+
+func (tooLargeBody) Close() error {
+	return nil
+}
+
+// PayloadTooLarge is the 413 returned for a request body over limit bytes
+func PayloadTooLarge(limit int64) *apierror.Error {
+	err := apierror.New(http.StatusRequestEntityTooLarge, apierror.CodePayloadTooLarge,
+		fmt.Sprintf("request body exceeds %d bytes", limit))
+	return err.WithMessage("PAYLOAD_TOO_LARGE", map[string]string{"limit": strconv.FormatInt(limit, 10)})
+}
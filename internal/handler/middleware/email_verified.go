@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireVerifiedEmail rejects users who have not verified their email yet
+// Must be registered after JWT so the email_verified claim is available
+func RequireVerifiedEmail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !IsEmailVerified(c) {
+			apierror.Respond(c, apierror.Wrap(entity.ErrEmailNotVerified, http.StatusForbidden, apierror.CodeEmailNotVerified), GetRequestID(c))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// IsEmailVerified reports whether the authenticated user's email is verified
+func IsEmailVerified(c *gin.Context) bool {
+	verified, exists := c.Get(EmailVerifiedKey)
+	if !exists {
+		return false
+	}
+
+	v, ok := verified.(bool)
+	return ok && v
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+var ErrGuestReadOnly = errors.New("guest accounts are read-only, please sign up")
+
+// GuestReadOnly limits guests to safe methods (GET/HEAD/OPTIONS)
+// Must be registered after JWT so the guest claim is available
+func GuestReadOnly() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if IsGuest(c) && !isSafeMethod(c.Request.Method) {
+			apierror.Respond(c, apierror.Wrap(ErrGuestReadOnly, http.StatusForbidden, apierror.CodeGuestReadOnly), GetRequestID(c))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// IsGuest reports whether the authenticated user is an anonymous guest
+func IsGuest(c *gin.Context) bool {
+	claims, ok := GetClaims(c)
+	return ok && claims.Guest
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+const HealthTokenHeader = "X-Health-Token"
+
+// HealthAuth protects health/metrics endpoints with a shared secret
+// When Server.HealthAuthToken is empty the endpoints stay open for load balancers
+// The token is sent in the X-Health-Token header, or as a bearer token, which is what
+// Prometheus scrape configs can send
+func HealthAuth(cfg *config.Config) gin.HandlerFunc {
+	token := cfg.Server.HealthAuthToken
+
+	return func(c *gin.Context) {
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		provided := c.GetHeader(HealthTokenHeader)
+		if provided == "" {
+			if scheme, credentials, ok := strings.Cut(c.GetHeader(AuthorizationHeader), " "); ok && strings.EqualFold(scheme, BearerScheme) {
+				provided = credentials
+			}
+		}
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			apierror.Respond(c, apierror.New(http.StatusUnauthorized, apierror.CodeInvalidHealthToken, "invalid health token"), GetRequestID(c))
+			return
+		}
+
+		c.Next()
+	}
+}
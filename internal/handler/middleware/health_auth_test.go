@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+func TestHealthAuth(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      string // configured
+		headers    map[string]string
+		wantStatus int
+	}{
+		{name: "open without a token", wantStatus: http.StatusOK},
+		{name: "missing token", token: "s3cret", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", token: "s3cret", headers: map[string]string{HealthTokenHeader: "guess"}, wantStatus: http.StatusUnauthorized},
+		{name: "health token header", token: "s3cret", headers: map[string]string{HealthTokenHeader: "s3cret"}, wantStatus: http.StatusOK},
+		{name: "bearer token", token: "s3cret", headers: map[string]string{AuthorizationHeader: "Bearer s3cret"}, wantStatus: http.StatusOK},
+		{name: "wrong bearer token", token: "s3cret", headers: map[string]string{AuthorizationHeader: "Bearer guess"}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Server: config.ServerConfig{HealthAuthToken: tt.token}}
+			router := gin.New()
+			router.GET("/health", HealthAuth(cfg), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodGet, "/health", nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
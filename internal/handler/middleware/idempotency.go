@@ -0,0 +1,193 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed from an earlier request with the same key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentResponseSize bounds the stored response; larger responses are not replayed
+	maxIdempotentResponseSize = 1 << 20
+	// idempotencyStaleAfter is how long a request may run before it is presumed lost with its instance,
+	// well past the request timeout, so a retry can run it again instead of waiting for the key to expire
+	idempotencyStaleAfter = 2 * DefaultTimeout
+)
+
+var (
+	ErrInvalidIdempotencyKey       = errors.New("idempotency key must be 1 to 255 printable ASCII characters")
+	ErrIdempotencyKeyReused        = errors.New("idempotency key was already used for a different request")
+	ErrIdempotentRequestInProgress = errors.New("a request with this idempotency key is still in progress")
+)
+
+// IdempotencyStore remembers requests sent with an Idempotency-Key, see repository.IdempotencyRepository
+type IdempotencyStore interface {
+	Begin(ctx context.Context, req *entity.IdempotentRequest) (*entity.IdempotentRequest, error)
+	Complete(ctx context.Context, userID, key string, status int, contentType string, body []byte) error
+	Abandon(ctx context.Context, userID, key string) error
+}
+
+// Idempotency makes POST requests sent with an Idempotency-Key safe to retry: the first successful
+// response is stored for ttl and replayed to retries of the same request by the same user
+// Rejected and failed requests are not stored, since they changed nothing and may be retried as they are
+// Must be registered after JWT, as keys are scoped to the user
+func Idempotency(store IdempotencyStore, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		userID, ok := GetUserID(c)
+		if c.Request.Method != http.MethodPost || key == "" || !ok {
+			c.Next()
+			return
+		}
+		if !validIdempotencyKey(key) {
+			apierror.Respond(c, apierror.Wrap(ErrInvalidIdempotencyKey, http.StatusBadRequest, apierror.CodeInvalidIdempotencyKey), GetRequestID(c))
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			apierror.Respond(c, PayloadTooLarge(tooLarge.Limit), GetRequestID(c))
+			return
+		case err != nil:
+			apierror.Respond(c, apierror.Wrap(err, http.StatusBadRequest, apierror.CodeBadRequest), GetRequestID(c))
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := time.Now()
+		req := &entity.IdempotentRequest{
+			UserID:      userID,
+			Key:         key,
+			Fingerprint: requestFingerprint(c.Request, body),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(ttl),
+		}
+		existing, err := beginIdempotent(c.Request.Context(), store, req)
+		switch {
+		case err != nil:
+			slog.Error("Failed to record idempotency key",
+				"error", err,
+				"request_id", GetRequestID(c),
+			)
+			apierror.Respond(c, apierror.New(http.StatusServiceUnavailable, apierror.CodeServiceUnavailable, "unable to check idempotency key"), GetRequestID(c))
+		case existing == nil:
+			runIdempotent(c, store, userID, key)
+		case existing.Fingerprint != req.Fingerprint:
+			apierror.Respond(c, apierror.Wrap(ErrIdempotencyKeyReused, http.StatusUnprocessableEntity, apierror.CodeIdempotencyKeyReused), GetRequestID(c))
+		case !existing.Completed():
+			apierror.Respond(c, apierror.Wrap(ErrIdempotentRequestInProgress, http.StatusConflict, apierror.CodeIdempotencyKeyInProgress), GetRequestID(c))
+		default:
+			c.Header(IdempotentReplayedHeader, "true")
+			c.Data(existing.Status, existing.ContentType, existing.Body)
+			c.Abort()
+		}
+	}
+}
+
+// beginIdempotent records req, taking over an earlier run of the same request that is too old to still be running
+func beginIdempotent(ctx context.Context, store IdempotencyStore, req *entity.IdempotentRequest) (*entity.IdempotentRequest, error) {
+	existing, err := store.Begin(ctx, req)
+	if err != nil || existing == nil || existing.Completed() || existing.Fingerprint != req.Fingerprint ||
+		req.CreatedAt.Sub(existing.CreatedAt) < idempotencyStaleAfter {
+		return existing, err
+	}
+	if err := store.Abandon(ctx, req.UserID, req.Key); err != nil {
+		return nil, err
+	}
+	return store.Begin(ctx, req)
+}
+
+// runIdempotent runs the request and stores its response for retries, or forgets the key when it failed
+func runIdempotent(c *gin.Context, store IdempotencyStore, userID, key string) {
+	writer := &capturingWriter{ResponseWriter: c.Writer}
+	c.Writer = writer
+	c.Next()
+	c.Writer = writer.ResponseWriter
+
+	// The outcome is recorded even when the client is gone, as that is when it will retry
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), 5*time.Second)
+	defer cancel()
+
+	if len(c.Errors) > 0 || writer.Status() >= http.StatusBadRequest || writer.overflow {
+		if err := store.Abandon(ctx, userID, key); err != nil {
+			slog.Warn("Failed to release idempotency key, retries are refused until it goes stale",
+				"error", err,
+				"request_id", GetRequestID(c),
+			)
+		}
+		return
+	}
+	if err := store.Complete(ctx, userID, key, writer.Status(), writer.Header().Get("Content-Type"), writer.body.Bytes()); err != nil {
+		slog.Error("Failed to store idempotent response, retries are refused until it goes stale",
+			"error", err,
+			"request_id", GetRequestID(c),
+		)
+	}
+}
+
+// capturingWriter keeps a copy of the response body while writing it through
+type capturingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+	// overflow is set once the body outgrew maxIdempotentResponseSize and stopped being copied
+	overflow bool
+}
+
+func (w *capturingWriter) Write(data []byte) (int, error) {
+	w.capture(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *capturingWriter) WriteString(s string) (int, error) {
+	w.capture([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *capturingWriter) capture(data []byte) {
+	if w.overflow {
+		return
+	}
+	if w.body.Len()+len(data) > maxIdempotentResponseSize {
+		w.overflow = true
+		w.body.Reset()
+		return
+	}
+	w.body.Write(data)
+}
+
+// requestFingerprint identifies a request by its method, URI and body
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x21 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
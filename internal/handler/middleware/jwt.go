@@ -1,13 +1,21 @@
 package middleware
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
 	"errors"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"log/slog"
+	"net/http"
 	"strings"
 	"time"
 
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 const (
@@ -15,6 +23,9 @@ const (
 	BearerScheme        = "Bearer"
 	UserIDKey           = "user_id"
 	UserEmailKey        = "user_email"
+	UserRoleKey         = "user_role"
+	EmailVerifiedKey    = "email_verified"
+	ClaimsKey           = "jwt_claims"
 )
 
 var (
@@ -22,79 +33,223 @@ var (
 	ErrInvalidToken  = errors.New("invalid authorization token")
 	ErrExpiredToken  = errors.New("token has expired")
 	ErrInvalidClaims = errors.New("invalid token claims")
+	ErrRevokedToken  = errors.New("token has been revoked")
 )
 
+// TokenBlacklist reports whether an access token was revoked before it expired
+type TokenBlacklist interface {
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+// SessionChecker reports whether the session an access token was issued with was signed out
+type SessionChecker interface {
+	IsSessionRevoked(ctx context.Context, sessionID string) (bool, error)
+}
+
+// Claims are the access token claims
+// exp/iat live only in RegisteredClaims so the parser actually enforces expiry
 type Claims struct {
-	UserID    string `json:"user_id"`
-	Email     string `json:"email"`
-	ExpiresAt int64  `json:"exp"`
-	IssuedAt  int64  `json:"iat"`
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Role   string `json:"role"`
+	// EmailVerified reflects the user at issue time; a refresh picks up a later verification
+	EmailVerified bool `json:"email_verified"`
+	// Guest marks an anonymous trial user, see GuestReadOnly
+	Guest bool `json:"guest,omitempty"`
+	// SessionID is the refresh token family this access token was issued with
+	SessionID string `json:"sid,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// MFAClaims identify a password-verified login that still needs a second factor
+// They carry no user_id claim, so ValidateToken never accepts them as access tokens
+type MFAClaims struct {
+	Purpose  string `json:"purpose"`
+	DeviceID string `json:"device_id"`
 	jwt.RegisteredClaims
 }
 
-func JWT(cfg *config.Config) gin.HandlerFunc {
+const mfaPurpose = "mfa"
+
+// RefreshClaims identifies a refresh token issued to a single device session
+type RefreshClaims struct {
+	DeviceID string `json:"device_id"`
+	jwt.RegisteredClaims
+}
+
+// tokenError is the 401 returned for a missing, invalid, expired or revoked access token
+func tokenError(err error) *apierror.Error {
+	code := apierror.CodeInvalidToken
+	switch {
+	case errors.Is(err, ErrMissingToken):
+		code = apierror.CodeMissingToken
+	case errors.Is(err, ErrExpiredToken):
+		code = apierror.CodeTokenExpired
+	case errors.Is(err, ErrRevokedToken):
+		code = apierror.CodeTokenRevoked
+	}
+	return apierror.Wrap(err, http.StatusUnauthorized, code)
+}
+
+func JWT(cfg *config.Config, blacklist TokenBlacklist, sessions SessionChecker) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		token, err := extractToken(c)
 		if err != nil {
-			c.JSON(401, gin.H{"error": err.Error()})
-			c.Abort()
+			apierror.Respond(c, tokenError(err), GetRequestID(c))
 			return
 		}
 
-		claims, err := ValidateToken(token, cfg.JWT.Secret)
+		claims, err := ValidateToken(token, cfg)
 		if err != nil {
-			c.JSON(401, gin.H{"error": err.Error()})
-			c.Abort()
+			apierror.Respond(c, tokenError(err), GetRequestID(c))
+			return
+		}
+
+		revoked, err := isRevoked(c.Request.Context(), claims, blacklist, sessions)
+		if err != nil {
+			slog.Error("Failed to check token revocation",
+				"error", err,
+				"request_id", GetRequestID(c),
+			)
+			apierror.Respond(c, apierror.New(http.StatusServiceUnavailable, apierror.CodeServiceUnavailable, "unable to verify token"), GetRequestID(c))
+			return
+		}
+		if revoked {
+			apierror.Respond(c, tokenError(ErrRevokedToken), GetRequestID(c))
 			return
 		}
 
 		c.Set(UserIDKey, claims.UserID)
+		c.Set(ClaimsKey, claims)
 		c.Set(UserEmailKey, claims.Email)
+		c.Set(UserRoleKey, claims.Role)
+		c.Set(EmailVerifiedKey, claims.EmailVerified)
 		c.Next()
 	}
 }
 
-func GenerateToken(userID, email string, cfg *config.Config) (string, error) {
+// isRevoked reports whether the token itself was blacklisted (logout) or its session was signed
+// out (remote sign-out, force logout, suspension), either of which ends it before expiry
+func isRevoked(ctx context.Context, claims *Claims, blacklist TokenBlacklist, sessions SessionChecker) (bool, error) {
+	if claims.ID != "" {
+		revoked, err := blacklist.IsRevoked(ctx, claims.ID)
+		if err != nil || revoked {
+			return revoked, err
+		}
+	}
+	if claims.SessionID != "" {
+		return sessions.IsSessionRevoked(ctx, claims.SessionID)
+	}
+	return false, nil
+}
+
+func GenerateToken(userID, email, role string, emailVerified bool, sessionID string, cfg *config.Config) (string, error) {
+	guest := role == string(entity.RoleGuest)
+
 	now := time.Now()
 	expiresAt := now.Add(cfg.JWT.Expiry)
 
 	claims := Claims{
-		UserID:    userID,
-		Email:     email,
-		ExpiresAt: expiresAt.Unix(),
-		IssuedAt:  now.Unix(),
+		UserID:        userID,
+		Email:         email,
+		Role:          role,
+		EmailVerified: emailVerified,
+		Guest:         guest,
+		SessionID:     sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 			Issuer:    cfg.App.Name,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(cfg.JWT.Secret))
+	return signToken(claims, cfg)
 }
 
-func GenerateRefreshToken(userID string, cfg *config.Config) (string, error) {
-	now := time.Now()
-	expiresAt := now.Add(cfg.JWT.RefreshExpiry)
+// GenerateRefreshToken signs a refresh token bound to the given device
+// The token ID (jti) identifies the server-side record used for rotation and revocation
+func GenerateRefreshToken(tokenID, userID, deviceID string, expiresAt time.Time, cfg *config.Config) (string, error) {
+	claims := RefreshClaims{
+		DeviceID: deviceID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    cfg.App.Name,
+		},
+	}
 
-	claims := jwt.RegisteredClaims{
-		Subject:   userID,
-		ExpiresAt: jwt.NewNumericDate(expiresAt),
-		IssuedAt:  jwt.NewNumericDate(now),
-		Issuer:    cfg.App.Name,
+	return signToken(claims, cfg)
+}
+
+func ValidateToken(tokenString string, cfg *config.Config) (*Claims, error) {
+	token, err := newParser(cfg).ParseWithClaims(tokenString, &Claims{}, keyFunc(cfg))
+
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok || claims.UserID == "" {
+		return nil, ErrInvalidClaims
+	}
+
+	if !token.Valid {
+		return nil, ErrInvalidToken
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(cfg.JWT.Secret))
+	return claims, nil
 }
 
-func ValidateToken(tokenString, secret string) (*Claims, error) {
-	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}))
+// ValidateRefreshToken parses a refresh token and returns its device-scoped claims
+func ValidateRefreshToken(tokenString string, cfg *config.Config) (*RefreshClaims, error) {
+	token, err := newParser(cfg).ParseWithClaims(tokenString, &RefreshClaims{}, keyFunc(cfg))
 
-	token, err := parser.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
-	})
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(*RefreshClaims)
+	if !ok || claims.Subject == "" || claims.DeviceID == "" {
+		return nil, ErrInvalidClaims
+	}
+
+	if !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// GenerateMFAToken signs a short-lived token that proves the first login step succeeded
+func GenerateMFAToken(userID, deviceID string, cfg *config.Config) (string, error) {
+	now := time.Now()
+	claims := MFAClaims{
+		Purpose:  mfaPurpose,
+		DeviceID: deviceID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(now.Add(cfg.Auth.MFATokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    cfg.App.Name,
+		},
+	}
+
+	return signToken(claims, cfg)
+}
+
+// ValidateMFAToken parses a token issued by GenerateMFAToken
+func ValidateMFAToken(tokenString string, cfg *config.Config) (*MFAClaims, error) {
+	token, err := newParser(cfg).ParseWithClaims(tokenString, &MFAClaims{}, keyFunc(cfg))
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -103,8 +258,8 @@ func ValidateToken(tokenString, secret string) (*Claims, error) {
 		return nil, ErrInvalidToken
 	}
 
-	claims, ok := token.Claims.(*Claims)
-	if !ok {
+	claims, ok := token.Claims.(*MFAClaims)
+	if !ok || claims.Purpose != mfaPurpose || claims.Subject == "" || claims.DeviceID == "" {
 		return nil, ErrInvalidClaims
 	}
 
@@ -115,6 +270,76 @@ func ValidateToken(tokenString, secret string) (*Claims, error) {
 	return claims, nil
 }
 
+// signingMethod returns the algorithm used for newly issued tokens (the first configured one)
+func signingMethod(cfg *config.Config) jwt.SigningMethod {
+	return jwt.GetSigningMethod(cfg.JWT.Algorithms[0])
+}
+
+// newParser builds a parser that only accepts the configured algorithms
+// The allow-list comes strictly from config so "none" or an unexpected
+// algorithm family can never be used to forge a token (algorithm confusion)
+func newParser(cfg *config.Config) *jwt.Parser {
+	return jwt.NewParser(jwt.WithValidMethods(cfg.JWT.Algorithms))
+}
+
+// signToken signs claims with the configured method, adding a kid header for asymmetric keys
+func signToken(claims jwt.Claims, cfg *config.Config) (string, error) {
+	method := signingMethod(cfg)
+	token := jwt.NewWithClaims(method, claims)
+
+	if _, ok := method.(*jwt.SigningMethodHMAC); ok {
+		token.Header["kid"] = cfg.JWT.HMACKeyID
+		return token.SignedString([]byte(cfg.JWT.Secret))
+	}
+
+	token.Header["kid"] = cfg.JWT.KeyID
+	return token.SignedString(cfg.JWT.PrivateKey)
+}
+
+// keyFunc returns the verification key for the token's algorithm family
+// HMAC tokens verify with the shared secret; RSA/ECDSA tokens select a public
+// key by kid, and the key type must match the algorithm so a public key can
+// never be abused as an HMAC secret
+func keyFunc(cfg *config.Config) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+			if kid == "" {
+				// Issued before kid headers were added: try every active secret
+				keys := make([]jwt.VerificationKey, 0, len(cfg.JWT.Secrets))
+				for _, secret := range cfg.JWT.Secrets {
+					keys = append(keys, []byte(secret))
+				}
+				return jwt.VerificationKeySet{Keys: keys}, nil
+			}
+
+			secret, ok := cfg.JWT.HMACKeys[kid]
+			if !ok {
+				return nil, ErrInvalidToken
+			}
+			return secret, nil
+		}
+
+		key, ok := cfg.JWT.PublicKeys[kid]
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA:
+			if rsaKey, ok := key.(*rsa.PublicKey); ok {
+				return rsaKey, nil
+			}
+		case *jwt.SigningMethodECDSA:
+			if ecKey, ok := key.(*ecdsa.PublicKey); ok {
+				return ecKey, nil
+			}
+		}
+		return nil, ErrInvalidToken
+	}
+}
+
 func extractToken(c *gin.Context) (string, error) {
 	authHeader := c.GetHeader(AuthorizationHeader)
 	if authHeader == "" {
@@ -139,6 +364,17 @@ func GetUserID(c *gin.Context) (string, bool) {
 	return id, ok
 }
 
+// GetClaims returns the validated access token claims of the current request
+func GetClaims(c *gin.Context) (*Claims, bool) {
+	value, exists := c.Get(ClaimsKey)
+	if !exists {
+		return nil, false
+	}
+
+	claims, ok := value.(*Claims)
+	return claims, ok
+}
+
 func GetUserEmail(c *gin.Context) (string, bool) {
 	email, exists := c.Get(UserEmailKey)
 	if !exists {
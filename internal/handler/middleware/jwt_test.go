@@ -0,0 +1,229 @@
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "abcdefghijabcdefghijabcdefghij123456"
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// newTestConfig signs and verifies with a single HS256 secret
+func newTestConfig() *config.Config {
+	return &config.Config{
+		App: config.AppConfig{Name: "test"},
+		JWT: config.JWTConfig{
+			Secret:     testSecret,
+			Secrets:    []string{testSecret},
+			Algorithms: []string{"HS256"},
+			Expiry:     time.Hour,
+			HMACKeyID:  "k1",
+			HMACKeys:   map[string][]byte{"k1": []byte(testSecret)},
+		},
+	}
+}
+
+// revocations is an in-memory TokenBlacklist and SessionChecker
+type revocations struct {
+	tokens   map[string]bool
+	sessions map[string]bool
+	err      error
+}
+
+func (r *revocations) IsRevoked(_ context.Context, tokenID string) (bool, error) {
+	return r.tokens[tokenID], r.err
+}
+
+func (r *revocations) IsSessionRevoked(_ context.Context, sessionID string) (bool, error) {
+	return r.sessions[sessionID], r.err
+}
+
+func TestJWTRevocation(t *testing.T) {
+	cfg := newTestConfig()
+	token, err := GenerateToken("u1", "u1@example.com", "user", true, "session-1", cfg)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	claims, err := ValidateToken(token, cfg)
+	if err != nil {
+		t.Fatalf("ValidateToken: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		revoked    *revocations
+		wantStatus int
+		wantCode   apierror.Code
+	}{
+		{
+			name:       "active",
+			revoked:    &revocations{},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "token blacklisted at logout",
+			revoked:    &revocations{tokens: map[string]bool{claims.ID: true}},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   apierror.CodeTokenRevoked,
+		},
+		{
+			name:       "session signed out",
+			revoked:    &revocations{sessions: map[string]bool{"session-1": true}},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   apierror.CodeTokenRevoked,
+		},
+		{
+			name:       "other session signed out",
+			revoked:    &revocations{sessions: map[string]bool{"session-2": true}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "revocation store unavailable",
+			revoked:    &revocations{err: errors.New("connection refused")},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   apierror.CodeServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/me", JWT(cfg, tt.revoked, tt.revoked), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			req.Header.Set(AuthorizationHeader, BearerScheme+" "+token)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantCode == "" {
+				return
+			}
+			var body apierror.Response
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode error body: %v", err)
+			}
+			if body.Code != tt.wantCode {
+				t.Errorf("code = %s, want %s", body.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestDeviceSessionsAreIndependent(t *testing.T) {
+	cfg := newTestConfig()
+	expiresAt := time.Now().Add(24 * time.Hour)
+	devices := []string{"phone", "tablet"}
+
+	access := map[string]string{}
+	for _, device := range devices {
+		token, err := GenerateToken("u1", "u1@example.com", "user", true, "family-"+device, cfg)
+		if err != nil {
+			t.Fatalf("GenerateToken: %v", err)
+		}
+		access[device] = token
+
+		refresh, err := GenerateRefreshToken("rt-"+device, "u1", device, expiresAt, cfg)
+		if err != nil {
+			t.Fatalf("GenerateRefreshToken: %v", err)
+		}
+		claims, err := ValidateRefreshToken(refresh, cfg)
+		if err != nil {
+			t.Fatalf("ValidateRefreshToken: %v", err)
+		}
+		if claims.DeviceID != device || claims.ID != "rt-"+device || claims.Subject != "u1" {
+			t.Errorf("refresh claims = %+v, want the %s session of u1", claims, device)
+		}
+	}
+
+	// Signing out on the phone ends only the phone's session
+	revoked := &revocations{sessions: map[string]bool{"family-phone": true}}
+	router := gin.New()
+	router.GET("/me", JWT(cfg, revoked, revoked), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	want := map[string]int{"phone": http.StatusUnauthorized, "tablet": http.StatusOK}
+	for _, device := range devices {
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		req.Header.Set(AuthorizationHeader, BearerScheme+" "+access[device])
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != want[device] {
+			t.Errorf("%s: status = %d, want %d", device, rec.Code, want[device])
+		}
+	}
+}
+
+func TestValidateTokenRejectsUnconfiguredAlgorithms(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	// The RSA key is published, but only HS256 is accepted
+	cfg := newTestConfig()
+	cfg.JWT.PublicKeys = map[string]crypto.PublicKey{"r1": &rsaKey.PublicKey}
+
+	claims := Claims{
+		UserID: "u1",
+		Role:   "user",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			Issuer:    cfg.App.Name,
+		},
+	}
+	sign := func(method jwt.SigningMethod, kid string, key any) string {
+		t.Helper()
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("failed to sign %s token: %v", method.Alg(), err)
+		}
+		return signed
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "configured HS256", token: sign(jwt.SigningMethodHS256, "k1", []byte(testSecret))},
+		{name: "alg none", token: sign(jwt.SigningMethodNone, "", jwt.UnsafeAllowNoneSignatureType), wantErr: ErrInvalidToken},
+		{name: "alg none with kid", token: sign(jwt.SigningMethodNone, "k1", jwt.UnsafeAllowNoneSignatureType), wantErr: ErrInvalidToken},
+		{name: "HS384 with the configured secret", token: sign(jwt.SigningMethodHS384, "k1", []byte(testSecret)), wantErr: ErrInvalidToken},
+		{name: "RS256 with a published key", token: sign(jwt.SigningMethodRS256, "r1", rsaKey), wantErr: ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidateToken(tt.token, cfg)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
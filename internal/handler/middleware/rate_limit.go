@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/ratelimit"
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitCode identifies which limit rejected the request
+type RateLimitCode string
+
+const (
+	RateLimitLogin       RateLimitCode = "LOGIN_RATE_LIMIT"
+	RateLimitSignup      RateLimitCode = "SIGNUP_RATE_LIMIT"
+	RateLimitUser        RateLimitCode = "USER_RATE_LIMIT"
+	RateLimitConcurrency RateLimitCode = "CONCURRENCY_LIMIT"
+)
+
+var rateLimitMessages = map[RateLimitCode]string{
+	RateLimitLogin:       "Too many login attempts",
+	RateLimitSignup:      "Too many accounts created",
+	RateLimitUser:        "Too many requests",
+	RateLimitConcurrency: "Too many concurrent requests",
+}
+
+// AbortTooManyRequests writes the 429 response shared by every limiter
+// retryAfter is rounded up to whole seconds and sent both as a header and in the details
+func AbortTooManyRequests(c *gin.Context, code RateLimitCode, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	c.Header("Retry-After", strconv.Itoa(seconds))
+	err := apierror.New(http.StatusTooManyRequests, apierror.Code(code), rateLimitMessages[code])
+	apierror.Respond(c, err.WithDetails(gin.H{"retryAfterSeconds": seconds}), GetRequestID(c))
+}
+
+// concurrencyRetryAfter is suggested to clients turned away while the instance is saturated
+const concurrencyRetryAfter = time.Second
+
+// LoginRateLimit limits login attempts per client IP, counted across every route it is registered on
+// Register one instance on the password, social and second-factor login routes so they share the limit
+func LoginRateLimit(cfg *config.Config) gin.HandlerFunc {
+	return clientIPRateLimit(cfg.RateLimit.LoginAttempts, cfg.RateLimit.LoginWindow, RateLimitLogin)
+}
+
+// SignupRateLimit limits account creation per client IP, as every signup adds a user row
+// Register one instance on the signup and guest routes so they share the limit
+func SignupRateLimit(cfg *config.Config) gin.HandlerFunc {
+	return clientIPRateLimit(cfg.RateLimit.Signups, cfg.RateLimit.SignupWindow, RateLimitSignup)
+}
+
+// clientIPRateLimit allows limit requests per client IP every window; zero means unlimited
+func clientIPRateLimit(limit int, window time.Duration, code RateLimitCode) gin.HandlerFunc {
+	if limit <= 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+	limiter := ratelimit.NewWindow(limit, window)
+
+	return func(c *gin.Context) {
+		if ok, retryAfter := limiter.Allow(c.ClientIP(), time.Now()); !ok {
+			AbortTooManyRequests(c, code, retryAfter)
+			return
+		}
+		c.Next()
+	}
+}
+
+// UserRateLimit limits requests per signed-in user; register it after JWT
+func UserRateLimit(cfg *config.Config) gin.HandlerFunc {
+	if cfg.RateLimit.UserRequests <= 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+	limiter := ratelimit.NewWindow(cfg.RateLimit.UserRequests, cfg.RateLimit.UserWindow)
+
+	return func(c *gin.Context) {
+		userID, ok := GetUserID(c)
+		if !ok {
+			c.Next()
+			return
+		}
+		if ok, retryAfter := limiter.Allow(userID, time.Now()); !ok {
+			AbortTooManyRequests(c, RateLimitUser, retryAfter)
+			return
+		}
+		c.Next()
+	}
+}
+
+// ConcurrencyLimit turns requests away while the instance already serves the configured number
+// Shedding load early keeps latency bounded for the requests already in progress
+func ConcurrencyLimit(cfg *config.Config) gin.HandlerFunc {
+	if cfg.RateLimit.MaxConcurrent <= 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+	slots := ratelimit.NewSemaphore(cfg.RateLimit.MaxConcurrent)
+
+	return func(c *gin.Context) {
+		if !slots.TryAcquire() {
+			AbortTooManyRequests(c, RateLimitConcurrency, concurrencyRetryAfter)
+			return
+		}
+		defer slots.Release()
+		c.Next()
+	}
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+// serve runs one GET / through handlers followed by a handler answering 200
+func serve(t *testing.T, handlers ...gin.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	router := gin.New()
+	router.GET("/", append(handlers, func(c *gin.Context) { c.Status(http.StatusOK) })...)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	return rec
+}
+
+// assertTooManyRequests checks the 429 envelope names the limit and carries a retry-after value
+func assertTooManyRequests(t *testing.T, rec *httptest.ResponseRecorder, code RateLimitCode) {
+	t.Helper()
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429", rec.Code)
+	}
+	var body struct {
+		Code    apierror.Code `json:"code"`
+		Details struct {
+			RetryAfterSeconds int `json:"retryAfterSeconds"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body.Code != apierror.Code(code) {
+		t.Errorf("code = %s, want %s", body.Code, code)
+	}
+	if body.Details.RetryAfterSeconds < 1 {
+		t.Errorf("retryAfterSeconds = %d, want at least 1", body.Details.RetryAfterSeconds)
+	}
+	if header := rec.Header().Get("Retry-After"); header != strconv.Itoa(body.Details.RetryAfterSeconds) {
+		t.Errorf("Retry-After = %q, want %d", header, body.Details.RetryAfterSeconds)
+	}
+}
+
+func TestLoginRateLimit(t *testing.T) {
+	cfg := &config.Config{RateLimit: config.RateLimitConfig{LoginAttempts: 2, LoginWindow: time.Minute}}
+	limit := LoginRateLimit(cfg)
+
+	for i := 0; i < 2; i++ {
+		if rec := serve(t, limit); rec.Code != http.StatusOK {
+			t.Fatalf("attempt %d: status = %d, want 200", i+1, rec.Code)
+		}
+	}
+	rec := serve(t, limit)
+	assertTooManyRequests(t, rec, RateLimitLogin)
+	if rec.Header().Get("Retry-After") != "60" {
+		t.Errorf("Retry-After = %s, want the rest of the 60s window", rec.Header().Get("Retry-After"))
+	}
+}
+
+func TestSignupRateLimit(t *testing.T) {
+	cfg := &config.Config{RateLimit: config.RateLimitConfig{LoginAttempts: 5, LoginWindow: time.Minute, Signups: 1, SignupWindow: time.Hour}}
+	signup, login := SignupRateLimit(cfg), LoginRateLimit(cfg)
+
+	if rec := serve(t, signup); rec.Code != http.StatusOK {
+		t.Fatalf("first signup: status = %d, want 200", rec.Code)
+	}
+	assertTooManyRequests(t, serve(t, signup), RateLimitSignup)
+	// Signing in is counted separately
+	if rec := serve(t, login); rec.Code != http.StatusOK {
+		t.Errorf("login after the signup limit: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestUserRateLimit(t *testing.T) {
+	cfg := &config.Config{RateLimit: config.RateLimitConfig{UserRequests: 1, UserWindow: time.Minute}}
+	limit := UserRateLimit(cfg)
+	as := func(userID string) gin.HandlerFunc {
+		return func(c *gin.Context) { c.Set(UserIDKey, userID) }
+	}
+
+	if rec := serve(t, as("u1"), limit); rec.Code != http.StatusOK {
+		t.Fatalf("first request: status = %d, want 200", rec.Code)
+	}
+	assertTooManyRequests(t, serve(t, as("u1"), limit), RateLimitUser)
+	if rec := serve(t, as("u2"), limit); rec.Code != http.StatusOK {
+		t.Errorf("other user: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	cfg := &config.Config{RateLimit: config.RateLimitConfig{MaxConcurrent: 1}}
+	limit := ConcurrencyLimit(cfg)
+
+	// The first request holds the only slot until released
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan int)
+	go func() {
+		rec := serve(t, limit, func(c *gin.Context) {
+			close(started)
+			<-release
+		})
+		done <- rec.Code
+	}()
+	<-started
+
+	assertTooManyRequests(t, serve(t, limit), RateLimitConcurrency)
+
+	close(release)
+	if status := <-done; status != http.StatusOK {
+		t.Fatalf("first request: status = %d, want 200", status)
+	}
+	if rec := serve(t, limit); rec.Code != http.StatusOK {
+		t.Errorf("after the slot was released: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestRateLimitsDisabled(t *testing.T) {
+	cfg := &config.Config{}
+	for _, limit := range []gin.HandlerFunc{LoginRateLimit(cfg), SignupRateLimit(cfg), UserRateLimit(cfg), ConcurrencyLimit(cfg)} {
+		for i := 0; i < 3; i++ {
+			if rec := serve(t, limit); rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200 with limits off", rec.Code)
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/requestinfo"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -20,6 +21,11 @@ func RequestID() gin.HandlerFunc {
 
 		c.Set(RequestIDKey, requestID)
 		c.Writer.Header().Set(RequestIDHeader, requestID)
+		// Usecases read the ID and client address from the context, e.g. for the audit log
+		c.Request = c.Request.WithContext(requestinfo.NewContext(c.Request.Context(), requestinfo.Info{
+			ID: requestID,
+			IP: c.ClientIP(),
+		}))
 
 		c.Next()
 	}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+var ErrInsufficientRole = errors.New("insufficient role")
+
+// RequireRole allows the request only when the token's role is one of roles
+// Must be registered after JWT so the role claim is available
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, ok := GetUserRole(c)
+		if !ok || !slices.Contains(roles, role) {
+			apierror.Respond(c, apierror.Wrap(ErrInsufficientRole, http.StatusForbidden, apierror.CodeInsufficientRole), GetRequestID(c))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// GetUserRole returns the role claim of the authenticated user
+func GetUserRole(c *gin.Context) (string, bool) {
+	role, exists := c.Get(UserRoleKey)
+	if !exists {
+		return "", false
+	}
+
+	r, ok := role.(string)
+	return r, ok
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// TokenIssuer signs and parses tokens with the configured JWT settings
+type TokenIssuer struct {
+	cfg *config.Config
+}
+
+// NewTokenIssuer creates a token issuer
+func NewTokenIssuer(cfg *config.Config) *TokenIssuer {
+	return &TokenIssuer{
+		cfg: cfg,
+	}
+}
+
+// IssueAccessToken signs an access token for the user's session
+func (i *TokenIssuer) IssueAccessToken(user *entity.User, sessionID string) (string, error) {
+	return GenerateToken(user.ID, user.Email, string(user.Role), user.IsEmailVerified(), sessionID, i.cfg)
+}
+
+// IssueRefreshToken signs the given refresh token record
+func (i *TokenIssuer) IssueRefreshToken(token *entity.RefreshToken) (string, error) {
+	return GenerateRefreshToken(token.ID, token.UserID, token.DeviceID, token.ExpiresAt, i.cfg)
+}
+
+// ParseRefreshToken validates a refresh token and returns its token ID
+func (i *TokenIssuer) ParseRefreshToken(raw string) (string, error) {
+	claims, err := ValidateRefreshToken(raw, i.cfg)
+	if err != nil {
+		return "", err
+	}
+	if claims.ID == "" {
+		return "", ErrInvalidClaims
+	}
+	return claims.ID, nil
+}
+
+// IssueMFAToken signs the intermediate token handed out while a second factor is pending
+func (i *TokenIssuer) IssueMFAToken(userID, deviceID string) (string, error) {
+	return GenerateMFAToken(userID, deviceID, i.cfg)
+}
+
+// ParseMFAToken validates an intermediate token and returns its user and device
+func (i *TokenIssuer) ParseMFAToken(raw string) (string, string, error) {
+	claims, err := ValidateMFAToken(raw, i.cfg)
+	if err != nil {
+		return "", "", err
+	}
+	return claims.Subject, claims.DeviceID, nil
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+// URILimit rejects requests whose URI or individual query values are pathologically long
+// Returns 414 when the whole request URI is too long and 400 for an over-length query value
+func URILimit(cfg *config.Config) gin.HandlerFunc {
+	maxURI := cfg.Server.MaxURILength
+	maxParam := cfg.Server.MaxQueryParamLength
+
+	return func(c *gin.Context) {
+		if maxURI > 0 && len(c.Request.RequestURI) > maxURI {
+			err := apierror.New(http.StatusRequestURITooLong, apierror.CodeURITooLong,
+				fmt.Sprintf("request URI exceeds %d characters", maxURI))
+			apierror.Respond(c, err.WithMessage("URI_TOO_LONG", map[string]string{"limit": strconv.Itoa(maxURI)}), GetRequestID(c))
+			return
+		}
+
+		if maxParam > 0 {
+			for key, values := range c.Request.URL.Query() {
+				for _, value := range values {
+					if len(value) > maxParam {
+						err := apierror.New(http.StatusBadRequest, apierror.CodeBadRequest,
+							fmt.Sprintf("query parameter %q exceeds %d characters", key, maxParam))
+						apierror.Respond(c, err.WithMessage("BAD_REQUEST.query_param_too_long",
+							map[string]string{"name": key, "limit": strconv.Itoa(maxParam)}), GetRequestID(c))
+						return
+					}
+				}
+			}
+		}
+
+		c.Next()
+	}
+}
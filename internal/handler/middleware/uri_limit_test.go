@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+)
+
+func TestURILimit(t *testing.T) {
+	const (
+		maxURI   = 64
+		maxParam = 16
+		prefix   = "/search?q="
+	)
+	// uri returns a search URI of exactly n characters
+	uri := func(n int) string {
+		return prefix + strings.Repeat("a", n-len(prefix))
+	}
+
+	tests := []struct {
+		name       string
+		maxURI     int
+		maxParam   int
+		target     string
+		wantStatus int
+		wantCode   apierror.Code
+	}{
+		{name: "URI at the limit", maxURI: maxURI, target: uri(maxURI), wantStatus: http.StatusOK},
+		{name: "URI over the limit", maxURI: maxURI, target: uri(maxURI + 1), wantStatus: http.StatusRequestURITooLong, wantCode: apierror.CodeURITooLong},
+		{name: "search param at the limit", maxURI: maxURI, maxParam: maxParam, target: uri(len(prefix) + maxParam), wantStatus: http.StatusOK},
+		{name: "search param over the limit", maxURI: maxURI, maxParam: maxParam, target: uri(len(prefix) + maxParam + 1), wantStatus: http.StatusBadRequest, wantCode: apierror.CodeBadRequest},
+		{name: "limits disabled", target: uri(4 * maxURI), wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Server: config.ServerConfig{MaxURILength: tt.maxURI, MaxQueryParamLength: tt.maxParam}}
+			router := gin.New()
+			router.GET("/search", URILimit(cfg), func(c *gin.Context) { c.Status(http.StatusOK) })
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantCode == "" {
+				return
+			}
+			var body apierror.Response
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode error body: %v", err)
+			}
+			if body.Code != tt.wantCode {
+				t.Errorf("code = %s, want %s", body.Code, tt.wantCode)
+			}
+		})
+	}
+}
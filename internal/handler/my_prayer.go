@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/gin-gonic/gin"
+)
+
+// MyPrayerHandler serves the signed-in user's own prayer topics across their rooms
+type MyPrayerHandler struct {
+	listUC    *prayer.MyPrayersUseCase
+	reorderUC *prayer.ReorderMyPrayersUseCase
+}
+
+func NewMyPrayerHandler(listUC *prayer.MyPrayersUseCase, reorderUC *prayer.ReorderMyPrayersUseCase) *MyPrayerHandler {
+	return &MyPrayerHandler{
+		listUC:    listUC,
+		reorderUC: reorderUC,
+	}
+}
+
+// List handles GET /api/v1/users/me/prayers
+func (h *MyPrayerHandler) List(c *gin.Context) {
+	var req dto.CursorRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	views, page, err := h.listUC.Execute(c.Request.Context(), userID, req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.PrayerTopicResponse, 0, len(views))
+	for _, v := range views {
+		resp = append(resp, dto.NewPrayerTopicViewResponse(v.Topic, v.Reacted))
+	}
+	c.JSON(http.StatusOK, dto.PrayerTopicListResponse{Prayers: resp, Page: page})
+}
+
+// Reorder handles PUT /api/v1/users/me/prayers/order
+func (h *MyPrayerHandler) Reorder(c *gin.Context) {
+	var req dto.ReorderMyPrayersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	views, err := h.reorderUC.Execute(c.Request.Context(), userID, req.PrayerIDs)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.PrayerTopicResponse, 0, len(views))
+	for _, v := range views {
+		resp = append(resp, dto.NewPrayerTopicViewResponse(v.Topic, v.Reacted))
+	}
+	c.JSON(http.StatusOK, dto.OrderedPrayerListResponse{Prayers: resp})
+}
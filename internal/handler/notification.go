@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationHandler serves the signed-in user's notification inbox
+type NotificationHandler struct {
+	listUC    *account.ListNotificationsUseCase
+	unreadUC  *account.UnreadCountsUseCase
+	readUC    *account.ReadNotificationUseCase
+	readAllUC *account.ReadAllNotificationsUseCase
+}
+
+func NewNotificationHandler(
+	listUC *account.ListNotificationsUseCase,
+	unreadUC *account.UnreadCountsUseCase,
+	readUC *account.ReadNotificationUseCase,
+	readAllUC *account.ReadAllNotificationsUseCase,
+) *NotificationHandler {
+	return &NotificationHandler{
+		listUC:    listUC,
+		unreadUC:  unreadUC,
+		readUC:    readUC,
+		readAllUC: readAllUC,
+	}
+}
+
+// List handles GET /api/v1/notifications
+func (h *NotificationHandler) List(c *gin.Context) {
+	var req dto.CursorRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	inbox, err := h.listUC.Execute(c.Request.Context(), userID, req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.NotificationResponse, 0, len(inbox.Notifications))
+	for _, n := range inbox.Notifications {
+		resp = append(resp, dto.NewNotificationResponse(n))
+	}
+	c.JSON(http.StatusOK, dto.NotificationListResponse{
+		Notifications: resp,
+		UnreadCount:   dto.Count(inbox.UnreadCount),
+		Page:          inbox.Page,
+	})
+}
+
+// UnreadCounts handles GET /api/v1/notifications/unread-counts
+func (h *NotificationHandler) UnreadCounts(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	counts, err := h.unreadUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewUnreadCountsResponse(counts))
+}
+
+// Read handles POST /api/v1/notifications/:id/read
+func (h *NotificationHandler) Read(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.readUC.Execute(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ReadAll handles POST /api/v1/notifications/read-all
+func (h *NotificationHandler) ReadAll(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.readAllUC.Execute(c.Request.Context(), userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
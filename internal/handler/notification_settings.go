@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationSettingsHandler serves which notifications the signed-in user wants
+type NotificationSettingsHandler struct {
+	getUC      *account.GetNotificationSettingsUseCase
+	updateUC   *account.UpdateNotificationSettingsUseCase
+	snoozeUC   *account.SnoozeNotificationsUseCase
+	unsnoozeUC *account.UnsnoozeNotificationsUseCase
+}
+
+func NewNotificationSettingsHandler(
+	getUC *account.GetNotificationSettingsUseCase,
+	updateUC *account.UpdateNotificationSettingsUseCase,
+	snoozeUC *account.SnoozeNotificationsUseCase,
+	unsnoozeUC *account.UnsnoozeNotificationsUseCase,
+) *NotificationSettingsHandler {
+	return &NotificationSettingsHandler{
+		getUC:      getUC,
+		updateUC:   updateUC,
+		snoozeUC:   snoozeUC,
+		unsnoozeUC: unsnoozeUC,
+	}
+}
+
+// Get handles GET /api/v1/users/me/notification-settings
+func (h *NotificationSettingsHandler) Get(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	settings, err := h.getUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewNotificationSettingsResponse(settings))
+}
+
+// Update handles PATCH /api/v1/users/me/notification-settings
+func (h *NotificationSettingsHandler) Update(c *gin.Context) {
+	var req dto.UpdateNotificationSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	settings, err := h.updateUC.Execute(c.Request.Context(), userID, req.ToNotificationSettingsUpdate())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewNotificationSettingsResponse(settings))
+}
+
+// Snooze handles POST /api/v1/users/me/notifications/snooze
+func (h *NotificationSettingsHandler) Snooze(c *gin.Context) {
+	var req dto.SnoozeNotificationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	settings, err := h.snoozeUC.Execute(c.Request.Context(), userID, req.SnoozedUntil(time.Now()))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewNotificationSettingsResponse(settings))
+}
+
+// Unsnooze handles DELETE /api/v1/users/me/notifications/snooze
+func (h *NotificationSettingsHandler) Unsnooze(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	settings, err := h.unsnoozeUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewNotificationSettingsResponse(settings))
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// OAuthHandler exchanges social provider ID tokens (Kakao, Google, Apple) for our JWTs
+type OAuthHandler struct {
+	socialLoginUC *auth.SocialLoginUseCase
+	linkUC        *auth.LinkSocialAccountUseCase
+	mfaUC         *auth.BeginTwoFactorLoginUseCase
+	issueUC       *auth.IssueTokensUseCase
+}
+
+func NewOAuthHandler(
+	socialLoginUC *auth.SocialLoginUseCase,
+	linkUC *auth.LinkSocialAccountUseCase,
+	mfaUC *auth.BeginTwoFactorLoginUseCase,
+	issueUC *auth.IssueTokensUseCase,
+) *OAuthHandler {
+	return &OAuthHandler{
+		socialLoginUC: socialLoginUC,
+		linkUC:        linkUC,
+		mfaUC:         mfaUC,
+		issueUC:       issueUC,
+	}
+}
+
+// Login handles POST /api/v1/auth/social/:provider
+func (h *OAuthHandler) Login(c *gin.Context) {
+	provider, err := entity.ParseSocialProvider(c.Param("provider"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var req dto.SocialLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user, err := h.socialLoginUC.Execute(c.Request.Context(), provider, req.IDToken, req.Nickname)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondWithLogin(c, h.mfaUC, h.issueUC, user, req.DeviceID)
+}
+
+// Link handles POST /api/v1/auth/social/:provider/link (authenticated)
+func (h *OAuthHandler) Link(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	provider, err := entity.ParseSocialProvider(c.Param("provider"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var req dto.LinkSocialAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	account, err := h.linkUC.Execute(c.Request.Context(), userID, provider, req.IDToken)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewSocialAccountResponse(account))
+}
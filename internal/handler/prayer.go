@@ -0,0 +1,386 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/gin-gonic/gin"
+)
+
+// PrayerHandler serves prayer topics (기도제목)
+type PrayerHandler struct {
+	createUC   *prayer.CreateTopicUseCase
+	getUC      *prayer.GetTopicUseCase
+	listUC     *prayer.ListTopicsUseCase
+	updateUC   *prayer.UpdateTopicUseCase
+	deleteUC   *prayer.DeleteTopicUseCase
+	restoreUC  *prayer.RestoreTopicUseCase
+	completeUC *prayer.CompleteTopicUseCase
+	answeredUC *prayer.ListAnsweredUseCase
+	reactUC    *prayer.ReactUseCase
+	tagsUC     *prayer.TagCloudUseCase
+	searchUC   *prayer.SearchPrayersUseCase
+	journalUC  *prayer.JournalUseCase
+	pauseUC    *prayer.PauseRecurrenceUseCase
+	cancelUC   *prayer.CancelRecurrenceUseCase
+	pinUC      *prayer.PinTopicUseCase
+	unpinUC    *prayer.UnpinTopicUseCase
+	reorderUC  *prayer.ReorderPinsUseCase
+}
+
+func NewPrayerHandler(
+	createUC *prayer.CreateTopicUseCase,
+	getUC *prayer.GetTopicUseCase,
+	listUC *prayer.ListTopicsUseCase,
+	updateUC *prayer.UpdateTopicUseCase,
+	deleteUC *prayer.DeleteTopicUseCase,
+	restoreUC *prayer.RestoreTopicUseCase,
+	completeUC *prayer.CompleteTopicUseCase,
+	answeredUC *prayer.ListAnsweredUseCase,
+	reactUC *prayer.ReactUseCase,
+	tagsUC *prayer.TagCloudUseCase,
+	searchUC *prayer.SearchPrayersUseCase,
+	journalUC *prayer.JournalUseCase,
+	pauseUC *prayer.PauseRecurrenceUseCase,
+	cancelUC *prayer.CancelRecurrenceUseCase,
+	pinUC *prayer.PinTopicUseCase,
+	unpinUC *prayer.UnpinTopicUseCase,
+	reorderUC *prayer.ReorderPinsUseCase,
+) *PrayerHandler {
+	return &PrayerHandler{
+		createUC:   createUC,
+		getUC:      getUC,
+		listUC:     listUC,
+		updateUC:   updateUC,
+		deleteUC:   deleteUC,
+		restoreUC:  restoreUC,
+		completeUC: completeUC,
+		answeredUC: answeredUC,
+		reactUC:    reactUC,
+		tagsUC:     tagsUC,
+		searchUC:   searchUC,
+		journalUC:  journalUC,
+		pauseUC:    pauseUC,
+		cancelUC:   cancelUC,
+		pinUC:      pinUC,
+		unpinUC:    unpinUC,
+		reorderUC:  reorderUC,
+	}
+}
+
+// Create handles POST /api/v1/rooms/:id/prayers
+func (h *PrayerHandler) Create(c *gin.Context) {
+	var req dto.PrayerTopicRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	topic, err := h.createUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Title, req.Tags, req.Private, req.Recurrence)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewPrayerTopicResponse(topic))
+}
+
+// List handles GET /api/v1/rooms/:id/prayers?cursor=&limit=&deleted=&tag=
+func (h *PrayerHandler) List(c *gin.Context) {
+	var req dto.PrayerTopicListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	views, page, err := h.listUC.Execute(c.Request.Context(), userID, c.Param("id"), req.ToPrayerTopicFilter(), req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.PrayerTopicResponse, 0, len(views))
+	for _, v := range views {
+		resp = append(resp, dto.NewPrayerTopicViewResponse(v.Topic, v.Reacted))
+	}
+	c.JSON(http.StatusOK, dto.PrayerTopicListResponse{Prayers: resp, Page: page})
+}
+
+// Answered handles GET /api/v1/rooms/:id/prayers/answered?cursor=&limit=
+func (h *PrayerHandler) Answered(c *gin.Context) {
+	var req dto.CursorRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	views, page, err := h.answeredUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.PrayerTopicResponse, 0, len(views))
+	for _, v := range views {
+		resp = append(resp, dto.NewPrayerTopicViewResponse(v.Topic, v.Reacted))
+	}
+	c.JSON(http.StatusOK, dto.PrayerTopicListResponse{Prayers: resp, Page: page})
+}
+
+// Search handles GET /api/v1/rooms/:id/prayers/search?q=&cursor=&limit=
+func (h *PrayerHandler) Search(c *gin.Context) {
+	var req dto.PrayerSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	hits, page, err := h.searchUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Q, req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.PrayerSearchHitResponse, 0, len(hits))
+	for _, hit := range hits {
+		resp = append(resp, dto.NewPrayerSearchHitResponse(hit))
+	}
+	c.JSON(http.StatusOK, dto.PrayerSearchResponse{Results: resp, Page: page})
+}
+
+// Journal handles GET /api/v1/users/me/journal?cursor=&limit=
+func (h *PrayerHandler) Journal(c *gin.Context) {
+	var req dto.CursorRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	views, page, err := h.journalUC.Execute(c.Request.Context(), userID, req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.PrayerTopicResponse, 0, len(views))
+	for _, v := range views {
+		resp = append(resp, dto.NewPrayerTopicViewResponse(v.Topic, v.Reacted))
+	}
+	c.JSON(http.StatusOK, dto.PrayerTopicListResponse{Prayers: resp, Page: page})
+}
+
+// Tags handles GET /api/v1/rooms/:id/prayers/tags
+func (h *PrayerHandler) Tags(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	counts, err := h.tagsUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerTagCloudResponse(counts))
+}
+
+// Get handles GET /api/v1/prayers/:id
+func (h *PrayerHandler) Get(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	view, err := h.getUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerTopicViewResponse(view.Topic, view.Reacted))
+}
+
+// Update handles PATCH /api/v1/prayers/:id
+func (h *PrayerHandler) Update(c *gin.Context) {
+	var req dto.UpdatePrayerTopicRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	topic, err := h.updateUC.Execute(c.Request.Context(), userID, c.Param("id"), req.ToPrayerTopicUpdate())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerTopicResponse(topic))
+}
+
+// Delete handles DELETE /api/v1/prayers/:id
+func (h *PrayerHandler) Delete(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.deleteUC.Execute(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Restore handles POST /api/v1/prayers/:id/restore
+func (h *PrayerHandler) Restore(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	topic, err := h.restoreUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerTopicResponse(topic))
+}
+
+// Complete handles POST /api/v1/prayers/:id/complete
+func (h *PrayerHandler) Complete(c *gin.Context) {
+	var req dto.CompletePrayerTopicRequest
+	// Body is optional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			respondBadRequest(c, err)
+			return
+		}
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	topic, err := h.completeUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Testimony)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerTopicResponse(topic))
+}
+
+// Pin handles PUT /api/v1/prayers/:id/pin
+func (h *PrayerHandler) Pin(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	topic, err := h.pinUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerTopicResponse(topic))
+}
+
+// Unpin handles DELETE /api/v1/prayers/:id/pin
+func (h *PrayerHandler) Unpin(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	topic, err := h.unpinUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerTopicResponse(topic))
+}
+
+// ReorderPins handles PUT /api/v1/rooms/:id/pins/order
+func (h *PrayerHandler) ReorderPins(c *gin.Context) {
+	var req dto.ReorderPinsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	views, err := h.reorderUC.Execute(c.Request.Context(), userID, c.Param("id"), req.PrayerIDs)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.PrayerTopicResponse, 0, len(views))
+	for _, v := range views {
+		resp = append(resp, dto.NewPrayerTopicViewResponse(v.Topic, v.Reacted))
+	}
+	c.JSON(http.StatusOK, dto.PinnedPrayerListResponse{Prayers: resp})
+}
+
+// PauseRecurrence handles PUT /api/v1/prayers/:id/recurrence/pause
+func (h *PrayerHandler) PauseRecurrence(c *gin.Context) {
+	var req dto.PauseRecurrenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	topic, err := h.pauseUC.Execute(c.Request.Context(), userID, c.Param("id"), *req.Paused)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerTopicResponse(topic))
+}
+
+// CancelRecurrence handles DELETE /api/v1/prayers/:id/recurrence
+func (h *PrayerHandler) CancelRecurrence(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	topic, err := h.cancelUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerTopicResponse(topic))
+}
+
+// React handles PUT /api/v1/prayers/:id/reaction
+func (h *PrayerHandler) React(c *gin.Context) {
+	h.react(c, true)
+}
+
+// Unreact handles DELETE /api/v1/prayers/:id/reaction
+func (h *PrayerHandler) Unreact(c *gin.Context) {
+	h.react(c, false)
+}
+
+func (h *PrayerHandler) react(c *gin.Context, react bool) {
+	var req dto.PrayerReactionRequest
+	// Body is optional
+	if react && c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			respondBadRequest(c, err)
+			return
+		}
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	count, prayed, err := h.reactUC.Execute(c.Request.Context(), userID, c.Param("id"), react, req.Anonymous)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PrayerReactionResponse{ReactionCount: dto.Count(count), Reacted: react, PrayedCount: dto.Count(prayed)})
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/gin-gonic/gin"
+)
+
+// PrayerCommentHandler serves comments on prayer topics
+type PrayerCommentHandler struct {
+	createUC *prayer.CreateCommentUseCase
+	listUC   *prayer.ListCommentsUseCase
+	updateUC *prayer.UpdateCommentUseCase
+	deleteUC *prayer.DeleteCommentUseCase
+}
+
+func NewPrayerCommentHandler(
+	createUC *prayer.CreateCommentUseCase,
+	listUC *prayer.ListCommentsUseCase,
+	updateUC *prayer.UpdateCommentUseCase,
+	deleteUC *prayer.DeleteCommentUseCase,
+) *PrayerCommentHandler {
+	return &PrayerCommentHandler{
+		createUC: createUC,
+		listUC:   listUC,
+		updateUC: updateUC,
+		deleteUC: deleteUC,
+	}
+}
+
+// Create handles POST /api/v1/prayers/:id/comments
+func (h *PrayerCommentHandler) Create(c *gin.Context) {
+	var req dto.PrayerCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	comment, err := h.createUC.Execute(c.Request.Context(), userID, c.Param("id"), string(req.ParentID), req.Body)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewPrayerCommentResponse(comment))
+}
+
+// List handles GET /api/v1/prayers/:id/comments?parentId=&cursor=&limit=
+func (h *PrayerCommentHandler) List(c *gin.Context) {
+	var req dto.PrayerCommentListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	comments, page, err := h.listUC.Execute(c.Request.Context(), userID, c.Param("id"), string(req.ParentID), req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.PrayerCommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		resp = append(resp, dto.NewPrayerCommentResponse(comment))
+	}
+	c.JSON(http.StatusOK, dto.PrayerCommentListResponse{Comments: resp, Page: page})
+}
+
+// Update handles PATCH /api/v1/prayers/:id/comments/:commentId
+func (h *PrayerCommentHandler) Update(c *gin.Context) {
+	var req dto.PrayerCommentUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	comment, err := h.updateUC.Execute(c.Request.Context(), userID, c.Param("id"), c.Param("commentId"), req.Body)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerCommentResponse(comment))
+}
+
+// Delete handles DELETE /api/v1/prayers/:id/comments/:commentId
+func (h *PrayerCommentHandler) Delete(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.deleteUC.Execute(c.Request.Context(), userID, c.Param("id"), c.Param("commentId")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/gin-gonic/gin"
+)
+
+// PrayerContentHandler serves the entries under a prayer topic (기도 내용)
+type PrayerContentHandler struct {
+	createUC *prayer.CreateContentUseCase
+	listUC   *prayer.ListContentsUseCase
+	updateUC *prayer.UpdateContentUseCase
+	deleteUC *prayer.DeleteContentUseCase
+}
+
+func NewPrayerContentHandler(
+	createUC *prayer.CreateContentUseCase,
+	listUC *prayer.ListContentsUseCase,
+	updateUC *prayer.UpdateContentUseCase,
+	deleteUC *prayer.DeleteContentUseCase,
+) *PrayerContentHandler {
+	return &PrayerContentHandler{
+		createUC: createUC,
+		listUC:   listUC,
+		updateUC: updateUC,
+		deleteUC: deleteUC,
+	}
+}
+
+// Create handles POST /api/v1/prayers/:id/contents
+func (h *PrayerContentHandler) Create(c *gin.Context) {
+	var req dto.PrayerContentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	content, err := h.createUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Body)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewPrayerContentResponse(content))
+}
+
+// List handles GET /api/v1/prayers/:id/contents?cursor=&limit=
+func (h *PrayerContentHandler) List(c *gin.Context) {
+	var req dto.CursorRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	contents, page, err := h.listUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.PrayerContentResponse, 0, len(contents))
+	for _, content := range contents {
+		resp = append(resp, dto.NewPrayerContentResponse(content))
+	}
+	c.JSON(http.StatusOK, dto.PrayerContentListResponse{Contents: resp, Page: page})
+}
+
+// Update handles PATCH /api/v1/prayers/:id/contents/:contentId
+func (h *PrayerContentHandler) Update(c *gin.Context) {
+	var req dto.PrayerContentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	content, err := h.updateUC.Execute(c.Request.Context(), userID, c.Param("id"), c.Param("contentId"), req.Body)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPrayerContentResponse(content))
+}
+
+// Delete handles DELETE /api/v1/prayers/:id/contents/:contentId
+func (h *PrayerContentHandler) Delete(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.deleteUC.Execute(c.Request.Context(), userID, c.Param("id"), c.Param("contentId")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
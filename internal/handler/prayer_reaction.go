@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/gin-gonic/gin"
+)
+
+// PrayerReactionHandler serves who prayed for a prayer topic
+type PrayerReactionHandler struct {
+	listUC *prayer.ListReactionsUseCase
+}
+
+func NewPrayerReactionHandler(listUC *prayer.ListReactionsUseCase) *PrayerReactionHandler {
+	return &PrayerReactionHandler{
+		listUC: listUC,
+	}
+}
+
+// List handles GET /api/v1/prayers/:id/completions
+func (h *PrayerReactionHandler) List(c *gin.Context) {
+	var req dto.CursorRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	result, err := h.listUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := dto.PrayerParticipantListResponse{
+		Total:        dto.Count(result.Total),
+		Participants: make([]dto.PrayerParticipantResponse, 0, len(result.Reactions)),
+		Page:         result.Page,
+	}
+	for _, r := range result.Reactions {
+		resp.Participants = append(resp.Participants, dto.NewPrayerParticipantResponse(r.Reaction, r.User))
+	}
+	c.JSON(http.StatusOK, resp)
+}
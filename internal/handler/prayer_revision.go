@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/gin-gonic/gin"
+)
+
+// PrayerRevisionHandler serves the edit history of prayer topics
+type PrayerRevisionHandler struct {
+	historyUC *prayer.TopicHistoryUseCase
+}
+
+func NewPrayerRevisionHandler(historyUC *prayer.TopicHistoryUseCase) *PrayerRevisionHandler {
+	return &PrayerRevisionHandler{
+		historyUC: historyUC,
+	}
+}
+
+// History handles GET /api/v1/prayers/:id/history
+func (h *PrayerRevisionHandler) History(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	revisions, err := h.historyUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := dto.PrayerRevisionListResponse{
+		Revisions: make([]dto.PrayerRevisionResponse, 0, len(revisions)),
+	}
+	for _, r := range revisions {
+		resp.Revisions = append(resp.Revisions, dto.NewPrayerRevisionResponse(r))
+	}
+	c.JSON(http.StatusOK, resp)
+}
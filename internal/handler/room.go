@@ -0,0 +1,308 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/gin-gonic/gin"
+)
+
+// RoomHandler serves prayer rooms (기도방)
+type RoomHandler struct {
+	createUC  *room.CreateRoomUseCase
+	getUC     *room.GetRoomUseCase
+	listUC    *room.ListMyRoomsUseCase
+	searchUC  *room.SearchRoomsUseCase
+	trendUC   *room.TrendingRoomsUseCase
+	updateUC  *room.UpdateRoomUseCase
+	archiveUC *room.ArchiveRoomUseCase
+	deleteUC  *room.DeleteRoomUseCase
+	membersUC *room.ListMembersUseCase
+	activeUC  *room.ListActiveMembersUseCase
+	roleUC    *room.ChangeMemberRoleUseCase
+	muteUC    *room.MuteRoomUseCase
+}
+
+func NewRoomHandler(
+	createUC *room.CreateRoomUseCase,
+	getUC *room.GetRoomUseCase,
+	listUC *room.ListMyRoomsUseCase,
+	searchUC *room.SearchRoomsUseCase,
+	trendUC *room.TrendingRoomsUseCase,
+	updateUC *room.UpdateRoomUseCase,
+	archiveUC *room.ArchiveRoomUseCase,
+	deleteUC *room.DeleteRoomUseCase,
+	membersUC *room.ListMembersUseCase,
+	activeUC *room.ListActiveMembersUseCase,
+	roleUC *room.ChangeMemberRoleUseCase,
+	muteUC *room.MuteRoomUseCase,
+) *RoomHandler {
+	return &RoomHandler{
+		createUC:  createUC,
+		getUC:     getUC,
+		listUC:    listUC,
+		searchUC:  searchUC,
+		trendUC:   trendUC,
+		updateUC:  updateUC,
+		archiveUC: archiveUC,
+		deleteUC:  deleteUC,
+		membersUC: membersUC,
+		activeUC:  activeUC,
+		roleUC:    roleUC,
+		muteUC:    muteUC,
+	}
+}
+
+// Create handles POST /api/v1/rooms
+func (h *RoomHandler) Create(c *gin.Context) {
+	var req dto.CreateRoomRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	created, err := h.createUC.Execute(
+		c.Request.Context(),
+		userID,
+		req.Name,
+		req.Description,
+		entity.RoomVisibility(req.Visibility),
+		entity.RoomCategory(req.Category),
+		req.Tags,
+	)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewRoomResponse(created))
+}
+
+// List handles GET /api/v1/rooms?cursor=&limit=&category=&tags=
+func (h *RoomHandler) List(c *gin.Context) {
+	var req dto.RoomListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	rooms, page, err := h.listUC.Execute(c.Request.Context(), userID, req.ToRoomFilter(), req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.RoomResponse, 0, len(rooms))
+	for _, r := range rooms {
+		resp = append(resp, dto.NewRoomResponse(r))
+	}
+	c.JSON(http.StatusOK, dto.RoomListResponse{Rooms: resp, Page: page})
+}
+
+// Search handles GET /api/v1/rooms/search?q=&cursor=&limit=&category=&tags=
+func (h *RoomHandler) Search(c *gin.Context) {
+	var req dto.RoomSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	rooms, page, err := h.searchUC.Execute(c.Request.Context(), userID, req.Query, req.ToRoomFilter(), req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.RoomResponse, 0, len(rooms))
+	for _, r := range rooms {
+		resp = append(resp, dto.NewRoomResponse(r))
+	}
+	c.JSON(http.StatusOK, dto.RoomListResponse{Rooms: resp, Page: page})
+}
+
+// Trending handles GET /api/v1/rooms/trending
+func (h *RoomHandler) Trending(c *gin.Context) {
+	var req dto.CursorRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	rooms, page, err := h.trendUC.Execute(c.Request.Context(), userID, req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.RoomResponse, 0, len(rooms))
+	for _, r := range rooms {
+		resp = append(resp, dto.NewRoomResponse(r))
+	}
+	c.JSON(http.StatusOK, dto.RoomListResponse{Rooms: resp, Page: page})
+}
+
+// Categories handles GET /api/v1/rooms/categories
+func (h *RoomHandler) Categories(c *gin.Context) {
+	resp := make([]dto.RoomCategoryResponse, 0, len(entity.RoomCategories))
+	for _, category := range entity.RoomCategories {
+		resp = append(resp, dto.RoomCategoryResponse{ID: string(category), Label: category.Label()})
+	}
+	c.JSON(http.StatusOK, dto.RoomCategoryListResponse{Categories: resp})
+}
+
+// Get handles GET /api/v1/rooms/:id
+func (h *RoomHandler) Get(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	found, latest, err := h.getUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := dto.RoomDetailResponse{RoomResponse: dto.NewRoomResponse(found)}
+	if latest != nil {
+		announcement := dto.NewAnnouncementResponse(latest)
+		resp.LatestAnnouncement = &announcement
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// Update handles PATCH /api/v1/rooms/:id
+func (h *RoomHandler) Update(c *gin.Context) {
+	var req dto.UpdateRoomRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	updated, err := h.updateUC.Execute(c.Request.Context(), userID, c.Param("id"), req.ToRoomUpdate())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewRoomResponse(updated))
+}
+
+// Archive handles POST /api/v1/rooms/:id/archive
+func (h *RoomHandler) Archive(c *gin.Context) {
+	h.setArchived(c, true)
+}
+
+// Unarchive handles POST /api/v1/rooms/:id/unarchive
+func (h *RoomHandler) Unarchive(c *gin.Context) {
+	h.setArchived(c, false)
+}
+
+func (h *RoomHandler) setArchived(c *gin.Context, archive bool) {
+	userID, _ := middleware.GetUserID(c)
+
+	updated, err := h.archiveUC.Execute(c.Request.Context(), userID, c.Param("id"), archive)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewRoomResponse(updated))
+}
+
+// Delete handles DELETE /api/v1/rooms/:id
+func (h *RoomHandler) Delete(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.deleteUC.Execute(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListMembers handles GET /api/v1/rooms/:id/members
+func (h *RoomHandler) ListMembers(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	members, err := h.membersUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.RoomMemberResponse, 0, len(members))
+	for _, m := range members {
+		resp = append(resp, dto.NewRoomMemberResponse(m.Member, m.User))
+	}
+	c.JSON(http.StatusOK, dto.RoomMemberListResponse{Members: resp})
+}
+
+// ListActiveMembers handles GET /api/v1/rooms/:id/members/active
+func (h *RoomHandler) ListActiveMembers(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	members, err := h.activeUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.RoomMemberResponse, 0, len(members))
+	for _, m := range members {
+		resp = append(resp, dto.NewRoomMemberResponse(m.Member, m.User))
+	}
+	c.JSON(http.StatusOK, dto.RoomMemberListResponse{Members: resp})
+}
+
+// ChangeMemberRole handles PATCH /api/v1/rooms/:id/members/:userId
+func (h *RoomHandler) ChangeMemberRole(c *gin.Context) {
+	var req dto.ChangeMemberRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	member, err := h.roleUC.Execute(c.Request.Context(), userID, c.Param("id"), c.Param("userId"), entity.RoomRole(req.Role))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RoomMemberRoleResponse{
+		UserID: dto.ID(member.UserID),
+		Role:   string(member.Role),
+	})
+}
+
+// Mute handles PUT /api/v1/rooms/:id/mute
+func (h *RoomHandler) Mute(c *gin.Context) {
+	var req dto.MuteRoomRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	member, err := h.muteUC.Execute(c.Request.Context(), userID, c.Param("id"), *req.Muted)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RoomMuteResponse{RoomID: dto.ID(member.RoomID), Muted: member.Muted})
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/gin-gonic/gin"
+)
+
+// AnnouncementHandler serves room announcements (공지사항)
+type AnnouncementHandler struct {
+	postUC *room.PostAnnouncementUseCase
+	editUC *room.EditAnnouncementUseCase
+	listUC *room.ListAnnouncementsUseCase
+}
+
+func NewAnnouncementHandler(
+	postUC *room.PostAnnouncementUseCase,
+	editUC *room.EditAnnouncementUseCase,
+	listUC *room.ListAnnouncementsUseCase,
+) *AnnouncementHandler {
+	return &AnnouncementHandler{
+		postUC: postUC,
+		editUC: editUC,
+		listUC: listUC,
+	}
+}
+
+// Post handles POST /api/v1/rooms/:id/announcements
+func (h *AnnouncementHandler) Post(c *gin.Context) {
+	var req dto.AnnouncementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	announcement, err := h.postUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Body)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewAnnouncementResponse(announcement))
+}
+
+// Edit handles PATCH /api/v1/rooms/:id/announcements/:announcementId
+func (h *AnnouncementHandler) Edit(c *gin.Context) {
+	var req dto.AnnouncementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	announcement, err := h.editUC.Execute(c.Request.Context(), userID, c.Param("id"), c.Param("announcementId"), req.Body)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewAnnouncementResponse(announcement))
+}
+
+// List handles GET /api/v1/rooms/:id/announcements?cursor=&limit=
+func (h *AnnouncementHandler) List(c *gin.Context) {
+	var req dto.CursorRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	announcements, page, err := h.listUC.Execute(c.Request.Context(), userID, c.Param("id"), req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.AnnouncementResponse, 0, len(announcements))
+	for _, a := range announcements {
+		resp = append(resp, dto.NewAnnouncementResponse(a))
+	}
+	c.JSON(http.StatusOK, dto.AnnouncementListResponse{Announcements: resp, Page: page})
+}
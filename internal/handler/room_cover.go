@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/gin-gonic/gin"
+)
+
+// RoomCoverHandler serves room cover image uploads
+type RoomCoverHandler struct {
+	setUC    *room.SetCoverImageUseCase
+	removeUC *room.RemoveCoverImageUseCase
+}
+
+func NewRoomCoverHandler(setUC *room.SetCoverImageUseCase, removeUC *room.RemoveCoverImageUseCase) *RoomCoverHandler {
+	return &RoomCoverHandler{
+		setUC:    setUC,
+		removeUC: removeUC,
+	}
+}
+
+// Set handles PUT /api/v1/rooms/:id/cover with the image in the multipart field "image"
+func (h *RoomCoverHandler) Set(c *gin.Context) {
+	file, err := c.FormFile("image")
+	if err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+	if file.Size > room.MaxCoverImageSize {
+		respondError(c, entity.ErrInvalidCoverImage)
+		return
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+	defer f.Close()
+
+	// Read one byte past the limit so a lying size header is still caught
+	data, err := io.ReadAll(io.LimitReader(f, room.MaxCoverImageSize+1))
+	if err != nil {
+		respondBadRequest(c, errors.New("failed to read image"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	updated, err := h.setUC.Execute(c.Request.Context(), userID, c.Param("id"), data)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewRoomResponse(updated))
+}
+
+// Remove handles DELETE /api/v1/rooms/:id/cover
+func (h *RoomCoverHandler) Remove(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	updated, err := h.removeUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewRoomResponse(updated))
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/gin-gonic/gin"
+)
+
+// RoomExportHandler serves exports of a room's prayer history
+type RoomExportHandler struct {
+	requestUC *prayer.RequestExportUseCase
+}
+
+func NewRoomExportHandler(requestUC *prayer.RequestExportUseCase) *RoomExportHandler {
+	return &RoomExportHandler{
+		requestUC: requestUC,
+	}
+}
+
+// Create handles POST /api/v1/rooms/:id/export
+// The file is generated in the background, so this answers 202 with the queued export
+func (h *RoomExportHandler) Create(c *gin.Context) {
+	var req dto.CreateExportRequest
+	// Body is optional; without one the export is a CSV
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			respondBadRequest(c, err)
+			return
+		}
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	export, err := h.requestUC.Execute(c.Request.Context(), userID, c.Param("id"), entity.ExportFormat(req.Format))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.NewRoomExportResponse(export))
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/gin-gonic/gin"
+)
+
+// InvitationHandler serves invitations addressed to users and their invitation inbox
+type InvitationHandler struct {
+	inviteUC  *room.InviteUserUseCase
+	listUC    *room.ListInvitationsUseCase
+	countUC   *room.CountInvitationsUseCase
+	respondUC *room.RespondInvitationUseCase
+}
+
+func NewInvitationHandler(
+	inviteUC *room.InviteUserUseCase,
+	listUC *room.ListInvitationsUseCase,
+	countUC *room.CountInvitationsUseCase,
+	respondUC *room.RespondInvitationUseCase,
+) *InvitationHandler {
+	return &InvitationHandler{
+		inviteUC:  inviteUC,
+		listUC:    listUC,
+		countUC:   countUC,
+		respondUC: respondUC,
+	}
+}
+
+// Create handles POST /api/v1/rooms/:id/invitations
+func (h *InvitationHandler) Create(c *gin.Context) {
+	var req dto.CreateInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	invitation, err := h.inviteUC.Execute(c.Request.Context(), userID, c.Param("id"), string(req.UserID))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewInvitationResponse(invitation, time.Now()))
+}
+
+// List handles GET /api/v1/invitations
+func (h *InvitationHandler) List(c *gin.Context) {
+	var req dto.InvitationListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	page, err := h.listUC.Execute(c.Request.Context(), userID, req.Status, req.Cursor, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	now := time.Now()
+	resp := make([]dto.InvitationResponse, 0, len(page.Invitations))
+	for _, i := range page.Invitations {
+		resp = append(resp, dto.NewInvitationResponse(i, now))
+	}
+	c.JSON(http.StatusOK, dto.InvitationListResponse{Invitations: resp, Page: page.Page})
+}
+
+// Count handles GET /api/v1/invitations/count
+func (h *InvitationHandler) Count(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	count, err := h.countUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.InvitationCountResponse{Count: dto.Count(count)})
+}
+
+// Accept handles POST /api/v1/invitations/:id/accept
+func (h *InvitationHandler) Accept(c *gin.Context) {
+	h.respond(c, true)
+}
+
+// Reject handles POST /api/v1/invitations/:id/reject
+func (h *InvitationHandler) Reject(c *gin.Context) {
+	h.respond(c, false)
+}
+
+func (h *InvitationHandler) respond(c *gin.Context, accept bool) {
+	userID, _ := middleware.GetUserID(c)
+
+	invitation, err := h.respondUC.Execute(c.Request.Context(), userID, c.Param("id"), accept)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewInvitationResponse(invitation, time.Now()))
+}
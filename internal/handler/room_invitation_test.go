@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/gin-gonic/gin"
+)
+
+// invitationInbox serves one user's invitations and records the status they were listed by
+type invitationInbox struct {
+	repository.RoomInvitationRepository
+	pending int64
+	listed  []entity.InvitationStatus
+}
+
+func (f *invitationInbox) CountPending(_ context.Context, inviteeID string, _ time.Time) (int64, error) {
+	if inviteeID != "grace" {
+		return 0, nil
+	}
+	return f.pending, nil
+}
+
+func (f *invitationInbox) ListByInvitee(_ context.Context, _ string, status entity.InvitationStatus, _ time.Time, _ *pagination.TimeKey, _ int) ([]*entity.RoomInvitation, error) {
+	f.listed = append(f.listed, status)
+	return []*entity.RoomInvitation{{ID: "i1", RoomID: "r1", InviterID: "owner", Status: entity.InvitationPending}}, nil
+}
+
+func newInvitationRouter(inbox *invitationInbox) *gin.Engine {
+	h := NewInvitationHandler(nil, room.NewListInvitationsUseCase(inbox), room.NewCountInvitationsUseCase(inbox), nil)
+	router := gin.New()
+	router.Use(apierror.Middleware(middleware.GetRequestID))
+	router.Use(func(c *gin.Context) { c.Set(middleware.UserIDKey, "grace") })
+	router.GET("/invitations", h.List)
+	router.GET("/invitations/count", h.Count)
+	return router
+}
+
+func TestInvitationCount(t *testing.T) {
+	router := newInvitationRouter(&invitationInbox{pending: 3})
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/invitations/count", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if rec.Body.String() != `{"count":3}` {
+		t.Errorf("body = %s, want just the pending count", rec.Body.String())
+	}
+}
+
+func TestInvitationListStatusFilter(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantStatus int
+		want       entity.InvitationStatus
+	}{
+		{query: "", wantStatus: http.StatusOK, want: entity.InvitationPending},
+		{query: "?status=pending", wantStatus: http.StatusOK, want: entity.InvitationPending},
+		{query: "?status=accepted", wantStatus: http.StatusOK, want: entity.InvitationAccepted},
+		{query: "?status=rejected", wantStatus: http.StatusOK, want: entity.InvitationRejected},
+		{query: "?status=expired", wantStatus: http.StatusOK, want: entity.InvitationExpired},
+		{query: "?status=declined", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			inbox := &invitationInbox{}
+			rec := httptest.NewRecorder()
+			newInvitationRouter(inbox).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/invitations"+tt.query, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				var body apierror.Response
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body.Code != apierror.CodeValidationFailed {
+					t.Errorf("body = %s, want %s", rec.Body.String(), apierror.CodeValidationFailed)
+				}
+				if len(inbox.listed) != 0 {
+					t.Errorf("listed %v for an invalid status", inbox.listed)
+				}
+				return
+			}
+			if len(inbox.listed) != 1 || inbox.listed[0] != tt.want {
+				t.Errorf("listed by %v, want %s", inbox.listed, tt.want)
+			}
+			var body dto.InvitationListResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			if len(body.Invitations) != 1 || body.Invitations[0].ID != "i1" {
+				t.Errorf("invitations = %+v, want i1", body.Invitations)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/gin-gonic/gin"
+)
+
+// InviteHandler serves room invite codes and their deep links
+type InviteHandler struct {
+	createUC   *room.CreateInviteUseCase
+	getUC      *room.GetInviteUseCase
+	validateUC *room.ValidateInviteUseCase
+	acceptUC   *room.AcceptInviteUseCase
+}
+
+func NewInviteHandler(
+	createUC *room.CreateInviteUseCase,
+	getUC *room.GetInviteUseCase,
+	validateUC *room.ValidateInviteUseCase,
+	acceptUC *room.AcceptInviteUseCase,
+) *InviteHandler {
+	return &InviteHandler{
+		createUC:   createUC,
+		getUC:      getUC,
+		validateUC: validateUC,
+		acceptUC:   acceptUC,
+	}
+}
+
+// Create handles POST /api/v1/rooms/:id/invites
+func (h *InviteHandler) Create(c *gin.Context) {
+	var req dto.CreateInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	ttl := time.Duration(req.ExpiresInHours) * time.Hour
+
+	invite, link, err := h.createUC.Execute(c.Request.Context(), userID, c.Param("id"), req.MaxUses, ttl)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewInviteResponse(invite, link))
+}
+
+// Get handles GET /api/v1/invites/:code
+func (h *InviteHandler) Get(c *gin.Context) {
+	invite, found, err := h.getUC.Execute(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.InvitePreviewResponse{
+		Room:      dto.NewRoomResponse(found),
+		ExpiresAt: dto.NewTimestamp(invite.ExpiresAt),
+	})
+}
+
+// Validate handles GET /api/v1/invite-codes/:code/validate
+// Unusable codes are answered with 200 and the reason, so the app can explain it before a join screen
+func (h *InviteHandler) Validate(c *gin.Context) {
+	check, err := h.validateUC.Execute(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewInviteValidationResponse(check.Invite, check.Room, check.Rejection))
+}
+
+// Accept handles POST /api/v1/invites/:code/accept
+func (h *InviteHandler) Accept(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	joined, err := h.acceptUC.Execute(c.Request.Context(), userID, c.Param("code"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewRoomResponse(joined))
+}
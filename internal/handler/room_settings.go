@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/gin-gonic/gin"
+)
+
+// RoomSettingsHandler serves the per-room settings resource
+type RoomSettingsHandler struct {
+	getUC    *room.GetRoomSettingsUseCase
+	updateUC *room.UpdateRoomSettingsUseCase
+}
+
+func NewRoomSettingsHandler(getUC *room.GetRoomSettingsUseCase, updateUC *room.UpdateRoomSettingsUseCase) *RoomSettingsHandler {
+	return &RoomSettingsHandler{
+		getUC:    getUC,
+		updateUC: updateUC,
+	}
+}
+
+// Get handles GET /api/v1/rooms/:id/settings
+func (h *RoomSettingsHandler) Get(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	found, err := h.getUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewRoomSettingsResponse(found))
+}
+
+// Update handles PATCH /api/v1/rooms/:id/settings
+func (h *RoomSettingsHandler) Update(c *gin.Context) {
+	var req dto.UpdateRoomSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	updated, err := h.updateUC.Execute(c.Request.Context(), userID, c.Param("id"), req.ToRoomSettingsUpdate())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewRoomSettingsResponse(updated))
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/gin-gonic/gin"
+)
+
+// RoomSubscriptionHandler serves the push topic subscriptions of the caller's devices
+type RoomSubscriptionHandler struct {
+	subscribeUC   *room.SubscribeRoomUseCase
+	unsubscribeUC *room.UnsubscribeRoomUseCase
+}
+
+func NewRoomSubscriptionHandler(subscribeUC *room.SubscribeRoomUseCase, unsubscribeUC *room.UnsubscribeRoomUseCase) *RoomSubscriptionHandler {
+	return &RoomSubscriptionHandler{
+		subscribeUC:   subscribeUC,
+		unsubscribeUC: unsubscribeUC,
+	}
+}
+
+// Subscribe handles POST /api/v1/rooms/:id/subscribe
+func (h *RoomSubscriptionHandler) Subscribe(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.subscribeUC.Execute(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Unsubscribe handles POST /api/v1/rooms/:id/unsubscribe
+func (h *RoomSubscriptionHandler) Unsubscribe(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.unsubscribeUC.Execute(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/seed"
+	"github.com/gin-gonic/gin"
+)
+
+// SeedHandler fills a development database with demo data; it is only routed outside production
+type SeedHandler struct {
+	seedUC *seed.SeedUseCase
+}
+
+func NewSeedHandler(seedUC *seed.SeedUseCase) *SeedHandler {
+	return &SeedHandler{
+		seedUC: seedUC,
+	}
+}
+
+// Seed handles POST /dev/seed with the fixtures shipped with the server
+func (h *SeedHandler) Seed(c *gin.Context) {
+	fixtures, err := seed.DefaultFixtures()
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	summary, err := h.seedUC.Execute(c.Request.Context(), fixtures)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewSeedResponse(summary))
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/gin-gonic/gin"
+)
+
+// StatsHandler serves prayer activity statistics of rooms and users
+type StatsHandler struct {
+	roomStatsUC *prayer.RoomStatsUseCase
+	userStatsUC *prayer.UserStatsUseCase
+}
+
+func NewStatsHandler(roomStatsUC *prayer.RoomStatsUseCase, userStatsUC *prayer.UserStatsUseCase) *StatsHandler {
+	return &StatsHandler{
+		roomStatsUC: roomStatsUC,
+		userStatsUC: userStatsUC,
+	}
+}
+
+// Room handles GET /api/v1/rooms/:id/stats
+func (h *StatsHandler) Room(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	stats, err := h.roomStatsUC.Execute(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewRoomStatsResponse(stats))
+}
+
+// Me handles GET /api/v1/users/me/stats
+func (h *StatsHandler) Me(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	stats, err := h.userStatsUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewUserStatsResponse(stats))
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/auth"
+	"github.com/gin-gonic/gin"
+)
+
+type TwoFactorHandler struct {
+	enrollUC  *auth.EnrollTwoFactorUseCase
+	enableUC  *auth.EnableTwoFactorUseCase
+	disableUC *auth.DisableTwoFactorUseCase
+	verifyUC  *auth.VerifyTwoFactorLoginUseCase
+	issueUC   *auth.IssueTokensUseCase
+}
+
+func NewTwoFactorHandler(
+	enrollUC *auth.EnrollTwoFactorUseCase,
+	enableUC *auth.EnableTwoFactorUseCase,
+	disableUC *auth.DisableTwoFactorUseCase,
+	verifyUC *auth.VerifyTwoFactorLoginUseCase,
+	issueUC *auth.IssueTokensUseCase,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		enrollUC:  enrollUC,
+		enableUC:  enableUC,
+		disableUC: disableUC,
+		verifyUC:  verifyUC,
+		issueUC:   issueUC,
+	}
+}
+
+// Enroll handles POST /api/v1/auth/2fa/enroll (authenticated)
+func (h *TwoFactorHandler) Enroll(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	enrollment, err := h.enrollUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TwoFactorEnrollmentResponse{
+		Secret:     enrollment.Secret,
+		OTPAuthURI: enrollment.URI,
+	})
+}
+
+// Enable handles POST /api/v1/auth/2fa/enable (authenticated)
+func (h *TwoFactorHandler) Enable(c *gin.Context) {
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	codes, err := h.enableUC.Execute(c.Request.Context(), userID, req.Code)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.BackupCodesResponse{BackupCodes: codes})
+}
+
+// Disable handles POST /api/v1/auth/2fa/disable (authenticated)
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.disableUC.Execute(c.Request.Context(), userID, req.Code); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Verify handles POST /api/v1/auth/2fa/verify, the second step of a login
+func (h *TwoFactorHandler) Verify(c *gin.Context) {
+	var req dto.VerifyTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user, deviceID, err := h.verifyUC.Execute(c.Request.Context(), req.MFAToken, req.Code)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondWithTokens(c, h.issueUC, http.StatusOK, user, deviceID)
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+	"github.com/gin-gonic/gin"
+)
+
+type UserHandler struct {
+	getUC    *account.GetProfileUseCase
+	updateUC *account.UpdateProfileUseCase
+	publicUC *account.GetPublicProfileUseCase
+	checkUC  *account.CheckNicknameUseCase
+	searchUC *account.SearchUsersUseCase
+	deleteUC *account.DeleteAccountUseCase
+	cancelUC *account.CancelAccountDeletionUseCase
+	listUC   *account.ListSessionsUseCase
+	revokeUC *account.RevokeSessionUseCase
+}
+
+func NewUserHandler(
+	getUC *account.GetProfileUseCase,
+	updateUC *account.UpdateProfileUseCase,
+	publicUC *account.GetPublicProfileUseCase,
+	checkUC *account.CheckNicknameUseCase,
+	searchUC *account.SearchUsersUseCase,
+	deleteUC *account.DeleteAccountUseCase,
+	cancelUC *account.CancelAccountDeletionUseCase,
+	listUC *account.ListSessionsUseCase,
+	revokeUC *account.RevokeSessionUseCase,
+) *UserHandler {
+	return &UserHandler{
+		getUC:    getUC,
+		updateUC: updateUC,
+		publicUC: publicUC,
+		checkUC:  checkUC,
+		searchUC: searchUC,
+		deleteUC: deleteUC,
+		cancelUC: cancelUC,
+		listUC:   listUC,
+		revokeUC: revokeUC,
+	}
+}
+
+// GetMe handles GET /api/v1/users/me
+func (h *UserHandler) GetMe(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	user, err := h.getUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewUserResponse(user))
+}
+
+// UpdateMe handles PATCH /api/v1/users/me
+func (h *UserHandler) UpdateMe(c *gin.Context) {
+	var req dto.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	user, err := h.updateUC.Execute(c.Request.Context(), userID, req.ToProfileUpdate())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewUserResponse(user))
+}
+
+// GetUser handles GET /api/v1/users/:id
+func (h *UserHandler) GetUser(c *gin.Context) {
+	user, err := h.publicUC.Execute(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewPublicUserResponse(user))
+}
+
+// CheckNickname handles GET /api/v1/users/nickname-check?name=
+func (h *UserHandler) CheckNickname(c *gin.Context) {
+	name := c.Query("name")
+
+	available, err := h.checkUC.Execute(c.Request.Context(), name)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NicknameCheckResponse{
+		Nickname:  name,
+		Available: available,
+	})
+}
+
+// SearchUsers handles GET /api/v1/users/search?q=&offset=&limit=
+func (h *UserHandler) SearchUsers(c *gin.Context) {
+	var req dto.UserSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	users, hasMore, err := h.searchUC.Execute(c.Request.Context(), userID, req.Query, req.Offset, req.Limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := dto.UserSearchResponse{
+		Users: make([]dto.PublicUserResponse, 0, len(users)),
+	}
+	for _, u := range users {
+		resp.Users = append(resp.Users, dto.NewPublicUserResponse(u))
+	}
+	if hasMore {
+		next := req.Offset + len(users)
+		resp.NextOffset = &next
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteMe handles DELETE /api/v1/users/me
+// The account is purged after the grace period unless the deletion is cancelled
+func (h *UserHandler) DeleteMe(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	purgeAt, err := h.deleteUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.AccountDeletionResponse{
+		PurgeAt: dto.NewTimestamp(purgeAt),
+	})
+}
+
+// CancelDeletion handles DELETE /api/v1/users/me/deletion
+func (h *UserHandler) CancelDeletion(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.cancelUC.Execute(c.Request.Context(), userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListSessions handles GET /api/v1/users/me/sessions
+func (h *UserHandler) ListSessions(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	sessions, err := h.listUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var currentSessionID string
+	if claims, ok := middleware.GetClaims(c); ok {
+		currentSessionID = claims.SessionID
+	}
+
+	resp := make([]dto.SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		resp = append(resp, dto.NewSessionResponse(s, currentSessionID))
+	}
+	c.JSON(http.StatusOK, dto.SessionListResponse{Sessions: resp})
+}
+
+// RevokeSession handles DELETE /api/v1/users/me/sessions/:id
+func (h *UserHandler) RevokeSession(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.revokeUC.Execute(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
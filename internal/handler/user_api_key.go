@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/dto"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// UserAPIKeyHandler manages the current user's personal keys
+type UserAPIKeyHandler struct {
+	createUC *auth.CreateUserAPIKeyUseCase
+	listUC   *auth.ListUserAPIKeysUseCase
+	revokeUC *auth.RevokeUserAPIKeyUseCase
+}
+
+func NewUserAPIKeyHandler(
+	createUC *auth.CreateUserAPIKeyUseCase,
+	listUC *auth.ListUserAPIKeysUseCase,
+	revokeUC *auth.RevokeUserAPIKeyUseCase,
+) *UserAPIKeyHandler {
+	return &UserAPIKeyHandler{
+		createUC: createUC,
+		listUC:   listUC,
+		revokeUC: revokeUC,
+	}
+}
+
+// Create handles POST /api/v1/users/me/api-keys
+// The plaintext key is in this response only
+func (h *UserAPIKeyHandler) Create(c *gin.Context) {
+	var req dto.CreateUserAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+
+	scopes := make([]entity.APIKeyScope, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, entity.APIKeyScope(scope))
+	}
+
+	key, raw, err := h.createUC.Execute(c.Request.Context(), userID, req.Name, scopes)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.APIKeySecretResponse{
+		APIKeyResponse: dto.NewAPIKeyResponse(key),
+		Key:            raw,
+	})
+}
+
+// List handles GET /api/v1/users/me/api-keys
+func (h *UserAPIKeyHandler) List(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	keys, err := h.listUC.Execute(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	resp := make([]dto.APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		resp = append(resp, dto.NewAPIKeyResponse(k))
+	}
+	c.JSON(http.StatusOK, dto.APIKeyListResponse{APIKeys: resp})
+}
+
+// Revoke handles DELETE /api/v1/users/me/api-keys/:id
+func (h *UserAPIKeyHandler) Revoke(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.revokeUC.Execute(c.Request.Context(), userID, c.Param("id")); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/i18n"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/profanity"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// RegisterValidators adds the custom binding rules and reports fields by their JSON or query names
+// It must run before the first request is bound
+//
+//	enum         the value's IsValid method accepts it, for entity enum types; empty values pass,
+//	             so pointers can clear a field and required still decides whether it must be set
+//	noprofanity  the text contains no swear words
+func RegisterValidators() error {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return errors.New("gin binding does not use go-playground/validator")
+	}
+
+	v.RegisterTagNameFunc(fieldName)
+	if err := v.RegisterValidation("enum", validateEnum); err != nil {
+		return err
+	}
+	return v.RegisterValidation("noprofanity", func(fl validator.FieldLevel) bool {
+		return !profanity.Contains(fl.Field().String())
+	})
+}
+
+// fieldName names a struct field as the client sent it
+func fieldName(f reflect.StructField) string {
+	for _, tag := range []string{"json", "form"} {
+		name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return f.Name
+}
+
+func validateEnum(fl validator.FieldLevel) bool {
+	if fl.Field().IsZero() {
+		return true
+	}
+	enum, ok := fl.Field().Interface().(interface{ IsValid() bool })
+	return ok && enum.IsValid()
+}
+
+// FieldError describes why one request field was rejected
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// fieldErrors turns a binding error into per-field errors explained in lang;
+// ok is false for errors that are not about a field
+func fieldErrors(err error, lang string) ([]FieldError, bool) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		fields := make([]FieldError, 0, len(validationErrs))
+		for _, fe := range validationErrs {
+			fields = append(fields, FieldError{
+				Field:   fieldPath(fe),
+				Rule:    fe.Tag(),
+				Message: fieldMessage(fe, lang),
+			})
+		}
+		return fields, true
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return []FieldError{{
+			Field: typeErr.Field,
+			Rule:  "type",
+			Message: apierror.Message(lang, "field.type", map[string]string{
+				"type": apierror.Message(lang, "type."+jsonType(typeErr.Type), nil),
+			}),
+		}}, true
+	}
+	return nil, false
+}
+
+// fieldPath is the field's dotted path below the request struct, e.g. "settings.memberCap"
+func fieldPath(fe validator.FieldError) string {
+	if _, path, ok := strings.Cut(fe.Namespace(), "."); ok {
+		return path
+	}
+	return fe.Field()
+}
+
+// fieldMessage explains a failed rule in lang, in words a client developer can act on
+func fieldMessage(fe validator.FieldError, lang string) string {
+	// Lengths of strings and slices, values of numbers
+	unit := ""
+	switch fe.Kind() {
+	case reflect.String:
+		unit = apierror.Message(lang, "unit.characters", nil)
+	case reflect.Slice, reflect.Array, reflect.Map:
+		unit = apierror.Message(lang, "unit.items", nil)
+	}
+
+	params := map[string]string{"limit": fe.Param(), "unit": unit}
+
+	key := "field.invalid"
+	switch fe.Tag() {
+	case "required", "len", "enum", "noprofanity":
+		key = "field." + fe.Tag()
+	case "min", "gte":
+		key = "field.min"
+	case "max", "lte":
+		key = "field.max"
+	case "oneof":
+		key = "field.oneof"
+		params = map[string]string{"values": strings.ReplaceAll(fe.Param(), " ", ", ")}
+	}
+	return apierror.Message(lang, key, params)
+}
+
+// jsonType names a Go type the way a JSON client thinks of it
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// respondBadRequest aborts the request with a 400 for malformed request input
+// Bodies over the size limit get a 413 instead
+// Binding failures list every rejected field in the details; malformed bodies get a fixed message
+// instead of the decoder's internals
+func respondBadRequest(c *gin.Context, err error) {
+	var syntaxErr *json.SyntaxError
+	var tooLarge *http.MaxBytesError
+	switch fields, ok := fieldErrors(err, i18n.RequestLanguage(c)); {
+	case errors.As(err, &tooLarge):
+		apierror.Abort(c, middleware.PayloadTooLarge(tooLarge.Limit))
+	case ok:
+		apierror.Abort(c, &apierror.Error{
+			Status:  http.StatusBadRequest,
+			Code:    apierror.CodeValidationFailed,
+			Message: "Invalid request",
+			Details: fields,
+			Err:     err,
+		})
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		apierror.Abort(c, &apierror.Error{Status: http.StatusBadRequest, Code: apierror.CodeMalformedBody, Message: "Malformed JSON body", Err: err})
+	case errors.Is(err, io.EOF):
+		apierror.Abort(c, &apierror.Error{Status: http.StatusBadRequest, Code: apierror.CodeMalformedBody, Message: "Request body is required", Key: "MALFORMED_BODY.missing", Err: err})
+	default:
+		apierror.Abort(c, apierror.Wrap(err, http.StatusBadRequest, apierror.CodeBadRequest))
+	}
+}
@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/requestinfo"
+	"github.com/google/uuid"
+)
+
+// New returns an auditor that appends entries to the audit log table
+// Entries that cannot be stored are logged in full, so the application log still has them
+func New(repo repository.AuditLogRepository) service.Auditor {
+	return &tableAuditor{repo: repo}
+}
+
+type tableAuditor struct {
+	repo repository.AuditLogRepository
+}
+
+func (a *tableAuditor) Record(ctx context.Context, entry entity.AuditEntry) {
+	info := requestinfo.FromContext(ctx)
+	entry.ID = uuid.New().String()
+	entry.RequestID = info.ID
+	entry.IP = info.IP
+	entry.CreatedAt = time.Now()
+
+	// The operation is done, so record it even if the request was cancelled meanwhile
+	if err := a.repo.Append(context.WithoutCancel(ctx), &entry); err != nil {
+		slog.ErrorContext(ctx, "Failed to write audit log",
+			"action", entry.Action,
+			"actor_id", entry.ActorID,
+			"target_type", entry.TargetType,
+			"target_id", entry.TargetID,
+			"details", entry.Details,
+			"request_id", entry.RequestID,
+			"ip", entry.IP,
+			"error", err,
+		)
+	}
+}
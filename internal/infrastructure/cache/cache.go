@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+// New returns a Redis cache shared by all instances, or a per-instance memory cache when no Redis URL is configured
+func New(cfg *config.Config) service.Cache {
+	if cfg.Cache.RedisURL == "" {
+		slog.Warn("Redis not configured, caching in memory per instance")
+		return newMemoryCache(cfg.Cache.MaxEntries)
+	}
+	c, err := newRedisCache(cfg.Cache.RedisURL)
+	if err != nil {
+		slog.Error("Invalid Redis URL, caching in memory per instance", "error", err)
+		return newMemoryCache(cfg.Cache.MaxEntries)
+	}
+	return c
+}
+
+// memoryCache keeps entries in this process only, so writes through other instances
+// are seen once the entries expire
+type memoryCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+}
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func newMemoryCache(maxEntries int) *memoryCache {
+	return &memoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]memoryEntry),
+	}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !time.Now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 || c.maxEntries <= 0 {
+		return nil
+	}
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	// Copied so the caller may reuse its buffer
+	c.entries[key] = memoryEntry{value: append([]byte(nil), value...), expiresAt: now.Add(ttl)}
+	return nil
+}
+
+func (c *memoryCache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+	return nil
+}
+
+// evict makes room for one entry: expired entries go first, otherwise an arbitrary one
+// The cache only holds short-lived copies, so which live entry goes matters little
+func (c *memoryCache) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) < c.maxEntries {
+		return
+	}
+	for key := range c.entries {
+		delete(c.entries, key)
+		return
+	}
+}
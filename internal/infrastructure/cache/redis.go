@@ -0,0 +1,146 @@
+package cache
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// redisTimeout bounds dialing and each command when the context allows longer;
+	// a slow cache must not be slower than the database it shields
+	redisTimeout = 500 * time.Millisecond
+	// redisPoolSize is the number of connections kept for reuse
+	redisPoolSize = 16
+	// redisRetryDelay is how long the cache is bypassed after Redis could not be reached
+	redisRetryDelay = 5 * time.Second
+)
+
+// errRedisUnavailable is returned while Redis is being bypassed after a failure
+var errRedisUnavailable = errors.New("redis unavailable")
+
+// redisCache stores entries in Redis through go-redis
+// While Redis is unreachable every call fails fast, so callers fall back to the database
+type redisCache struct {
+	client *redis.Client
+
+	mu        sync.Mutex
+	downUntil time.Time
+}
+
+// newRedisCache parses a redis:// or rediss:// URL: redis://[[user]:password@]host[:port][/db]
+func newRedisCache(rawURL string) (*redisCache, error) {
+	opts, err := redis.ParseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	opts.DialTimeout = redisTimeout
+	opts.ReadTimeout = redisTimeout
+	opts.WriteTimeout = redisTimeout
+	opts.ContextTimeoutEnabled = true
+	opts.PoolSize = redisPoolSize
+	// go-redis would retry timeouts too; run retries only what a stale connection explains
+	opts.MaxRetries = -1
+	opts.Protocol = 2
+	opts.DisableIdentity = true
+	if opts.TLSConfig != nil {
+		opts.TLSConfig.MinVersion = tls.VersionTLS12
+	}
+	return &redisCache{client: redis.NewClient(opts)}, nil
+}
+
+func (c *redisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	var value []byte
+	err := c.run(ctx, func(ctx context.Context) error {
+		var err error
+		value, err = c.client.Get(ctx, key).Bytes()
+		return err
+	})
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (c *redisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl < time.Millisecond {
+		return nil
+	}
+	return c.run(ctx, func(ctx context.Context) error {
+		return c.client.Set(ctx, key, value, ttl).Err()
+	})
+}
+
+func (c *redisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.run(ctx, func(ctx context.Context) error {
+		return c.client.Del(ctx, keys...).Err()
+	})
+}
+
+// HealthCheck pings Redis; while the cache is being bypassed after a failure it reports that failure
+func (c *redisCache) HealthCheck(ctx context.Context) error {
+	return c.run(ctx, func(ctx context.Context) error {
+		return c.client.Ping(ctx).Err()
+	})
+}
+
+// run runs one command, retrying it once when its pooled connection had been closed by Redis
+// Any other failure to reach Redis, a timeout included, bypasses the cache right away, so a
+// hung server costs one timeout rather than one per pooled connection
+func (c *redisCache) run(ctx context.Context, cmd func(ctx context.Context) error) error {
+	if c.unavailable() {
+		return errRedisUnavailable
+	}
+
+	err := cmd(ctx)
+	if isClosedConn(err) && ctx.Err() == nil {
+		err = cmd(ctx)
+	}
+	if err == nil || errors.Is(err, redis.Nil) || isReply(err) || ctx.Err() != nil {
+		return err
+	}
+	c.markDown(err)
+	return err
+}
+
+// isClosedConn reports whether err comes from a connection the server closed while it sat in the pool
+func isClosedConn(err error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE)
+}
+
+// isReply reports whether err is an error reply, which Redis sends when it is up
+func isReply(err error) bool {
+	var reply redis.Error
+	return errors.As(err, &reply)
+}
+
+func (c *redisCache) unavailable() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return time.Now().Before(c.downUntil)
+}
+
+// markDown bypasses Redis for a while so an outage costs one timeout, not one per request
+func (c *redisCache) markDown(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Now().Before(c.downUntil) {
+		return
+	}
+	c.downUntil = time.Now().Add(redisRetryDelay)
+	slog.Warn("Redis unreachable, bypassing cache", "retry_in", redisRetryDelay, "error", err)
+}
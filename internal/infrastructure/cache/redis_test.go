@@ -0,0 +1,221 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRedis answers RESP commands over TCP through reply, which returns the raw reply
+// and whether to keep the connection open; a nil reply never answers
+type fakeRedis struct {
+	addr  string
+	reply func(args []string) (raw []byte, keepOpen bool)
+
+	mu    sync.Mutex
+	conns int
+}
+
+func newFakeRedis(t *testing.T, reply func(args []string) ([]byte, bool)) *fakeRedis {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	f := &fakeRedis{addr: ln.Addr().String(), reply: reply}
+	var wg sync.WaitGroup
+	t.Cleanup(func() {
+		ln.Close()
+		wg.Wait()
+	})
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			f.mu.Lock()
+			f.conns++
+			f.mu.Unlock()
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				f.serve(conn)
+			}()
+		}
+	}()
+	return f
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		raw, keepOpen := f.reply(args)
+		if raw == nil {
+			// Hung: hold the connection without answering until the client gives up
+			_, _ = r.ReadByte()
+			return
+		}
+		if _, err := conn.Write(raw); err != nil || !keepOpen {
+			return
+		}
+	}
+}
+
+func (f *fakeRedis) connections() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.conns
+}
+
+// readCommand reads one RESP array of bulk strings
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err := r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(arg, "\r\n")
+	}
+	return args, nil
+}
+
+func bulk(s string) []byte {
+	return []byte(fmt.Sprintf("$%d\r\n%s\r\n", len(s), s))
+}
+
+func newTestRedisCache(t *testing.T, addr string) *redisCache {
+	t.Helper()
+	c, err := newRedisCache("redis://" + addr)
+	if err != nil {
+		t.Fatalf("newRedisCache: %v", err)
+	}
+	t.Cleanup(func() { _ = c.client.Close() })
+	return c
+}
+
+func TestRedisCacheRoundTrip(t *testing.T) {
+	var mu sync.Mutex
+	values := map[string]string{}
+	server := newFakeRedis(t, func(args []string) ([]byte, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch strings.ToUpper(args[0]) {
+		case "SET":
+			values[args[1]] = args[2]
+			return []byte("+OK\r\n"), true
+		case "GET":
+			if v, ok := values[args[1]]; ok {
+				return bulk(v), true
+			}
+			return []byte("$-1\r\n"), true
+		case "DEL":
+			delete(values, args[1])
+			return []byte(":1\r\n"), true
+		default:
+			return []byte("-ERR unknown command\r\n"), true
+		}
+	})
+	c := newTestRedisCache(t, server.addr)
+	ctx := context.Background()
+
+	if err := c.Set(ctx, "room:r1", []byte("새벽기도"), time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	value, ok, err := c.Get(ctx, "room:r1")
+	if err != nil || !ok || string(value) != "새벽기도" {
+		t.Fatalf("Get = %q, %v, %v, want the stored value", value, ok, err)
+	}
+	if err := c.Delete(ctx, "room:r1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, ok, err := c.Get(ctx, "room:r1"); ok || err != nil {
+		t.Errorf("Get after Delete = %v, %v, want a miss", ok, err)
+	}
+}
+
+func TestRedisCacheBypassesHungServer(t *testing.T) {
+	server := newFakeRedis(t, func([]string) ([]byte, bool) { return nil, false })
+	c := newTestRedisCache(t, server.addr)
+	ctx := context.Background()
+
+	start := time.Now()
+	if _, _, err := c.Get(ctx, "room:r1"); err == nil {
+		t.Fatal("Get from a hung server succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 2*redisTimeout {
+		t.Errorf("Get took %v, want one timeout of %v", elapsed, redisTimeout)
+	}
+
+	// The timeout marked Redis down: later calls fail without waiting
+	start = time.Now()
+	if _, _, err := c.Get(ctx, "room:r1"); !errors.Is(err, errRedisUnavailable) {
+		t.Errorf("err = %v, want errRedisUnavailable", err)
+	}
+	if elapsed := time.Since(start); elapsed > redisTimeout/10 {
+		t.Errorf("bypassed Get took %v", elapsed)
+	}
+	if n := server.connections(); n != 1 {
+		t.Errorf("opened %d connections, want 1", n)
+	}
+}
+
+func TestRedisCacheRetriesClosedConnectionOnce(t *testing.T) {
+	// Every connection answers once and is then closed by the server, as after an idle timeout
+	server := newFakeRedis(t, func(args []string) ([]byte, bool) {
+		if strings.EqualFold(args[0], "HELLO") {
+			return []byte("-ERR unknown command\r\n"), true
+		}
+		return bulk("v"), false
+	})
+	c := newTestRedisCache(t, server.addr)
+	ctx := context.Background()
+
+	for i := range 3 {
+		if _, ok, err := c.Get(ctx, "k"); err != nil || !ok {
+			t.Fatalf("Get %d = %v, %v, want a hit on a fresh connection", i+1, ok, err)
+		}
+	}
+	if c.unavailable() {
+		t.Error("closed idle connections marked Redis down")
+	}
+}
+
+func TestRedisCacheErrorReplyIsNotAnOutage(t *testing.T) {
+	server := newFakeRedis(t, func([]string) ([]byte, bool) {
+		return []byte("-WRONGTYPE Operation against a key holding the wrong kind of value\r\n"), true
+	})
+	c := newTestRedisCache(t, server.addr)
+
+	if _, _, err := c.Get(context.Background(), "k"); err == nil || !strings.Contains(err.Error(), "WRONGTYPE") {
+		t.Fatalf("err = %v, want the error reply", err)
+	}
+	if c.unavailable() {
+		t.Error("an error reply marked Redis down")
+	}
+}
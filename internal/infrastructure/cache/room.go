@@ -0,0 +1,166 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+// Keys carry a version so entries written by an older release are not decoded into a changed entity
+const (
+	roomKeyPrefix     = "room:v1:"
+	memberKeyPrefix   = "room_member:v1:"
+	trendingKeyPrefix = "rooms_trending:v1:"
+)
+
+// stats counts cache lookups since startup, published as "cache" on the expvar endpoint
+// Errors are lookups that fell back to the database because the cache failed
+var stats = expvar.NewMap("cache")
+
+// NewRoomRepository caches room lookups by ID, which back every room-scoped request
+// Writes through the returned repository invalidate the room; other changes are seen within ttl
+func NewRoomRepository(rooms repository.RoomRepository, cache service.Cache, ttl time.Duration) repository.RoomRepository {
+	return &roomRepository{RoomRepository: rooms, cache: cache, ttl: ttl}
+}
+
+type roomRepository struct {
+	repository.RoomRepository
+	cache service.Cache
+	ttl   time.Duration
+}
+
+func (r *roomRepository) GetByID(ctx context.Context, id string) (*entity.Room, error) {
+	return load(ctx, r.cache, roomKeyPrefix+id, r.ttl, func() (*entity.Room, error) {
+		return r.RoomRepository.GetByID(ctx, id)
+	})
+}
+
+// ListTrending shares the ranking across requests for ttl, as it aggregates every room's recent activity
+func (r *roomRepository) ListTrending(ctx context.Context, window time.Duration, limit int) ([]repository.TrendingRoom, error) {
+	key := fmt.Sprintf("%s%s:%d", trendingKeyPrefix, window, limit)
+	rooms, err := load(ctx, r.cache, key, r.ttl, func() (*[]repository.TrendingRoom, error) {
+		rooms, err := r.RoomRepository.ListTrending(ctx, window, limit)
+		return &rooms, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return *rooms, nil
+}
+
+func (r *roomRepository) Update(ctx context.Context, room *entity.Room) error {
+	defer invalidate(ctx, r.cache, roomKeyPrefix+room.ID)
+	return r.RoomRepository.Update(ctx, room)
+}
+
+func (r *roomRepository) UpdateSettings(ctx context.Context, room *entity.Room) error {
+	defer invalidate(ctx, r.cache, roomKeyPrefix+room.ID)
+	return r.RoomRepository.UpdateSettings(ctx, room)
+}
+
+func (r *roomRepository) SetCoverImage(ctx context.Context, id, key, url string, at time.Time) error {
+	defer invalidate(ctx, r.cache, roomKeyPrefix+id)
+	return r.RoomRepository.SetCoverImage(ctx, id, key, url, at)
+}
+
+func (r *roomRepository) Archive(ctx context.Context, id string, at time.Time) error {
+	defer invalidate(ctx, r.cache, roomKeyPrefix+id)
+	return r.RoomRepository.Archive(ctx, id, at)
+}
+
+func (r *roomRepository) Unarchive(ctx context.Context, id string, at time.Time) error {
+	defer invalidate(ctx, r.cache, roomKeyPrefix+id)
+	return r.RoomRepository.Unarchive(ctx, id, at)
+}
+
+// Delete invalidates only the room: cached memberships of a deleted room are never reached,
+// since every membership check looks the room up first
+func (r *roomRepository) Delete(ctx context.Context, id string) error {
+	defer invalidate(ctx, r.cache, roomKeyPrefix+id)
+	return r.RoomRepository.Delete(ctx, id)
+}
+
+// NewRoomMemberRepository caches membership lookups, the permission check of every room-scoped request
+// Only memberships found are cached, so a user who just joined is let in right away;
+// writes through the returned repository invalidate the membership, other changes are seen within ttl
+func NewRoomMemberRepository(members repository.RoomMemberRepository, cache service.Cache, ttl time.Duration) repository.RoomMemberRepository {
+	return &roomMemberRepository{RoomMemberRepository: members, cache: cache, ttl: ttl}
+}
+
+type roomMemberRepository struct {
+	repository.RoomMemberRepository
+	cache service.Cache
+	ttl   time.Duration
+}
+
+func (r *roomMemberRepository) Get(ctx context.Context, roomID, userID string) (*entity.RoomMember, error) {
+	return load(ctx, r.cache, memberKey(roomID, userID), r.ttl, func() (*entity.RoomMember, error) {
+		return r.RoomMemberRepository.Get(ctx, roomID, userID)
+	})
+}
+
+func (r *roomMemberRepository) UpdateRole(ctx context.Context, roomID, userID string, role entity.RoomRole) error {
+	defer invalidate(ctx, r.cache, memberKey(roomID, userID))
+	return r.RoomMemberRepository.UpdateRole(ctx, roomID, userID, role)
+}
+
+func (r *roomMemberRepository) SetMuted(ctx context.Context, roomID, userID string, muted bool) error {
+	defer invalidate(ctx, r.cache, memberKey(roomID, userID))
+	return r.RoomMemberRepository.SetMuted(ctx, roomID, userID, muted)
+}
+
+// TouchLastSeen invalidates the membership so the next lookup sees when the member was last seen,
+// which keeps the throttled presence updates from repeating while a stale copy is cached
+func (r *roomMemberRepository) TouchLastSeen(ctx context.Context, roomID, userID string, at time.Time) error {
+	defer invalidate(ctx, r.cache, memberKey(roomID, userID))
+	return r.RoomMemberRepository.TouchLastSeen(ctx, roomID, userID, at)
+}
+
+func memberKey(roomID, userID string) string {
+	return memberKeyPrefix + roomID + ":" + userID
+}
+
+// load returns the cached value under key, or fetches it and caches it for ttl
+// A failing cache only costs the lookup: the value is then fetched as if it were missing
+func load[T any](ctx context.Context, cache service.Cache, key string, ttl time.Duration, fetch func() (*T, error)) (*T, error) {
+	data, ok, err := cache.Get(ctx, key)
+	switch {
+	case err != nil:
+		stats.Add("errors", 1)
+	case ok:
+		var value T
+		if err := json.Unmarshal(data, &value); err == nil {
+			stats.Add("hits", 1)
+			return &value, nil
+		}
+		stats.Add("errors", 1)
+	default:
+		stats.Add("misses", 1)
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(value); err == nil {
+		_ = cache.Set(ctx, key, data, ttl)
+	}
+	return value, nil
+}
+
+// invalidate drops the keys after a write, whether or not it succeeded
+// The delete runs even when the request was cancelled, so a completed write is not left shadowed
+func invalidate(ctx context.Context, cache service.Cache, keys ...string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), redisTimeout)
+	defer cancel()
+	if err := cache.Delete(ctx, keys...); err != nil {
+		slog.WarnContext(ctx, "Failed to invalidate cache, entries stay stale until they expire", "keys", keys, "error", err)
+	}
+}
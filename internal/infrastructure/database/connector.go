@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/secrets"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/stdlib"
+	go_ora "github.com/sijms/go-ora/v2"
+)
+
+// contextDriver is a database/sql driver that can open connectors from a DSN
+type contextDriver interface {
+	driver.Driver
+	driver.DriverContext
+}
+
+// rotatingConnector opens each connection with the password currently in the secret manager
+// Connections are recycled after ConnMaxLifetime, so a rotated password is picked up without
+// restart once the secrets cache has expired
+type rotatingConnector struct {
+	cfg    config.DatabaseConfig
+	store  *secrets.Cache
+	name   string
+	driver contextDriver
+}
+
+func newRotatingConnector(cfg *config.Config) (*rotatingConnector, error) {
+	var drv contextDriver
+	switch cfg.Database.Driver {
+	case DriverOracle:
+		drv = go_ora.NewDriver()
+	case DriverPostgres:
+		drv = stdlib.GetDefaultDriver().(contextDriver)
+	case DriverMySQL:
+		drv = &mysqldriver.MySQLDriver{}
+	default:
+		return nil, fmt.Errorf("database driver %s does not support secret manager passwords", cfg.Database.Driver)
+	}
+	return &rotatingConnector{
+		cfg:    cfg.Database,
+		store:  cfg.Secrets.Store,
+		name:   cfg.Secrets.DBPasswordName,
+		driver: drv,
+	}, nil
+}
+
+func (c *rotatingConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	password, err := c.store.Get(ctx, c.name, secrets.Current)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch database password: %w", err)
+	}
+	dbCfg := c.cfg
+	dbCfg.Password = password
+
+	connector, err := c.driver.OpenConnector(buildDSN(dbCfg))
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(ctx)
+}
+
+func (c *rotatingConnector) Driver() driver.Driver {
+	return c.driver
+}
@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+)
+
+// newSQLiteDB opens a sqlite database with one table of names
+func newSQLiteDB(t *testing.T) *DB {
+	t.Helper()
+	db, err := New(&config.Config{
+		App: config.AppConfig{Env: "test"},
+		Database: config.DatabaseConfig{
+			Driver:          DriverSQLite,
+			Path:            filepath.Join(t.TempDir(), "test.db"),
+			MaxIdleConns:    1,
+			MaxOpenConns:    1,
+			ConnMaxLifetime: time.Hour,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	if err := db.Exec("CREATE TABLE names (name TEXT PRIMARY KEY)").Error; err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	return db
+}
+
+func TestInTransaction(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	// insert adds a name through whatever transaction ctx carries
+	insert := func(db *DB, ctx context.Context, name string) error {
+		return db.WithContext(ctx).Exec("INSERT INTO names (name) VALUES (?)", name).Error
+	}
+
+	tests := []struct {
+		name    string
+		run     func(db *DB, ctx context.Context) error
+		wantErr error
+		want    []string
+	}{
+		{
+			name: "commits",
+			run: func(db *DB, ctx context.Context) error {
+				return db.InTransaction(ctx, func(ctx context.Context) error {
+					return insert(db, ctx, "a")
+				})
+			},
+			want: []string{"a"},
+		},
+		{
+			name: "rolls back every write on error",
+			run: func(db *DB, ctx context.Context) error {
+				return db.InTransaction(ctx, func(ctx context.Context) error {
+					if err := insert(db, ctx, "a"); err != nil {
+						return err
+					}
+					if err := insert(db, ctx, "b"); err != nil {
+						return err
+					}
+					return errFailed
+				})
+			},
+			wantErr: errFailed,
+		},
+		{
+			name: "failed savepoint leaves the outer transaction",
+			run: func(db *DB, ctx context.Context) error {
+				return db.InTransaction(ctx, func(ctx context.Context) error {
+					if err := insert(db, ctx, "outer"); err != nil {
+						return err
+					}
+					err := db.InTransaction(ctx, func(ctx context.Context) error {
+						if err := insert(db, ctx, "inner"); err != nil {
+							return err
+						}
+						return errFailed
+					})
+					if !errors.Is(err, errFailed) {
+						return err
+					}
+					return insert(db, ctx, "after")
+				})
+			},
+			want: []string{"after", "outer"},
+		},
+		{
+			name: "failed outer transaction discards the savepoint",
+			run: func(db *DB, ctx context.Context) error {
+				return db.InTransaction(ctx, func(ctx context.Context) error {
+					if err := db.InTransaction(ctx, func(ctx context.Context) error {
+						return insert(db, ctx, "inner")
+					}); err != nil {
+						return err
+					}
+					return errFailed
+				})
+			},
+			wantErr: errFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newSQLiteDB(t)
+			ctx := context.Background()
+
+			if err := tt.run(db, ctx); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			var names []string
+			if err := db.WithContext(ctx).Raw("SELECT name FROM names ORDER BY name").Scan(&names).Error; err != nil {
+				t.Fatalf("failed to list names: %v", err)
+			}
+			if !slices.Equal(names, tt.want) {
+				t.Errorf("names = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
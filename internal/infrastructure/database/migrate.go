@@ -0,0 +1,370 @@
+package database
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migrationFileName matches NNNN_name.up.sql and NNNN_name.down.sql
+var migrationFileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Markers around a statement that contains semicolons of its own, such as a PL/SQL block
+const (
+	statementBegin = "-- +statement-begin"
+	statementEnd   = "-- +statement-end"
+)
+
+// ErrPendingMigrations means the schema is behind the code
+var ErrPendingMigrations = errors.New("database has pending migrations")
+
+// baselineTable is created by the first migration; a database that has it but no migration
+// history was created by AutoMigrate before migrations existed
+const baselineTable = "users"
+
+// Migration is a versioned schema change with the SQL that reverts it
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string // of Up, to detect migrations edited after they were applied
+}
+
+// MigrationStatus is a migration and whether it has been applied
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+	// Modified is set when the applied migration's file no longer matches what was applied
+	Modified bool
+}
+
+// migrationModel is a row of the migration history
+// Dirty marks a migration that is being applied or reverted, or that failed part-way
+type migrationModel struct {
+	Version   int64  `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"size:100;not null"`
+	Checksum  string `gorm:"size:64;not null"`
+	Dirty     bool   `gorm:"not null;default:0"`
+	AppliedAt time.Time
+}
+
+func (migrationModel) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator applies and reverts the SQL migrations of one database driver
+// Most databases cannot roll DDL back, so each migration is recorded as dirty while it runs;
+// one that fails part-way stays dirty and blocks further migrations until repaired by hand
+type Migrator struct {
+	db         *DB
+	migrations []Migration
+}
+
+// NewMigrator reads the migrations in files, which holds the up and down SQL file of each
+func NewMigrator(db *DB, files fs.FS) (*Migrator, error) {
+	migrations, err := readMigrations(files)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Up applies the pending migrations in order and returns how many were applied
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied, err := m.history(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(applied) == 0 && len(m.migrations) > 0 && m.db.WithContext(ctx).Migrator().HasTable(baselineTable) {
+		baseline := m.migrations[0]
+		if err := m.record(ctx, baseline, false); err != nil {
+			return 0, err
+		}
+		applied[baseline.Version] = migrationModel{Version: baseline.Version, Checksum: baseline.Checksum}
+		slog.Info("Existing schema adopted as the baseline migration", "version", baseline.Version, "name", baseline.Name)
+	}
+
+	count := 0
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		if err := m.apply(ctx, migration); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// Down reverts the last steps applied migrations and returns how many were reverted
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	applied, err := m.history(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := len(m.migrations) - 1; i >= 0 && count < steps; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if err := m.revert(ctx, migration); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// Status lists every migration with when it was applied
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	var rows []migrationModel
+	if err := m.db.WithContext(ctx).Order("version").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read migration history: %w", err)
+	}
+	applied := make(map[int64]migrationModel, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := MigrationStatus{Migration: migration}
+		if row, ok := applied[migration.Version]; ok && !row.Dirty {
+			appliedAt := row.AppliedAt
+			status.AppliedAt = &appliedAt
+			status.Modified = row.Checksum != migration.Checksum
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Pending returns the number of migrations not applied yet
+func (m *Migrator) Pending(ctx context.Context) (int, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	pending := 0
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+// history returns the applied migrations by version after checking them against the files
+func (m *Migrator) history(ctx context.Context) (map[int64]migrationModel, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	var rows []migrationModel
+	if err := m.db.WithContext(ctx).Order("version").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read migration history: %w", err)
+	}
+
+	files := make(map[int64]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		files[migration.Version] = migration
+	}
+	applied := make(map[int64]migrationModel, len(rows))
+	for _, row := range rows {
+		if row.Dirty {
+			return nil, fmt.Errorf("migration %d (%s) did not complete; repair the schema by hand, then delete its row from %s",
+				row.Version, row.Name, migrationModel{}.TableName())
+		}
+		migration, ok := files[row.Version]
+		if !ok {
+			return nil, fmt.Errorf("applied migration %d (%s) has no file", row.Version, row.Name)
+		}
+		if row.Checksum != migration.Checksum {
+			return nil, fmt.Errorf("migration %d (%s) was modified after it was applied", row.Version, row.Name)
+		}
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	if err := m.db.WithContext(ctx).AutoMigrate(&migrationModel{}); err != nil {
+		return fmt.Errorf("failed to create migration history table: %w", err)
+	}
+	return nil
+}
+
+// apply runs the up SQL of migration, recorded as dirty until it completes
+// Recording first also stops a second instance migrating at the same time, as the version is the key
+func (m *Migrator) apply(ctx context.Context, migration Migration) error {
+	if err := m.record(ctx, migration, true); err != nil {
+		return err
+	}
+	if err := m.exec(ctx, migration.Up); err != nil {
+		return fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Name, err)
+	}
+	err := m.db.WithContext(ctx).Model(&migrationModel{}).
+		Where("version = ?", migration.Version).
+		Updates(map[string]interface{}{"dirty": false, "applied_at": time.Now().UTC()}).Error
+	if err != nil {
+		return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+	}
+	slog.Info("Migration applied", "version", migration.Version, "name", migration.Name)
+	return nil
+}
+
+// revert runs the down SQL of migration and removes it from the history
+func (m *Migrator) revert(ctx context.Context, migration Migration) error {
+	err := m.db.WithContext(ctx).Model(&migrationModel{}).
+		Where("version = ?", migration.Version).
+		Update("dirty", true).Error
+	if err != nil {
+		return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+	}
+	if err := m.exec(ctx, migration.Down); err != nil {
+		return fmt.Errorf("reverting migration %d (%s) failed: %w", migration.Version, migration.Name, err)
+	}
+	if err := m.db.WithContext(ctx).Delete(&migrationModel{}, "version = ?", migration.Version).Error; err != nil {
+		return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+	}
+	slog.Info("Migration reverted", "version", migration.Version, "name", migration.Name)
+	return nil
+}
+
+func (m *Migrator) record(ctx context.Context, migration Migration, dirty bool) error {
+	err := m.db.WithContext(ctx).Create(&migrationModel{
+		Version:   migration.Version,
+		Name:      migration.Name,
+		Checksum:  migration.Checksum,
+		Dirty:     dirty,
+		AppliedAt: time.Now().UTC(),
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to record migration %d, is another instance migrating? %w", migration.Version, err)
+	}
+	return nil
+}
+
+// exec runs each statement of script on the pool directly, bypassing GORM's prepared statements
+func (m *Migrator) exec(ctx context.Context, script string) error {
+	sqlDB, err := m.db.DB.DB()
+	if err != nil {
+		return err
+	}
+	for _, statement := range splitStatements(script) {
+		if _, err := sqlDB.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("%w\n%s", err, statement)
+		}
+	}
+	return nil
+}
+
+// readMigrations pairs the up and down files of each version, in version order
+func readMigrations(files fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := migrationFileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+		content, err := fs.ReadFile(files, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has files with different names", version)
+		}
+		if match[3] == "up" {
+			sum := sha256.Sum256(content)
+			migration.Up = string(content)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Checksum == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d (%s) needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// splitStatements splits a script into statements ending with a semicolon at the end of a line
+// Statements between the begin and end markers are kept whole with their semicolons, as PL/SQL
+// blocks need; other statements lose theirs, which Oracle rejects in plain SQL
+func splitStatements(script string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+		inBlock    bool
+	)
+	flush := func() {
+		if statement := strings.TrimSpace(current.String()); statement != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(script))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == statementBegin:
+			flush()
+			inBlock = true
+		case trimmed == statementEnd:
+			flush()
+			inBlock = false
+		case inBlock:
+			current.WriteString(line + "\n")
+		case trimmed == "" || strings.HasPrefix(trimmed, "--"):
+			// Comments between statements
+		case strings.HasSuffix(trimmed, ";"):
+			current.WriteString(strings.TrimSuffix(trimmed, ";"))
+			flush()
+		default:
+			current.WriteString(line + "\n")
+		}
+	}
+	flush()
+	return statements
+}
@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const monitorPingTimeout = 3 * time.Second
+
+// IsConnected reports the last connectivity state observed by the health monitor
+func (db *DB) IsConnected() bool {
+	return db.connected.Load()
+}
+
+// StartHealthMonitor periodically pings the database and recovers the pool after an outage
+// It returns immediately; the monitor stops when ctx is cancelled
+func (db *DB) StartHealthMonitor(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		slog.Info("Database health monitor disabled")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				db.checkConnection(ctx)
+			}
+		}
+	}()
+}
+
+// checkConnection pings the database and handles connected/disconnected transitions
+func (db *DB) checkConnection(ctx context.Context) {
+	pingCtx, cancel := context.WithTimeout(ctx, monitorPingTimeout)
+	defer cancel()
+
+	err := db.HealthCheck(pingCtx)
+	if ctx.Err() != nil {
+		// Shutting down, not an outage
+		return
+	}
+
+	connected := err == nil
+	if db.connected.Swap(connected) == connected {
+		return
+	}
+
+	if !connected {
+		slog.Warn("Database connection lost",
+			"db_connected", false,
+			"error", err,
+		)
+		return
+	}
+
+	db.resetPool()
+	slog.Info("Database connection restored",
+		"db_connected", true,
+	)
+}
+
+// resetPool drops connections and prepared statements that may predate an outage
+// database/sql only evicts connections on driver.ErrBadConn, and GORM's prepared
+// statement cache likewise only evicts on ErrBadConn, so network errors reported
+// differently by the driver would otherwise leave stale entries behind
+func (db *DB) resetPool() {
+	if stmtDB, ok := db.DB.ConnPool.(*gorm.PreparedStmtDB); ok {
+		stmtDB.Reset()
+	}
+
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		slog.Error("Failed to reset database pool", "error", err)
+		return
+	}
+
+	// Setting max idle to zero closes every idle connection, then restore the limit
+	sqlDB.SetMaxIdleConns(0)
+	sqlDB.SetMaxIdleConns(db.maxIdleConns)
+}
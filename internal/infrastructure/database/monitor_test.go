@@ -0,0 +1,180 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const flakyDriverName = "sqlite_flaky"
+
+// outage makes every ping of the flaky driver fail while set
+var outage atomic.Bool
+
+func init() {
+	conn, err := sql.Open(DriverSQLite, "")
+	if err != nil {
+		panic(err)
+	}
+	sql.Register(flakyDriverName, flakyDriver{Driver: conn.Driver()})
+	_ = conn.Close()
+}
+
+// flakyDriver is the sqlite driver with pings that fail during an outage
+type flakyDriver struct {
+	driver.Driver
+}
+
+func (d flakyDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return flakyConn{Conn: conn}, nil
+}
+
+type flakyConn struct {
+	driver.Conn
+}
+
+func (c flakyConn) Ping(ctx context.Context) error {
+	if outage.Load() {
+		return errors.New("dial tcp 10.0.0.5:1521: connect: connection refused")
+	}
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// newFlakyDB opens a sqlite database through the flaky driver, caching prepared statements
+func newFlakyDB(t *testing.T) *DB {
+	t.Helper()
+	outage.Store(false)
+	t.Cleanup(func() { outage.Store(false) })
+
+	gormDB, err := gorm.Open(sqlite.Dialector{DriverName: flakyDriverName, DSN: filepath.Join(t.TempDir(), "test.db")}, &gorm.Config{
+		Logger:      logger.Discard,
+		PrepareStmt: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		t.Fatalf("failed to get database instance: %v", err)
+	}
+	sqlDB.SetMaxIdleConns(2)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	db := &DB{DB: gormDB, driver: DriverSQLite, maxIdleConns: 2}
+	db.connected.Store(true)
+	return db
+}
+
+// captureLogs sends the default logger to a buffer for the rest of the test
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func TestCheckConnectionResetsPoolAfterOutage(t *testing.T) {
+	db := newFlakyDB(t)
+	logs := captureLogs(t)
+	ctx := context.Background()
+
+	var n int
+	if err := db.DB.Raw("SELECT 1").Scan(&n).Error; err != nil {
+		t.Fatalf("failed to query: %v", err)
+	}
+	stmtDB := db.DB.ConnPool.(*gorm.PreparedStmtDB)
+	if len(stmtDB.Stmts) == 0 {
+		t.Fatal("the query left no prepared statement to reset")
+	}
+	sqlDB, _ := db.DB.DB()
+
+	outage.Store(true)
+	db.checkConnection(ctx)
+	db.checkConnection(ctx)
+	if db.IsConnected() {
+		t.Fatal("IsConnected = true during the outage")
+	}
+	if got := strings.Count(logs.String(), "Database connection lost"); got != 1 {
+		t.Errorf("logged the outage %d times, want once on the transition:\n%s", got, logs)
+	}
+	if !strings.Contains(logs.String(), "db_connected=false") {
+		t.Errorf("outage log lacks the connection state:\n%s", logs)
+	}
+	if len(stmtDB.Stmts) == 0 {
+		t.Error("the prepared statements were dropped before the database came back")
+	}
+
+	outage.Store(false)
+	db.checkConnection(ctx)
+	db.checkConnection(ctx)
+	if !db.IsConnected() {
+		t.Fatal("IsConnected = false after recovery")
+	}
+	if got := strings.Count(logs.String(), "Database connection restored"); got != 1 {
+		t.Errorf("logged the recovery %d times, want once on the transition:\n%s", got, logs)
+	}
+	if len(stmtDB.Stmts) != 0 {
+		t.Errorf("%d prepared statements survived the outage, want none", len(stmtDB.Stmts))
+	}
+	if closed := sqlDB.Stats().MaxIdleClosed; closed == 0 {
+		t.Error("no idle connection was closed on recovery")
+	}
+}
+
+func TestCheckConnectionIgnoresShutdown(t *testing.T) {
+	db := newFlakyDB(t)
+	logs := captureLogs(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	outage.Store(true)
+	db.checkConnection(ctx)
+	if !db.IsConnected() || logs.Len() != 0 {
+		t.Errorf("connected = %v, logs = %q, want a cancelled check to change nothing", db.IsConnected(), logs)
+	}
+}
+
+func TestStartHealthMonitor(t *testing.T) {
+	db := newFlakyDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	db.StartHealthMonitor(ctx, 5*time.Millisecond)
+
+	waitFor := func(want bool) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for db.IsConnected() != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("IsConnected stayed %v, want %v", !want, want)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	outage.Store(true)
+	waitFor(false)
+	outage.Store(false)
+	waitFor(true)
+}
@@ -0,0 +1,17 @@
+package database
+
+import (
+	"gorm.io/gorm"
+	otelgorm "gorm.io/plugin/opentelemetry/tracing"
+)
+
+// newTracingPlugin traces each query in a client span under the span of the request that ran it
+// The span carries the parameterized SQL, never the bound values
+func newTracingPlugin(driver string) gorm.Plugin {
+	opts := []otelgorm.Option{otelgorm.WithoutQueryVariables(), otelgorm.WithoutMetrics()}
+	if driver == DriverOracle {
+		// The plugin names the system of the other drivers from their dialector
+		opts = append(opts, otelgorm.WithDBSystem("oracle.db"))
+	}
+	return otelgorm.NewPlugin(opts...)
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestQuerySpansLeaveOutBoundValues(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	db := newSQLiteDB(t)
+	ctx, request := otel.Tracer("test").Start(context.Background(), "GET /names")
+	if err := db.WithContext(ctx).Exec("INSERT INTO names (name) VALUES (?)", "secret-name").Error; err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	request.End()
+
+	var found bool
+	for _, span := range recorder.Ended() {
+		if span.Parent().SpanID() != request.SpanContext().SpanID() {
+			continue
+		}
+		found = true
+		attributes := map[string]string{}
+		for _, kv := range span.Attributes() {
+			attributes[string(kv.Key)] = kv.Value.Emit()
+		}
+		if attributes["db.system.name"] != "sqlite" {
+			t.Errorf("db.system.name = %q, want sqlite", attributes["db.system.name"])
+		}
+		for key, value := range attributes {
+			if strings.Contains(value, "secret-name") {
+				t.Errorf("%s = %q carries the bound value", key, value)
+			}
+		}
+	}
+	if !found {
+		t.Error("no query span under the request span")
+	}
+}
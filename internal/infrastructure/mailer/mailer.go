@@ -0,0 +1,99 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+// New returns an SMTP mailer, or a log-only mailer when no SMTP host is configured
+func New(cfg *config.Config) service.Mailer {
+	if cfg.Mail.Host == "" {
+		slog.Warn("SMTP host not configured, emails will only be logged")
+		return &logMailer{}
+	}
+	return &smtpMailer{cfg: cfg.Mail}
+}
+
+// smtpMailer sends email through an SMTP relay with STARTTLS
+type smtpMailer struct {
+	cfg config.MailConfig
+}
+
+func (m *smtpMailer) Send(ctx context.Context, email service.Email) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port))
+
+	var auth smtp.Auth
+	if m.cfg.Username != "" {
+		auth = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+	}
+
+	// The envelope sender must be a bare address, the From header may carry a display name
+	sender := m.cfg.From
+	if parsed, err := mail.ParseAddress(m.cfg.From); err == nil {
+		sender = parsed.Address
+	}
+
+	if err := smtp.SendMail(addr, auth, sender, []string{email.To}, buildMessage(m.cfg.From, email)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+// HealthCheck connects to the SMTP relay and waits for its greeting, without sending anything
+func (m *smtpMailer) HealthCheck(ctx context.Context) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp relay: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.cfg.Host)
+	if err != nil {
+		return fmt.Errorf("smtp relay did not greet: %w", err)
+	}
+	return client.Quit()
+}
+
+// buildMessage renders a UTF-8 plain-text message (subjects are often Korean)
+func buildMessage(from string, email service.Email) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + email.To + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", email.Subject) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(email.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// logMailer logs emails instead of sending them (local development)
+type logMailer struct{}
+
+func (m *logMailer) Send(ctx context.Context, email service.Email) error {
+	slog.InfoContext(ctx, "Email (not sent, SMTP disabled)",
+		"to", email.To,
+		"subject", email.Subject,
+		"body", email.Body,
+	)
+	return nil
+}
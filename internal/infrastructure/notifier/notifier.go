@@ -0,0 +1,299 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+// queuedPushGrace is how long a queued push waits for its sender before the Retrier sends it instead
+const queuedPushGrace = time.Minute
+
+// New returns a notifier that keeps every notification in the users' inboxes and
+// pushes it to registered devices; the few types that are Emailed also go to the user's email address
+// Users pending deletion are skipped; users who turned the notification's group off
+// still find it in their inbox but are not emailed or pushed, and users without an email address are not emailed
+// Room activity for users on a digest waits in the inbox until the digest job summarises it
+// Users who snoozed their notifications are not pushed to until the snooze ends; what arrives meanwhile stays in the inbox
+// Pushes that fail temporarily are queued for the Retrier
+func New(mailer service.Mailer, pusher service.Pusher, userRepo repository.UserRepository, deviceRepo repository.DeviceRepository, notificationRepo repository.NotificationRepository, retryRepo repository.PushRetryRepository) service.Notifier {
+	return &channelNotifier{
+		mailer:           mailer,
+		pusher:           pusher,
+		userRepo:         userRepo,
+		deviceRepo:       deviceRepo,
+		notificationRepo: notificationRepo,
+		retryRepo:        retryRepo,
+	}
+}
+
+type channelNotifier struct {
+	mailer           service.Mailer
+	pusher           service.Pusher
+	userRepo         repository.UserRepository
+	deviceRepo       repository.DeviceRepository
+	notificationRepo repository.NotificationRepository
+	retryRepo        repository.PushRetryRepository
+}
+
+func (n *channelNotifier) Notify(ctx context.Context, userIDs []string, notification entity.Notification) error {
+	// The inbox is written first so the notification is there by the time an email or push is opened
+	// Delivery goes ahead when that fails: the notification still reaches the recipients
+	var errs []error
+	recipients, err := n.Record(ctx, userIDs, notification)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if err := n.deliver(ctx, recipients, notification); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (n *channelNotifier) Record(ctx context.Context, userIDs []string, notification entity.Notification) ([]*entity.User, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	users, err := n.userRepo.GetByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	inbox := make([]*entity.InboxNotification, 0, len(users))
+	recipients := make([]*entity.User, 0, len(users))
+	for _, u := range users {
+		if u.IsDeletionScheduled() {
+			continue
+		}
+		entry := entity.NewInboxNotification(u.ID, render(notification, u.Locale), now)
+		entry.ID = uuid.New().String()
+		inbox = append(inbox, entry)
+		if !u.NotificationSettings.Allows(notification.Type) {
+			continue
+		}
+		if u.NotificationSettings.Digests(notification.Type) {
+			entry.DigestPending = true
+			continue
+		}
+		recipients = append(recipients, u)
+	}
+
+	return recipients, n.notificationRepo.CreateMany(ctx, inbox)
+}
+
+func (n *channelNotifier) QueuePushes(ctx context.Context, users []*entity.User, notification entity.Notification) ([]*entity.PushRetry, error) {
+	byLanguage, err := n.tokensByLanguage(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var pushes []*entity.PushRetry
+	for lang, tokens := range byLanguage {
+		rendered := render(notification, lang)
+		for _, token := range tokens {
+			push := entity.NewQueuedPush(token, rendered, now.Add(queuedPushGrace), now)
+			push.ID = uuid.New().String()
+			pushes = append(pushes, push)
+		}
+	}
+	return pushes, n.retryRepo.Enqueue(ctx, pushes)
+}
+
+func (n *channelNotifier) DeliverTo(ctx context.Context, users []*entity.User, pushes []*entity.PushRetry, notification entity.Notification) error {
+	return errors.Join(n.email(ctx, users, notification), n.sendQueued(ctx, pushes))
+}
+
+func (n *channelNotifier) Deliver(ctx context.Context, userID string, notification entity.Notification) error {
+	user, err := n.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.IsDeletionScheduled() {
+		return nil
+	}
+	return n.deliver(ctx, []*entity.User{user}, notification)
+}
+
+// deliver pushes the notification to the users, each in their own language, and emails it
+// when its type is Emailed
+// It keeps going after a failed recipient so one bad address does not silence the rest
+func (n *channelNotifier) deliver(ctx context.Context, users []*entity.User, notification entity.Notification) error {
+	return errors.Join(n.email(ctx, users, notification), n.push(ctx, users, notification))
+}
+
+// email sends the notification to the users' email addresses when its type is Emailed
+func (n *channelNotifier) email(ctx context.Context, users []*entity.User, notification entity.Notification) error {
+	var errs []error
+	for _, u := range users {
+		if u.Email == "" || !notification.Type.Emailed() {
+			continue
+		}
+		email := render(notification, u.Locale)
+		err := n.mailer.Send(ctx, service.Email{
+			To:      u.Email,
+			Subject: "[PrayTogether] " + email.Title,
+			Body:    email.Body,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// push sends the notification to the users' devices in each device's language
+func (n *channelNotifier) push(ctx context.Context, users []*entity.User, notification entity.Notification) error {
+	byLanguage, err := n.tokensByLanguage(ctx, users)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for lang, tokens := range byLanguage {
+		if err := n.pushTokens(ctx, tokens, render(notification, lang)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// tokensByLanguage groups the users' device tokens by the language their pushes are rendered in
+// Users who snoozed their notifications are left out
+func (n *channelNotifier) tokensByLanguage(ctx context.Context, users []*entity.User) (map[string][]string, error) {
+	now := time.Now()
+	userIDs := make([]string, 0, len(users))
+	locales := make(map[string]string, len(users))
+	for _, u := range users {
+		if u.NotificationSettings.Snoozed(now) {
+			continue
+		}
+		userIDs = append(userIDs, u.ID)
+		locales[u.ID] = u.Locale
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	devices, err := n.deviceRepo.ListByUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Devices without a locale of their own follow their user's
+	byLanguage := make(map[string][]string)
+	for _, d := range devices {
+		locale := d.Locale
+		if locale == "" {
+			locale = locales[d.UserID]
+		}
+		lang := languageOf(locale)
+		byLanguage[lang] = append(byLanguage[lang], d.Token)
+	}
+	return byLanguage, nil
+}
+
+// pushTokens sends the rendered notification to the tokens, prunes the tokens FCM rejected for good and queues temporary failures
+func (n *channelNotifier) pushTokens(ctx context.Context, tokens []string, notification entity.Notification) error {
+	result := n.pusher.Push(ctx, tokens, notification)
+	pruneTokens(ctx, n.deviceRepo, result.Invalid)
+	if len(result.Failed) == 0 {
+		return nil
+	}
+
+	// Temporary failures are queued for the retrier; the rest could never succeed and are dead-lettered now
+	now := time.Now()
+	var retries, dead []*entity.PushRetry
+	var errs []error
+	for _, f := range result.Failed {
+		retry := entity.NewPushRetry(f.Token, notification, f.Err.Error(), now)
+		retry.ID = uuid.New().String()
+		if f.Temporary {
+			retries = append(retries, retry)
+		} else {
+			dead = append(dead, retry)
+			errs = append(errs, f.Err)
+		}
+	}
+	if err := n.retryRepo.Enqueue(ctx, retries); err != nil {
+		errs = append(errs, err)
+	}
+	if err := n.retryRepo.DeadLetter(ctx, dead, now); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// sendQueued sends the pushes QueuePushes queued, those rendered alike together, and settles them:
+// delivered and unregistered pushes leave the queue, temporary failures stay for the Retrier and
+// the rest are dead-lettered
+func (n *channelNotifier) sendQueued(ctx context.Context, pushes []*entity.PushRetry) error {
+	if len(pushes) == 0 {
+		return nil
+	}
+	type rendering struct{ title, body string }
+	groups := make(map[rendering][]*entity.PushRetry)
+	for _, p := range pushes {
+		key := rendering{p.Notification.Title, p.Notification.Body}
+		groups[key] = append(groups[key], p)
+	}
+
+	now := time.Now()
+	var settled []string
+	var dead []*entity.PushRetry
+	var errs []error
+	for _, group := range groups {
+		tokens := make([]string, 0, len(group))
+		for _, p := range group {
+			tokens = append(tokens, p.Token)
+		}
+		result := n.pusher.Push(ctx, tokens, group[0].Notification)
+		pruneTokens(ctx, n.deviceRepo, result.Invalid)
+
+		failed := make(map[string]service.PushFailure, len(result.Failed))
+		for _, f := range result.Failed {
+			failed[f.Token] = f
+		}
+		for _, p := range group {
+			f, ok := failed[p.Token]
+			if !ok {
+				settled = append(settled, p.ID)
+				continue
+			}
+			p.Fail(f.Err.Error(), now)
+			if !f.Temporary {
+				dead = append(dead, p)
+				errs = append(errs, f.Err)
+			} else if err := n.retryRepo.Reschedule(ctx, p); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if err := n.retryRepo.Delete(ctx, settled...); err != nil {
+		errs = append(errs, err)
+	}
+	if err := n.retryRepo.DeadLetter(ctx, dead, now); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// pruneTokens forgets the devices whose tokens FCM rejected for good
+func pruneTokens(ctx context.Context, deviceRepo repository.DeviceRepository, tokens []string) {
+	if len(tokens) == 0 {
+		return
+	}
+	if err := deviceRepo.DeleteTokens(ctx, tokens); err != nil {
+		slog.ErrorContext(ctx, "Failed to prune device tokens", "count", len(tokens), "error", err)
+	} else {
+		slog.InfoContext(ctx, "Pruned unregistered device tokens", "count", len(tokens))
+	}
+}
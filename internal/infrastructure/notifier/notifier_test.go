@@ -0,0 +1,293 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+type sentMail struct {
+	to []string
+}
+
+func (m *sentMail) Send(_ context.Context, email service.Email) error {
+	m.to = append(m.to, email.To)
+	return nil
+}
+
+type sentPushes struct {
+	tokens []string
+}
+
+func (p *sentPushes) Push(_ context.Context, tokens []string, _ entity.Notification) service.PushResult {
+	p.tokens = append(p.tokens, tokens...)
+	return service.PushResult{}
+}
+
+type usersByID struct {
+	repository.UserRepository
+	users []*entity.User
+}
+
+func (f usersByID) GetByIDs(context.Context, []string) ([]*entity.User, error) {
+	return f.users, nil
+}
+
+func (f usersByID) GetByID(_ context.Context, id string) (*entity.User, error) {
+	for _, u := range f.users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return nil, entity.ErrUserNotFound
+}
+
+type devicesOf struct {
+	repository.DeviceRepository
+	devices []*entity.Device
+}
+
+func (f devicesOf) ListByUsers(_ context.Context, userIDs []string) ([]*entity.Device, error) {
+	var devices []*entity.Device
+	for _, d := range f.devices {
+		if slices.Contains(userIDs, d.UserID) {
+			devices = append(devices, d)
+		}
+	}
+	return devices, nil
+}
+
+type inbox struct {
+	repository.NotificationRepository
+	entries []*entity.InboxNotification
+}
+
+func (f *inbox) CreateMany(_ context.Context, entries []*entity.InboxNotification) error {
+	f.entries = append(f.entries, entries...)
+	return nil
+}
+
+func TestNotifyEmailsOnlyEmailedTypes(t *testing.T) {
+	users := usersByID{users: []*entity.User{
+		{ID: "u1", Email: "kim@example.com", NotificationSettings: entity.DefaultNotificationSettings()},
+		{ID: "u2", Email: "lee@example.com", NotificationSettings: entity.DefaultNotificationSettings()},
+	}}
+	devices := devicesOf{devices: []*entity.Device{{UserID: "u1", Token: "t1"}, {UserID: "u2", Token: "t2"}}}
+
+	tests := []struct {
+		typ       entity.NotificationType
+		wantEmail bool
+	}{
+		{typ: entity.NotificationPrayerAnswered},
+		{typ: entity.NotificationCommentMention},
+		{typ: entity.NotificationReactionMilestone},
+		{typ: entity.NotificationAnnouncement},
+		{typ: entity.NotificationPrayerReminder},
+		{typ: entity.NotificationDigest},
+		{typ: entity.NotificationRoomWelcome},
+		{typ: entity.NotificationJoinRequested, wantEmail: true},
+		{typ: entity.NotificationJoinApproved, wantEmail: true},
+		{typ: entity.NotificationExportReady, wantEmail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.typ), func(t *testing.T) {
+			mail, pushes, stored := &sentMail{}, &sentPushes{}, &inbox{}
+			n := New(mail, pushes, users, devices, stored, nil)
+
+			if err := n.Notify(context.Background(), []string{"u1", "u2"}, entity.Notification{Type: tt.typ}); err != nil {
+				t.Fatalf("Notify: %v", err)
+			}
+			if len(stored.entries) != 2 || len(pushes.tokens) != 2 {
+				t.Errorf("stored %d and pushed %d, want both users in the inbox and on their devices", len(stored.entries), len(pushes.tokens))
+			}
+			if emailed := len(mail.to) > 0; emailed != tt.wantEmail {
+				t.Errorf("emailed %v, want emailed = %v", mail.to, tt.wantEmail)
+			}
+		})
+	}
+}
+
+func TestDeliverSkipsUsersWithoutEmail(t *testing.T) {
+	mail := &sentMail{}
+	n := New(mail, &sentPushes{}, nil, devicesOf{}, nil, nil)
+	users := []*entity.User{{ID: "guest"}, {ID: "u1", Email: "kim@example.com"}}
+
+	if err := n.DeliverTo(context.Background(), users, nil, entity.Notification{Type: entity.NotificationExportReady}); err != nil {
+		t.Fatalf("DeliverTo: %v", err)
+	}
+	if len(mail.to) != 1 || mail.to[0] != "kim@example.com" {
+		t.Errorf("emailed %v, want only kim@example.com", mail.to)
+	}
+}
+
+func TestNotifyHoldsPushesWhileSnoozed(t *testing.T) {
+	now := time.Now()
+	snoozed := &entity.User{ID: "u1", NotificationSettings: entity.DefaultNotificationSettings()}
+	if err := snoozed.SnoozeNotifications(now.Add(time.Hour), now); err != nil {
+		t.Fatalf("SnoozeNotifications: %v", err)
+	}
+	awake := &entity.User{ID: "u2", NotificationSettings: entity.DefaultNotificationSettings()}
+	users := usersByID{users: []*entity.User{snoozed, awake}}
+	devices := devicesOf{devices: []*entity.Device{{UserID: "u1", Token: "t1"}, {UserID: "u2", Token: "t2"}}}
+	ctx := context.Background()
+	notification := entity.Notification{Type: entity.NotificationPrayerAnswered}
+
+	pushes, stored := &sentPushes{}, &inbox{}
+	n := New(&sentMail{}, pushes, users, devices, stored, nil)
+	if err := n.Notify(ctx, []string{"u1", "u2"}, notification); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if len(stored.entries) != 2 {
+		t.Errorf("stored %d inbox entries, want the snoozed user's kept too", len(stored.entries))
+	}
+	if !slices.Equal(pushes.tokens, []string{"t2"}) {
+		t.Errorf("pushed to %v, want only the user who is not snoozed", pushes.tokens)
+	}
+	if err := n.Deliver(ctx, "u1", notification); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+	if !slices.Equal(pushes.tokens, []string{"t2"}) {
+		t.Errorf("pushed to %v, want digests held back as well", pushes.tokens)
+	}
+
+	// Once the snooze has ended pushes go through again
+	ended := now.Add(-time.Minute)
+	snoozed.NotificationSettings.SnoozedUntil = &ended
+	pushes.tokens = nil
+	if err := n.Notify(ctx, []string{"u1", "u2"}, notification); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if !slices.Equal(pushes.tokens, []string{"t1", "t2"}) {
+		t.Errorf("pushed to %v, want both users after the snooze", pushes.tokens)
+	}
+}
+
+// scriptedPusher fails the tokens it has a failure for and reports the unregistered ones as invalid
+type scriptedPusher struct {
+	failures     map[string]service.PushFailure
+	unregistered map[string]bool
+	calls        int
+}
+
+func (p *scriptedPusher) Push(_ context.Context, tokens []string, _ entity.Notification) service.PushResult {
+	p.calls++
+	var result service.PushResult
+	for _, token := range tokens {
+		if f, ok := p.failures[token]; ok {
+			result.Failed = append(result.Failed, f)
+		} else if p.unregistered[token] {
+			result.Invalid = append(result.Invalid, token)
+		}
+	}
+	return result
+}
+
+// pushQueue keeps the queued pushes in memory
+type pushQueue struct {
+	queued      map[string]*entity.PushRetry
+	deadLetters []*entity.PushRetry
+}
+
+func (q *pushQueue) Enqueue(_ context.Context, retries []*entity.PushRetry) error {
+	for _, r := range retries {
+		q.queued[r.ID] = r
+	}
+	return nil
+}
+
+func (q *pushQueue) ListDue(context.Context, time.Time, int) ([]*entity.PushRetry, error) {
+	return nil, nil
+}
+
+func (q *pushQueue) Reschedule(_ context.Context, retry *entity.PushRetry) error {
+	q.queued[retry.ID] = retry
+	return nil
+}
+
+func (q *pushQueue) Delete(_ context.Context, ids ...string) error {
+	for _, id := range ids {
+		delete(q.queued, id)
+	}
+	return nil
+}
+
+func (q *pushQueue) DeadLetter(_ context.Context, retries []*entity.PushRetry, _ time.Time) error {
+	for _, r := range retries {
+		delete(q.queued, r.ID)
+	}
+	q.deadLetters = append(q.deadLetters, retries...)
+	return nil
+}
+
+// prunedTokens lists the devices and records the tokens pruned from them
+type prunedTokens struct {
+	devicesOf
+	pruned []string
+}
+
+func (f *prunedTokens) DeleteTokens(_ context.Context, tokens []string) error {
+	f.pruned = append(f.pruned, tokens...)
+	return nil
+}
+
+func TestQueuedPushesAreSettledAfterSending(t *testing.T) {
+	users := []*entity.User{{ID: "u1", Locale: "ko"}, {ID: "u2", Locale: "en"}}
+	devices := &prunedTokens{devicesOf: devicesOf{devices: []*entity.Device{
+		{UserID: "u1", Token: "sent"},
+		{UserID: "u1", Token: "unregistered"},
+		{UserID: "u2", Token: "throttled"},
+		{UserID: "u2", Token: "rejected"},
+	}}}
+	pusher := &scriptedPusher{
+		failures: map[string]service.PushFailure{
+			"throttled": {Token: "throttled", Err: errors.New("quota exceeded"), Temporary: true},
+			"rejected":  {Token: "rejected", Err: errors.New("invalid argument")},
+		},
+		unregistered: map[string]bool{"unregistered": true},
+	}
+	queue := &pushQueue{queued: map[string]*entity.PushRetry{}}
+	n := New(&sentMail{}, pusher, nil, devices, nil, queue)
+	ctx := context.Background()
+	notification := entity.Notification{Type: entity.NotificationPrayerAnswered}
+
+	pushes, err := n.QueuePushes(ctx, users, notification)
+	if err != nil {
+		t.Fatalf("QueuePushes: %v", err)
+	}
+	if len(queue.queued) != 4 || pusher.calls != 0 {
+		t.Fatalf("queued %d and pushed %d times, want all four queued and nothing sent yet", len(queue.queued), pusher.calls)
+	}
+	for _, p := range pushes {
+		if p.Attempts != 0 || !p.NextAttemptAt.After(time.Now()) {
+			t.Errorf("queued push = %+v, want it untried and left to its sender for now", p)
+		}
+	}
+
+	if err := n.DeliverTo(ctx, users, pushes, notification); err == nil {
+		t.Error("DeliverTo succeeded, want the rejected push reported")
+	}
+	if pusher.calls != 2 {
+		t.Errorf("pushed %d times, want once per language", pusher.calls)
+	}
+	if len(queue.queued) != 1 {
+		t.Fatalf("queue holds %d pushes, want only the throttled one", len(queue.queued))
+	}
+	for _, p := range queue.queued {
+		if p.Token != "throttled" || p.Attempts != 1 {
+			t.Errorf("queued push = %+v, want the throttled push after one attempt", p)
+		}
+	}
+	if len(queue.deadLetters) != 1 || queue.deadLetters[0].Token != "rejected" {
+		t.Errorf("dead letters = %+v, want the rejected push", queue.deadLetters)
+	}
+	if !slices.Equal(devices.pruned, []string{"unregistered"}) {
+		t.Errorf("pruned %v, want the unregistered token", devices.pruned)
+	}
+}
@@ -0,0 +1,102 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+const (
+	// retryBatchSize bounds the pushes retried per run; the rest wait for the next tick
+	retryBatchSize = 100
+	// retryConcurrency bounds the retries in flight, so a batch fits well within the scheduler's lock
+	retryConcurrency = 8
+)
+
+// Retrier resends the pushes the notifier queued after a temporary failure,
+// backing off exponentially and dead-lettering those that run out of attempts
+type Retrier struct {
+	pusher     service.Pusher
+	deviceRepo repository.DeviceRepository
+	retryRepo  repository.PushRetryRepository
+}
+
+func NewRetrier(pusher service.Pusher, deviceRepo repository.DeviceRepository, retryRepo repository.PushRetryRepository) *Retrier {
+	return &Retrier{
+		pusher:     pusher,
+		deviceRepo: deviceRepo,
+		retryRepo:  retryRepo,
+	}
+}
+
+// Execute retries the pushes due at to; queued retries carry their own schedule, so from is not needed
+// It must run on one instance at a time, which the scheduler guarantees
+func (r *Retrier) Execute(ctx context.Context, _, to time.Time) error {
+	retries, err := r.retryRepo.ListDue(ctx, to, retryBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mu       sync.Mutex
+		outcomes = make(map[string]int)
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, retryConcurrency)
+	for _, retry := range retries {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			outcome, err := r.retry(ctx, retry)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to update push retry", "retry_id", retry.ID, "error", err)
+			}
+			mu.Lock()
+			outcomes[outcome]++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if len(retries) > 0 {
+		slog.InfoContext(ctx, "Retried push notifications",
+			"delivered", outcomes["delivered"],
+			"rescheduled", outcomes["rescheduled"],
+			"dead_lettered", outcomes["dead_lettered"],
+			"unregistered", outcomes["unregistered"],
+		)
+	}
+	return nil
+}
+
+// retry sends one queued push again and records what happened to it
+func (r *Retrier) retry(ctx context.Context, retry *entity.PushRetry) (string, error) {
+	result := r.pusher.Push(ctx, []string{retry.Token}, retry.Notification)
+	switch {
+	case len(result.Invalid) > 0:
+		pruneTokens(ctx, r.deviceRepo, result.Invalid)
+		return "unregistered", r.retryRepo.Delete(ctx, retry.ID)
+	case len(result.Failed) == 0:
+		return "delivered", r.retryRepo.Delete(ctx, retry.ID)
+	}
+
+	now := time.Now()
+	failure := result.Failed[0]
+	retry.Fail(failure.Err.Error(), now)
+	if !failure.Temporary || retry.Exhausted() {
+		slog.WarnContext(ctx, "Push notification dead-lettered",
+			"retry_id", retry.ID,
+			"type", retry.Notification.Type,
+			"attempts", retry.Attempts,
+			"error", failure.Err,
+		)
+		return "dead_lettered", r.retryRepo.DeadLetter(ctx, []*entity.PushRetry{retry}, now)
+	}
+	return "rescheduled", r.retryRepo.Reschedule(ctx, retry)
+}
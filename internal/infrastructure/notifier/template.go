@@ -0,0 +1,123 @@
+package notifier
+
+import (
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"golang.org/x/text/language"
+)
+
+// fallbackLanguage is used for locales without their own messages
+const fallbackLanguage = "ko"
+
+// message is a notification's title and body with {name} placeholders for its params
+type message struct {
+	title string
+	body  string
+}
+
+// templates holds the messages of every notification by template name and language
+// The name is the notification type unless the notification picks a variant
+var templates = map[string]map[string]message{
+	string(entity.NotificationJoinRequested): {
+		"ko": {"기도방 가입 요청", "{nickname}님이 '{room}' 기도방에 가입을 요청했습니다."},
+		"en": {"Join request", "{nickname} asked to join '{room}'."},
+	},
+	string(entity.NotificationJoinApproved): {
+		"ko": {"기도방 가입 승인", "'{room}' 기도방 가입이 승인되었습니다. 함께 기도해요!"},
+		"en": {"Join request approved", "You are now a member of '{room}'. Let's pray together!"},
+	},
+	string(entity.NotificationJoinRejected): {
+		"ko": {"기도방 가입 거절", "'{room}' 기도방 가입 요청이 거절되었습니다."},
+		"en": {"Join request declined", "Your request to join '{room}' was declined."},
+	},
+	string(entity.NotificationRoomInvited): {
+		"ko": {"기도방 초대", "{nickname}님이 '{room}' 기도방에 초대했습니다."},
+		"en": {"Room invitation", "{nickname} invited you to '{room}'."},
+	},
+	string(entity.NotificationAnnouncement): {
+		"ko": {"'{room}' 새 공지사항", "{preview}"},
+		"en": {"New announcement in '{room}'", "{preview}"},
+	},
+	string(entity.NotificationPrayerAnswered): {
+		"ko": {"기도가 응답되었습니다", "'{title}' 기도제목이 응답되었어요. 함께 감사해요!"},
+		"en": {"A prayer was answered", "'{title}' was answered. Let's give thanks together!"},
+	},
+	entity.TemplateAnsweredWithTestimony: {
+		"ko": {"기도가 응답되었습니다", "'{title}' 기도제목이 응답되었어요. 함께 감사해요! 응답 간증도 함께 나눠 주셨어요."},
+		"en": {"A prayer was answered", "'{title}' was answered. Let's give thanks together! A testimony was shared too."},
+	},
+	string(entity.NotificationPrayerPinned): {
+		"ko": {"'{room}' 고정된 기도제목", "'{title}' 기도제목이 기도방 상단에 고정되었어요. 함께 기도해요!"},
+		"en": {"Pinned in '{room}'", "'{title}' was pinned to the top of the room. Let's pray together!"},
+	},
+	string(entity.NotificationCommentMention): {
+		"ko": {"{nickname}님이 댓글에서 회원님을 언급했어요", "{comment}"},
+		"en": {"{nickname} mentioned you in a comment", "{comment}"},
+	},
+	string(entity.NotificationReactionMilestone): {
+		"ko": {"{count}명이 함께 기도했어요", "'{title}' 기도제목을 위해 {count}명이 함께 기도하고 있어요."},
+		"en": {"{count} people prayed with you", "{count} people are praying for '{title}'."},
+	},
+	string(entity.NotificationExportReady): {
+		"ko": {"기도 기록 내보내기가 완료되었어요", "'{room}' 기도방의 기도 기록을 아래 링크에서 {hours}시간 동안 내려받을 수 있어요.\n{url}"},
+		"en": {"Your prayer export is ready", "Download the prayer history of '{room}' from the link below within {hours} hours.\n{url}"},
+	},
+	string(entity.NotificationExportFailed): {
+		"ko": {"기도 기록을 내보내지 못했어요", "잠시 후 다시 시도해 주세요."},
+		"en": {"We couldn't export your prayers", "Please try again in a moment."},
+	},
+	string(entity.NotificationPrayerReminder): {
+		"ko": {"오늘의 기도", "'{room}' 기도방에서 함께 기도할 시간이에요."},
+		"en": {"Today's prayer", "It's time to pray together in '{room}'."},
+	},
+	string(entity.NotificationRoomWelcome): {
+		"ko": {"환영합니다", "'{room}' 기도방에 함께하게 되었어요. 함께 기도해요!"},
+		"en": {"Welcome", "You have joined '{room}'. Let's pray together!"},
+	},
+	string(entity.NotificationDigest): {
+		"ko": {"'{room}' 기도방 소식", "새 소식 {count}건이 있어요. 최근: {latest}"},
+		"en": {"Updates from '{room}'", "{count} new updates. Latest: {latest}"},
+	},
+}
+
+// render returns the notification with its title and body in the language of the BCP 47 locale
+// Notifications that are already rendered, such as digests of inbox entries, or that have no template
+// keep the title and body they came with
+func render(n entity.Notification, locale string) entity.Notification {
+	if n.Title != "" {
+		return n
+	}
+	name := n.Template
+	if name == "" {
+		name = string(n.Type)
+	}
+	variants, ok := templates[name]
+	if !ok {
+		return n
+	}
+	msg, ok := variants[languageOf(locale)]
+	if !ok {
+		msg = variants[fallbackLanguage]
+	}
+
+	pairs := make([]string, 0, 2*len(n.Params))
+	for k, v := range n.Params {
+		pairs = append(pairs, "{"+k+"}", v)
+	}
+	// A single pass, so placeholders inside values such as comment text are left alone
+	r := strings.NewReplacer(pairs...)
+	n.Title = r.Replace(msg.title)
+	n.Body = r.Replace(msg.body)
+	return n
+}
+
+// languageOf returns the base language of a locale, such as "en" for en-US
+func languageOf(locale string) string {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return fallbackLanguage
+	}
+	base, _ := tag.Base()
+	return base.String()
+}
@@ -0,0 +1,158 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const httpTimeout = 5 * time.Second
+
+// providerSpec describes how to verify a provider's OIDC ID tokens
+type providerSpec struct {
+	issuers []string
+	jwksURL string
+}
+
+var providerSpecs = map[entity.SocialProvider]providerSpec{
+	entity.ProviderGoogle: {
+		issuers: []string{"https://accounts.google.com", "accounts.google.com"},
+		jwksURL: "https://www.googleapis.com/oauth2/v3/certs",
+	},
+	entity.ProviderApple: {
+		issuers: []string{"https://appleid.apple.com"},
+		jwksURL: "https://appleid.apple.com/auth/keys",
+	},
+	entity.ProviderKakao: {
+		issuers: []string{"https://kauth.kakao.com"},
+		jwksURL: "https://kauth.kakao.com/.well-known/jwks.json",
+	},
+}
+
+// provider is a configured provider: its spec, accepted audiences and key cache
+type provider struct {
+	spec      providerSpec
+	audiences []string
+	keys      *jwksCache
+}
+
+// Verifier verifies OIDC ID tokens from Kakao, Google and Apple
+// Providers without configured client IDs are treated as unsupported
+type Verifier struct {
+	providers map[entity.SocialProvider]*provider
+}
+
+// NewVerifier creates a verifier for every provider that has client IDs configured
+func NewVerifier(cfg *config.Config) *Verifier {
+	client := &http.Client{Timeout: httpTimeout, Transport: otelhttp.NewTransport(nil)}
+	audiences := map[entity.SocialProvider][]string{
+		entity.ProviderGoogle: cfg.OAuth.GoogleClientIDs,
+		entity.ProviderApple:  cfg.OAuth.AppleClientIDs,
+		entity.ProviderKakao:  cfg.OAuth.KakaoAppKeys,
+	}
+
+	providers := make(map[entity.SocialProvider]*provider)
+	for name, spec := range providerSpecs {
+		if len(audiences[name]) == 0 {
+			continue
+		}
+		providers[name] = &provider{
+			spec:      spec,
+			audiences: audiences[name],
+			keys:      newJWKSCache(spec.jwksURL, client),
+		}
+	}
+
+	return &Verifier{providers: providers}
+}
+
+// idTokenClaims covers the claims used across providers
+type idTokenClaims struct {
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	Name          string       `json:"name"`
+	Nickname      string       `json:"nickname"` // Kakao
+	jwt.RegisteredClaims
+}
+
+// Verify checks the token signature, issuer, audience and expiry
+func (v *Verifier) Verify(ctx context.Context, name entity.SocialProvider, idToken string) (*entity.SocialIdentity, error) {
+	p, ok := v.providers[name]
+	if !ok {
+		return nil, entity.ErrUnsupportedProvider
+	}
+
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Name}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30*time.Second),
+	)
+
+	var claims idTokenClaims
+	_, err := parser.ParseWithClaims(idToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.keys.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, service.ErrInvalidIDToken
+	}
+
+	if !slices.Contains(p.spec.issuers, claims.Issuer) {
+		return nil, service.ErrInvalidIDToken
+	}
+	if !audienceMatches(claims.Audience, p.audiences) {
+		return nil, service.ErrInvalidIDToken
+	}
+	if claims.Subject == "" {
+		return nil, service.ErrInvalidIDToken
+	}
+
+	displayName := claims.Name
+	if displayName == "" {
+		displayName = claims.Nickname
+	}
+
+	return &entity.SocialIdentity{
+		Provider:      name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          displayName,
+	}, nil
+}
+
+func audienceMatches(tokenAudiences jwt.ClaimStrings, allowed []string) bool {
+	for _, aud := range tokenAudiences {
+		if slices.Contains(allowed, aud) {
+			return true
+		}
+	}
+	return false
+}
+
+// flexibleBool accepts both true and "true" (Apple sends booleans as strings)
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch t := v.(type) {
+	case bool:
+		*b = flexibleBool(t)
+	case string:
+		*b = flexibleBool(t == "true")
+	default:
+		*b = false
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// apiKeyModel is the GORM mapping of entity.APIKey
+type apiKeyModel struct {
+	ID         string  `gorm:"primaryKey;size:36"`
+	Name       string  `gorm:"size:100;not null"`
+	Prefix     string  `gorm:"size:16;not null"`
+	KeyHash    string  `gorm:"size:64;not null;uniqueIndex"`
+	CreatedBy  string  `gorm:"size:36;not null"`
+	UserID     *string `gorm:"size:36;index"` // NULL for service keys
+	Scopes     string  `gorm:"size:50"`       // comma-separated; empty for service keys
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (apiKeyModel) TableName() string {
+	return "api_keys"
+}
+
+func newAPIKeyModel(k *entity.APIKey) *apiKeyModel {
+	var userID *string
+	if k.IsPersonal() {
+		userID = &k.UserID
+	}
+	scopes := make([]string, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	return &apiKeyModel{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		KeyHash:    k.KeyHash,
+		CreatedBy:  k.CreatedBy,
+		UserID:     userID,
+		Scopes:     strings.Join(scopes, ","),
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+	}
+}
+
+func (m *apiKeyModel) toEntity() *entity.APIKey {
+	key := &entity.APIKey{
+		ID:         m.ID,
+		Name:       m.Name,
+		Prefix:     m.Prefix,
+		KeyHash:    m.KeyHash,
+		CreatedBy:  m.CreatedBy,
+		LastUsedAt: m.LastUsedAt,
+		RevokedAt:  m.RevokedAt,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+	if m.UserID != nil {
+		key.UserID = *m.UserID
+	}
+	if m.Scopes != "" {
+		for _, scope := range strings.Split(m.Scopes, ",") {
+			key.Scopes = append(key.Scopes, entity.APIKeyScope(scope))
+		}
+	}
+	return key
+}
+
+type apiKeyRepository struct {
+	db *database.DB
+}
+
+func NewAPIKeyRepository(db *database.DB) repository.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *entity.APIKey) error {
+	return r.db.WithContext(ctx).Create(newAPIKeyModel(key)).Error
+}
+
+func (r *apiKeyRepository) GetByID(ctx context.Context, id string) (*entity.APIKey, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	return r.findOne(ctx, "key_hash = ?", keyHash)
+}
+
+func (r *apiKeyRepository) List(ctx context.Context) ([]*entity.APIKey, error) {
+	return r.findMany(ctx, "user_id IS NULL")
+}
+
+func (r *apiKeyRepository) ListByUser(ctx context.Context, userID string) ([]*entity.APIKey, error) {
+	return r.findMany(ctx, "user_id = ?", userID)
+}
+
+func (r *apiKeyRepository) Rotate(ctx context.Context, id, prefix, keyHash string) error {
+	result := r.db.WithContext(ctx).
+		Model(&apiKeyModel{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"prefix":     prefix,
+			"key_hash":   keyHash,
+			"updated_at": time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	result := r.db.WithContext(ctx).
+		Model(&apiKeyModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"revoked_at": gorm.Expr("COALESCE(revoked_at, ?)", now),
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&apiKeyModel{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt.UTC()).Error
+}
+
+func (r *apiKeyRepository) findOne(ctx context.Context, query string, args ...interface{}) (*entity.APIKey, error) {
+	var model apiKeyModel
+	if err := r.db.WithContext(ctx).Where(query, args...).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *apiKeyRepository) findMany(ctx context.Context, query string, args ...interface{}) ([]*entity.APIKey, error) {
+	var models []apiKeyModel
+	if err := r.db.WithContext(ctx).Where(query, args...).Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]*entity.APIKey, 0, len(models))
+	for i := range models {
+		keys = append(keys, models[i].toEntity())
+	}
+	return keys, nil
+}
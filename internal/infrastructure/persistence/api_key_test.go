@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+func TestAPIKeyRepositoryListsServiceAndPersonalKeysApart(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAPIKeyRepository(newTestDB(t))
+
+	service, err := entity.NewAPIKey("batch", "admin")
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	personal, err := entity.NewUserAPIKey("sync", "grace", []entity.APIKeyScope{entity.APIKeyScopeRead, entity.APIKeyScopeWrite})
+	if err != nil {
+		t.Fatalf("NewUserAPIKey: %v", err)
+	}
+	for i, key := range []*entity.APIKey{service, personal} {
+		key.ID = []string{"service", "personal"}[i]
+		key.Prefix = "ptk_" + key.ID
+		key.KeyHash = "hash-" + key.ID
+		if err := repo.Create(ctx, key); err != nil {
+			t.Fatalf("Create(%s): %v", key.ID, err)
+		}
+	}
+
+	keys, err := repo.List(ctx)
+	if err != nil || len(keys) != 1 || keys[0].ID != "service" || keys[0].IsPersonal() {
+		t.Errorf("List = %+v, err = %v, want only the service key", keys, err)
+	}
+
+	keys, err = repo.ListByUser(ctx, "grace")
+	if err != nil || len(keys) != 1 || keys[0].ID != "personal" {
+		t.Fatalf("ListByUser = %+v, err = %v, want grace's key", keys, err)
+	}
+	if want := personal.Scopes; keys[0].UserID != "grace" || !slices.Equal(keys[0].Scopes, want) {
+		t.Errorf("key = %+v, want grace's key with scopes %v", keys[0], want)
+	}
+}
@@ -0,0 +1,60 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+)
+
+// auditLogModel is the GORM mapping of entity.AuditEntry
+// Rows are only ever inserted; ActorID and TargetID are plain columns so entries outlive purged accounts
+type auditLogModel struct {
+	ID         string `gorm:"primaryKey;size:36"`
+	Action     string `gorm:"size:50;not null;index:idx_audit_logs_action_created"`
+	ActorID    string `gorm:"size:36;index:idx_audit_logs_actor_created"`
+	TargetType string `gorm:"size:20"`
+	TargetID   string `gorm:"size:36;index:idx_audit_logs_target"`
+	// Details is the JSON-encoded entity.AuditEntry.Details
+	Details   string    `gorm:"size:2000"`
+	RequestID string    `gorm:"size:100"`
+	IP        string    `gorm:"size:45"`
+	CreatedAt time.Time `gorm:"not null;index:idx_audit_logs_action_created;index:idx_audit_logs_actor_created"`
+}
+
+func (auditLogModel) TableName() string {
+	return "audit_logs"
+}
+
+type auditLogRepository struct {
+	db *database.DB
+}
+
+func NewAuditLogRepository(db *database.DB) repository.AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+func (r *auditLogRepository) Append(ctx context.Context, entry *entity.AuditEntry) error {
+	details := ""
+	if len(entry.Details) > 0 {
+		raw, err := json.Marshal(entry.Details)
+		if err != nil {
+			return err
+		}
+		details = string(raw)
+	}
+	return r.db.WithContext(ctx).Create(&auditLogModel{
+		ID:         entry.ID,
+		Action:     string(entry.Action),
+		ActorID:    entry.ActorID,
+		TargetType: string(entry.TargetType),
+		TargetID:   entry.TargetID,
+		Details:    details,
+		RequestID:  entry.RequestID,
+		IP:         entry.IP,
+		CreatedAt:  entry.CreatedAt,
+	}).Error
+}
@@ -0,0 +1,77 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// blockModel is the GORM mapping of entity.Block
+type blockModel struct {
+	BlockerID string `gorm:"primaryKey;size:36"`
+	BlockedID string `gorm:"primaryKey;size:36;index"`
+	CreatedAt time.Time
+}
+
+func (blockModel) TableName() string {
+	return "user_blocks"
+}
+
+// notBlockedBy is a scope that drops rows whose column holds a user blocked by viewerID
+// Every list query shown to a user must apply it to the author/sender column
+func notBlockedBy(viewerID, column string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(column+" NOT IN (SELECT blocked_id FROM user_blocks WHERE blocker_id = ?)", viewerID)
+	}
+}
+
+type blockRepository struct {
+	db *database.DB
+}
+
+func NewBlockRepository(db *database.DB) repository.BlockRepository {
+	return &blockRepository{db: db}
+}
+
+func (r *blockRepository) Create(ctx context.Context, block *entity.Block) error {
+	err := r.db.WithContext(ctx).Create(&blockModel{
+		BlockerID: block.BlockerID,
+		BlockedID: block.BlockedID,
+		CreatedAt: block.CreatedAt,
+	}).Error
+	if isUniqueViolation(err) {
+		return nil
+	}
+	return err
+}
+
+func (r *blockRepository) Delete(ctx context.Context, blockerID, blockedID string) error {
+	return r.db.WithContext(ctx).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Delete(&blockModel{}).Error
+}
+
+func (r *blockRepository) List(ctx context.Context, blockerID string) ([]*entity.Block, error) {
+	var models []blockModel
+	err := r.db.WithContext(ctx).
+		Where("blocker_id = ?", blockerID).
+		Order("created_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*entity.Block, 0, len(models))
+	for _, m := range models {
+		blocks = append(blocks, &entity.Block{
+			BlockerID: m.BlockerID,
+			BlockedID: m.BlockedID,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return blocks, nil
+}
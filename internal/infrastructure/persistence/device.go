@@ -0,0 +1,126 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// deviceModel is the GORM mapping of entity.Device
+type deviceModel struct {
+	ID         string `gorm:"primaryKey;size:36"`
+	UserID     string `gorm:"size:36;not null;uniqueIndex:idx_devices_user_device"`
+	DeviceID   string `gorm:"size:400;not null;uniqueIndex:idx_devices_user_device"` // 100 characters in UTF-8
+	Token      string `gorm:"size:512;not null;uniqueIndex"`
+	Platform   string `gorm:"size:10;not null"`
+	AppVersion string `gorm:"size:80"` // 20 characters in UTF-8
+	Locale     string `gorm:"size:35"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (deviceModel) TableName() string {
+	return "user_devices"
+}
+
+func (m *deviceModel) toEntity() *entity.Device {
+	return &entity.Device{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		DeviceID:   m.DeviceID,
+		Token:      m.Token,
+		Platform:   entity.DevicePlatform(m.Platform),
+		AppVersion: m.AppVersion,
+		Locale:     m.Locale,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+type deviceRepository struct {
+	db *database.DB
+}
+
+func NewDeviceRepository(db *database.DB) repository.DeviceRepository {
+	return &deviceRepository{db: db}
+}
+
+func (r *deviceRepository) Register(ctx context.Context, device *entity.Device) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("token = ? OR (user_id = ? AND device_id = ?)", device.Token, device.UserID, device.DeviceID).
+			Delete(&deviceModel{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&deviceModel{
+			ID:         device.ID,
+			UserID:     device.UserID,
+			DeviceID:   device.DeviceID,
+			Token:      device.Token,
+			Platform:   string(device.Platform),
+			AppVersion: device.AppVersion,
+			Locale:     device.Locale,
+			CreatedAt:  device.CreatedAt,
+			UpdatedAt:  device.UpdatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		var stale []string
+		err = tx.Model(&deviceModel{}).
+			Where("user_id = ?", device.UserID).
+			Order("updated_at DESC, id DESC").
+			Offset(entity.MaxDevicesPerUser).
+			Pluck("id", &stale).Error
+		if err != nil || len(stale) == 0 {
+			return err
+		}
+		return tx.Where("id IN ?", stale).Delete(&deviceModel{}).Error
+	})
+}
+
+func (r *deviceRepository) Delete(ctx context.Context, userID, deviceID string) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND device_id = ?", userID, deviceID).
+		Delete(&deviceModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrDeviceNotFound
+	}
+	return nil
+}
+
+func (r *deviceRepository) ListByUsers(ctx context.Context, userIDs []string) ([]*entity.Device, error) {
+	devices := make([]*entity.Device, 0, len(userIDs))
+	// Oracle allows at most 1000 expressions in an IN list
+	for start := 0; start < len(userIDs); start += maxInListSize {
+		end := min(start+maxInListSize, len(userIDs))
+
+		var models []deviceModel
+		if err := r.db.WithContext(ctx).Where("user_id IN ?", userIDs[start:end]).Find(&models).Error; err != nil {
+			return nil, err
+		}
+		for i := range models {
+			devices = append(devices, models[i].toEntity())
+		}
+	}
+	return devices, nil
+}
+
+func (r *deviceRepository) DeleteTokens(ctx context.Context, tokens []string) error {
+	for start := 0; start < len(tokens); start += maxInListSize {
+		end := min(start+maxInListSize, len(tokens))
+		if err := r.db.WithContext(ctx).Where("token IN ?", tokens[start:end]).Delete(&deviceModel{}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// emailVerificationModel is the GORM mapping of entity.EmailVerificationToken
+type emailVerificationModel struct {
+	ID        string    `gorm:"primaryKey;size:36"`
+	UserID    string    `gorm:"size:36;not null;index"`
+	Email     string    `gorm:"size:255;not null"`
+	TokenHash string    `gorm:"size:64;not null;uniqueIndex"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (emailVerificationModel) TableName() string {
+	return "email_verification_tokens"
+}
+
+func (m *emailVerificationModel) toEntity() *entity.EmailVerificationToken {
+	return &entity.EmailVerificationToken{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Email:     m.Email,
+		TokenHash: m.TokenHash,
+		ExpiresAt: m.ExpiresAt,
+		UsedAt:    m.UsedAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type emailVerificationRepository struct {
+	db *database.DB
+}
+
+func NewEmailVerificationRepository(db *database.DB) repository.EmailVerificationRepository {
+	return &emailVerificationRepository{db: db}
+}
+
+func (r *emailVerificationRepository) Create(ctx context.Context, token *entity.EmailVerificationToken) error {
+	return r.db.WithContext(ctx).Create(&emailVerificationModel{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		Email:     token.Email,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+	}).Error
+}
+
+func (r *emailVerificationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.EmailVerificationToken, error) {
+	var model emailVerificationModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrEmailVerificationTokenNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *emailVerificationRepository) MarkUsed(ctx context.Context, id string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&emailVerificationModel{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now().UTC())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
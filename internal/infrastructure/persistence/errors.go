@@ -0,0 +1,84 @@
+package persistence
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/glebarez/go-sqlite"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sijms/go-ora/v2/network"
+)
+
+// Unique violation codes of each supported database
+const (
+	oraUniqueViolation    = 1       // ORA-00001: unique constraint violated
+	pgUniqueViolation     = "23505" // unique_violation
+	mysqlDuplicateEntry   = 1062    // ER_DUP_ENTRY
+	sqliteConstraint      = 19      // SQLITE_CONSTRAINT, the primary code of constraint failures
+	sqliteUniqueViolation = "UNIQUE constraint failed: "
+)
+
+// maxInListSize is Oracle's limit on expressions in an IN list (ORA-01795)
+const maxInListSize = 1000
+
+// isUniqueViolation reports whether err is a unique constraint violation
+func isUniqueViolation(err error) bool {
+	_, ok := uniqueViolation(err)
+	return ok
+}
+
+// isUniqueViolationOf reports whether err violated the named unique index
+func isUniqueViolationOf(err error, index string) bool {
+	name, ok := uniqueViolation(err)
+	return ok && strings.EqualFold(name, index)
+}
+
+// uniqueViolation reports whether err is a unique constraint violation, and of which index
+//   - Oracle reports the constraint as (SCHEMA.NAME) in the message
+//   - PostgreSQL reports the constraint name
+//   - MySQL reports the key as 'NAME' or 'TABLE.NAME' at the end of the message
+//   - SQLite only reports the columns, as TABLE.COLUMN; the index is assumed to be named
+//     idx_TABLE_COLUMN, as GORM names single-column indexes
+func uniqueViolation(err error) (string, bool) {
+	var oraErr *network.OracleError
+	if errors.As(err, &oraErr) {
+		if oraErr.ErrCode != oraUniqueViolation {
+			return "", false
+		}
+		// Newer releases append the table and columns in parentheses too, so take the first
+		_, constraint, _ := strings.Cut(oraErr.Error(), "(")
+		constraint, _, _ = strings.Cut(constraint, ")")
+		return afterLast(constraint, "."), true
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName, pgErr.Code == pgUniqueViolation
+	}
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		if mysqlErr.Number != mysqlDuplicateEntry {
+			return "", false
+		}
+		key := strings.TrimSuffix(afterLast(mysqlErr.Message, "for key '"), "'")
+		return afterLast(key, "."), true
+	}
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		_, columns, found := strings.Cut(sqliteErr.Error(), sqliteUniqueViolation)
+		if sqliteErr.Code()&0xff != sqliteConstraint || !found {
+			return "", false
+		}
+		columns, _, _ = strings.Cut(columns, " (") // the result code follows
+		return "idx_" + strings.NewReplacer(".", "_", ", ", "_").Replace(columns), true
+	}
+	return "", false
+}
+
+// afterLast returns the part of s after the last sep, or s when it has none
+func afterLast(s, sep string) string {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[i+len(sep):]
+	}
+	return s
+}
@@ -0,0 +1,122 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// idempotencyModel is the GORM mapping of entity.IdempotentRequest, keyed by user and key
+type idempotencyModel struct {
+	UserID         string `gorm:"primaryKey;size:36"`
+	IdempotencyKey string `gorm:"primaryKey;size:255"`
+	Fingerprint    string `gorm:"size:64;not null"`
+	Status         int    `gorm:"not null;default:0"`
+	ContentType    string `gorm:"size:100"`
+	Body           []byte
+	CreatedAt      time.Time
+	ExpiresAt      time.Time `gorm:"not null;index"`
+}
+
+func (idempotencyModel) TableName() string {
+	return "idempotency_keys"
+}
+
+func (m *idempotencyModel) toEntity() *entity.IdempotentRequest {
+	return &entity.IdempotentRequest{
+		UserID:      m.UserID,
+		Key:         m.IdempotencyKey,
+		Fingerprint: m.Fingerprint,
+		Status:      m.Status,
+		ContentType: m.ContentType,
+		Body:        m.Body,
+		CreatedAt:   m.CreatedAt,
+		ExpiresAt:   m.ExpiresAt,
+	}
+}
+
+type idempotencyRepository struct {
+	db *database.DB
+}
+
+func NewIdempotencyRepository(db *database.DB) repository.IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+func (r *idempotencyRepository) Begin(ctx context.Context, req *entity.IdempotentRequest) (*entity.IdempotentRequest, error) {
+	db := r.db.WithContext(ctx)
+	model := &idempotencyModel{
+		UserID:         req.UserID,
+		IdempotencyKey: req.Key,
+		Fingerprint:    req.Fingerprint,
+		CreatedAt:      req.CreatedAt.UTC(),
+		ExpiresAt:      req.ExpiresAt.UTC(),
+	}
+
+	// The primary key lets exactly one of two concurrent retries record the request
+	err := db.Create(model).Error
+	if err == nil || !isUniqueViolation(err) {
+		return nil, err
+	}
+	existing, err := r.get(ctx, req.UserID, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.ExpiresAt.After(req.CreatedAt) {
+		return existing, nil
+	}
+
+	// The earlier request expired without being cleaned up yet, or was abandoned since: take its place
+	if err := db.Where("user_id = ? AND idempotency_key = ? AND expires_at <= ?", req.UserID, req.Key, req.CreatedAt.UTC()).
+		Delete(&idempotencyModel{}).Error; err != nil {
+		return nil, err
+	}
+	err = db.Create(model).Error
+	if err == nil || !isUniqueViolation(err) {
+		return nil, err
+	}
+	// Another retry took its place first
+	existing, getErr := r.get(ctx, req.UserID, req.Key)
+	if getErr != nil || existing == nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// get returns the user's request under key, nil when there is none
+func (r *idempotencyRepository) get(ctx context.Context, userID, key string) (*entity.IdempotentRequest, error) {
+	var model idempotencyModel
+	err := r.db.WithContext(ctx).Where("user_id = ? AND idempotency_key = ?", userID, key).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, userID, key string, status int, contentType string, body []byte) error {
+	return r.db.WithContext(ctx).Model(&idempotencyModel{}).
+		Where("user_id = ? AND idempotency_key = ?", userID, key).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"content_type": contentType,
+			"body":         body,
+		}).Error
+}
+
+func (r *idempotencyRepository) Abandon(ctx context.Context, userID, key string) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND idempotency_key = ? AND status = 0", userID, key).
+		Delete(&idempotencyModel{}).Error
+}
+
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, before time.Time) error {
+	return r.db.WithContext(ctx).Where("expires_at <= ?", before.UTC()).Delete(&idempotencyModel{}).Error
+}
@@ -0,0 +1,80 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm/clause"
+)
+
+// jobLockModel is the GORM mapping of entity.JobLock; one row per scheduled job
+type jobLockModel struct {
+	Name        string `gorm:"primaryKey;size:50"`
+	Owner       string `gorm:"size:36"`
+	LockedUntil *time.Time
+	LastRunAt   *time.Time
+}
+
+func (jobLockModel) TableName() string {
+	return "job_locks"
+}
+
+type jobLockRepository struct {
+	db *database.DB
+}
+
+func NewJobLockRepository(db *database.DB) repository.JobLockRepository {
+	return &jobLockRepository{db: db}
+}
+
+func (r *jobLockRepository) Acquire(ctx context.Context, name, owner string, now, until time.Time) (*entity.JobLock, bool, error) {
+	db := r.db.WithContext(ctx)
+
+	// The first instance to see a job creates its row; later calls and the instances that lose
+	// the race leave it alone, without a unique violation for every tick
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&jobLockModel{Name: name}).Error; err != nil {
+		return nil, false, err
+	}
+
+	// A single conditional update decides the winner, so two instances can never both hold the lock
+	result := db.Model(&jobLockModel{}).
+		Where("name = ? AND (locked_until IS NULL OR locked_until < ?)", name, now.UTC()).
+		Updates(map[string]interface{}{
+			"owner":        owner,
+			"locked_until": until.UTC(),
+		})
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, false, nil
+	}
+
+	var model jobLockModel
+	if err := db.Where("name = ?", name).First(&model).Error; err != nil {
+		return nil, false, err
+	}
+	return &entity.JobLock{
+		Name:        model.Name,
+		Owner:       model.Owner,
+		LockedUntil: model.LockedUntil,
+		LastRunAt:   model.LastRunAt,
+	}, true, nil
+}
+
+func (r *jobLockRepository) Release(ctx context.Context, name, owner string, lastRunAt *time.Time) error {
+	updates := map[string]interface{}{
+		"owner":        "",
+		"locked_until": nil,
+	}
+	if lastRunAt != nil {
+		updates["last_run_at"] = lastRunAt.UTC()
+	}
+	return r.db.WithContext(ctx).
+		Model(&jobLockModel{}).
+		Where("name = ? AND owner = ?", name, owner).
+		Updates(updates).Error
+}
@@ -0,0 +1,43 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestJobLockRepositoryAcquire(t *testing.T) {
+	ctx := context.Background()
+	repo := NewJobLockRepository(newTestDB(t))
+	now := time.Now().UTC().Truncate(time.Second)
+
+	lock, ok, err := repo.Acquire(ctx, "digest", "a", now, now.Add(time.Minute))
+	if err != nil || !ok {
+		t.Fatalf("first Acquire = %v, %v, want the lock", ok, err)
+	}
+	if lock.Owner != "a" || lock.LastRunAt != nil {
+		t.Errorf("lock = %+v, want a fresh lock owned by a", lock)
+	}
+
+	// The row exists now; contending for it must not fail on the insert
+	if _, ok, err := repo.Acquire(ctx, "digest", "b", now, now.Add(time.Minute)); err != nil || ok {
+		t.Fatalf("Acquire while held = %v, %v, want refused without an error", ok, err)
+	}
+
+	if err := repo.Release(ctx, "digest", "a", &now); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	lock, ok, err = repo.Acquire(ctx, "digest", "b", now.Add(time.Second), now.Add(time.Minute))
+	if err != nil || !ok {
+		t.Fatalf("Acquire after release = %v, %v, want the lock", ok, err)
+	}
+	if lock.Owner != "b" || lock.LastRunAt == nil || !lock.LastRunAt.Equal(now) {
+		t.Errorf("lock = %+v, want it owned by b with the last run kept", lock)
+	}
+
+	// An expired lock is taken over
+	later := now.Add(2 * time.Minute)
+	if _, ok, err := repo.Acquire(ctx, "digest", "c", later, later.Add(time.Minute)); err != nil || !ok {
+		t.Fatalf("Acquire after expiry = %v, %v, want the lock", ok, err)
+	}
+}
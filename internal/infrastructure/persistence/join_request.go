@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// joinRequestModel is the GORM mapping of entity.JoinRequest
+type joinRequestModel struct {
+	ID        string `gorm:"primaryKey;size:36"`
+	RoomID    string `gorm:"size:36;not null;index:idx_join_requests_room_status"`
+	UserID    string `gorm:"size:36;not null;index"`
+	Message   string `gorm:"size:800"` // 200 characters in UTF-8
+	Status    string `gorm:"size:10;not null;index:idx_join_requests_room_status"`
+	DecidedBy string `gorm:"size:36"`
+	DecidedAt *time.Time
+	CreatedAt time.Time
+}
+
+func (joinRequestModel) TableName() string {
+	return "room_join_requests"
+}
+
+func newJoinRequestModel(r *entity.JoinRequest) *joinRequestModel {
+	return &joinRequestModel{
+		ID:        r.ID,
+		RoomID:    r.RoomID,
+		UserID:    r.UserID,
+		Message:   r.Message,
+		Status:    string(r.Status),
+		DecidedBy: r.DecidedBy,
+		DecidedAt: r.DecidedAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func (m *joinRequestModel) toEntity() *entity.JoinRequest {
+	return &entity.JoinRequest{
+		ID:        m.ID,
+		RoomID:    m.RoomID,
+		UserID:    m.UserID,
+		Message:   m.Message,
+		Status:    entity.JoinRequestStatus(m.Status),
+		DecidedBy: m.DecidedBy,
+		DecidedAt: m.DecidedAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type joinRequestRepository struct {
+	db *database.DB
+}
+
+func NewJoinRequestRepository(db *database.DB) repository.JoinRequestRepository {
+	return &joinRequestRepository{db: db}
+}
+
+func (r *joinRequestRepository) Create(ctx context.Context, request *entity.JoinRequest) error {
+	return r.db.WithContext(ctx).Create(newJoinRequestModel(request)).Error
+}
+
+func (r *joinRequestRepository) GetByID(ctx context.Context, id string) (*entity.JoinRequest, error) {
+	var model joinRequestModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrJoinRequestNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *joinRequestRepository) HasPending(ctx context.Context, roomID, userID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&joinRequestModel{}).
+		Where("room_id = ? AND user_id = ? AND status = ?", roomID, userID, string(entity.JoinRequestPending)).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *joinRequestRepository) ListPending(ctx context.Context, roomID string) ([]*entity.JoinRequest, error) {
+	var models []joinRequestModel
+	err := r.db.WithContext(ctx).
+		Where("room_id = ? AND status = ?", roomID, string(entity.JoinRequestPending)).
+		Order("created_at, id").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*entity.JoinRequest, 0, len(models))
+	for i := range models {
+		requests = append(requests, models[i].toEntity())
+	}
+	return requests, nil
+}
+
+func (r *joinRequestRepository) Approve(ctx context.Context, id, decidedBy string, member *entity.RoomMember, at time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := decideJoinRequest(tx, id, decidedBy, entity.JoinRequestApproved, at); err != nil {
+			return err
+		}
+
+		err := addRoomMember(tx, member)
+		if err != nil && !errors.Is(err, entity.ErrAlreadyRoomMember) {
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *joinRequestRepository) Reject(ctx context.Context, id, decidedBy string, at time.Time) error {
+	return decideJoinRequest(r.db.WithContext(ctx), id, decidedBy, entity.JoinRequestRejected, at)
+}
+
+// decideJoinRequest moves a pending request to its final status
+// The status condition makes concurrent decisions on the same request mutually exclusive
+func decideJoinRequest(db *gorm.DB, id, decidedBy string, status entity.JoinRequestStatus, at time.Time) error {
+	result := db.Model(&joinRequestModel{}).
+		Where("id = ? AND status = ?", id, string(entity.JoinRequestPending)).
+		Updates(map[string]interface{}{
+			"status":     string(status),
+			"decided_by": decidedBy,
+			"decided_at": at.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrJoinRequestNotPending
+	}
+	return nil
+}
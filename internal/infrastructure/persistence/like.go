@@ -0,0 +1,17 @@
+package persistence
+
+import "strings"
+
+// likeEscaper escapes LIKE wildcards; queries must declare ESCAPE '!'
+// A backslash would be an escape inside MySQL string literals, so it cannot be the LIKE escape
+var likeEscaper = strings.NewReplacer(`!`, `!!`, `%`, `!%`, `_`, `!_`)
+
+// likePrefix returns a LIKE pattern matching values that start with s literally
+func likePrefix(s string) string {
+	return likeEscaper.Replace(s) + "%"
+}
+
+// likeContains returns a LIKE pattern matching values that contain s literally
+func likeContains(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
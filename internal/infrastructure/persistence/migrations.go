@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// migrationFiles holds the schema migrations of each database driver, in migrations/{driver}
+// Every schema change is a new NNNN_name.up.sql and NNNN_name.down.sql pair for each driver;
+// applied files must never be edited, which the migration checksums enforce
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// Migrations returns the migration files of the database driver
+func Migrations(driver string) (fs.FS, error) {
+	return fs.Sub(migrationFiles, "migrations/"+driver)
+}
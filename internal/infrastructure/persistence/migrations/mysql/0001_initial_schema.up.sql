@@ -0,0 +1,409 @@
+CREATE TABLE `users` (
+    `id` varchar(36),
+    `email` varchar(255),
+    `nickname` varchar(40) NOT NULL,
+    `nickname_key` varchar(80),
+    `password_hash` varchar(100),
+    `role` varchar(20) NOT NULL DEFAULT 'user',
+    `bio` varchar(800),
+    `profile_image_url` varchar(500),
+    `timezone` varchar(64) NOT NULL DEFAULT 'Asia/Seoul',
+    `locale` varchar(35) NOT NULL DEFAULT 'ko-KR',
+    `hidden_from_search` boolean NOT NULL DEFAULT false,
+    `mute_invites` boolean NOT NULL DEFAULT false,
+    `mute_answered` boolean NOT NULL DEFAULT false,
+    `mute_comments` boolean NOT NULL DEFAULT false,
+    `mute_reminders` boolean NOT NULL DEFAULT false,
+    `mute_announcements` boolean NOT NULL DEFAULT false,
+    `digest_frequency` varchar(10) NOT NULL DEFAULT 'off',
+    `email_verified_at` datetime(3) NULL,
+    `purge_at` datetime(3) NULL,
+    `suspended_at` datetime(3) NULL,
+    `suspension_reason` varchar(2000),
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_users_email` (`email`),
+    UNIQUE INDEX `idx_users_nickname_key` (`nickname_key`),
+    INDEX `idx_users_purge_at` (`purge_at`)
+);
+
+CREATE TABLE `social_accounts` (
+    `id` varchar(36),
+    `user_id` varchar(36) NOT NULL,
+    `provider` varchar(20) NOT NULL,
+    `provider_user_id` varchar(255) NOT NULL,
+    `email` varchar(255),
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_social_user_provider` (`user_id`,`provider`),
+    UNIQUE INDEX `idx_social_provider_subject` (`provider`,`provider_user_id`)
+);
+
+CREATE TABLE `refresh_tokens` (
+    `id` varchar(36),
+    `family_id` varchar(36) NOT NULL,
+    `user_id` varchar(36) NOT NULL,
+    `device_id` varchar(100) NOT NULL,
+    `expires_at` datetime(3) NOT NULL,
+    `used_at` datetime(3) NULL,
+    `revoked_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_refresh_tokens_family_id` (`family_id`),
+    INDEX `idx_refresh_tokens_user_id` (`user_id`),
+    INDEX `idx_refresh_tokens_expires_at` (`expires_at`)
+);
+
+CREATE TABLE `revoked_tokens` (
+    `token_id` varchar(36),
+    `user_id` varchar(36) NOT NULL,
+    `expires_at` datetime(3) NOT NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`token_id`),
+    INDEX `idx_revoked_tokens_expires_at` (`expires_at`),
+    INDEX `idx_revoked_tokens_user_id` (`user_id`)
+);
+
+CREATE TABLE `password_reset_tokens` (
+    `id` varchar(36),
+    `user_id` varchar(36) NOT NULL,
+    `token_hash` varchar(64) NOT NULL,
+    `expires_at` datetime(3) NOT NULL,
+    `used_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_password_reset_tokens_user_id` (`user_id`),
+    UNIQUE INDEX `idx_password_reset_tokens_token_hash` (`token_hash`)
+);
+
+CREATE TABLE `email_verification_tokens` (
+    `id` varchar(36),
+    `user_id` varchar(36) NOT NULL,
+    `email` varchar(255) NOT NULL,
+    `token_hash` varchar(64) NOT NULL,
+    `expires_at` datetime(3) NOT NULL,
+    `used_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_email_verification_tokens_user_id` (`user_id`),
+    UNIQUE INDEX `idx_email_verification_tokens_token_hash` (`token_hash`)
+);
+
+CREATE TABLE `api_keys` (
+    `id` varchar(36),
+    `name` varchar(100) NOT NULL,
+    `prefix` varchar(16) NOT NULL,
+    `key_hash` varchar(64) NOT NULL,
+    `created_by` varchar(36) NOT NULL,
+    `last_used_at` datetime(3) NULL,
+    `revoked_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_api_keys_key_hash` (`key_hash`)
+);
+
+CREATE TABLE `user_two_factor` (
+    `user_id` varchar(36),
+    `secret` varchar(64) NOT NULL,
+    `enabled_at` datetime(3) NULL,
+    `last_used_step` bigint NOT NULL DEFAULT 0,
+    `failed_attempts` bigint NOT NULL DEFAULT 0,
+    `locked_until` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`user_id`)
+);
+
+CREATE TABLE `two_factor_backup_codes` (
+    `id` varchar(36),
+    `user_id` varchar(36) NOT NULL,
+    `code_hash` varchar(64) NOT NULL,
+    `used_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_two_factor_backup_codes_user_id` (`user_id`)
+);
+
+CREATE TABLE `user_blocks` (
+    `blocker_id` varchar(36),
+    `blocked_id` varchar(36),
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`blocker_id`,`blocked_id`),
+    INDEX `idx_user_blocks_blocked_id` (`blocked_id`)
+);
+
+CREATE TABLE `rooms` (
+    `id` varchar(36),
+    `name` varchar(200) NOT NULL,
+    `description` varchar(2000),
+    `visibility` varchar(10) NOT NULL DEFAULT 'private',
+    `category` varchar(20),
+    `owner_id` varchar(36) NOT NULL,
+    `member_cap` bigint NOT NULL DEFAULT 0,
+    `reminder_time` varchar(5),
+    `post_policy` varchar(20) NOT NULL DEFAULT 'members',
+    `cover_image_key` varchar(200),
+    `cover_image_url` varchar(500),
+    `archived_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_rooms_visibility` (`visibility`),
+    INDEX `idx_rooms_category` (`category`),
+    INDEX `idx_rooms_owner_id` (`owner_id`)
+);
+
+CREATE TABLE `room_tags` (
+    `room_id` varchar(36),
+    `tag` varchar(80),
+    PRIMARY KEY (`room_id`,`tag`),
+    INDEX `idx_room_tags_tag` (`tag`)
+);
+
+CREATE TABLE `room_members` (
+    `room_id` varchar(36),
+    `user_id` varchar(36),
+    `role` varchar(20) NOT NULL DEFAULT 'member',
+    `invited_by` varchar(36),
+    `muted` boolean NOT NULL DEFAULT false,
+    `joined_at` datetime(3) NULL,
+    PRIMARY KEY (`room_id`,`user_id`),
+    INDEX `idx_room_members_user_id` (`user_id`)
+);
+
+CREATE TABLE `room_invites` (
+    `id` varchar(36),
+    `room_id` varchar(36) NOT NULL,
+    `code` varchar(16) NOT NULL,
+    `created_by` varchar(36) NOT NULL,
+    `max_uses` bigint NOT NULL,
+    `use_count` bigint NOT NULL,
+    `expires_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_room_invites_room_id` (`room_id`),
+    UNIQUE INDEX `idx_room_invites_code` (`code`),
+    INDEX `idx_room_invites_created_by` (`created_by`)
+);
+
+CREATE TABLE `room_join_requests` (
+    `id` varchar(36),
+    `room_id` varchar(36) NOT NULL,
+    `user_id` varchar(36) NOT NULL,
+    `message` varchar(800),
+    `status` varchar(10) NOT NULL,
+    `decided_by` varchar(36),
+    `decided_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_join_requests_room_status` (`room_id`,`status`),
+    INDEX `idx_room_join_requests_user_id` (`user_id`)
+);
+
+CREATE TABLE `room_announcements` (
+    `id` varchar(36),
+    `room_id` varchar(36) NOT NULL,
+    `author_id` varchar(36) NOT NULL,
+    `body` varchar(4000) NOT NULL,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_room_announcements_room_created` (`room_id`,`created_at`),
+    INDEX `idx_room_announcements_author_id` (`author_id`)
+);
+
+CREATE TABLE `prayer_topics` (
+    `id` varchar(36),
+    `room_id` varchar(36) NOT NULL,
+    `author_id` varchar(36) NOT NULL,
+    `title` varchar(400) NOT NULL,
+    `private` boolean NOT NULL DEFAULT false,
+    `recurrence` varchar(10),
+    `recurrence_paused` boolean NOT NULL DEFAULT false,
+    `next_recurrence_at` datetime(3) NULL,
+    `answered_at` datetime(3) NULL,
+    `testimony` varchar(4000),
+    `comment_count` bigint NOT NULL DEFAULT 0,
+    `reaction_count` bigint NOT NULL DEFAULT 0,
+    `deleted_at` datetime(3) NULL,
+    `deleted_by` varchar(36),
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_prayer_topics_next_recurrence_at` (`next_recurrence_at`),
+    INDEX `idx_prayer_topics_deleted_at` (`deleted_at`),
+    INDEX `idx_prayer_topics_room_created` (`room_id`,`created_at`),
+    INDEX `idx_prayer_topics_room_answered` (`room_id`,`answered_at`),
+    INDEX `idx_prayer_topics_author_id` (`author_id`)
+);
+
+CREATE TABLE `prayer_topic_tags` (
+    `topic_id` varchar(36),
+    `tag` varchar(80),
+    `room_id` varchar(36) NOT NULL,
+    PRIMARY KEY (`topic_id`,`tag`),
+    INDEX `idx_prayer_tags_room_tag` (`room_id`,`tag`)
+);
+
+CREATE TABLE `prayer_contents` (
+    `id` varchar(36),
+    `topic_id` varchar(36) NOT NULL,
+    `room_id` varchar(36) NOT NULL,
+    `author_id` varchar(36) NOT NULL,
+    `body` varchar(4000) NOT NULL,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_prayer_contents_topic_created` (`topic_id`,`created_at`),
+    INDEX `idx_prayer_contents_room_id` (`room_id`),
+    INDEX `idx_prayer_contents_author_id` (`author_id`)
+);
+
+CREATE TABLE `prayer_comments` (
+    `id` varchar(36),
+    `topic_id` varchar(36) NOT NULL,
+    `room_id` varchar(36) NOT NULL,
+    `parent_id` varchar(36),
+    `author_id` varchar(36) NOT NULL,
+    `body` varchar(2000) NOT NULL,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_prayer_comments_room_id` (`room_id`),
+    INDEX `idx_prayer_comments_parent_id` (`parent_id`),
+    INDEX `idx_prayer_comments_author_id` (`author_id`),
+    INDEX `idx_prayer_comments_topic_created` (`topic_id`,`created_at`)
+);
+
+CREATE TABLE `prayer_comment_mentions` (
+    `comment_id` varchar(36),
+    `user_id` varchar(36),
+    PRIMARY KEY (`comment_id`,`user_id`),
+    INDEX `idx_prayer_comment_mentions_user_id` (`user_id`)
+);
+
+CREATE TABLE `prayer_reactions` (
+    `topic_id` varchar(36),
+    `user_id` varchar(36),
+    `room_id` varchar(36) NOT NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`topic_id`,`user_id`),
+    INDEX `idx_prayer_reactions_user_id` (`user_id`),
+    INDEX `idx_prayer_reactions_room_id` (`room_id`)
+);
+
+CREATE TABLE `room_exports` (
+    `id` varchar(36),
+    `room_id` varchar(36) NOT NULL,
+    `requested_by` varchar(36) NOT NULL,
+    `format` varchar(10) NOT NULL,
+    `status` varchar(10) NOT NULL,
+    `file_key` varchar(200),
+    `started_at` datetime(3) NULL,
+    `completed_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_room_exports_room_user` (`room_id`,`requested_by`),
+    INDEX `idx_room_exports_status` (`status`),
+    INDEX `idx_room_exports_created_at` (`created_at`)
+);
+
+CREATE TABLE `user_devices` (
+    `id` varchar(36),
+    `user_id` varchar(36) NOT NULL,
+    `device_id` varchar(400) NOT NULL,
+    `token` varchar(512) NOT NULL,
+    `platform` varchar(10) NOT NULL,
+    `app_version` varchar(80),
+    `locale` varchar(35),
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_devices_user_device` (`user_id`,`device_id`),
+    UNIQUE INDEX `idx_user_devices_token` (`token`)
+);
+
+CREATE TABLE `job_locks` (
+    `name` varchar(50),
+    `owner` varchar(36),
+    `locked_until` datetime(3) NULL,
+    `last_run_at` datetime(3) NULL,
+    PRIMARY KEY (`name`)
+);
+
+CREATE TABLE `notifications` (
+    `id` varchar(36),
+    `user_id` varchar(36) NOT NULL,
+    `type` varchar(50) NOT NULL,
+    `title` varchar(400) NOT NULL,
+    `body` varchar(4000),
+    `data` varchar(1000),
+    `digest_pending` boolean NOT NULL DEFAULT false,
+    `read_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_notifications_user_created` (`user_id`,`created_at`),
+    INDEX `idx_notifications_digest` (`digest_pending`,`created_at`)
+);
+
+CREATE TABLE `push_retries` (
+    `id` varchar(36),
+    `token` varchar(512) NOT NULL,
+    `type` varchar(50) NOT NULL,
+    `title` varchar(400) NOT NULL,
+    `body` varchar(4000),
+    `data` varchar(1000),
+    `attempts` bigint NOT NULL,
+    `last_error` varchar(1000),
+    `next_attempt_at` datetime(3) NOT NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_push_retries_token` (`token`),
+    INDEX `idx_push_retries_next_attempt_at` (`next_attempt_at`)
+);
+
+CREATE TABLE `push_dead_letters` (
+    `id` varchar(36),
+    `token` varchar(512) NOT NULL,
+    `type` varchar(50) NOT NULL,
+    `title` varchar(400) NOT NULL,
+    `body` varchar(4000),
+    `data` varchar(1000),
+    `attempts` bigint NOT NULL,
+    `last_error` varchar(1000),
+    `created_at` datetime(3) NULL,
+    `dead_at` datetime(3) NOT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_push_dead_letters_dead_at` (`dead_at`)
+);
+
+CREATE TABLE `idempotency_keys` (
+    `user_id` varchar(36),
+    `idempotency_key` varchar(255),
+    `fingerprint` varchar(64) NOT NULL,
+    `status` bigint NOT NULL DEFAULT 0,
+    `content_type` varchar(100),
+    `body` longblob,
+    `created_at` datetime(3) NULL,
+    `expires_at` datetime(3) NOT NULL,
+    PRIMARY KEY (`user_id`,`idempotency_key`),
+    INDEX `idx_idempotency_keys_expires_at` (`expires_at`)
+);
+
+CREATE TABLE `audit_logs` (
+    `id` varchar(36),
+    `action` varchar(50) NOT NULL,
+    `actor_id` varchar(36),
+    `target_type` varchar(20),
+    `target_id` varchar(36),
+    `details` varchar(2000),
+    `request_id` varchar(100),
+    `ip` varchar(45),
+    `created_at` datetime(3) NOT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_audit_logs_action_created` (`action`,`created_at`),
+    INDEX `idx_audit_logs_actor_created` (`actor_id`,`created_at`),
+    INDEX `idx_audit_logs_target` (`target_id`)
+);
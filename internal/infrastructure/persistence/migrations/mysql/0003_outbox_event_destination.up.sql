@@ -0,0 +1 @@
+ALTER TABLE `outbox_events` ADD COLUMN `destination` varchar(20) NOT NULL DEFAULT 'members';
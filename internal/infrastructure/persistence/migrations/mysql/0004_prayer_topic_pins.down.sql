@@ -0,0 +1,4 @@
+DROP INDEX `idx_prayer_topics_room_pinned` ON `prayer_topics`;
+ALTER TABLE `prayer_topics` DROP COLUMN `pin_position`;
+ALTER TABLE `prayer_topics` DROP COLUMN `pinned_by`;
+ALTER TABLE `prayer_topics` DROP COLUMN `pinned_at`;
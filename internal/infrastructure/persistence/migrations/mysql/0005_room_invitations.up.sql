@@ -0,0 +1,13 @@
+CREATE TABLE `room_invitations` (
+    `id` varchar(36),
+    `room_id` varchar(36) NOT NULL,
+    `inviter_id` varchar(36) NOT NULL,
+    `invitee_id` varchar(36) NOT NULL,
+    `status` varchar(10) NOT NULL,
+    `expires_at` datetime(3) NOT NULL,
+    `responded_at` datetime(3) NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_room_invitations_invitee` (`invitee_id`,`status`,`created_at`),
+    INDEX `idx_room_invitations_room` (`room_id`,`invitee_id`,`status`)
+);
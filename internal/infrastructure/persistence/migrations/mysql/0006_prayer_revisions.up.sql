@@ -0,0 +1,13 @@
+CREATE TABLE `prayer_revisions` (
+    `id` varchar(36),
+    `topic_id` varchar(36) NOT NULL,
+    `room_id` varchar(36) NOT NULL,
+    `content_id` varchar(36),
+    `field` varchar(10) NOT NULL,
+    `previous` varchar(4000) NOT NULL,
+    `editor_id` varchar(36) NOT NULL,
+    `created_at` datetime(3) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_prayer_revisions_topic_created` (`topic_id`,`created_at`),
+    INDEX `idx_prayer_revisions_room_id` (`room_id`)
+);
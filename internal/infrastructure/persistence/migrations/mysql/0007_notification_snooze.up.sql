@@ -0,0 +1 @@
+ALTER TABLE `users` ADD COLUMN `snoozed_until` datetime(3) NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE `prayer_reactions` ADD COLUMN `anonymous` boolean NOT NULL DEFAULT false;
+CREATE INDEX `idx_prayer_reactions_topic_created` ON `prayer_reactions`(`topic_id`,`created_at`);
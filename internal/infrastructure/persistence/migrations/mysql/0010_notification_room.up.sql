@@ -0,0 +1,3 @@
+ALTER TABLE `notifications` ADD COLUMN `room_id` varchar(36) NULL;
+UPDATE `notifications` SET `room_id` = JSON_UNQUOTE(JSON_EXTRACT(`data`, '$.room_id')) WHERE `data` <> '';
+CREATE INDEX `idx_notifications_user_room` ON `notifications`(`user_id`,`room_id`);
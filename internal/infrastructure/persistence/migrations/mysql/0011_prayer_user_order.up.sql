@@ -0,0 +1,2 @@
+ALTER TABLE `prayer_topics` ADD COLUMN `user_order` bigint;
+CREATE INDEX `idx_prayer_topics_author_order` ON `prayer_topics`(`author_id`,`user_order`);
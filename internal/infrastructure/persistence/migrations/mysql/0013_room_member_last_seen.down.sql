@@ -0,0 +1,2 @@
+DROP INDEX `idx_room_members_room_seen` ON `room_members`;
+ALTER TABLE `room_members` DROP COLUMN `last_seen_at`;
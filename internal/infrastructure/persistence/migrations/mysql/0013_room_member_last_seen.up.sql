@@ -0,0 +1,2 @@
+ALTER TABLE `room_members` ADD COLUMN `last_seen_at` datetime(3) NULL;
+CREATE INDEX `idx_room_members_room_seen` ON `room_members`(`room_id`,`last_seen_at`);
@@ -0,0 +1,419 @@
+CREATE TABLE users (
+    ID VARCHAR2(36),
+    EMAIL VARCHAR2(255),
+    NICKNAME VARCHAR2(40) NOT NULL,
+    NICKNAME_KEY VARCHAR2(80),
+    PASSWORD_HASH VARCHAR2(100),
+    ROLE VARCHAR2(20) DEFAULT 'user' NOT NULL,
+    BIO VARCHAR2(800),
+    PROFILE_IMAGE_URL VARCHAR2(500),
+    TIMEZONE VARCHAR2(64) DEFAULT 'Asia/Seoul' NOT NULL,
+    LOCALE VARCHAR2(35) DEFAULT 'ko-KR' NOT NULL,
+    HIDDEN_FROM_SEARCH NUMBER(1) DEFAULT 0 NOT NULL,
+    MUTE_INVITES NUMBER(1) DEFAULT 0 NOT NULL,
+    MUTE_ANSWERED NUMBER(1) DEFAULT 0 NOT NULL,
+    MUTE_COMMENTS NUMBER(1) DEFAULT 0 NOT NULL,
+    MUTE_REMINDERS NUMBER(1) DEFAULT 0 NOT NULL,
+    MUTE_ANNOUNCEMENTS NUMBER(1) DEFAULT 0 NOT NULL,
+    DIGEST_FREQUENCY VARCHAR2(10) DEFAULT 'off' NOT NULL,
+    EMAIL_VERIFIED_AT TIMESTAMP WITH TIME ZONE,
+    PURGE_AT TIMESTAMP WITH TIME ZONE,
+    SUSPENDED_AT TIMESTAMP WITH TIME ZONE,
+    SUSPENSION_REASON VARCHAR2(2000),
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    UPDATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_USERS_PURGE_AT ON users(PURGE_AT);
+CREATE UNIQUE INDEX IDX_USERS_EMAIL ON users(EMAIL);
+CREATE UNIQUE INDEX idx_users_nickname_key ON users(NICKNAME_KEY);
+
+CREATE TABLE social_accounts (
+    ID VARCHAR2(36),
+    USER_ID VARCHAR2(36) NOT NULL,
+    PROVIDER VARCHAR2(20) NOT NULL,
+    PROVIDER_USER_ID VARCHAR2(255) NOT NULL,
+    EMAIL VARCHAR2(255),
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE UNIQUE INDEX idx_social_provider_subject ON social_accounts(PROVIDER,PROVIDER_USER_ID);
+CREATE UNIQUE INDEX idx_social_user_provider ON social_accounts(USER_ID,PROVIDER);
+
+CREATE TABLE refresh_tokens (
+    ID VARCHAR2(36),
+    FAMILY_ID VARCHAR2(36) NOT NULL,
+    USER_ID VARCHAR2(36) NOT NULL,
+    DEVICE_ID VARCHAR2(100) NOT NULL,
+    EXPIRES_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    USED_AT TIMESTAMP WITH TIME ZONE,
+    REVOKED_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_REFRESH_TOKENS_EXPIRES_AT ON refresh_tokens(EXPIRES_AT);
+CREATE INDEX IDX_REFRESH_TOKENS_FAMILY_ID ON refresh_tokens(FAMILY_ID);
+CREATE INDEX IDX_REFRESH_TOKENS_USER_ID ON refresh_tokens(USER_ID);
+
+CREATE TABLE revoked_tokens (
+    TOKEN_ID VARCHAR2(36),
+    USER_ID VARCHAR2(36) NOT NULL,
+    EXPIRES_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (TOKEN_ID)
+);
+CREATE INDEX IDX_REVOKED_TOKENS_EXPIRES_AT ON revoked_tokens(EXPIRES_AT);
+CREATE INDEX IDX_REVOKED_TOKENS_USER_ID ON revoked_tokens(USER_ID);
+
+CREATE TABLE password_reset_tokens (
+    ID VARCHAR2(36),
+    USER_ID VARCHAR2(36) NOT NULL,
+    TOKEN_HASH VARCHAR2(64) NOT NULL,
+    EXPIRES_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    USED_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_PASSWORD_RESET_TOKENS_USER_ID ON password_reset_tokens(USER_ID);
+CREATE UNIQUE INDEX IDX_PASSWORD_RESET_TOKENS_TOKEN_HASH ON password_reset_tokens(TOKEN_HASH);
+
+CREATE TABLE email_verification_tokens (
+    ID VARCHAR2(36),
+    USER_ID VARCHAR2(36) NOT NULL,
+    EMAIL VARCHAR2(255) NOT NULL,
+    TOKEN_HASH VARCHAR2(64) NOT NULL,
+    EXPIRES_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    USED_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_EMAIL_VERIFICATION_TOKENS_USER_ID ON email_verification_tokens(USER_ID);
+CREATE UNIQUE INDEX IDX_EMAIL_VERIFICATION_TOKENS_TOKEN_HASH ON email_verification_tokens(TOKEN_HASH);
+
+CREATE TABLE api_keys (
+    ID VARCHAR2(36),
+    NAME VARCHAR2(100) NOT NULL,
+    PREFIX VARCHAR2(16) NOT NULL,
+    KEY_HASH VARCHAR2(64) NOT NULL,
+    CREATED_BY VARCHAR2(36) NOT NULL,
+    LAST_USED_AT TIMESTAMP WITH TIME ZONE,
+    REVOKED_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    UPDATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE UNIQUE INDEX IDX_API_KEYS_KEY_HASH ON api_keys(KEY_HASH);
+
+CREATE TABLE user_two_factor (
+    USER_ID VARCHAR2(36),
+    SECRET VARCHAR2(64) NOT NULL,
+    ENABLED_AT TIMESTAMP WITH TIME ZONE,
+    LAST_USED_STEP INTEGER DEFAULT 0 NOT NULL,
+    FAILED_ATTEMPTS INTEGER DEFAULT 0 NOT NULL,
+    LOCKED_UNTIL TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    UPDATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (USER_ID)
+);
+
+CREATE TABLE two_factor_backup_codes (
+    ID VARCHAR2(36),
+    USER_ID VARCHAR2(36) NOT NULL,
+    CODE_HASH VARCHAR2(64) NOT NULL,
+    USED_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_TWO_FACTOR_BACKUP_CODES_USER_ID ON two_factor_backup_codes(USER_ID);
+
+CREATE TABLE user_blocks (
+    BLOCKER_ID VARCHAR2(36),
+    BLOCKED_ID VARCHAR2(36),
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (BLOCKER_ID,BLOCKED_ID)
+);
+CREATE INDEX IDX_USER_BLOCKS_BLOCKED_ID ON user_blocks(BLOCKED_ID);
+
+CREATE TABLE rooms (
+    ID VARCHAR2(36),
+    NAME VARCHAR2(200) NOT NULL,
+    DESCRIPTION VARCHAR2(2000),
+    VISIBILITY VARCHAR2(10) DEFAULT 'private' NOT NULL,
+    CATEGORY VARCHAR2(20),
+    OWNER_ID VARCHAR2(36) NOT NULL,
+    MEMBER_CAP INTEGER DEFAULT 0 NOT NULL,
+    REMINDER_TIME VARCHAR2(5),
+    POST_POLICY VARCHAR2(20) DEFAULT 'members' NOT NULL,
+    COVER_IMAGE_KEY VARCHAR2(200),
+    COVER_IMAGE_URL VARCHAR2(500),
+    ARCHIVED_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    UPDATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_ROOMS_CATEGORY ON rooms(CATEGORY);
+CREATE INDEX IDX_ROOMS_OWNER_ID ON rooms(OWNER_ID);
+CREATE INDEX IDX_ROOMS_VISIBILITY ON rooms(VISIBILITY);
+
+CREATE TABLE room_tags (
+    ROOM_ID VARCHAR2(36),
+    TAG VARCHAR2(80),
+    PRIMARY KEY (ROOM_ID,TAG)
+);
+CREATE INDEX idx_room_tags_tag ON room_tags(TAG);
+
+CREATE TABLE room_members (
+    ROOM_ID VARCHAR2(36),
+    USER_ID VARCHAR2(36),
+    ROLE VARCHAR2(20) DEFAULT 'member' NOT NULL,
+    INVITED_BY VARCHAR2(36),
+    MUTED NUMBER(1) DEFAULT 0 NOT NULL,
+    JOINED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ROOM_ID,USER_ID)
+);
+CREATE INDEX IDX_ROOM_MEMBERS_USER_ID ON room_members(USER_ID);
+
+CREATE TABLE room_invites (
+    ID VARCHAR2(36),
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    CODE VARCHAR2(16) NOT NULL,
+    CREATED_BY VARCHAR2(36) NOT NULL,
+    MAX_USES INTEGER NOT NULL,
+    USE_COUNT INTEGER NOT NULL,
+    EXPIRES_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_ROOM_INVITES_CREATED_BY ON room_invites(CREATED_BY);
+CREATE INDEX IDX_ROOM_INVITES_ROOM_ID ON room_invites(ROOM_ID);
+CREATE UNIQUE INDEX IDX_ROOM_INVITES_CODE ON room_invites(CODE);
+
+CREATE TABLE room_join_requests (
+    ID VARCHAR2(36),
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    USER_ID VARCHAR2(36) NOT NULL,
+    MESSAGE VARCHAR2(800),
+    STATUS VARCHAR2(10) NOT NULL,
+    DECIDED_BY VARCHAR2(36),
+    DECIDED_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX idx_join_requests_room_status ON room_join_requests(ROOM_ID,STATUS);
+CREATE INDEX IDX_ROOM_JOIN_REQUESTS_USER_ID ON room_join_requests(USER_ID);
+
+CREATE TABLE room_announcements (
+    ID VARCHAR2(36),
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    AUTHOR_ID VARCHAR2(36) NOT NULL,
+    BODY VARCHAR2(4000) NOT NULL,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    UPDATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_ROOM_ANNOUNCEMENTS_AUTHOR_ID ON room_announcements(AUTHOR_ID);
+CREATE INDEX idx_room_announcements_room_created ON room_announcements(ROOM_ID,CREATED_AT);
+
+CREATE TABLE prayer_topics (
+    ID VARCHAR2(36),
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    AUTHOR_ID VARCHAR2(36) NOT NULL,
+    TITLE VARCHAR2(400) NOT NULL,
+    PRIVATE NUMBER(1) DEFAULT 0 NOT NULL,
+    RECURRENCE VARCHAR2(10),
+    RECURRENCE_PAUSED NUMBER(1) DEFAULT 0 NOT NULL,
+    NEXT_RECURRENCE_AT TIMESTAMP WITH TIME ZONE,
+    ANSWERED_AT TIMESTAMP WITH TIME ZONE,
+    TESTIMONY VARCHAR2(4000),
+    COMMENT_COUNT INTEGER DEFAULT 0 NOT NULL,
+    REACTION_COUNT INTEGER DEFAULT 0 NOT NULL,
+    DELETED_AT TIMESTAMP WITH TIME ZONE,
+    DELETED_BY VARCHAR2(36),
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    UPDATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_PRAYER_TOPICS_AUTHOR_ID ON prayer_topics(AUTHOR_ID);
+CREATE INDEX IDX_PRAYER_TOPICS_DELETED_AT ON prayer_topics(DELETED_AT);
+CREATE INDEX IDX_PRAYER_TOPICS_NEXT_RECURRENCE_AT ON prayer_topics(NEXT_RECURRENCE_AT);
+CREATE INDEX idx_prayer_topics_room_answered ON prayer_topics(ROOM_ID,ANSWERED_AT);
+CREATE INDEX idx_prayer_topics_room_created ON prayer_topics(ROOM_ID,CREATED_AT);
+
+CREATE TABLE prayer_topic_tags (
+    TOPIC_ID VARCHAR2(36),
+    TAG VARCHAR2(80),
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    PRIMARY KEY (TOPIC_ID,TAG)
+);
+CREATE INDEX idx_prayer_tags_room_tag ON prayer_topic_tags(ROOM_ID,TAG);
+
+CREATE TABLE prayer_contents (
+    ID VARCHAR2(36),
+    TOPIC_ID VARCHAR2(36) NOT NULL,
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    AUTHOR_ID VARCHAR2(36) NOT NULL,
+    BODY VARCHAR2(4000) NOT NULL,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    UPDATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_PRAYER_CONTENTS_AUTHOR_ID ON prayer_contents(AUTHOR_ID);
+CREATE INDEX IDX_PRAYER_CONTENTS_ROOM_ID ON prayer_contents(ROOM_ID);
+CREATE INDEX idx_prayer_contents_topic_created ON prayer_contents(TOPIC_ID,CREATED_AT);
+
+CREATE TABLE prayer_comments (
+    ID VARCHAR2(36),
+    TOPIC_ID VARCHAR2(36) NOT NULL,
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    PARENT_ID VARCHAR2(36),
+    AUTHOR_ID VARCHAR2(36) NOT NULL,
+    BODY VARCHAR2(2000) NOT NULL,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    UPDATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_PRAYER_COMMENTS_AUTHOR_ID ON prayer_comments(AUTHOR_ID);
+CREATE INDEX IDX_PRAYER_COMMENTS_PARENT_ID ON prayer_comments(PARENT_ID);
+CREATE INDEX IDX_PRAYER_COMMENTS_ROOM_ID ON prayer_comments(ROOM_ID);
+CREATE INDEX idx_prayer_comments_topic_created ON prayer_comments(TOPIC_ID,CREATED_AT);
+
+CREATE TABLE prayer_comment_mentions (
+    COMMENT_ID VARCHAR2(36),
+    USER_ID VARCHAR2(36),
+    PRIMARY KEY (COMMENT_ID,USER_ID)
+);
+CREATE INDEX IDX_PRAYER_COMMENT_MENTIONS_USER_ID ON prayer_comment_mentions(USER_ID);
+
+CREATE TABLE prayer_reactions (
+    TOPIC_ID VARCHAR2(36),
+    USER_ID VARCHAR2(36),
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (TOPIC_ID,USER_ID)
+);
+CREATE INDEX IDX_PRAYER_REACTIONS_ROOM_ID ON prayer_reactions(ROOM_ID);
+CREATE INDEX IDX_PRAYER_REACTIONS_USER_ID ON prayer_reactions(USER_ID);
+
+CREATE TABLE room_exports (
+    ID VARCHAR2(36),
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    REQUESTED_BY VARCHAR2(36) NOT NULL,
+    FORMAT VARCHAR2(10) NOT NULL,
+    STATUS VARCHAR2(10) NOT NULL,
+    FILE_KEY VARCHAR2(200),
+    STARTED_AT TIMESTAMP WITH TIME ZONE,
+    COMPLETED_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_ROOM_EXPORTS_CREATED_AT ON room_exports(CREATED_AT);
+CREATE INDEX idx_room_exports_room_user ON room_exports(ROOM_ID,REQUESTED_BY);
+CREATE INDEX IDX_ROOM_EXPORTS_STATUS ON room_exports(STATUS);
+
+CREATE TABLE user_devices (
+    ID VARCHAR2(36),
+    USER_ID VARCHAR2(36) NOT NULL,
+    DEVICE_ID VARCHAR2(400) NOT NULL,
+    TOKEN VARCHAR2(512) NOT NULL,
+    PLATFORM VARCHAR2(10) NOT NULL,
+    APP_VERSION VARCHAR2(80),
+    LOCALE VARCHAR2(35),
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    UPDATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE UNIQUE INDEX idx_devices_user_device ON user_devices(USER_ID,DEVICE_ID);
+CREATE UNIQUE INDEX IDX_USER_DEVICES_TOKEN ON user_devices(TOKEN);
+
+CREATE TABLE job_locks (
+    NAME VARCHAR2(50),
+    OWNER VARCHAR2(36),
+    LOCKED_UNTIL TIMESTAMP WITH TIME ZONE,
+    LAST_RUN_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (NAME)
+);
+
+CREATE TABLE notifications (
+    ID VARCHAR2(36),
+    USER_ID VARCHAR2(36) NOT NULL,
+    TYPE VARCHAR2(50) NOT NULL,
+    TITLE VARCHAR2(400) NOT NULL,
+    BODY VARCHAR2(4000),
+    DATA VARCHAR2(1000),
+    DIGEST_PENDING NUMBER(1) DEFAULT 0 NOT NULL,
+    READ_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX idx_notifications_digest ON notifications(DIGEST_PENDING,CREATED_AT);
+CREATE INDEX idx_notifications_user_created ON notifications(USER_ID,CREATED_AT);
+
+CREATE TABLE push_retries (
+    ID VARCHAR2(36),
+    TOKEN VARCHAR2(512) NOT NULL,
+    TYPE VARCHAR2(50) NOT NULL,
+    TITLE VARCHAR2(400) NOT NULL,
+    BODY VARCHAR2(4000),
+    DATA VARCHAR2(1000),
+    ATTEMPTS INTEGER NOT NULL,
+    LAST_ERROR VARCHAR2(1000),
+    NEXT_ATTEMPT_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_PUSH_RETRIES_NEXT_ATTEMPT_AT ON push_retries(NEXT_ATTEMPT_AT);
+CREATE INDEX IDX_PUSH_RETRIES_TOKEN ON push_retries(TOKEN);
+
+CREATE TABLE push_dead_letters (
+    ID VARCHAR2(36),
+    TOKEN VARCHAR2(512) NOT NULL,
+    TYPE VARCHAR2(50) NOT NULL,
+    TITLE VARCHAR2(400) NOT NULL,
+    BODY VARCHAR2(4000),
+    DATA VARCHAR2(1000),
+    ATTEMPTS INTEGER NOT NULL,
+    LAST_ERROR VARCHAR2(1000),
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    DEAD_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_PUSH_DEAD_LETTERS_DEAD_AT ON push_dead_letters(DEAD_AT);
+
+CREATE TABLE idempotency_keys (
+    USER_ID VARCHAR2(36),
+    IDEMPOTENCY_KEY VARCHAR2(255),
+    FINGERPRINT VARCHAR2(64) NOT NULL,
+    STATUS INTEGER DEFAULT 0 NOT NULL,
+    CONTENT_TYPE VARCHAR2(100),
+    BODY BLOB,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    EXPIRES_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (USER_ID,IDEMPOTENCY_KEY)
+);
+CREATE INDEX IDX_IDEMPOTENCY_KEYS_EXPIRES_AT ON idempotency_keys(EXPIRES_AT);
+
+CREATE TABLE audit_logs (
+    ID VARCHAR2(36),
+    ACTION VARCHAR2(50) NOT NULL,
+    ACTOR_ID VARCHAR2(36),
+    TARGET_TYPE VARCHAR2(20),
+    TARGET_ID VARCHAR2(36),
+    DETAILS VARCHAR2(2000),
+    REQUEST_ID VARCHAR2(100),
+    IP VARCHAR2(45),
+    CREATED_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX idx_audit_logs_action_created ON audit_logs(ACTION,CREATED_AT);
+CREATE INDEX idx_audit_logs_actor_created ON audit_logs(ACTOR_ID,CREATED_AT);
+CREATE INDEX idx_audit_logs_target ON audit_logs(TARGET_ID);
+-- Oracle Text indexes for prayer search
+-- WORLD_LEXER segments Korean text, which the default BASIC_LEXER treats as one token per word run
+-- +statement-begin
+BEGIN
+    ctx_ddl.create_preference('prayer_lexer', 'WORLD_LEXER');
+END;
+-- +statement-end
+-- SYNC (ON COMMIT) makes new prayers searchable as soon as they are saved
+CREATE INDEX idx_prayer_topics_title_text ON prayer_topics (title) INDEXTYPE IS CTXSYS.CONTEXT PARAMETERS ('LEXER prayer_lexer SYNC (ON COMMIT)');
+CREATE INDEX idx_prayer_contents_body_text ON prayer_contents (body) INDEXTYPE IS CTXSYS.CONTEXT PARAMETERS ('LEXER prayer_lexer SYNC (ON COMMIT)');
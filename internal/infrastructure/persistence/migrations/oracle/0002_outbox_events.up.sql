@@ -0,0 +1,17 @@
+CREATE TABLE outbox_events (
+    ID VARCHAR2(36),
+    TYPE VARCHAR2(50) NOT NULL,
+    TEMPLATE VARCHAR2(50),
+    PARAMS VARCHAR2(2000),
+    DATA VARCHAR2(1000),
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    ACTOR_ID VARCHAR2(36),
+    ATTEMPTS INTEGER NOT NULL,
+    LAST_ERROR VARCHAR2(1000),
+    NEXT_ATTEMPT_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    DEAD_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX IDX_OUTBOX_EVENTS_NEXT_ATTEMPT_AT ON outbox_events(NEXT_ATTEMPT_AT);
+CREATE INDEX IDX_OUTBOX_EVENTS_DEAD_AT ON outbox_events(DEAD_AT);
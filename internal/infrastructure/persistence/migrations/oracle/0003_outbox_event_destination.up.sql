@@ -0,0 +1 @@
+ALTER TABLE outbox_events ADD (DESTINATION VARCHAR2(20) DEFAULT 'members' NOT NULL);
@@ -0,0 +1,2 @@
+DROP INDEX idx_prayer_topics_room_pinned;
+ALTER TABLE prayer_topics DROP (PINNED_AT, PINNED_BY, PIN_POSITION);
@@ -0,0 +1,2 @@
+ALTER TABLE prayer_topics ADD (PINNED_AT TIMESTAMP WITH TIME ZONE, PINNED_BY VARCHAR2(36), PIN_POSITION INTEGER);
+CREATE INDEX idx_prayer_topics_room_pinned ON prayer_topics(ROOM_ID,PIN_POSITION);
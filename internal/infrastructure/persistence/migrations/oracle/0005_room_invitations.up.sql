@@ -0,0 +1,13 @@
+CREATE TABLE room_invitations (
+    ID VARCHAR2(36),
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    INVITER_ID VARCHAR2(36) NOT NULL,
+    INVITEE_ID VARCHAR2(36) NOT NULL,
+    STATUS VARCHAR2(10) NOT NULL,
+    EXPIRES_AT TIMESTAMP WITH TIME ZONE NOT NULL,
+    RESPONDED_AT TIMESTAMP WITH TIME ZONE,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX idx_room_invitations_invitee ON room_invitations(INVITEE_ID,STATUS,CREATED_AT);
+CREATE INDEX idx_room_invitations_room ON room_invitations(ROOM_ID,INVITEE_ID,STATUS);
@@ -0,0 +1,13 @@
+CREATE TABLE prayer_revisions (
+    ID VARCHAR2(36),
+    TOPIC_ID VARCHAR2(36) NOT NULL,
+    ROOM_ID VARCHAR2(36) NOT NULL,
+    CONTENT_ID VARCHAR2(36),
+    FIELD VARCHAR2(10) NOT NULL,
+    PREVIOUS VARCHAR2(4000) NOT NULL,
+    EDITOR_ID VARCHAR2(36) NOT NULL,
+    CREATED_AT TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (ID)
+);
+CREATE INDEX idx_prayer_revisions_topic_created ON prayer_revisions(TOPIC_ID,CREATED_AT);
+CREATE INDEX IDX_PRAYER_REVISIONS_ROOM_ID ON prayer_revisions(ROOM_ID);
@@ -0,0 +1 @@
+ALTER TABLE users DROP (SNOOZED_UNTIL);
@@ -0,0 +1 @@
+ALTER TABLE users ADD (SNOOZED_UNTIL TIMESTAMP WITH TIME ZONE);
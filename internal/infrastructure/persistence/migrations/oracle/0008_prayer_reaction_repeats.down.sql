@@ -0,0 +1 @@
+ALTER TABLE prayer_reactions DROP (PRAYER_COUNT, PRAYED_AT);
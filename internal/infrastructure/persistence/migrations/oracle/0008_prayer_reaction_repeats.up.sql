@@ -0,0 +1,2 @@
+ALTER TABLE prayer_reactions ADD (PRAYER_COUNT INTEGER DEFAULT 1 NOT NULL, PRAYED_AT TIMESTAMP WITH TIME ZONE);
+UPDATE prayer_reactions SET PRAYED_AT = CREATED_AT;
@@ -0,0 +1,2 @@
+ALTER TABLE prayer_reactions ADD (ANONYMOUS NUMBER(1) DEFAULT 0 NOT NULL);
+CREATE INDEX idx_prayer_reactions_topic_created ON prayer_reactions(TOPIC_ID,CREATED_AT);
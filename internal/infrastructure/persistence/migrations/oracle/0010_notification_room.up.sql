@@ -0,0 +1,3 @@
+ALTER TABLE notifications ADD (ROOM_ID VARCHAR2(36));
+UPDATE notifications SET ROOM_ID = JSON_VALUE(DATA, '$.room_id') WHERE DATA IS NOT NULL;
+CREATE INDEX idx_notifications_user_room ON notifications(USER_ID,ROOM_ID);
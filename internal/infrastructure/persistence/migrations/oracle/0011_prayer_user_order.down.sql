@@ -0,0 +1,2 @@
+DROP INDEX idx_prayer_topics_author_order;
+ALTER TABLE prayer_topics DROP (USER_ORDER);
@@ -0,0 +1,2 @@
+ALTER TABLE prayer_topics ADD (USER_ORDER INTEGER);
+CREATE INDEX idx_prayer_topics_author_order ON prayer_topics(AUTHOR_ID,USER_ORDER);
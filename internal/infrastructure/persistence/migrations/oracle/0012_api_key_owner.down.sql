@@ -0,0 +1,2 @@
+DROP INDEX idx_api_keys_user_id;
+ALTER TABLE api_keys DROP (USER_ID, SCOPES);
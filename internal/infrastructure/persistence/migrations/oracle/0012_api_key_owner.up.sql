@@ -0,0 +1,2 @@
+ALTER TABLE api_keys ADD (USER_ID VARCHAR2(36), SCOPES VARCHAR2(50));
+CREATE INDEX idx_api_keys_user_id ON api_keys(USER_ID);
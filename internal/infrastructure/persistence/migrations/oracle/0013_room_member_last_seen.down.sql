@@ -0,0 +1,2 @@
+DROP INDEX idx_room_members_room_seen;
+ALTER TABLE room_members DROP (LAST_SEEN_AT);
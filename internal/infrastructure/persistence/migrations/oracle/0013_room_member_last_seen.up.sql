@@ -0,0 +1,2 @@
+ALTER TABLE room_members ADD (LAST_SEEN_AT TIMESTAMP WITH TIME ZONE);
+CREATE INDEX idx_room_members_room_seen ON room_members(ROOM_ID,LAST_SEEN_AT);
@@ -0,0 +1,30 @@
+DROP TABLE "audit_logs";
+DROP TABLE "idempotency_keys";
+DROP TABLE "push_dead_letters";
+DROP TABLE "push_retries";
+DROP TABLE "notifications";
+DROP TABLE "job_locks";
+DROP TABLE "user_devices";
+DROP TABLE "room_exports";
+DROP TABLE "prayer_reactions";
+DROP TABLE "prayer_comment_mentions";
+DROP TABLE "prayer_comments";
+DROP TABLE "prayer_contents";
+DROP TABLE "prayer_topic_tags";
+DROP TABLE "prayer_topics";
+DROP TABLE "room_announcements";
+DROP TABLE "room_join_requests";
+DROP TABLE "room_invites";
+DROP TABLE "room_members";
+DROP TABLE "room_tags";
+DROP TABLE "rooms";
+DROP TABLE "user_blocks";
+DROP TABLE "two_factor_backup_codes";
+DROP TABLE "user_two_factor";
+DROP TABLE "api_keys";
+DROP TABLE "email_verification_tokens";
+DROP TABLE "password_reset_tokens";
+DROP TABLE "revoked_tokens";
+DROP TABLE "refresh_tokens";
+DROP TABLE "social_accounts";
+DROP TABLE "users";
@@ -0,0 +1,409 @@
+CREATE TABLE "users" (
+    "id" varchar(36),
+    "email" varchar(255),
+    "nickname" varchar(40) NOT NULL,
+    "nickname_key" varchar(80),
+    "password_hash" varchar(100),
+    "role" varchar(20) NOT NULL DEFAULT 'user',
+    "bio" varchar(800),
+    "profile_image_url" varchar(500),
+    "timezone" varchar(64) NOT NULL DEFAULT 'Asia/Seoul',
+    "locale" varchar(35) NOT NULL DEFAULT 'ko-KR',
+    "hidden_from_search" boolean NOT NULL DEFAULT false,
+    "mute_invites" boolean NOT NULL DEFAULT false,
+    "mute_answered" boolean NOT NULL DEFAULT false,
+    "mute_comments" boolean NOT NULL DEFAULT false,
+    "mute_reminders" boolean NOT NULL DEFAULT false,
+    "mute_announcements" boolean NOT NULL DEFAULT false,
+    "digest_frequency" varchar(10) NOT NULL DEFAULT 'off',
+    "email_verified_at" timestamptz,
+    "purge_at" timestamptz,
+    "suspended_at" timestamptz,
+    "suspension_reason" varchar(2000),
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_users_purge_at" ON "users" ("purge_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_users_email" ON "users" ("email");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_users_nickname_key" ON "users" ("nickname_key");
+
+CREATE TABLE "social_accounts" (
+    "id" varchar(36),
+    "user_id" varchar(36) NOT NULL,
+    "provider" varchar(20) NOT NULL,
+    "provider_user_id" varchar(255) NOT NULL,
+    "email" varchar(255),
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_social_provider_subject" ON "social_accounts" ("provider","provider_user_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_social_user_provider" ON "social_accounts" ("user_id","provider");
+
+CREATE TABLE "refresh_tokens" (
+    "id" varchar(36),
+    "family_id" varchar(36) NOT NULL,
+    "user_id" varchar(36) NOT NULL,
+    "device_id" varchar(100) NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "used_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_refresh_tokens_expires_at" ON "refresh_tokens" ("expires_at");
+CREATE INDEX IF NOT EXISTS "idx_refresh_tokens_family_id" ON "refresh_tokens" ("family_id");
+CREATE INDEX IF NOT EXISTS "idx_refresh_tokens_user_id" ON "refresh_tokens" ("user_id");
+
+CREATE TABLE "revoked_tokens" (
+    "token_id" varchar(36),
+    "user_id" varchar(36) NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("token_id")
+);
+CREATE INDEX IF NOT EXISTS "idx_revoked_tokens_expires_at" ON "revoked_tokens" ("expires_at");
+CREATE INDEX IF NOT EXISTS "idx_revoked_tokens_user_id" ON "revoked_tokens" ("user_id");
+
+CREATE TABLE "password_reset_tokens" (
+    "id" varchar(36),
+    "user_id" varchar(36) NOT NULL,
+    "token_hash" varchar(64) NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_password_reset_tokens_user_id" ON "password_reset_tokens" ("user_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_password_reset_tokens_token_hash" ON "password_reset_tokens" ("token_hash");
+
+CREATE TABLE "email_verification_tokens" (
+    "id" varchar(36),
+    "user_id" varchar(36) NOT NULL,
+    "email" varchar(255) NOT NULL,
+    "token_hash" varchar(64) NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_email_verification_tokens_user_id" ON "email_verification_tokens" ("user_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_email_verification_tokens_token_hash" ON "email_verification_tokens" ("token_hash");
+
+CREATE TABLE "api_keys" (
+    "id" varchar(36),
+    "name" varchar(100) NOT NULL,
+    "prefix" varchar(16) NOT NULL,
+    "key_hash" varchar(64) NOT NULL,
+    "created_by" varchar(36) NOT NULL,
+    "last_used_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_api_keys_key_hash" ON "api_keys" ("key_hash");
+
+CREATE TABLE "user_two_factor" (
+    "user_id" varchar(36),
+    "secret" varchar(64) NOT NULL,
+    "enabled_at" timestamptz,
+    "last_used_step" bigint NOT NULL DEFAULT 0,
+    "failed_attempts" bigint NOT NULL DEFAULT 0,
+    "locked_until" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("user_id")
+);
+
+CREATE TABLE "two_factor_backup_codes" (
+    "id" varchar(36),
+    "user_id" varchar(36) NOT NULL,
+    "code_hash" varchar(64) NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_two_factor_backup_codes_user_id" ON "two_factor_backup_codes" ("user_id");
+
+CREATE TABLE "user_blocks" (
+    "blocker_id" varchar(36),
+    "blocked_id" varchar(36),
+    "created_at" timestamptz,
+    PRIMARY KEY ("blocker_id","blocked_id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_blocks_blocked_id" ON "user_blocks" ("blocked_id");
+
+CREATE TABLE "rooms" (
+    "id" varchar(36),
+    "name" varchar(200) NOT NULL,
+    "description" varchar(2000),
+    "visibility" varchar(10) NOT NULL DEFAULT 'private',
+    "category" varchar(20),
+    "owner_id" varchar(36) NOT NULL,
+    "member_cap" bigint NOT NULL DEFAULT 0,
+    "reminder_time" varchar(5),
+    "post_policy" varchar(20) NOT NULL DEFAULT 'members',
+    "cover_image_key" varchar(200),
+    "cover_image_url" varchar(500),
+    "archived_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_rooms_category" ON "rooms" ("category");
+CREATE INDEX IF NOT EXISTS "idx_rooms_owner_id" ON "rooms" ("owner_id");
+CREATE INDEX IF NOT EXISTS "idx_rooms_visibility" ON "rooms" ("visibility");
+
+CREATE TABLE "room_tags" (
+    "room_id" varchar(36),
+    "tag" varchar(80),
+    PRIMARY KEY ("room_id","tag")
+);
+CREATE INDEX IF NOT EXISTS "idx_room_tags_tag" ON "room_tags" ("tag");
+
+CREATE TABLE "room_members" (
+    "room_id" varchar(36),
+    "user_id" varchar(36),
+    "role" varchar(20) NOT NULL DEFAULT 'member',
+    "invited_by" varchar(36),
+    "muted" boolean NOT NULL DEFAULT false,
+    "joined_at" timestamptz,
+    PRIMARY KEY ("room_id","user_id")
+);
+CREATE INDEX IF NOT EXISTS "idx_room_members_user_id" ON "room_members" ("user_id");
+
+CREATE TABLE "room_invites" (
+    "id" varchar(36),
+    "room_id" varchar(36) NOT NULL,
+    "code" varchar(16) NOT NULL,
+    "created_by" varchar(36) NOT NULL,
+    "max_uses" bigint NOT NULL,
+    "use_count" bigint NOT NULL,
+    "expires_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_room_invites_created_by" ON "room_invites" ("created_by");
+CREATE INDEX IF NOT EXISTS "idx_room_invites_room_id" ON "room_invites" ("room_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_room_invites_code" ON "room_invites" ("code");
+
+CREATE TABLE "room_join_requests" (
+    "id" varchar(36),
+    "room_id" varchar(36) NOT NULL,
+    "user_id" varchar(36) NOT NULL,
+    "message" varchar(800),
+    "status" varchar(10) NOT NULL,
+    "decided_by" varchar(36),
+    "decided_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_join_requests_room_status" ON "room_join_requests" ("room_id","status");
+CREATE INDEX IF NOT EXISTS "idx_room_join_requests_user_id" ON "room_join_requests" ("user_id");
+
+CREATE TABLE "room_announcements" (
+    "id" varchar(36),
+    "room_id" varchar(36) NOT NULL,
+    "author_id" varchar(36) NOT NULL,
+    "body" varchar(4000) NOT NULL,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_room_announcements_author_id" ON "room_announcements" ("author_id");
+CREATE INDEX IF NOT EXISTS "idx_room_announcements_room_created" ON "room_announcements" ("room_id","created_at");
+
+CREATE TABLE "prayer_topics" (
+    "id" varchar(36),
+    "room_id" varchar(36) NOT NULL,
+    "author_id" varchar(36) NOT NULL,
+    "title" varchar(400) NOT NULL,
+    "private" boolean NOT NULL DEFAULT false,
+    "recurrence" varchar(10),
+    "recurrence_paused" boolean NOT NULL DEFAULT false,
+    "next_recurrence_at" timestamptz,
+    "answered_at" timestamptz,
+    "testimony" varchar(4000),
+    "comment_count" bigint NOT NULL DEFAULT 0,
+    "reaction_count" bigint NOT NULL DEFAULT 0,
+    "deleted_at" timestamptz,
+    "deleted_by" varchar(36),
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_prayer_topics_author_id" ON "prayer_topics" ("author_id");
+CREATE INDEX IF NOT EXISTS "idx_prayer_topics_deleted_at" ON "prayer_topics" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_prayer_topics_next_recurrence_at" ON "prayer_topics" ("next_recurrence_at");
+CREATE INDEX IF NOT EXISTS "idx_prayer_topics_room_answered" ON "prayer_topics" ("room_id","answered_at");
+CREATE INDEX IF NOT EXISTS "idx_prayer_topics_room_created" ON "prayer_topics" ("room_id","created_at");
+
+CREATE TABLE "prayer_topic_tags" (
+    "topic_id" varchar(36),
+    "tag" varchar(80),
+    "room_id" varchar(36) NOT NULL,
+    PRIMARY KEY ("topic_id","tag")
+);
+CREATE INDEX IF NOT EXISTS "idx_prayer_tags_room_tag" ON "prayer_topic_tags" ("room_id","tag");
+
+CREATE TABLE "prayer_contents" (
+    "id" varchar(36),
+    "topic_id" varchar(36) NOT NULL,
+    "room_id" varchar(36) NOT NULL,
+    "author_id" varchar(36) NOT NULL,
+    "body" varchar(4000) NOT NULL,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_prayer_contents_author_id" ON "prayer_contents" ("author_id");
+CREATE INDEX IF NOT EXISTS "idx_prayer_contents_room_id" ON "prayer_contents" ("room_id");
+CREATE INDEX IF NOT EXISTS "idx_prayer_contents_topic_created" ON "prayer_contents" ("topic_id","created_at");
+
+CREATE TABLE "prayer_comments" (
+    "id" varchar(36),
+    "topic_id" varchar(36) NOT NULL,
+    "room_id" varchar(36) NOT NULL,
+    "parent_id" varchar(36),
+    "author_id" varchar(36) NOT NULL,
+    "body" varchar(2000) NOT NULL,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_prayer_comments_author_id" ON "prayer_comments" ("author_id");
+CREATE INDEX IF NOT EXISTS "idx_prayer_comments_parent_id" ON "prayer_comments" ("parent_id");
+CREATE INDEX IF NOT EXISTS "idx_prayer_comments_room_id" ON "prayer_comments" ("room_id");
+CREATE INDEX IF NOT EXISTS "idx_prayer_comments_topic_created" ON "prayer_comments" ("topic_id","created_at");
+
+CREATE TABLE "prayer_comment_mentions" (
+    "comment_id" varchar(36),
+    "user_id" varchar(36),
+    PRIMARY KEY ("comment_id","user_id")
+);
+CREATE INDEX IF NOT EXISTS "idx_prayer_comment_mentions_user_id" ON "prayer_comment_mentions" ("user_id");
+
+CREATE TABLE "prayer_reactions" (
+    "topic_id" varchar(36),
+    "user_id" varchar(36),
+    "room_id" varchar(36) NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("topic_id","user_id")
+);
+CREATE INDEX IF NOT EXISTS "idx_prayer_reactions_room_id" ON "prayer_reactions" ("room_id");
+CREATE INDEX IF NOT EXISTS "idx_prayer_reactions_user_id" ON "prayer_reactions" ("user_id");
+
+CREATE TABLE "room_exports" (
+    "id" varchar(36),
+    "room_id" varchar(36) NOT NULL,
+    "requested_by" varchar(36) NOT NULL,
+    "format" varchar(10) NOT NULL,
+    "status" varchar(10) NOT NULL,
+    "file_key" varchar(200),
+    "started_at" timestamptz,
+    "completed_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_room_exports_created_at" ON "room_exports" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_room_exports_room_user" ON "room_exports" ("room_id","requested_by");
+CREATE INDEX IF NOT EXISTS "idx_room_exports_status" ON "room_exports" ("status");
+
+CREATE TABLE "user_devices" (
+    "id" varchar(36),
+    "user_id" varchar(36) NOT NULL,
+    "device_id" varchar(400) NOT NULL,
+    "token" varchar(512) NOT NULL,
+    "platform" varchar(10) NOT NULL,
+    "app_version" varchar(80),
+    "locale" varchar(35),
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_devices_user_device" ON "user_devices" ("user_id","device_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_devices_token" ON "user_devices" ("token");
+
+CREATE TABLE "job_locks" (
+    "name" varchar(50),
+    "owner" varchar(36),
+    "locked_until" timestamptz,
+    "last_run_at" timestamptz,
+    PRIMARY KEY ("name")
+);
+
+CREATE TABLE "notifications" (
+    "id" varchar(36),
+    "user_id" varchar(36) NOT NULL,
+    "type" varchar(50) NOT NULL,
+    "title" varchar(400) NOT NULL,
+    "body" varchar(4000),
+    "data" varchar(1000),
+    "digest_pending" boolean NOT NULL DEFAULT false,
+    "read_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_notifications_digest" ON "notifications" ("digest_pending","created_at");
+CREATE INDEX IF NOT EXISTS "idx_notifications_user_created" ON "notifications" ("user_id","created_at");
+
+CREATE TABLE "push_retries" (
+    "id" varchar(36),
+    "token" varchar(512) NOT NULL,
+    "type" varchar(50) NOT NULL,
+    "title" varchar(400) NOT NULL,
+    "body" varchar(4000),
+    "data" varchar(1000),
+    "attempts" bigint NOT NULL,
+    "last_error" varchar(1000),
+    "next_attempt_at" timestamptz NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_push_retries_next_attempt_at" ON "push_retries" ("next_attempt_at");
+CREATE INDEX IF NOT EXISTS "idx_push_retries_token" ON "push_retries" ("token");
+
+CREATE TABLE "push_dead_letters" (
+    "id" varchar(36),
+    "token" varchar(512) NOT NULL,
+    "type" varchar(50) NOT NULL,
+    "title" varchar(400) NOT NULL,
+    "body" varchar(4000),
+    "data" varchar(1000),
+    "attempts" bigint NOT NULL,
+    "last_error" varchar(1000),
+    "created_at" timestamptz,
+    "dead_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_push_dead_letters_dead_at" ON "push_dead_letters" ("dead_at");
+
+CREATE TABLE "idempotency_keys" (
+    "user_id" varchar(36),
+    "idempotency_key" varchar(255),
+    "fingerprint" varchar(64) NOT NULL,
+    "status" bigint NOT NULL DEFAULT 0,
+    "content_type" varchar(100),
+    "body" bytea,
+    "created_at" timestamptz,
+    "expires_at" timestamptz NOT NULL,
+    PRIMARY KEY ("user_id","idempotency_key")
+);
+CREATE INDEX IF NOT EXISTS "idx_idempotency_keys_expires_at" ON "idempotency_keys" ("expires_at");
+
+CREATE TABLE "audit_logs" (
+    "id" varchar(36),
+    "action" varchar(50) NOT NULL,
+    "actor_id" varchar(36),
+    "target_type" varchar(20),
+    "target_id" varchar(36),
+    "details" varchar(2000),
+    "request_id" varchar(100),
+    "ip" varchar(45),
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_action_created" ON "audit_logs" ("action","created_at");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_actor_created" ON "audit_logs" ("actor_id","created_at");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_target" ON "audit_logs" ("target_id");
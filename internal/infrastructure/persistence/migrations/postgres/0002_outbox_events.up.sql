@@ -0,0 +1,17 @@
+CREATE TABLE "outbox_events" (
+    "id" varchar(36),
+    "type" varchar(50) NOT NULL,
+    "template" varchar(50),
+    "params" varchar(2000),
+    "data" varchar(1000),
+    "room_id" varchar(36) NOT NULL,
+    "actor_id" varchar(36),
+    "attempts" bigint NOT NULL,
+    "last_error" varchar(1000),
+    "next_attempt_at" timestamptz NOT NULL,
+    "dead_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_outbox_events_next_attempt_at" ON "outbox_events" ("next_attempt_at");
+CREATE INDEX IF NOT EXISTS "idx_outbox_events_dead_at" ON "outbox_events" ("dead_at");
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "idx_prayer_topics_room_pinned";
+ALTER TABLE "prayer_topics" DROP COLUMN "pin_position";
+ALTER TABLE "prayer_topics" DROP COLUMN "pinned_by";
+ALTER TABLE "prayer_topics" DROP COLUMN "pinned_at";
@@ -0,0 +1,4 @@
+ALTER TABLE "prayer_topics" ADD COLUMN "pinned_at" timestamptz;
+ALTER TABLE "prayer_topics" ADD COLUMN "pinned_by" varchar(36);
+ALTER TABLE "prayer_topics" ADD COLUMN "pin_position" bigint;
+CREATE INDEX IF NOT EXISTS "idx_prayer_topics_room_pinned" ON "prayer_topics" ("room_id","pin_position");
@@ -0,0 +1,13 @@
+CREATE TABLE "room_invitations" (
+    "id" varchar(36),
+    "room_id" varchar(36) NOT NULL,
+    "inviter_id" varchar(36) NOT NULL,
+    "invitee_id" varchar(36) NOT NULL,
+    "status" varchar(10) NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "responded_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_room_invitations_invitee" ON "room_invitations" ("invitee_id","status","created_at");
+CREATE INDEX IF NOT EXISTS "idx_room_invitations_room" ON "room_invitations" ("room_id","invitee_id","status");
@@ -0,0 +1 @@
+DROP TABLE "prayer_revisions";
@@ -0,0 +1,13 @@
+CREATE TABLE "prayer_revisions" (
+    "id" varchar(36),
+    "topic_id" varchar(36) NOT NULL,
+    "room_id" varchar(36) NOT NULL,
+    "content_id" varchar(36),
+    "field" varchar(10) NOT NULL,
+    "previous" varchar(4000) NOT NULL,
+    "editor_id" varchar(36) NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_prayer_revisions_topic_created" ON "prayer_revisions" ("topic_id","created_at");
+CREATE INDEX IF NOT EXISTS "idx_prayer_revisions_room_id" ON "prayer_revisions" ("room_id");
@@ -0,0 +1,2 @@
+ALTER TABLE "prayer_reactions" DROP COLUMN "prayed_at";
+ALTER TABLE "prayer_reactions" DROP COLUMN "prayer_count";
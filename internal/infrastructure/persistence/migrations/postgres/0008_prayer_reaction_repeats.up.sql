@@ -0,0 +1,3 @@
+ALTER TABLE "prayer_reactions" ADD COLUMN "prayer_count" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "prayer_reactions" ADD COLUMN "prayed_at" timestamptz;
+UPDATE "prayer_reactions" SET "prayed_at" = "created_at";
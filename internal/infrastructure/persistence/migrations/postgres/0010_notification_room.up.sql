@@ -0,0 +1,3 @@
+ALTER TABLE "notifications" ADD COLUMN "room_id" varchar(36);
+UPDATE "notifications" SET "room_id" = "data"::json ->> 'room_id' WHERE "data" <> '';
+CREATE INDEX IF NOT EXISTS "idx_notifications_user_room" ON "notifications" ("user_id","room_id");
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_prayer_topics_author_order";
+ALTER TABLE "prayer_topics" DROP COLUMN "user_order";
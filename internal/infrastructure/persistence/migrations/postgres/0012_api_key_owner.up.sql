@@ -0,0 +1,3 @@
+ALTER TABLE "api_keys" ADD COLUMN "user_id" varchar(36);
+ALTER TABLE "api_keys" ADD COLUMN "scopes" varchar(50);
+CREATE INDEX IF NOT EXISTS "idx_api_keys_user_id" ON "api_keys" ("user_id");
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_room_members_room_seen";
+ALTER TABLE "room_members" DROP COLUMN "last_seen_at";
@@ -0,0 +1,2 @@
+ALTER TABLE "room_members" ADD COLUMN "last_seen_at" timestamptz;
+CREATE INDEX IF NOT EXISTS "idx_room_members_room_seen" ON "room_members" ("room_id","last_seen_at");
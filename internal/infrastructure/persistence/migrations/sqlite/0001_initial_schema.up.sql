@@ -0,0 +1,409 @@
+CREATE TABLE `users` (
+    `id` text,
+    `email` text,
+    `nickname` text NOT NULL,
+    `nickname_key` text,
+    `password_hash` text,
+    `role` text NOT NULL DEFAULT "user",
+    `bio` text,
+    `profile_image_url` text,
+    `timezone` text NOT NULL DEFAULT "Asia/Seoul",
+    `locale` text NOT NULL DEFAULT "ko-KR",
+    `hidden_from_search` numeric NOT NULL DEFAULT false,
+    `mute_invites` numeric NOT NULL DEFAULT false,
+    `mute_answered` numeric NOT NULL DEFAULT false,
+    `mute_comments` numeric NOT NULL DEFAULT false,
+    `mute_reminders` numeric NOT NULL DEFAULT false,
+    `mute_announcements` numeric NOT NULL DEFAULT false,
+    `digest_frequency` text NOT NULL DEFAULT "off",
+    `email_verified_at` datetime,
+    `purge_at` datetime,
+    `suspended_at` datetime,
+    `suspension_reason` text,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_users_purge_at` ON `users`(`purge_at`);
+CREATE UNIQUE INDEX `idx_users_email` ON `users`(`email`);
+CREATE UNIQUE INDEX `idx_users_nickname_key` ON `users`(`nickname_key`);
+
+CREATE TABLE `social_accounts` (
+    `id` text,
+    `user_id` text NOT NULL,
+    `provider` text NOT NULL,
+    `provider_user_id` text NOT NULL,
+    `email` text,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE UNIQUE INDEX `idx_social_provider_subject` ON `social_accounts`(`provider`,`provider_user_id`);
+CREATE UNIQUE INDEX `idx_social_user_provider` ON `social_accounts`(`user_id`,`provider`);
+
+CREATE TABLE `refresh_tokens` (
+    `id` text,
+    `family_id` text NOT NULL,
+    `user_id` text NOT NULL,
+    `device_id` text NOT NULL,
+    `expires_at` datetime NOT NULL,
+    `used_at` datetime,
+    `revoked_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_refresh_tokens_expires_at` ON `refresh_tokens`(`expires_at`);
+CREATE INDEX `idx_refresh_tokens_family_id` ON `refresh_tokens`(`family_id`);
+CREATE INDEX `idx_refresh_tokens_user_id` ON `refresh_tokens`(`user_id`);
+
+CREATE TABLE `revoked_tokens` (
+    `token_id` text,
+    `user_id` text NOT NULL,
+    `expires_at` datetime NOT NULL,
+    `created_at` datetime,
+    PRIMARY KEY (`token_id`)
+);
+CREATE INDEX `idx_revoked_tokens_expires_at` ON `revoked_tokens`(`expires_at`);
+CREATE INDEX `idx_revoked_tokens_user_id` ON `revoked_tokens`(`user_id`);
+
+CREATE TABLE `password_reset_tokens` (
+    `id` text,
+    `user_id` text NOT NULL,
+    `token_hash` text NOT NULL,
+    `expires_at` datetime NOT NULL,
+    `used_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_password_reset_tokens_user_id` ON `password_reset_tokens`(`user_id`);
+CREATE UNIQUE INDEX `idx_password_reset_tokens_token_hash` ON `password_reset_tokens`(`token_hash`);
+
+CREATE TABLE `email_verification_tokens` (
+    `id` text,
+    `user_id` text NOT NULL,
+    `email` text NOT NULL,
+    `token_hash` text NOT NULL,
+    `expires_at` datetime NOT NULL,
+    `used_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_email_verification_tokens_user_id` ON `email_verification_tokens`(`user_id`);
+CREATE UNIQUE INDEX `idx_email_verification_tokens_token_hash` ON `email_verification_tokens`(`token_hash`);
+
+CREATE TABLE `api_keys` (
+    `id` text,
+    `name` text NOT NULL,
+    `prefix` text NOT NULL,
+    `key_hash` text NOT NULL,
+    `created_by` text NOT NULL,
+    `last_used_at` datetime,
+    `revoked_at` datetime,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE UNIQUE INDEX `idx_api_keys_key_hash` ON `api_keys`(`key_hash`);
+
+CREATE TABLE `user_two_factor` (
+    `user_id` text,
+    `secret` text NOT NULL,
+    `enabled_at` datetime,
+    `last_used_step` integer NOT NULL DEFAULT 0,
+    `failed_attempts` integer NOT NULL DEFAULT 0,
+    `locked_until` datetime,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`user_id`)
+);
+
+CREATE TABLE `two_factor_backup_codes` (
+    `id` text,
+    `user_id` text NOT NULL,
+    `code_hash` text NOT NULL,
+    `used_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_two_factor_backup_codes_user_id` ON `two_factor_backup_codes`(`user_id`);
+
+CREATE TABLE `user_blocks` (
+    `blocker_id` text,
+    `blocked_id` text,
+    `created_at` datetime,
+    PRIMARY KEY (`blocker_id`,`blocked_id`)
+);
+CREATE INDEX `idx_user_blocks_blocked_id` ON `user_blocks`(`blocked_id`);
+
+CREATE TABLE `rooms` (
+    `id` text,
+    `name` text NOT NULL,
+    `description` text,
+    `visibility` text NOT NULL DEFAULT "private",
+    `category` text,
+    `owner_id` text NOT NULL,
+    `member_cap` integer NOT NULL DEFAULT 0,
+    `reminder_time` text,
+    `post_policy` text NOT NULL DEFAULT "members",
+    `cover_image_key` text,
+    `cover_image_url` text,
+    `archived_at` datetime,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_rooms_category` ON `rooms`(`category`);
+CREATE INDEX `idx_rooms_owner_id` ON `rooms`(`owner_id`);
+CREATE INDEX `idx_rooms_visibility` ON `rooms`(`visibility`);
+
+CREATE TABLE `room_tags` (
+    `room_id` text,
+    `tag` text,
+    PRIMARY KEY (`room_id`,`tag`)
+);
+CREATE INDEX `idx_room_tags_tag` ON `room_tags`(`tag`);
+
+CREATE TABLE `room_members` (
+    `room_id` text,
+    `user_id` text,
+    `role` text NOT NULL DEFAULT "member",
+    `invited_by` text,
+    `muted` numeric NOT NULL DEFAULT false,
+    `joined_at` datetime,
+    PRIMARY KEY (`room_id`,`user_id`)
+);
+CREATE INDEX `idx_room_members_user_id` ON `room_members`(`user_id`);
+
+CREATE TABLE `room_invites` (
+    `id` text,
+    `room_id` text NOT NULL,
+    `code` text NOT NULL,
+    `created_by` text NOT NULL,
+    `max_uses` integer NOT NULL,
+    `use_count` integer NOT NULL,
+    `expires_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_room_invites_created_by` ON `room_invites`(`created_by`);
+CREATE INDEX `idx_room_invites_room_id` ON `room_invites`(`room_id`);
+CREATE UNIQUE INDEX `idx_room_invites_code` ON `room_invites`(`code`);
+
+CREATE TABLE `room_join_requests` (
+    `id` text,
+    `room_id` text NOT NULL,
+    `user_id` text NOT NULL,
+    `message` text,
+    `status` text NOT NULL,
+    `decided_by` text,
+    `decided_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_join_requests_room_status` ON `room_join_requests`(`room_id`,`status`);
+CREATE INDEX `idx_room_join_requests_user_id` ON `room_join_requests`(`user_id`);
+
+CREATE TABLE `room_announcements` (
+    `id` text,
+    `room_id` text NOT NULL,
+    `author_id` text NOT NULL,
+    `body` text NOT NULL,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_room_announcements_author_id` ON `room_announcements`(`author_id`);
+CREATE INDEX `idx_room_announcements_room_created` ON `room_announcements`(`room_id`,`created_at`);
+
+CREATE TABLE `prayer_topics` (
+    `id` text,
+    `room_id` text NOT NULL,
+    `author_id` text NOT NULL,
+    `title` text NOT NULL,
+    `private` numeric NOT NULL DEFAULT false,
+    `recurrence` text,
+    `recurrence_paused` numeric NOT NULL DEFAULT false,
+    `next_recurrence_at` datetime,
+    `answered_at` datetime,
+    `testimony` text,
+    `comment_count` integer NOT NULL DEFAULT 0,
+    `reaction_count` integer NOT NULL DEFAULT 0,
+    `deleted_at` datetime,
+    `deleted_by` text,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_prayer_topics_author_id` ON `prayer_topics`(`author_id`);
+CREATE INDEX `idx_prayer_topics_deleted_at` ON `prayer_topics`(`deleted_at`);
+CREATE INDEX `idx_prayer_topics_next_recurrence_at` ON `prayer_topics`(`next_recurrence_at`);
+CREATE INDEX `idx_prayer_topics_room_answered` ON `prayer_topics`(`room_id`,`answered_at`);
+CREATE INDEX `idx_prayer_topics_room_created` ON `prayer_topics`(`room_id`,`created_at`);
+
+CREATE TABLE `prayer_topic_tags` (
+    `topic_id` text,
+    `tag` text,
+    `room_id` text NOT NULL,
+    PRIMARY KEY (`topic_id`,`tag`)
+);
+CREATE INDEX `idx_prayer_tags_room_tag` ON `prayer_topic_tags`(`room_id`,`tag`);
+
+CREATE TABLE `prayer_contents` (
+    `id` text,
+    `topic_id` text NOT NULL,
+    `room_id` text NOT NULL,
+    `author_id` text NOT NULL,
+    `body` text NOT NULL,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_prayer_contents_author_id` ON `prayer_contents`(`author_id`);
+CREATE INDEX `idx_prayer_contents_room_id` ON `prayer_contents`(`room_id`);
+CREATE INDEX `idx_prayer_contents_topic_created` ON `prayer_contents`(`topic_id`,`created_at`);
+
+CREATE TABLE `prayer_comments` (
+    `id` text,
+    `topic_id` text NOT NULL,
+    `room_id` text NOT NULL,
+    `parent_id` text,
+    `author_id` text NOT NULL,
+    `body` text NOT NULL,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_prayer_comments_author_id` ON `prayer_comments`(`author_id`);
+CREATE INDEX `idx_prayer_comments_parent_id` ON `prayer_comments`(`parent_id`);
+CREATE INDEX `idx_prayer_comments_room_id` ON `prayer_comments`(`room_id`);
+CREATE INDEX `idx_prayer_comments_topic_created` ON `prayer_comments`(`topic_id`,`created_at`);
+
+CREATE TABLE `prayer_comment_mentions` (
+    `comment_id` text,
+    `user_id` text,
+    PRIMARY KEY (`comment_id`,`user_id`)
+);
+CREATE INDEX `idx_prayer_comment_mentions_user_id` ON `prayer_comment_mentions`(`user_id`);
+
+CREATE TABLE `prayer_reactions` (
+    `topic_id` text,
+    `user_id` text,
+    `room_id` text NOT NULL,
+    `created_at` datetime,
+    PRIMARY KEY (`topic_id`,`user_id`)
+);
+CREATE INDEX `idx_prayer_reactions_room_id` ON `prayer_reactions`(`room_id`);
+CREATE INDEX `idx_prayer_reactions_user_id` ON `prayer_reactions`(`user_id`);
+
+CREATE TABLE `room_exports` (
+    `id` text,
+    `room_id` text NOT NULL,
+    `requested_by` text NOT NULL,
+    `format` text NOT NULL,
+    `status` text NOT NULL,
+    `file_key` text,
+    `started_at` datetime,
+    `completed_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_room_exports_created_at` ON `room_exports`(`created_at`);
+CREATE INDEX `idx_room_exports_room_user` ON `room_exports`(`room_id`,`requested_by`);
+CREATE INDEX `idx_room_exports_status` ON `room_exports`(`status`);
+
+CREATE TABLE `user_devices` (
+    `id` text,
+    `user_id` text NOT NULL,
+    `device_id` text NOT NULL,
+    `token` text NOT NULL,
+    `platform` text NOT NULL,
+    `app_version` text,
+    `locale` text,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE UNIQUE INDEX `idx_devices_user_device` ON `user_devices`(`user_id`,`device_id`);
+CREATE UNIQUE INDEX `idx_user_devices_token` ON `user_devices`(`token`);
+
+CREATE TABLE `job_locks` (
+    `name` text,
+    `owner` text,
+    `locked_until` datetime,
+    `last_run_at` datetime,
+    PRIMARY KEY (`name`)
+);
+
+CREATE TABLE `notifications` (
+    `id` text,
+    `user_id` text NOT NULL,
+    `type` text NOT NULL,
+    `title` text NOT NULL,
+    `body` text,
+    `data` text,
+    `digest_pending` numeric NOT NULL DEFAULT false,
+    `read_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_notifications_digest` ON `notifications`(`digest_pending`,`created_at`);
+CREATE INDEX `idx_notifications_user_created` ON `notifications`(`user_id`,`created_at`);
+
+CREATE TABLE `push_retries` (
+    `id` text,
+    `token` text NOT NULL,
+    `type` text NOT NULL,
+    `title` text NOT NULL,
+    `body` text,
+    `data` text,
+    `attempts` integer NOT NULL,
+    `last_error` text,
+    `next_attempt_at` datetime NOT NULL,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_push_retries_next_attempt_at` ON `push_retries`(`next_attempt_at`);
+CREATE INDEX `idx_push_retries_token` ON `push_retries`(`token`);
+
+CREATE TABLE `push_dead_letters` (
+    `id` text,
+    `token` text NOT NULL,
+    `type` text NOT NULL,
+    `title` text NOT NULL,
+    `body` text,
+    `data` text,
+    `attempts` integer NOT NULL,
+    `last_error` text,
+    `created_at` datetime,
+    `dead_at` datetime NOT NULL,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_push_dead_letters_dead_at` ON `push_dead_letters`(`dead_at`);
+
+CREATE TABLE `idempotency_keys` (
+    `user_id` text,
+    `idempotency_key` text,
+    `fingerprint` text NOT NULL,
+    `status` integer NOT NULL DEFAULT 0,
+    `content_type` text,
+    `body` blob,
+    `created_at` datetime,
+    `expires_at` datetime NOT NULL,
+    PRIMARY KEY (`user_id`,`idempotency_key`)
+);
+CREATE INDEX `idx_idempotency_keys_expires_at` ON `idempotency_keys`(`expires_at`);
+
+CREATE TABLE `audit_logs` (
+    `id` text,
+    `action` text NOT NULL,
+    `actor_id` text,
+    `target_type` text,
+    `target_id` text,
+    `details` text,
+    `request_id` text,
+    `ip` text,
+    `created_at` datetime NOT NULL,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_audit_logs_action_created` ON `audit_logs`(`action`,`created_at`);
+CREATE INDEX `idx_audit_logs_actor_created` ON `audit_logs`(`actor_id`,`created_at`);
+CREATE INDEX `idx_audit_logs_target` ON `audit_logs`(`target_id`);
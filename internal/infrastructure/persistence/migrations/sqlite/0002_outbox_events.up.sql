@@ -0,0 +1,17 @@
+CREATE TABLE `outbox_events` (
+    `id` text,
+    `type` text NOT NULL,
+    `template` text,
+    `params` text,
+    `data` text,
+    `room_id` text NOT NULL,
+    `actor_id` text,
+    `attempts` integer NOT NULL,
+    `last_error` text,
+    `next_attempt_at` datetime NOT NULL,
+    `dead_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_outbox_events_next_attempt_at` ON `outbox_events`(`next_attempt_at`);
+CREATE INDEX `idx_outbox_events_dead_at` ON `outbox_events`(`dead_at`);
@@ -0,0 +1 @@
+ALTER TABLE `outbox_events` ADD COLUMN `destination` text NOT NULL DEFAULT "members";
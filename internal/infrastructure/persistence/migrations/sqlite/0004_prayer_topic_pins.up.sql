@@ -0,0 +1,4 @@
+ALTER TABLE `prayer_topics` ADD COLUMN `pinned_at` datetime;
+ALTER TABLE `prayer_topics` ADD COLUMN `pinned_by` text;
+ALTER TABLE `prayer_topics` ADD COLUMN `pin_position` integer;
+CREATE INDEX `idx_prayer_topics_room_pinned` ON `prayer_topics`(`room_id`,`pin_position`);
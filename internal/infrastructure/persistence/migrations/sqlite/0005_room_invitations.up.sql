@@ -0,0 +1,13 @@
+CREATE TABLE `room_invitations` (
+    `id` text,
+    `room_id` text NOT NULL,
+    `inviter_id` text NOT NULL,
+    `invitee_id` text NOT NULL,
+    `status` text NOT NULL,
+    `expires_at` datetime NOT NULL,
+    `responded_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_room_invitations_invitee` ON `room_invitations`(`invitee_id`,`status`,`created_at`);
+CREATE INDEX `idx_room_invitations_room` ON `room_invitations`(`room_id`,`invitee_id`,`status`);
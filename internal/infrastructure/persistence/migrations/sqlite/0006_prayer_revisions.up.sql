@@ -0,0 +1,13 @@
+CREATE TABLE `prayer_revisions` (
+    `id` text,
+    `topic_id` text NOT NULL,
+    `room_id` text NOT NULL,
+    `content_id` text,
+    `field` text NOT NULL,
+    `previous` text NOT NULL,
+    `editor_id` text NOT NULL,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_prayer_revisions_topic_created` ON `prayer_revisions`(`topic_id`,`created_at`);
+CREATE INDEX `idx_prayer_revisions_room_id` ON `prayer_revisions`(`room_id`);
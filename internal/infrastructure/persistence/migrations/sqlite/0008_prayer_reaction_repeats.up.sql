@@ -0,0 +1,3 @@
+ALTER TABLE `prayer_reactions` ADD COLUMN `prayer_count` integer NOT NULL DEFAULT 1;
+ALTER TABLE `prayer_reactions` ADD COLUMN `prayed_at` datetime;
+UPDATE `prayer_reactions` SET `prayed_at` = `created_at`;
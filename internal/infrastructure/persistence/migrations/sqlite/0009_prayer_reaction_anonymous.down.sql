@@ -0,0 +1,2 @@
+DROP INDEX `idx_prayer_reactions_topic_created`;
+ALTER TABLE `prayer_reactions` DROP COLUMN `anonymous`;
@@ -0,0 +1,2 @@
+DROP INDEX `idx_notifications_user_room`;
+ALTER TABLE `notifications` DROP COLUMN `room_id`;
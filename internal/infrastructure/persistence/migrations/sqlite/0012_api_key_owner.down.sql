@@ -0,0 +1,3 @@
+DROP INDEX `idx_api_keys_user_id`;
+ALTER TABLE `api_keys` DROP COLUMN `scopes`;
+ALTER TABLE `api_keys` DROP COLUMN `user_id`;
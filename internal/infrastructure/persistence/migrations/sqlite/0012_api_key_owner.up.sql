@@ -0,0 +1,3 @@
+ALTER TABLE `api_keys` ADD COLUMN `user_id` text;
+ALTER TABLE `api_keys` ADD COLUMN `scopes` text;
+CREATE INDEX `idx_api_keys_user_id` ON `api_keys`(`user_id`);
@@ -0,0 +1,248 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// notificationInsertBatch bounds the rows per INSERT when fanning a notification out to a room
+const notificationInsertBatch = 100
+
+// notificationModel is the GORM mapping of entity.InboxNotification
+type notificationModel struct {
+	ID     string `gorm:"primaryKey;size:36"`
+	UserID string `gorm:"size:36;not null;index:idx_notifications_user_created;index:idx_notifications_user_room"`
+	// RoomID copies the room_id of Data so unread counts can be grouped by room
+	// It is NULL rather than empty for notifications about no room, as Oracle reads '' as NULL
+	RoomID *string `gorm:"size:36;index:idx_notifications_user_room"`
+	Type   string  `gorm:"size:50;not null"`
+	Title  string  `gorm:"size:400;not null"`
+	Body   string  `gorm:"size:4000"`
+	// Data is the JSON-encoded deep link identifiers
+	Data          string `gorm:"size:1000"`
+	DigestPending bool   `gorm:"not null;default:0;index:idx_notifications_digest"`
+	ReadAt        *time.Time
+	CreatedAt     time.Time `gorm:"index:idx_notifications_user_created;index:idx_notifications_digest"`
+}
+
+func (notificationModel) TableName() string {
+	return "notifications"
+}
+
+func newNotificationModel(n *entity.InboxNotification) (*notificationModel, error) {
+	data, err := encodeNotificationData(n.Data)
+	if err != nil {
+		return nil, err
+	}
+	var roomID *string
+	if id := n.Data["room_id"]; id != "" {
+		roomID = &id
+	}
+	return &notificationModel{
+		ID:            n.ID,
+		UserID:        n.UserID,
+		RoomID:        roomID,
+		Type:          string(n.Type),
+		Title:         n.Title,
+		Body:          n.Body,
+		Data:          data,
+		DigestPending: n.DigestPending,
+		ReadAt:        n.ReadAt,
+		CreatedAt:     n.CreatedAt,
+	}, nil
+}
+
+func (m *notificationModel) toEntity() *entity.InboxNotification {
+	return &entity.InboxNotification{
+		ID:     m.ID,
+		UserID: m.UserID,
+		Notification: entity.Notification{
+			Type:  entity.NotificationType(m.Type),
+			Title: m.Title,
+			Body:  m.Body,
+			Data:  decodeNotificationData(m.Data),
+		},
+		DigestPending: m.DigestPending,
+		ReadAt:        m.ReadAt,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+// encodeNotificationData stores the deep link identifiers of a notification as JSON
+func encodeNotificationData(data map[string]string) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// decodeNotificationData reverses encodeNotificationData
+// Rows are only written through it, so the JSON is always valid
+func decodeNotificationData(data string) map[string]string {
+	var decoded map[string]string
+	if data != "" {
+		_ = json.Unmarshal([]byte(data), &decoded)
+	}
+	return decoded
+}
+
+// unreadCountRow is a row of CountUnreadByRoom's grouped query
+type unreadCountRow struct {
+	RoomID      string
+	UnreadCount int64
+}
+
+type notificationRepository struct {
+	db *database.DB
+}
+
+func NewNotificationRepository(db *database.DB) repository.NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) CreateMany(ctx context.Context, notifications []*entity.InboxNotification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	models := make([]*notificationModel, 0, len(notifications))
+	for _, n := range notifications {
+		model, err := newNotificationModel(n)
+		if err != nil {
+			return err
+		}
+		models = append(models, model)
+	}
+	return r.db.WithContext(ctx).CreateInBatches(models, notificationInsertBatch).Error
+}
+
+func (r *notificationRepository) List(ctx context.Context, userID string, unreadOnly bool, after *pagination.TimeKey, limit int) ([]*entity.InboxNotification, error) {
+	var models []notificationModel
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	err := query.
+		Scopes(afterTimeKey("created_at", "id", after)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*entity.InboxNotification, 0, len(models))
+	for i := range models {
+		notifications = append(notifications, models[i].toEntity())
+	}
+	return notifications, nil
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&notificationModel{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *notificationRepository) CountUnreadByRoom(ctx context.Context, userID string) (map[string]int64, error) {
+	var rows []unreadCountRow
+	err := r.db.WithContext(ctx).
+		Model(&notificationModel{}).
+		Select("room_id, COUNT(*) AS unread_count").
+		Where("user_id = ? AND read_at IS NULL AND room_id IS NOT NULL", userID).
+		Group("room_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.RoomID] = row.UnreadCount
+	}
+	return counts, nil
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, userID, id string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&notificationModel{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", at.UTC()))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrNotificationNotFound
+	}
+	return nil
+}
+
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&notificationModel{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", at.UTC()).Error
+}
+
+func (r *notificationRepository) ListDueDigests(ctx context.Context, frequency entity.DigestFrequency, before time.Time, limit int) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).
+		Table("notifications n").
+		Joins("JOIN users u ON u.id = n.user_id").
+		Where("n.digest_pending = ? AND n.created_at <= ? AND u.digest_frequency = ?", true, before.UTC(), string(frequency)).
+		Distinct("n.user_id").
+		Limit(limit).
+		Pluck("n.user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (r *notificationRepository) TakeDigest(ctx context.Context, userID string) ([]*entity.InboxNotification, error) {
+	var models []notificationModel
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND digest_pending = ?", userID, true).
+			Order("created_at, id").
+			Find(&models).Error
+		if err != nil {
+			return err
+		}
+
+		ids := make([]string, 0, len(models))
+		for i := range models {
+			ids = append(ids, models[i].ID)
+		}
+		for start := 0; start < len(ids); start += maxInListSize {
+			end := min(start+maxInListSize, len(ids))
+			err := tx.Model(&notificationModel{}).
+				Where("id IN ?", ids[start:end]).
+				Update("digest_pending", false).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*entity.InboxNotification, 0, len(models))
+	for i := range models {
+		notifications = append(notifications, models[i].toEntity())
+	}
+	return notifications, nil
+}
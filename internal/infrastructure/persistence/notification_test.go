@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+func TestNotificationRepositoryCountsUnreadByRoom(t *testing.T) {
+	ctx := context.Background()
+	repo := NewNotificationRepository(newTestDB(t))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	var notifications []*entity.InboxNotification
+	add := func(userID, roomID string) *entity.InboxNotification {
+		n := entity.NewInboxNotification(userID, entity.Notification{
+			Type:  entity.NotificationAnnouncement,
+			Title: "공지",
+			Data:  map[string]string{"room_id": roomID},
+		}, now)
+		if roomID == "" {
+			n.Data = nil
+		}
+		n.ID = fmt.Sprintf("n-%d", len(notifications))
+		notifications = append(notifications, n)
+		return n
+	}
+	add("grace", "room-1")
+	add("grace", "room-1")
+	add("grace", "room-2")
+	add("grace", "room-3").ReadAt = &now
+	add("grace", "")
+	add("john", "room-1")
+	if err := repo.CreateMany(ctx, notifications); err != nil {
+		t.Fatalf("CreateMany: %v", err)
+	}
+
+	counts, err := repo.CountUnreadByRoom(ctx, "grace")
+	if err != nil {
+		t.Fatalf("CountUnreadByRoom: %v", err)
+	}
+	if want := map[string]int64{"room-1": 2, "room-2": 1}; !maps.Equal(counts, want) {
+		t.Errorf("counts = %v, want %v without the read room or roomless notifications", counts, want)
+	}
+
+	if err := repo.MarkAllRead(ctx, "grace", now); err != nil {
+		t.Fatalf("MarkAllRead: %v", err)
+	}
+	if counts, err := repo.CountUnreadByRoom(ctx, "grace"); err != nil || len(counts) != 0 {
+		t.Errorf("after reading all: counts = %v, err = %v, want none", counts, err)
+	}
+}
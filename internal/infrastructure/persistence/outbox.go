@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+)
+
+// outboxEventModel is the GORM mapping of entity.OutboxEvent
+// Published events are deleted; DeadAt is set on those that ran out of attempts
+type outboxEventModel struct {
+	ID            string     `gorm:"primaryKey;size:36"`
+	Destination   string     `gorm:"size:20;not null;default:members"`
+	Type          string     `gorm:"size:50;not null"`
+	Template      string     `gorm:"size:50"`
+	Params        string     `gorm:"size:2000"`
+	Data          string     `gorm:"size:1000"`
+	RoomID        string     `gorm:"size:36;not null"`
+	ActorID       string     `gorm:"size:36"`
+	Attempts      int        `gorm:"not null"`
+	LastError     string     `gorm:"size:1000"`
+	NextAttemptAt time.Time  `gorm:"not null;index"`
+	DeadAt        *time.Time `gorm:"index"`
+	CreatedAt     time.Time
+}
+
+func (outboxEventModel) TableName() string {
+	return "outbox_events"
+}
+
+func newOutboxEventModel(e *entity.OutboxEvent) (*outboxEventModel, error) {
+	params, err := encodeNotificationData(e.Notification.Params)
+	if err != nil {
+		return nil, err
+	}
+	data, err := encodeNotificationData(e.Notification.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &outboxEventModel{
+		ID:            e.ID,
+		Destination:   string(e.Destination),
+		Type:          string(e.Notification.Type),
+		Template:      e.Notification.Template,
+		Params:        params,
+		Data:          data,
+		RoomID:        e.RoomID,
+		ActorID:       e.ActorID,
+		Attempts:      e.Attempts,
+		LastError:     truncateError(e.LastError),
+		NextAttemptAt: e.NextAttemptAt,
+		CreatedAt:     e.CreatedAt,
+	}, nil
+}
+
+func (m *outboxEventModel) toEntity() *entity.OutboxEvent {
+	return &entity.OutboxEvent{
+		ID:          m.ID,
+		Destination: entity.OutboxDestination(m.Destination),
+		Notification: entity.Notification{
+			Type:     entity.NotificationType(m.Type),
+			Template: m.Template,
+			Params:   decodeNotificationData(m.Params),
+			Data:     decodeNotificationData(m.Data),
+		},
+		RoomID:        m.RoomID,
+		ActorID:       m.ActorID,
+		Attempts:      m.Attempts,
+		LastError:     m.LastError,
+		NextAttemptAt: m.NextAttemptAt,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+type outboxRepository struct {
+	db *database.DB
+}
+
+func NewOutboxRepository(db *database.DB) repository.OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) Append(ctx context.Context, event *entity.OutboxEvent) error {
+	model, err := newOutboxEventModel(event)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Create(model).Error
+}
+
+func (r *outboxRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	var models []outboxEventModel
+	err := r.db.WithContext(ctx).
+		Where("dead_at IS NULL AND next_attempt_at <= ?", now.UTC()).
+		Order("next_attempt_at, id").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*entity.OutboxEvent, 0, len(models))
+	for i := range models {
+		events = append(events, models[i].toEntity())
+	}
+	return events, nil
+}
+
+func (r *outboxRepository) Claim(ctx context.Context, id string) (bool, error) {
+	result := r.db.WithContext(ctx).Where("id = ? AND dead_at IS NULL", id).Delete(&outboxEventModel{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *outboxRepository) Reschedule(ctx context.Context, event *entity.OutboxEvent) error {
+	return r.db.WithContext(ctx).
+		Model(&outboxEventModel{}).
+		Where("id = ?", event.ID).
+		Updates(map[string]interface{}{
+			"attempts":        event.Attempts,
+			"last_error":      truncateError(event.LastError),
+			"next_attempt_at": event.NextAttemptAt.UTC(),
+		}).Error
+}
+
+func (r *outboxRepository) DeadLetter(ctx context.Context, event *entity.OutboxEvent, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&outboxEventModel{}).
+		Where("id = ?", event.ID).
+		Updates(map[string]interface{}{
+			"attempts":   event.Attempts,
+			"last_error": truncateError(event.LastError),
+			"dead_at":    at.UTC(),
+		}).Error
+}
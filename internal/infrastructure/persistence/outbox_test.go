@@ -0,0 +1,50 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+func TestOutboxRepositoryKeepsDestination(t *testing.T) {
+	ctx := context.Background()
+	repo := NewOutboxRepository(newTestDB(t))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	event := entity.NewRoomOutboxEvent("room-1", "actor", entity.Notification{
+		Type: entity.NotificationPrayerAnswered,
+		Data: map[string]string{"topic_id": "topic-1"},
+	}, now.Add(-time.Minute))
+	event.ID = "members-1"
+	copied := event.ForWebhook(now.Add(-time.Second))
+	copied.ID = "webhook-1"
+	for _, e := range []*entity.OutboxEvent{event, copied} {
+		if err := repo.Append(ctx, e); err != nil {
+			t.Fatalf("Append(%s): %v", e.ID, err)
+		}
+	}
+
+	due, err := repo.ListDue(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("ListDue: %v", err)
+	}
+	if len(due) != 2 {
+		t.Fatalf("got %d events, want 2", len(due))
+	}
+	if due[0].ID != "members-1" || due[0].Destination != entity.OutboxToMembers {
+		t.Errorf("first = %s to %s, want members-1 to members", due[0].ID, due[0].Destination)
+	}
+	if due[1].ID != "webhook-1" || due[1].Destination != entity.OutboxToWebhook || due[1].Notification.Data["topic_id"] != "topic-1" {
+		t.Errorf("second = %+v, want webhook-1 to the webhook with the notification data", due[1])
+	}
+
+	claimed, err := repo.Claim(ctx, "webhook-1")
+	if err != nil || !claimed {
+		t.Fatalf("Claim = %v, %v, want true", claimed, err)
+	}
+	if claimed, _ := repo.Claim(ctx, "webhook-1"); claimed {
+		t.Error("claimed a published event again")
+	}
+}
@@ -0,0 +1,20 @@
+package persistence
+
+import (
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+// afterTimeKey is a scope that continues a "timeColumn DESC, idColumn DESC" list after the cursor key
+// A nil key starts from the top
+func afterTimeKey(timeColumn, idColumn string, after *pagination.TimeKey) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if after == nil {
+			return db
+		}
+		return db.Where(
+			"("+timeColumn+" < ? OR ("+timeColumn+" = ? AND "+idColumn+" < ?))",
+			after.Time.UTC(), after.Time.UTC(), after.ID,
+		)
+	}
+}
@@ -0,0 +1,45 @@
+package persistence
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+)
+
+// newTestDB opens a migrated sqlite database that lives as long as the test
+func newTestDB(t *testing.T) *database.DB {
+	t.Helper()
+
+	cfg := &config.Config{
+		App: config.AppConfig{Env: "test"},
+		Database: config.DatabaseConfig{
+			Driver:          database.DriverSQLite,
+			Path:            filepath.Join(t.TempDir(), "test.db"),
+			MaxIdleConns:    1,
+			MaxOpenConns:    1,
+			ConnMaxLifetime: time.Hour,
+		},
+	}
+	db, err := database.New(cfg)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	files, err := Migrations(database.DriverSQLite)
+	if err != nil {
+		t.Fatalf("failed to read migrations: %v", err)
+	}
+	migrator, err := database.NewMigrator(db, files)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db
+}
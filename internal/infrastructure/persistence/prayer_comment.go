@@ -0,0 +1,252 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+// prayerCommentModel is the GORM mapping of entity.PrayerComment
+// Every insert and delete also adjusts prayer_topics.comment_count in the same transaction
+type prayerCommentModel struct {
+	ID        string    `gorm:"primaryKey;size:36"`
+	TopicID   string    `gorm:"size:36;not null;index:idx_prayer_comments_topic_created"`
+	RoomID    string    `gorm:"size:36;not null;index"`
+	ParentID  string    `gorm:"size:36;index"`
+	AuthorID  string    `gorm:"size:36;not null;index"`
+	Body      string    `gorm:"size:2000;not null"` // 500 characters in UTF-8
+	CreatedAt time.Time `gorm:"index:idx_prayer_comments_topic_created"`
+	UpdatedAt time.Time
+}
+
+func (prayerCommentModel) TableName() string {
+	return "prayer_comments"
+}
+
+func newPrayerCommentModel(c *entity.PrayerComment) *prayerCommentModel {
+	return &prayerCommentModel{
+		ID:        c.ID,
+		TopicID:   c.TopicID,
+		RoomID:    c.RoomID,
+		ParentID:  c.ParentID,
+		AuthorID:  c.AuthorID,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func (m *prayerCommentModel) toEntity() *entity.PrayerComment {
+	return &entity.PrayerComment{
+		ID:        m.ID,
+		TopicID:   m.TopicID,
+		RoomID:    m.RoomID,
+		ParentID:  m.ParentID,
+		AuthorID:  m.AuthorID,
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// commentMentionModel is one member mentioned in a comment
+// The user index serves removing a purged user's mentions
+type commentMentionModel struct {
+	CommentID string `gorm:"primaryKey;size:36"`
+	UserID    string `gorm:"primaryKey;size:36;index"`
+}
+
+func (commentMentionModel) TableName() string {
+	return "prayer_comment_mentions"
+}
+
+type prayerCommentRepository struct {
+	db *database.DB
+}
+
+func NewPrayerCommentRepository(db *database.DB) repository.PrayerCommentRepository {
+	return &prayerCommentRepository{db: db}
+}
+
+func (r *prayerCommentRepository) Create(ctx context.Context, comment *entity.PrayerComment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newPrayerCommentModel(comment)).Error; err != nil {
+			return err
+		}
+		if err := createCommentMentions(tx, comment); err != nil {
+			return err
+		}
+		return adjustCommentCount(tx, comment.TopicID, 1)
+	})
+}
+
+func (r *prayerCommentRepository) GetByID(ctx context.Context, id string) (*entity.PrayerComment, error) {
+	db := r.db.WithContext(ctx)
+
+	var model prayerCommentModel
+	if err := db.Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrPrayerCommentNotFound
+		}
+		return nil, err
+	}
+
+	comment := model.toEntity()
+	if err := loadCommentMentions(db, []*entity.PrayerComment{comment}); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+func (r *prayerCommentRepository) List(ctx context.Context, topicID string, filter repository.PrayerCommentFilter, after *pagination.TimeKey, limit int) ([]*entity.PrayerComment, error) {
+	db := r.db.WithContext(ctx)
+
+	query := db.Where("topic_id = ?", topicID)
+	if filter.ParentID == "" {
+		query = query.Where("parent_id IS NULL")
+	} else {
+		query = query.Where("parent_id = ?", filter.ParentID)
+	}
+	if filter.ViewerID != "" {
+		query = query.Scopes(notBlockedBy(filter.ViewerID, "author_id"))
+	}
+
+	var models []prayerCommentModel
+	err := query.Scopes(afterTimeKey("created_at", "id", after)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*entity.PrayerComment, 0, len(models))
+	for i := range models {
+		comments = append(comments, models[i].toEntity())
+	}
+	if err := loadCommentMentions(db, comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (r *prayerCommentRepository) Update(ctx context.Context, comment *entity.PrayerComment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&prayerCommentModel{}).
+			Where("id = ?", comment.ID).
+			Updates(map[string]interface{}{
+				"body":       comment.Body,
+				"updated_at": comment.UpdatedAt.UTC(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrPrayerCommentNotFound
+		}
+
+		if err := tx.Where("comment_id = ?", comment.ID).Delete(&commentMentionModel{}).Error; err != nil {
+			return err
+		}
+		return createCommentMentions(tx, comment)
+	})
+}
+
+func (r *prayerCommentRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model prayerCommentModel
+		if err := tx.Where("id = ?", id).First(&model).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrPrayerCommentNotFound
+			}
+			return err
+		}
+
+		// Replies go with their parent, so the count drops by the whole thread
+		thread := "id = ? OR parent_id = ?"
+		if err := tx.Where("comment_id IN (SELECT id FROM prayer_comments WHERE "+thread+")", id, id).Delete(&commentMentionModel{}).Error; err != nil {
+			return err
+		}
+		result := tx.Where(thread, id, id).Delete(&prayerCommentModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		return adjustCommentCount(tx, model.TopicID, -int(result.RowsAffected))
+	})
+}
+
+// adjustCommentCount moves the topic's denormalized comment count by delta
+func adjustCommentCount(tx *gorm.DB, topicID string, delta int) error {
+	return tx.Model(&prayerTopicModel{}).
+		Where("id = ?", topicID).
+		Update("comment_count", gorm.Expr("comment_count + ?", delta)).Error
+}
+
+func createCommentMentions(tx *gorm.DB, comment *entity.PrayerComment) error {
+	if len(comment.MentionIDs) == 0 {
+		return nil
+	}
+	models := make([]commentMentionModel, 0, len(comment.MentionIDs))
+	for _, userID := range comment.MentionIDs {
+		models = append(models, commentMentionModel{CommentID: comment.ID, UserID: userID})
+	}
+	return tx.Create(models).Error
+}
+
+// loadCommentMentions fills in the mentions of the comments with a single query
+// Callers pass at most one page of comments, well below maxInListSize
+func loadCommentMentions(db *gorm.DB, comments []*entity.PrayerComment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entity.PrayerComment, len(comments))
+	ids := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		byID[comment.ID] = comment
+		ids = append(ids, comment.ID)
+	}
+
+	var models []commentMentionModel
+	if err := db.Where("comment_id IN ?", ids).Order("comment_id, user_id").Find(&models).Error; err != nil {
+		return err
+	}
+	for _, m := range models {
+		comment := byID[m.CommentID]
+		comment.MentionIDs = append(comment.MentionIDs, m.UserID)
+	}
+	return nil
+}
+
+// deleteComments removes the comments matching the condition together with their mentions
+// Callers keep the comment counts of surviving topics in step
+func deleteComments(tx *gorm.DB, query string, args ...interface{}) error {
+	if err := tx.Where("comment_id IN (SELECT id FROM prayer_comments WHERE "+query+")", args...).Delete(&commentMentionModel{}).Error; err != nil {
+		return err
+	}
+	return tx.Where(query, args...).Delete(&prayerCommentModel{}).Error
+}
+
+// deleteCommentsBy removes the user's comments and the replies to them,
+// recounting the comments of the topics they were on
+func deleteCommentsBy(tx *gorm.DB, userID string) error {
+	err := tx.Model(&prayerTopicModel{}).
+		Where("id IN (SELECT topic_id FROM prayer_comments WHERE author_id = ?)", userID).
+		Update("comment_count", gorm.Expr(
+			`(SELECT COUNT(*) FROM prayer_comments c
+			  WHERE c.topic_id = prayer_topics.id
+			    AND c.author_id <> ?
+			    AND (c.parent_id IS NULL OR c.parent_id NOT IN (SELECT id FROM prayer_comments WHERE author_id = ?)))`,
+			userID, userID,
+		)).Error
+	if err != nil {
+		return err
+	}
+	return deleteComments(tx, "author_id = ? OR parent_id IN (SELECT id FROM prayer_comments WHERE author_id = ?)", userID, userID)
+}
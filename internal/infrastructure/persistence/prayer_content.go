@@ -0,0 +1,122 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+// prayerContentModel is the GORM mapping of entity.PrayerContent
+type prayerContentModel struct {
+	ID        string    `gorm:"primaryKey;size:36"`
+	TopicID   string    `gorm:"size:36;not null;index:idx_prayer_contents_topic_created"`
+	RoomID    string    `gorm:"size:36;not null;index"`
+	AuthorID  string    `gorm:"size:36;not null;index"`
+	Body      string    `gorm:"size:4000;not null"` // 1000 characters in UTF-8
+	CreatedAt time.Time `gorm:"index:idx_prayer_contents_topic_created"`
+	UpdatedAt time.Time
+}
+
+func (prayerContentModel) TableName() string {
+	return "prayer_contents"
+}
+
+func newPrayerContentModel(c *entity.PrayerContent) *prayerContentModel {
+	return &prayerContentModel{
+		ID:        c.ID,
+		TopicID:   c.TopicID,
+		RoomID:    c.RoomID,
+		AuthorID:  c.AuthorID,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func (m *prayerContentModel) toEntity() *entity.PrayerContent {
+	return &entity.PrayerContent{
+		ID:        m.ID,
+		TopicID:   m.TopicID,
+		RoomID:    m.RoomID,
+		AuthorID:  m.AuthorID,
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+type prayerContentRepository struct {
+	db *database.DB
+}
+
+func NewPrayerContentRepository(db *database.DB) repository.PrayerContentRepository {
+	return &prayerContentRepository{db: db}
+}
+
+func (r *prayerContentRepository) Create(ctx context.Context, content *entity.PrayerContent) error {
+	return r.db.WithContext(ctx).Create(newPrayerContentModel(content)).Error
+}
+
+func (r *prayerContentRepository) GetByID(ctx context.Context, id string) (*entity.PrayerContent, error) {
+	var model prayerContentModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrPrayerContentNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *prayerContentRepository) List(ctx context.Context, topicID, viewerID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerContent, error) {
+	var models []prayerContentModel
+	err := r.db.WithContext(ctx).
+		Where("topic_id = ?", topicID).
+		Scopes(notBlockedBy(viewerID, "author_id"), afterTimeKey("created_at", "id", after)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	contents := make([]*entity.PrayerContent, 0, len(models))
+	for i := range models {
+		contents = append(contents, models[i].toEntity())
+	}
+	return contents, nil
+}
+
+func (r *prayerContentRepository) Update(ctx context.Context, content *entity.PrayerContent) error {
+	result := r.db.WithContext(ctx).
+		Model(&prayerContentModel{}).
+		Where("id = ?", content.ID).
+		Updates(map[string]interface{}{
+			"body":       content.Body,
+			"updated_at": content.UpdatedAt.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrPrayerContentNotFound
+	}
+	return nil
+}
+
+func (r *prayerContentRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&prayerContentModel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrPrayerContentNotFound
+	}
+	return nil
+}
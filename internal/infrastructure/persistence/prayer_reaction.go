@@ -0,0 +1,195 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+// prayerReactionModel is the GORM mapping of entity.PrayerReaction
+// The primary key is the one-reaction-per-user guarantee
+type prayerReactionModel struct {
+	TopicID     string `gorm:"primaryKey;size:36;index:idx_prayer_reactions_topic_created"`
+	UserID      string `gorm:"primaryKey;size:36;index"`
+	RoomID      string `gorm:"size:36;not null;index"`
+	PrayerCount int    `gorm:"not null;default:1"`
+	PrayedAt    time.Time
+	Anonymous   bool      `gorm:"not null;default:0"`
+	CreatedAt   time.Time `gorm:"index:idx_prayer_reactions_topic_created"`
+}
+
+func (prayerReactionModel) TableName() string {
+	return "prayer_reactions"
+}
+
+func (m *prayerReactionModel) toEntity() *entity.PrayerReaction {
+	return &entity.PrayerReaction{
+		TopicID:   m.TopicID,
+		RoomID:    m.RoomID,
+		UserID:    m.UserID,
+		Count:     m.PrayerCount,
+		PrayedAt:  m.PrayedAt,
+		Anonymous: m.Anonymous,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type prayerReactionRepository struct {
+	db *database.DB
+}
+
+func NewPrayerReactionRepository(db *database.DB) repository.PrayerReactionRepository {
+	return &prayerReactionRepository{db: db}
+}
+
+func (r *prayerReactionRepository) Add(ctx context.Context, reaction *entity.PrayerReaction, policy entity.PrayerReactionPolicy) (int, error) {
+	var count int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		mine := tx.Model(&prayerReactionModel{}).
+			Where("topic_id = ? AND user_id = ?", reaction.TopicID, reaction.UserID).
+			Session(&gorm.Session{})
+
+		// A repeat is counted by a conditional update, so two prayers racing within the window count once
+		if cutoff, ok := policy.RepeatCutoff(reaction.PrayedAt); ok {
+			result := mine.
+				Where("prayed_at <= ?", cutoff.UTC()).
+				Updates(map[string]interface{}{
+					"prayer_count": gorm.Expr("prayer_count + 1"),
+					"prayed_at":    reaction.PrayedAt.UTC(),
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				if err := mine.Select("prayer_count").Scan(&reaction.Count).Error; err != nil {
+					return err
+				}
+				var err error
+				count, err = adjustReactionCount(tx, reaction.TopicID, 0)
+				return err
+			}
+		}
+
+		// Looked up before inserting: a failed insert would abort the transaction on PostgreSQL
+		var existing []int
+		if err := mine.Pluck("prayer_count", &existing).Error; err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			reaction.Count = existing[0]
+			return entity.ErrAlreadyReacted
+		}
+
+		err := tx.Create(&prayerReactionModel{
+			TopicID:     reaction.TopicID,
+			UserID:      reaction.UserID,
+			RoomID:      reaction.RoomID,
+			PrayerCount: 1,
+			PrayedAt:    reaction.PrayedAt,
+			Anonymous:   reaction.Anonymous,
+			CreatedAt:   reaction.CreatedAt,
+		}).Error
+		if isUniqueViolation(err) {
+			return entity.ErrAlreadyReacted
+		}
+		if err != nil {
+			return err
+		}
+		reaction.Count = 1
+
+		count, err = adjustReactionCount(tx, reaction.TopicID, 1)
+		return err
+	})
+	return count, err
+}
+
+func (r *prayerReactionRepository) Remove(ctx context.Context, topicID, userID string) (int, error) {
+	var count int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("topic_id = ? AND user_id = ?", topicID, userID).Delete(&prayerReactionModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		var err error
+		count, err = adjustReactionCount(tx, topicID, -int(result.RowsAffected))
+		return err
+	})
+	return count, err
+}
+
+func (r *prayerReactionRepository) Reacted(ctx context.Context, userID string, topicIDs []string) (map[string]bool, error) {
+	reacted := make(map[string]bool, len(topicIDs))
+	if len(topicIDs) == 0 {
+		return reacted, nil
+	}
+
+	// Callers pass at most one page of topics, well below maxInListSize
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Model(&prayerReactionModel{}).
+		Where("user_id = ? AND topic_id IN ?", userID, topicIDs).
+		Pluck("topic_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		reacted[id] = true
+	}
+	return reacted, nil
+}
+
+func (r *prayerReactionRepository) ListByTopic(ctx context.Context, topicID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerReaction, error) {
+	var models []prayerReactionModel
+	err := r.db.WithContext(ctx).
+		Where("topic_id = ?", topicID).
+		Scopes(afterTimeKey("created_at", "user_id", after)).
+		Order("created_at DESC, user_id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	reactions := make([]*entity.PrayerReaction, 0, len(models))
+	for i := range models {
+		reactions = append(reactions, models[i].toEntity())
+	}
+	return reactions, nil
+}
+
+// adjustReactionCount moves the topic's denormalized reaction count by delta and returns the result
+// Reading it back in the same transaction gives every reaction its own count, even under concurrency
+func adjustReactionCount(tx *gorm.DB, topicID string, delta int) (int, error) {
+	if delta != 0 {
+		err := tx.Model(&prayerTopicModel{}).
+			Where("id = ?", topicID).
+			Update("reaction_count", gorm.Expr("reaction_count + ?", delta)).Error
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var count int
+	err := tx.Model(&prayerTopicModel{}).
+		Select("reaction_count").
+		Where("id = ?", topicID).
+		Scan(&count).Error
+	return count, err
+}
+
+// deleteReactionsBy removes the user's reactions, lowering the counts of the topics they were on
+func deleteReactionsBy(tx *gorm.DB, userID string) error {
+	err := tx.Model(&prayerTopicModel{}).
+		Where("id IN (SELECT topic_id FROM prayer_reactions WHERE user_id = ?)", userID).
+		Update("reaction_count", gorm.Expr("reaction_count - 1")).Error
+	if err != nil {
+		return err
+	}
+	return tx.Where("user_id = ?", userID).Delete(&prayerReactionModel{}).Error
+}
@@ -0,0 +1,97 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+func TestPrayerReactionRepositoryRepeats(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewPrayerReactionRepository(db)
+
+	room, err := entity.NewRoom("owner", "새벽기도", "", entity.RoomPrivate, entity.RoomCategoryChurch, nil)
+	if err != nil {
+		t.Fatalf("NewRoom: %v", err)
+	}
+	room.ID = "room-1"
+	owner := &entity.RoomMember{RoomID: room.ID, UserID: "owner", Role: entity.RoomRoleOwner, JoinedAt: room.CreatedAt}
+	if err := NewRoomRepository(db).Create(ctx, room, owner); err != nil {
+		t.Fatalf("Create room: %v", err)
+	}
+	topics := NewPrayerTopicRepository(db)
+	for _, id := range []string{"once", "window", "count"} {
+		if err := topics.Create(ctx, &entity.PrayerTopic{ID: id, RoomID: room.ID, AuthorID: "owner", Title: id, CreatedAt: time.Now()}); err != nil {
+			t.Fatalf("Create(%s): %v", id, err)
+		}
+	}
+
+	start := time.Now().UTC().Truncate(time.Second)
+	// pray adds userID's prayer for the topic made at offset after start and returns the topic's
+	// count and the user's, or the error
+	pray := func(topicID, userID string, offset time.Duration, policy entity.PrayerReactionPolicy) (int, int, error) {
+		t.Helper()
+		at := start.Add(offset)
+		reaction := &entity.PrayerReaction{TopicID: topicID, RoomID: room.ID, UserID: userID, Count: 1, PrayedAt: at, CreatedAt: at}
+		count, err := repo.Add(ctx, reaction, policy)
+		return count, reaction.Count, err
+	}
+
+	tests := []struct {
+		topic  string
+		policy entity.PrayerReactionPolicy
+		// counted says, for prayers by the same member at 0, 1h, 2h and 5h, which ones count
+		counted []bool
+	}{
+		{topic: "once", policy: entity.PrayerReactionPolicy{Mode: entity.PrayerReactOnce}, counted: []bool{true, false, false, false}},
+		{topic: "window", policy: entity.PrayerReactionPolicy{Mode: entity.PrayerReactPerWindow, Window: 3 * time.Hour}, counted: []bool{true, false, false, true}},
+		{topic: "count", policy: entity.PrayerReactionPolicy{Mode: entity.PrayerReactEveryTime}, counted: []bool{true, true, true, true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.topic, func(t *testing.T) {
+			want := 0
+			for i, offset := range []time.Duration{0, time.Hour, 2 * time.Hour, 5 * time.Hour} {
+				count, prayed, err := pray(tt.topic, "grace", offset, tt.policy)
+				if tt.counted[i] {
+					want++
+					if err != nil {
+						t.Fatalf("prayer %d: %v", i, err)
+					}
+				} else if !errors.Is(err, entity.ErrAlreadyReacted) {
+					t.Fatalf("prayer %d: err = %v, want ErrAlreadyReacted", i, err)
+				}
+				if prayed != want {
+					t.Errorf("prayer %d: member's count = %d, want %d", i, prayed, want)
+				}
+				if err == nil && count != 1 {
+					t.Errorf("prayer %d: topic count = %d, want 1 member however often they pray", i, count)
+				}
+			}
+
+			if count, _, err := pray(tt.topic, "john", 5*time.Hour, tt.policy); err != nil || count != 2 {
+				t.Errorf("another member: count = %d, err = %v, want 2", count, err)
+			}
+			if count, err := repo.Remove(ctx, tt.topic, "grace"); err != nil || count != 1 {
+				t.Errorf("Remove: count = %d, err = %v, want the member's repeats withdrawn with them", count, err)
+			}
+		})
+	}
+
+	anonymous := &entity.PrayerReaction{TopicID: "once", RoomID: room.ID, UserID: "maria", Count: 1, Anonymous: true, PrayedAt: start.Add(6 * time.Hour), CreatedAt: start.Add(6 * time.Hour)}
+	if _, err := repo.Add(ctx, anonymous, entity.PrayerReactionPolicy{Mode: entity.PrayerReactOnce}); err != nil {
+		t.Fatalf("Add anonymous: %v", err)
+	}
+	first, err := repo.ListByTopic(ctx, "once", nil, 1)
+	if err != nil || len(first) != 1 || first[0].UserID != "maria" || !first[0].Anonymous {
+		t.Fatalf("ListByTopic = %+v, err = %v, want the newest, anonymous reaction first", first, err)
+	}
+	rest, err := repo.ListByTopic(ctx, "once", &pagination.TimeKey{Time: first[0].CreatedAt, ID: first[0].UserID}, 10)
+	if err != nil || len(rest) != 1 || rest[0].UserID != "john" {
+		t.Errorf("ListByTopic after maria = %+v, err = %v, want john", rest, err)
+	}
+}
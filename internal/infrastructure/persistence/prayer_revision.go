@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// prayerRevisionModel is the GORM mapping of entity.PrayerRevision
+type prayerRevisionModel struct {
+	ID        string    `gorm:"primaryKey;size:36"`
+	TopicID   string    `gorm:"size:36;not null;index:idx_prayer_revisions_topic_created"`
+	RoomID    string    `gorm:"size:36;not null;index"`
+	ContentID string    `gorm:"size:36"`
+	Field     string    `gorm:"size:10;not null"`
+	Previous  string    `gorm:"size:4000;not null"` // 1000 characters in UTF-8
+	EditorID  string    `gorm:"size:36;not null"`
+	CreatedAt time.Time `gorm:"index:idx_prayer_revisions_topic_created"`
+}
+
+func (prayerRevisionModel) TableName() string {
+	return "prayer_revisions"
+}
+
+func newPrayerRevisionModel(r *entity.PrayerRevision) *prayerRevisionModel {
+	return &prayerRevisionModel{
+		ID:        r.ID,
+		TopicID:   r.TopicID,
+		RoomID:    r.RoomID,
+		ContentID: r.ContentID,
+		Field:     string(r.Field),
+		Previous:  r.Previous,
+		EditorID:  r.EditorID,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func (m *prayerRevisionModel) toEntity() *entity.PrayerRevision {
+	return &entity.PrayerRevision{
+		ID:        m.ID,
+		TopicID:   m.TopicID,
+		RoomID:    m.RoomID,
+		ContentID: m.ContentID,
+		Field:     entity.PrayerRevisionField(m.Field),
+		Previous:  m.Previous,
+		EditorID:  m.EditorID,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type prayerRevisionRepository struct {
+	db *database.DB
+}
+
+func NewPrayerRevisionRepository(db *database.DB) repository.PrayerRevisionRepository {
+	return &prayerRevisionRepository{db: db}
+}
+
+func (r *prayerRevisionRepository) Create(ctx context.Context, revision *entity.PrayerRevision, keep int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newPrayerRevisionModel(revision)).Error; err != nil {
+			return err
+		}
+
+		// The topic holds at most keep revisions before this one unless the cap was lowered,
+		// so the IDs are read whole rather than with an OFFSET, which MySQL cannot run alone
+		var ids []string
+		err := tx.Model(&prayerRevisionModel{}).
+			Where("topic_id = ?", revision.TopicID).
+			Order("created_at DESC, id DESC").
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) <= keep {
+			return err
+		}
+		stale := ids[keep:]
+		for start := 0; start < len(stale); start += maxInListSize {
+			end := min(start+maxInListSize, len(stale))
+			if err := tx.Where("id IN ?", stale[start:end]).Delete(&prayerRevisionModel{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *prayerRevisionRepository) ListByTopic(ctx context.Context, topicID string) ([]*entity.PrayerRevision, error) {
+	var models []prayerRevisionModel
+	err := r.db.WithContext(ctx).
+		Where("topic_id = ?", topicID).
+		Order("created_at DESC, id DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*entity.PrayerRevision, 0, len(models))
+	for i := range models {
+		revisions = append(revisions, models[i].toEntity())
+	}
+	return revisions, nil
+}
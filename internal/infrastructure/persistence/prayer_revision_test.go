@@ -0,0 +1,51 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+func TestPrayerRevisionRepositoryKeepsNewest(t *testing.T) {
+	ctx := context.Background()
+	repo := NewPrayerRevisionRepository(newTestDB(t))
+
+	created := time.Now().UTC().Truncate(time.Second)
+	for i := range 5 {
+		revision := &entity.PrayerRevision{
+			ID: fmt.Sprintf("rev-%d", i), TopicID: "topic-1", RoomID: "room-1",
+			Field: entity.PrayerRevisionTitle, Previous: fmt.Sprintf("제목 %d", i), EditorID: "grace",
+			CreatedAt: created.Add(time.Duration(i) * time.Minute),
+		}
+		if err := repo.Create(ctx, revision, 3); err != nil {
+			t.Fatalf("Create(%s): %v", revision.ID, err)
+		}
+	}
+	other := &entity.PrayerRevision{
+		ID: "other", TopicID: "topic-2", RoomID: "room-1",
+		Field: entity.PrayerRevisionTitle, Previous: "다른 제목", EditorID: "grace", CreatedAt: created,
+	}
+	if err := repo.Create(ctx, other, 3); err != nil {
+		t.Fatalf("Create(other): %v", err)
+	}
+
+	revisions, err := repo.ListByTopic(ctx, "topic-1")
+	if err != nil {
+		t.Fatalf("ListByTopic: %v", err)
+	}
+	var ids []string
+	for _, r := range revisions {
+		ids = append(ids, r.ID)
+	}
+	if want := []string{"rev-4", "rev-3", "rev-2"}; !slices.Equal(ids, want) {
+		t.Errorf("revisions = %v, want the newest 3 newest first", ids)
+	}
+
+	if revisions, err := repo.ListByTopic(ctx, "topic-2"); err != nil || len(revisions) != 1 {
+		t.Errorf("other topic: %d revisions, err = %v, want its own revision untouched", len(revisions), err)
+	}
+}
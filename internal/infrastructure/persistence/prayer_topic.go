@@ -0,0 +1,777 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// prayerTopicModel is the GORM mapping of entity.PrayerTopic
+// DeletedAt is a plain column rather than gorm.DeletedAt so deleted rows stay visible to audits
+type prayerTopicModel struct {
+	ID       string `gorm:"primaryKey;size:36"`
+	RoomID   string `gorm:"size:36;not null;index:idx_prayer_topics_room_created;index:idx_prayer_topics_room_answered;index:idx_prayer_topics_room_pinned"`
+	AuthorID string `gorm:"size:36;not null;index;index:idx_prayer_topics_author_order"`
+	Title    string `gorm:"size:400;not null"` // 100 characters in UTF-8
+	Private  bool   `gorm:"not null;default:0"`
+	// Recurrence is NULL for topics that do not recur
+	Recurrence       string     `gorm:"size:10"`
+	RecurrencePaused bool       `gorm:"not null;default:0"`
+	NextRecurrenceAt *time.Time `gorm:"index"`
+	AnsweredAt       *time.Time `gorm:"index:idx_prayer_topics_room_answered"`
+	Testimony        string     `gorm:"size:4000"` // 1000 characters in UTF-8
+	CommentCount     int        `gorm:"not null;default:0"`
+	ReactionCount    int        `gorm:"not null;default:0"`
+	// PinPosition is NULL for topics that are not pinned
+	PinnedAt    *time.Time
+	PinnedBy    string     `gorm:"size:36"`
+	PinPosition *int       `gorm:"index:idx_prayer_topics_room_pinned"`
+	UserOrder   *int       `gorm:"index:idx_prayer_topics_author_order"` // NULL for topics their author has not put in order
+	DeletedAt   *time.Time `gorm:"index"`
+	DeletedBy   string     `gorm:"size:36"`
+	CreatedAt   time.Time  `gorm:"index:idx_prayer_topics_room_created"`
+	UpdatedAt   time.Time
+}
+
+func (prayerTopicModel) TableName() string {
+	return "prayer_topics"
+}
+
+func newPrayerTopicModel(t *entity.PrayerTopic) *prayerTopicModel {
+	var pinPosition *int
+	if t.IsPinned() {
+		pinPosition = &t.PinPosition
+	}
+	var userOrder *int
+	if t.UserOrder > 0 {
+		userOrder = &t.UserOrder
+	}
+	return &prayerTopicModel{
+		ID:               t.ID,
+		RoomID:           t.RoomID,
+		AuthorID:         t.AuthorID,
+		Title:            t.Title,
+		Private:          t.Private,
+		Recurrence:       string(t.Recurrence),
+		RecurrencePaused: t.RecurrencePaused,
+		NextRecurrenceAt: t.NextRecurrenceAt,
+		AnsweredAt:       t.AnsweredAt,
+		Testimony:        t.Testimony,
+		CommentCount:     t.CommentCount,
+		ReactionCount:    t.ReactionCount,
+		PinnedAt:         t.PinnedAt,
+		PinnedBy:         t.PinnedBy,
+		PinPosition:      pinPosition,
+		UserOrder:        userOrder,
+		DeletedAt:        t.DeletedAt,
+		DeletedBy:        t.DeletedBy,
+		CreatedAt:        t.CreatedAt,
+		UpdatedAt:        t.UpdatedAt,
+	}
+}
+
+func (m *prayerTopicModel) toEntity() *entity.PrayerTopic {
+	topic := &entity.PrayerTopic{
+		ID:               m.ID,
+		RoomID:           m.RoomID,
+		AuthorID:         m.AuthorID,
+		Title:            m.Title,
+		Private:          m.Private,
+		Recurrence:       entity.PrayerRecurrence(m.Recurrence),
+		RecurrencePaused: m.RecurrencePaused,
+		NextRecurrenceAt: m.NextRecurrenceAt,
+		AnsweredAt:       m.AnsweredAt,
+		Testimony:        m.Testimony,
+		CommentCount:     m.CommentCount,
+		ReactionCount:    m.ReactionCount,
+		PinnedAt:         m.PinnedAt,
+		PinnedBy:         m.PinnedBy,
+		DeletedAt:        m.DeletedAt,
+		DeletedBy:        m.DeletedBy,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+	if m.PinPosition != nil {
+		topic.PinPosition = *m.PinPosition
+	}
+	if m.UserOrder != nil {
+		topic.UserOrder = *m.UserOrder
+	}
+	return topic
+}
+
+// prayerTagModel is one tag of a prayer topic
+// RoomID is copied from the topic so the room's tag cloud and cleanup need no join
+type prayerTagModel struct {
+	TopicID string `gorm:"primaryKey;size:36"`
+	Tag     string `gorm:"primaryKey;size:80;index:idx_prayer_tags_room_tag,priority:2"` // 20 characters in UTF-8
+	RoomID  string `gorm:"size:36;not null;index:idx_prayer_tags_room_tag,priority:1"`
+}
+
+func (prayerTagModel) TableName() string {
+	return "prayer_topic_tags"
+}
+
+// visibleTopicsTo is a scope that drops other people's private topics
+// Every topic query shown to a user must apply it next to notBlockedBy
+func visibleTopicsTo(viewerID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(private = ? OR author_id = ?)", false, viewerID)
+	}
+}
+
+// prayerTagCountRow is a row of the tag cloud query
+type prayerTagCountRow struct {
+	Tag      string
+	TagCount int
+}
+
+type prayerTopicRepository struct {
+	db *database.DB
+}
+
+func NewPrayerTopicRepository(db *database.DB) repository.PrayerTopicRepository {
+	return &prayerTopicRepository{db: db}
+}
+
+func (r *prayerTopicRepository) Create(ctx context.Context, topic *entity.PrayerTopic) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newPrayerTopicModel(topic)).Error; err != nil {
+			return err
+		}
+		return createPrayerTags(tx, topic)
+	})
+}
+
+func (r *prayerTopicRepository) GetByID(ctx context.Context, id string) (*entity.PrayerTopic, error) {
+	var model prayerTopicModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrPrayerTopicNotFound
+		}
+		return nil, err
+	}
+
+	topic := model.toEntity()
+	if err := loadPrayerTags(r.db.WithContext(ctx), []*entity.PrayerTopic{topic}); err != nil {
+		return nil, err
+	}
+	return topic, nil
+}
+
+func (r *prayerTopicRepository) List(ctx context.Context, roomID string, filter repository.PrayerTopicFilter, after *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error) {
+	db := r.db.WithContext(ctx).Where("room_id = ?", roomID)
+	if filter.Deleted {
+		db = db.Where("deleted_at IS NOT NULL")
+	} else {
+		db = db.Where("deleted_at IS NULL")
+	}
+	if filter.ViewerID != "" {
+		db = db.Scopes(notBlockedBy(filter.ViewerID, "author_id"), visibleTopicsTo(filter.ViewerID))
+	}
+	if filter.Tag != "" {
+		db = db.Where("id IN (SELECT topic_id FROM prayer_topic_tags WHERE room_id = ? AND tag = ?)", roomID, filter.Tag)
+	}
+	if filter.Active {
+		db = db.Where("answered_at IS NULL")
+	}
+	if filter.Unpinned {
+		db = db.Where("pinned_at IS NULL")
+	}
+
+	var models []prayerTopicModel
+	err := db.Scopes(afterTimeKey("created_at", "id", after)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]*entity.PrayerTopic, 0, len(models))
+	for i := range models {
+		topics = append(topics, models[i].toEntity())
+	}
+	if err := loadPrayerTags(r.db.WithContext(ctx), topics); err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+func (r *prayerTopicRepository) Search(ctx context.Context, roomID string, search repository.PrayerSearch, after *pagination.TimeKey, limit int) ([]repository.PrayerSearchHit, error) {
+	db := r.db.WithContext(ctx)
+	titleMatch, titleArgs := textMatch(r.db, "title", search.Terms)
+	bodyMatch, bodyArgs := textMatch(r.db, "body", search.Terms)
+
+	args := append([]interface{}{}, titleArgs...)
+	args = append(args, roomID)
+	args = append(args, bodyArgs...)
+	args = append(args, search.ViewerID)
+
+	var models []prayerTopicModel
+	err := db.Where("room_id = ? AND deleted_at IS NULL", roomID).
+		Where(
+			`((`+titleMatch+`) OR id IN (
+				SELECT topic_id FROM prayer_contents
+				WHERE room_id = ? AND (`+bodyMatch+`)
+				  AND author_id NOT IN (SELECT blocked_id FROM user_blocks WHERE blocker_id = ?)))`,
+			args...,
+		).
+		Scopes(
+			notBlockedBy(search.ViewerID, "author_id"),
+			visibleTopicsTo(search.ViewerID),
+			afterTimeKey("created_at", "id", after),
+		).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+
+	topics := make([]*entity.PrayerTopic, 0, len(models))
+	ids := make([]string, 0, len(models))
+	for i := range models {
+		topics = append(topics, models[i].toEntity())
+		ids = append(ids, models[i].ID)
+	}
+	if err := loadPrayerTags(db, topics); err != nil {
+		return nil, err
+	}
+
+	// Newest first, so the first entry seen for a topic is the one to show
+	var contents []prayerContentModel
+	err = db.Where("topic_id IN ?", ids).
+		Where(bodyMatch, bodyArgs...).
+		Scopes(notBlockedBy(search.ViewerID, "author_id")).
+		Order("created_at DESC, id DESC").
+		Find(&contents).Error
+	if err != nil {
+		return nil, err
+	}
+	matched := make(map[string]*entity.PrayerContent, len(contents))
+	for i := range contents {
+		if _, ok := matched[contents[i].TopicID]; !ok {
+			matched[contents[i].TopicID] = contents[i].toEntity()
+		}
+	}
+
+	hits := make([]repository.PrayerSearchHit, 0, len(topics))
+	for _, topic := range topics {
+		hits = append(hits, repository.PrayerSearchHit{Topic: topic, Content: matched[topic.ID]})
+	}
+	return hits, nil
+}
+
+func (r *prayerTopicRepository) ListAnswered(ctx context.Context, roomID, viewerID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error) {
+	var models []prayerTopicModel
+	err := r.db.WithContext(ctx).
+		Where("room_id = ? AND answered_at IS NOT NULL AND deleted_at IS NULL", roomID).
+		Scopes(
+			notBlockedBy(viewerID, "author_id"),
+			visibleTopicsTo(viewerID),
+			afterTimeKey("answered_at", "id", after),
+		).
+		Order("answered_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]*entity.PrayerTopic, 0, len(models))
+	for i := range models {
+		topics = append(topics, models[i].toEntity())
+	}
+	if err := loadPrayerTags(r.db.WithContext(ctx), topics); err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+func (r *prayerTopicRepository) ListPrivate(ctx context.Context, authorID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error) {
+	var models []prayerTopicModel
+	err := r.db.WithContext(ctx).
+		Where("author_id = ? AND private = ? AND deleted_at IS NULL", authorID, true).
+		Where("room_id IN (SELECT room_id FROM room_members WHERE user_id = ?)", authorID).
+		Scopes(afterTimeKey("created_at", "id", after)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]*entity.PrayerTopic, 0, len(models))
+	for i := range models {
+		topics = append(topics, models[i].toEntity())
+	}
+	if err := loadPrayerTags(r.db.WithContext(ctx), topics); err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+// authoredLive keeps the author's live topics in the rooms they still belong to
+func authoredLive(authorID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("author_id = ? AND deleted_at IS NULL", authorID).
+			Where("room_id IN (SELECT room_id FROM room_members WHERE user_id = ?)", authorID)
+	}
+}
+
+func (r *prayerTopicRepository) ListOrderedByAuthor(ctx context.Context, authorID string) ([]*entity.PrayerTopic, error) {
+	var models []prayerTopicModel
+	err := r.db.WithContext(ctx).
+		Scopes(authoredLive(authorID)).
+		Where("user_order IS NOT NULL").
+		Order("user_order, id").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]*entity.PrayerTopic, 0, len(models))
+	for i := range models {
+		topics = append(topics, models[i].toEntity())
+	}
+	if err := loadPrayerTags(r.db.WithContext(ctx), topics); err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+func (r *prayerTopicRepository) ListUnorderedByAuthor(ctx context.Context, authorID string, after *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error) {
+	var models []prayerTopicModel
+	err := r.db.WithContext(ctx).
+		Scopes(authoredLive(authorID), afterTimeKey("created_at", "id", after)).
+		Where("user_order IS NULL").
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]*entity.PrayerTopic, 0, len(models))
+	for i := range models {
+		topics = append(topics, models[i].toEntity())
+	}
+	if err := loadPrayerTags(r.db.WithContext(ctx), topics); err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+func (r *prayerTopicRepository) ReorderByAuthor(ctx context.Context, authorID string, topicIDs []string) error {
+	listed := make(map[string]bool, len(topicIDs))
+	for _, id := range topicIDs {
+		if listed[id] {
+			return entity.ErrInvalidPrayerOrder
+		}
+		listed[id] = true
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(topicIDs) > 0 {
+			var owned int64
+			err := tx.Model(&prayerTopicModel{}).
+				Scopes(authoredLive(authorID)).
+				Where("id IN ?", topicIDs).
+				Count(&owned).Error
+			if err != nil {
+				return err
+			}
+			if owned != int64(len(topicIDs)) {
+				return entity.ErrInvalidPrayerOrder
+			}
+		}
+
+		err := tx.Model(&prayerTopicModel{}).
+			Where("author_id = ? AND user_order IS NOT NULL", authorID).
+			Update("user_order", nil).Error
+		if err != nil {
+			return err
+		}
+		for i, id := range topicIDs {
+			if err := tx.Model(&prayerTopicModel{}).Where("id = ?", id).Update("user_order", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *prayerTopicRepository) TagCloud(ctx context.Context, roomID, viewerID string, limit int) ([]repository.PrayerTagCount, error) {
+	var rows []prayerTagCountRow
+	err := r.db.WithContext(ctx).
+		Model(&prayerTagModel{}).
+		Select("tag, COUNT(*) AS tag_count").
+		Where("room_id = ?", roomID).
+		Where(
+			"topic_id IN (SELECT id FROM prayer_topics WHERE room_id = ? AND deleted_at IS NULL AND (private = ? OR author_id = ?))",
+			roomID, false, viewerID,
+		).
+		Group("tag").
+		Order("tag_count DESC, tag").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]repository.PrayerTagCount, 0, len(rows))
+	for _, row := range rows {
+		counts = append(counts, repository.PrayerTagCount{Tag: row.Tag, Count: row.TagCount})
+	}
+	return counts, nil
+}
+
+func (r *prayerTopicRepository) Update(ctx context.Context, topic *entity.PrayerTopic) error {
+	var nextRecurrenceAt interface{}
+	if topic.NextRecurrenceAt != nil {
+		nextRecurrenceAt = topic.NextRecurrenceAt.UTC()
+	}
+
+	columns := map[string]interface{}{
+		"title":              topic.Title,
+		"private":            topic.Private,
+		"testimony":          topic.Testimony,          // empty is stored as NULL
+		"recurrence":         string(topic.Recurrence), // empty is stored as NULL
+		"recurrence_paused":  topic.RecurrencePaused,
+		"next_recurrence_at": nextRecurrenceAt,
+		"updated_at":         topic.UpdatedAt.UTC(),
+	}
+	// Private topics cannot stay pinned; otherwise pins change only through Pin and Unpin
+	if topic.Private {
+		clearPinColumns(columns)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&prayerTopicModel{}).
+			Where("id = ? AND deleted_at IS NULL", topic.ID).
+			Updates(columns)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrPrayerTopicNotFound
+		}
+
+		if err := tx.Where("topic_id = ?", topic.ID).Delete(&prayerTagModel{}).Error; err != nil {
+			return err
+		}
+		return createPrayerTags(tx, topic)
+	})
+}
+
+func (r *prayerTopicRepository) ListDueRecurrences(ctx context.Context, now time.Time, limit int) ([]*entity.PrayerTopic, error) {
+	var models []prayerTopicModel
+	err := r.db.WithContext(ctx).
+		Where("next_recurrence_at <= ? AND recurrence_paused = ? AND deleted_at IS NULL", now.UTC(), false).
+		Where("room_id NOT IN (SELECT id FROM rooms WHERE archived_at IS NOT NULL)").
+		Order("next_recurrence_at").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]*entity.PrayerTopic, 0, len(models))
+	for i := range models {
+		topics = append(topics, models[i].toEntity())
+	}
+	if err := loadPrayerTags(r.db.WithContext(ctx), topics); err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+func (r *prayerTopicRepository) Recur(ctx context.Context, topic, next *entity.PrayerTopic) (bool, error) {
+	recurred := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Matching the due time makes concurrent runs post each occurrence once
+		result := tx.Model(&prayerTopicModel{}).
+			Where("id = ? AND next_recurrence_at = ? AND recurrence_paused = ? AND deleted_at IS NULL", topic.ID, topic.NextRecurrenceAt.UTC(), false).
+			Updates(map[string]interface{}{
+				"recurrence":         nil,
+				"next_recurrence_at": nil,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Create(newPrayerTopicModel(next)).Error; err != nil {
+			return err
+		}
+		if err := createPrayerTags(tx, next); err != nil {
+			return err
+		}
+		recurred = true
+		return nil
+	})
+	return recurred, err
+}
+
+func (r *prayerTopicRepository) MarkAnswered(ctx context.Context, id, testimony string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&prayerTopicModel{}).
+		Where("id = ? AND deleted_at IS NULL AND answered_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"answered_at": at.UTC(),
+			"testimony":   testimony,
+			"updated_at":  at.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		topic, err := r.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if topic.IsDeleted() {
+			return entity.ErrPrayerTopicNotFound
+		}
+		return entity.ErrPrayerTopicAnswered
+	}
+	return nil
+}
+
+func (r *prayerTopicRepository) ListPinned(ctx context.Context, roomID string, filter repository.PrayerTopicFilter) ([]*entity.PrayerTopic, error) {
+	db := r.db.WithContext(ctx).Where("room_id = ? AND pinned_at IS NOT NULL AND deleted_at IS NULL", roomID)
+	if filter.ViewerID != "" {
+		db = db.Scopes(notBlockedBy(filter.ViewerID, "author_id"), visibleTopicsTo(filter.ViewerID))
+	}
+	if filter.Tag != "" {
+		db = db.Where("id IN (SELECT topic_id FROM prayer_topic_tags WHERE room_id = ? AND tag = ?)", roomID, filter.Tag)
+	}
+
+	var models []prayerTopicModel
+	if err := db.Order("pin_position, id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	topics := make([]*entity.PrayerTopic, 0, len(models))
+	for i := range models {
+		topics = append(topics, models[i].toEntity())
+	}
+	if err := loadPrayerTags(r.db.WithContext(ctx), topics); err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+func (r *prayerTopicRepository) Pin(ctx context.Context, topic *entity.PrayerTopic, max int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The room row is locked so concurrent pins cannot overshoot the limit or share a position
+		var room roomModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", topic.RoomID).
+			First(&room).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrRoomNotFound
+			}
+			return err
+		}
+
+		var pins []prayerTopicModel
+		err = tx.Select("id, pin_position").
+			Where("room_id = ? AND pinned_at IS NOT NULL AND deleted_at IS NULL", topic.RoomID).
+			Find(&pins).Error
+		if err != nil {
+			return err
+		}
+		last := 0
+		for _, pin := range pins {
+			if pin.ID == topic.ID {
+				return entity.ErrPrayerTopicPinned
+			}
+			if pin.PinPosition != nil && *pin.PinPosition > last {
+				last = *pin.PinPosition
+			}
+		}
+		if len(pins) >= max {
+			return entity.ErrPinLimitReached
+		}
+
+		result := tx.Model(&prayerTopicModel{}).
+			Where("id = ? AND pinned_at IS NULL AND deleted_at IS NULL", topic.ID).
+			Updates(map[string]interface{}{
+				"pinned_at":    topic.PinnedAt.UTC(),
+				"pinned_by":    topic.PinnedBy,
+				"pin_position": last + 1,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrPrayerTopicNotFound
+		}
+		topic.PinPosition = last + 1
+		return nil
+	})
+}
+
+func (r *prayerTopicRepository) Unpin(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).
+		Model(&prayerTopicModel{}).
+		Where("id = ? AND pinned_at IS NOT NULL AND deleted_at IS NULL", id).
+		Updates(clearPinColumns(map[string]interface{}{}))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		topic, err := r.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if topic.IsDeleted() {
+			return entity.ErrPrayerTopicNotFound
+		}
+		return entity.ErrPrayerTopicNotPinned
+	}
+	return nil
+}
+
+func (r *prayerTopicRepository) ReorderPins(ctx context.Context, roomID string, topicIDs []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var room roomModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", roomID).
+			First(&room).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrRoomNotFound
+			}
+			return err
+		}
+
+		var pinned []string
+		err = tx.Model(&prayerTopicModel{}).
+			Where("room_id = ? AND pinned_at IS NOT NULL AND deleted_at IS NULL", roomID).
+			Pluck("id", &pinned).Error
+		if err != nil {
+			return err
+		}
+		if len(pinned) != len(topicIDs) {
+			return entity.ErrInvalidPinOrder
+		}
+		listed := make(map[string]bool, len(topicIDs))
+		for _, id := range topicIDs {
+			if listed[id] {
+				return entity.ErrInvalidPinOrder
+			}
+			listed[id] = true
+		}
+		for _, id := range pinned {
+			if !listed[id] {
+				return entity.ErrInvalidPinOrder
+			}
+		}
+
+		for i, id := range topicIDs {
+			if err := tx.Model(&prayerTopicModel{}).Where("id = ?", id).Update("pin_position", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *prayerTopicRepository) SoftDelete(ctx context.Context, id, deletedBy string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&prayerTopicModel{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(clearPinColumns(map[string]interface{}{
+			"deleted_at": at.UTC(),
+			"deleted_by": deletedBy,
+		}))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrPrayerTopicNotFound
+	}
+	return nil
+}
+
+func (r *prayerTopicRepository) Restore(ctx context.Context, id string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&prayerTopicModel{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"deleted_by": nil,
+			"updated_at": at.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return entity.ErrPrayerTopicNotDeleted
+	}
+	return nil
+}
+
+// clearPinColumns adds the columns that unpin a topic to an update and returns it
+func clearPinColumns(columns map[string]interface{}) map[string]interface{} {
+	columns["pinned_at"] = nil
+	columns["pinned_by"] = nil
+	columns["pin_position"] = nil
+	return columns
+}
+
+// createPrayerTags stores the topic's tags
+func createPrayerTags(tx *gorm.DB, topic *entity.PrayerTopic) error {
+	if len(topic.Tags) == 0 {
+		return nil
+	}
+	models := make([]prayerTagModel, 0, len(topic.Tags))
+	for _, tag := range topic.Tags {
+		models = append(models, prayerTagModel{TopicID: topic.ID, Tag: tag, RoomID: topic.RoomID})
+	}
+	return tx.Create(models).Error
+}
+
+// loadPrayerTags fills in the tags of the topics with a single query
+// Callers pass at most one page of topics, well below maxInListSize
+func loadPrayerTags(db *gorm.DB, topics []*entity.PrayerTopic) error {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entity.PrayerTopic, len(topics))
+	ids := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		byID[topic.ID] = topic
+		ids = append(ids, topic.ID)
+	}
+
+	var models []prayerTagModel
+	if err := db.Where("topic_id IN ?", ids).Order("topic_id, tag").Find(&models).Error; err != nil {
+		return err
+	}
+	for _, m := range models {
+		topic := byID[m.TopicID]
+		topic.Tags = append(topic.Tags, m.Tag)
+	}
+	return nil
+}
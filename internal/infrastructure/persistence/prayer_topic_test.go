@@ -0,0 +1,216 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+func TestPrayerTopicRepositoryPins(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewPrayerTopicRepository(db)
+
+	room, err := entity.NewRoom("owner", "새벽기도", "", entity.RoomPrivate, entity.RoomCategoryChurch, nil)
+	if err != nil {
+		t.Fatalf("NewRoom: %v", err)
+	}
+	room.ID = "room-1"
+	owner := &entity.RoomMember{RoomID: room.ID, UserID: "owner", Role: entity.RoomRoleOwner, JoinedAt: room.CreatedAt}
+	if err := NewRoomRepository(db).Create(ctx, room, owner); err != nil {
+		t.Fatalf("Create room: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for i, id := range []string{"t1", "t2", "t3", "t4"} {
+		topic := &entity.PrayerTopic{ID: id, RoomID: room.ID, AuthorID: "grace", Title: id, CreatedAt: now.Add(time.Duration(i) * time.Minute)}
+		if err := repo.Create(ctx, topic); err != nil {
+			t.Fatalf("Create(%s): %v", id, err)
+		}
+	}
+
+	elsewhere := &entity.PrayerTopic{ID: "elsewhere", RoomID: "room-2", AuthorID: "grace", Title: "elsewhere", CreatedAt: now}
+	if err := repo.Create(ctx, elsewhere); err != nil {
+		t.Fatalf("Create(elsewhere): %v", err)
+	}
+
+	// pin pins the stored topic, bypassing the entity's checks so the repository's own are tested
+	pin := func(id string) error {
+		topic, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		topic.PinnedAt = &now
+		topic.PinnedBy = "owner"
+		return repo.Pin(ctx, topic, 2)
+	}
+	pinned := func() []string {
+		t.Helper()
+		topics, err := repo.ListPinned(ctx, room.ID, repository.PrayerTopicFilter{ViewerID: "owner"})
+		if err != nil {
+			t.Fatalf("ListPinned: %v", err)
+		}
+		ids := make([]string, 0, len(topics))
+		for _, topic := range topics {
+			ids = append(ids, topic.ID)
+		}
+		return ids
+	}
+
+	for _, id := range []string{"t1", "t3"} {
+		if err := pin(id); err != nil {
+			t.Fatalf("Pin(%s): %v", id, err)
+		}
+	}
+	if err := pin("t1"); !errors.Is(err, entity.ErrPrayerTopicPinned) {
+		t.Errorf("pinning t1 again: err = %v, want ErrPrayerTopicPinned", err)
+	}
+	if err := pin("t2"); !errors.Is(err, entity.ErrPinLimitReached) {
+		t.Errorf("pinning a third topic: err = %v, want ErrPinLimitReached", err)
+	}
+	if got := pinned(); !slices.Equal(got, []string{"t1", "t3"}) {
+		t.Errorf("pinned = %v, want [t1 t3] in pin order", got)
+	}
+
+	// The feed pages leave the pinned topics to ListPinned
+	feed, err := repo.List(ctx, room.ID, repository.PrayerTopicFilter{ViewerID: "owner", Unpinned: true}, nil, 10)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(feed) != 2 || feed[0].ID != "t4" || feed[1].ID != "t2" {
+		t.Errorf("unpinned feed = %d topics starting with %+v, want t4 and t2", len(feed), feed)
+	}
+
+	// Missing, repeated, unpinned and other rooms' topics are all rejected
+	for _, order := range [][]string{{"t3"}, {"t3", "t3"}, {"t3", "t2"}, {"t3", "elsewhere"}, {"t1", "t3", "elsewhere"}} {
+		if err := repo.ReorderPins(ctx, room.ID, order); !errors.Is(err, entity.ErrInvalidPinOrder) {
+			t.Errorf("ReorderPins(%v): err = %v, want ErrInvalidPinOrder", order, err)
+		}
+	}
+	if err := repo.ReorderPins(ctx, room.ID, []string{"t3", "t1"}); err != nil {
+		t.Fatalf("ReorderPins: %v", err)
+	}
+	if got := pinned(); !slices.Equal(got, []string{"t3", "t1"}) {
+		t.Errorf("pinned = %v, want [t3 t1] after reordering", got)
+	}
+
+	if err := repo.Unpin(ctx, "t3"); err != nil {
+		t.Fatalf("Unpin: %v", err)
+	}
+	if err := repo.Unpin(ctx, "t3"); !errors.Is(err, entity.ErrPrayerTopicNotPinned) {
+		t.Errorf("unpinning t3 again: err = %v, want ErrPrayerTopicNotPinned", err)
+	}
+	if err := pin("t2"); err != nil {
+		t.Fatalf("Pin(t2) after an unpin: %v", err)
+	}
+	t2, err := repo.GetByID(ctx, "t2")
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if !t2.IsPinned() || t2.PinPosition != 3 || t2.PinnedBy != "owner" {
+		t.Errorf("t2 = pinned %v at %d by %q, want pinned by owner after t1's position 2", t2.IsPinned(), t2.PinPosition, t2.PinnedBy)
+	}
+
+	// Deleting a topic or making it private unpins it
+	if err := repo.SoftDelete(ctx, "t1", "owner", now); err != nil {
+		t.Fatalf("SoftDelete: %v", err)
+	}
+	t2.Private = true
+	if err := repo.Update(ctx, t2); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if got := pinned(); len(got) != 0 {
+		t.Errorf("pinned = %v, want none", got)
+	}
+	if err := repo.Restore(ctx, "t1", now); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if t1, _ := repo.GetByID(ctx, "t1"); t1.IsPinned() {
+		t.Error("restored topic is pinned again")
+	}
+}
+
+func TestPrayerTopicRepositoryUserOrder(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewPrayerTopicRepository(db)
+
+	room, err := entity.NewRoom("grace", "새벽기도", "", entity.RoomPrivate, entity.RoomCategoryChurch, nil)
+	if err != nil {
+		t.Fatalf("NewRoom: %v", err)
+	}
+	room.ID = "room-1"
+	owner := &entity.RoomMember{RoomID: room.ID, UserID: "grace", Role: entity.RoomRoleOwner, JoinedAt: room.CreatedAt}
+	if err := NewRoomRepository(db).Create(ctx, room, owner); err != nil {
+		t.Fatalf("Create room: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	topics := []*entity.PrayerTopic{
+		{ID: "t1", AuthorID: "grace"},
+		{ID: "t2", AuthorID: "grace", Private: true},
+		{ID: "t3", AuthorID: "grace"},
+		{ID: "t4", AuthorID: "grace"},
+		{ID: "john-1", AuthorID: "john"},
+		{ID: "deleted", AuthorID: "grace"},
+	}
+	for i, topic := range topics {
+		topic.RoomID, topic.Title, topic.CreatedAt = room.ID, topic.ID, now.Add(time.Duration(i)*time.Minute)
+		if err := repo.Create(ctx, topic); err != nil {
+			t.Fatalf("Create(%s): %v", topic.ID, err)
+		}
+	}
+	if err := repo.SoftDelete(ctx, "deleted", "grace", now); err != nil {
+		t.Fatalf("SoftDelete: %v", err)
+	}
+
+	// mine lists grace's topics as the "my prayers" view puts them together
+	mine := func() []string {
+		t.Helper()
+		ordered, err := repo.ListOrderedByAuthor(ctx, "grace")
+		if err != nil {
+			t.Fatalf("ListOrderedByAuthor: %v", err)
+		}
+		rest, err := repo.ListUnorderedByAuthor(ctx, "grace", nil, 10)
+		if err != nil {
+			t.Fatalf("ListUnorderedByAuthor: %v", err)
+		}
+		var ids []string
+		for _, topic := range append(ordered, rest...) {
+			ids = append(ids, topic.ID)
+		}
+		return ids
+	}
+
+	if got := mine(); !slices.Equal(got, []string{"t4", "t3", "t2", "t1"}) {
+		t.Errorf("my prayers = %v, want newest first before any order is set", got)
+	}
+
+	for _, order := range [][]string{{"t1", "t1"}, {"t1", "john-1"}, {"deleted"}, {"missing"}} {
+		if err := repo.ReorderByAuthor(ctx, "grace", order); !errors.Is(err, entity.ErrInvalidPrayerOrder) {
+			t.Errorf("ReorderByAuthor(%v): err = %v, want ErrInvalidPrayerOrder", order, err)
+		}
+	}
+
+	if err := repo.ReorderByAuthor(ctx, "grace", []string{"t1", "t3"}); err != nil {
+		t.Fatalf("ReorderByAuthor: %v", err)
+	}
+	if got := mine(); !slices.Equal(got, []string{"t1", "t3", "t4", "t2"}) {
+		t.Errorf("my prayers = %v, want [t1 t3] first, then the rest newest first", got)
+	}
+
+	if err := repo.ReorderByAuthor(ctx, "grace", []string{"t2"}); err != nil {
+		t.Fatalf("ReorderByAuthor: %v", err)
+	}
+	if got := mine(); !slices.Equal(got, []string{"t2", "t4", "t3", "t1"}) {
+		t.Errorf("my prayers = %v, want the earlier order replaced", got)
+	}
+	if john, _ := repo.GetByID(ctx, "john-1"); john.UserOrder != 0 {
+		t.Errorf("john's topic has order %d, want it untouched", john.UserOrder)
+	}
+}
@@ -0,0 +1,193 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// pushRetryModel is the GORM mapping of a queued entity.PushRetry
+type pushRetryModel struct {
+	ID            string    `gorm:"primaryKey;size:36"`
+	Token         string    `gorm:"size:512;not null;index"`
+	Type          string    `gorm:"size:50;not null"`
+	Title         string    `gorm:"size:400;not null"`
+	Body          string    `gorm:"size:4000"`
+	Data          string    `gorm:"size:1000"`
+	Attempts      int       `gorm:"not null"`
+	LastError     string    `gorm:"size:1000"`
+	NextAttemptAt time.Time `gorm:"not null;index"`
+	CreatedAt     time.Time
+}
+
+func (pushRetryModel) TableName() string {
+	return "push_retries"
+}
+
+// pushDeadLetterModel is a push that ran out of attempts, kept for inspection
+type pushDeadLetterModel struct {
+	ID        string `gorm:"primaryKey;size:36"`
+	Token     string `gorm:"size:512;not null"`
+	Type      string `gorm:"size:50;not null"`
+	Title     string `gorm:"size:400;not null"`
+	Body      string `gorm:"size:4000"`
+	Data      string `gorm:"size:1000"`
+	Attempts  int    `gorm:"not null"`
+	LastError string `gorm:"size:1000"`
+	CreatedAt time.Time
+	DeadAt    time.Time `gorm:"not null;index"`
+}
+
+func (pushDeadLetterModel) TableName() string {
+	return "push_dead_letters"
+}
+
+func newPushRetryModel(r *entity.PushRetry) (*pushRetryModel, error) {
+	data, err := encodeNotificationData(r.Notification.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &pushRetryModel{
+		ID:            r.ID,
+		Token:         r.Token,
+		Type:          string(r.Notification.Type),
+		Title:         r.Notification.Title,
+		Body:          r.Notification.Body,
+		Data:          data,
+		Attempts:      r.Attempts,
+		LastError:     truncateError(r.LastError),
+		NextAttemptAt: r.NextAttemptAt,
+		CreatedAt:     r.CreatedAt,
+	}, nil
+}
+
+func (m *pushRetryModel) toEntity() *entity.PushRetry {
+	return &entity.PushRetry{
+		ID:    m.ID,
+		Token: m.Token,
+		Notification: entity.Notification{
+			Type:  entity.NotificationType(m.Type),
+			Title: m.Title,
+			Body:  m.Body,
+			Data:  decodeNotificationData(m.Data),
+		},
+		Attempts:      m.Attempts,
+		LastError:     m.LastError,
+		NextAttemptAt: m.NextAttemptAt,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+// truncateError fits an error message into its column; FCM messages are usually far shorter
+func truncateError(s string) string {
+	if len(s) <= 1000 {
+		return s
+	}
+	return s[:1000]
+}
+
+type pushRetryRepository struct {
+	db *database.DB
+}
+
+func NewPushRetryRepository(db *database.DB) repository.PushRetryRepository {
+	return &pushRetryRepository{db: db}
+}
+
+func (r *pushRetryRepository) Enqueue(ctx context.Context, retries []*entity.PushRetry) error {
+	if len(retries) == 0 {
+		return nil
+	}
+	models := make([]*pushRetryModel, 0, len(retries))
+	for _, retry := range retries {
+		model, err := newPushRetryModel(retry)
+		if err != nil {
+			return err
+		}
+		models = append(models, model)
+	}
+	return r.db.WithContext(ctx).CreateInBatches(models, notificationInsertBatch).Error
+}
+
+func (r *pushRetryRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.PushRetry, error) {
+	var models []pushRetryModel
+	err := r.db.WithContext(ctx).
+		Where("next_attempt_at <= ?", now.UTC()).
+		Order("next_attempt_at, id").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	retries := make([]*entity.PushRetry, 0, len(models))
+	for i := range models {
+		retries = append(retries, models[i].toEntity())
+	}
+	return retries, nil
+}
+
+func (r *pushRetryRepository) Reschedule(ctx context.Context, retry *entity.PushRetry) error {
+	return r.db.WithContext(ctx).
+		Model(&pushRetryModel{}).
+		Where("id = ?", retry.ID).
+		Updates(map[string]interface{}{
+			"attempts":        retry.Attempts,
+			"last_error":      truncateError(retry.LastError),
+			"next_attempt_at": retry.NextAttemptAt.UTC(),
+		}).Error
+}
+
+func (r *pushRetryRepository) Delete(ctx context.Context, ids ...string) error {
+	for start := 0; start < len(ids); start += maxInListSize {
+		end := min(start+maxInListSize, len(ids))
+		if err := r.db.WithContext(ctx).Where("id IN ?", ids[start:end]).Delete(&pushRetryModel{}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *pushRetryRepository) DeadLetter(ctx context.Context, retries []*entity.PushRetry, at time.Time) error {
+	if len(retries) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		letters := make([]*pushDeadLetterModel, 0, len(retries))
+		ids := make([]string, 0, len(retries))
+		for _, retry := range retries {
+			model, err := newPushRetryModel(retry)
+			if err != nil {
+				return err
+			}
+			letters = append(letters, &pushDeadLetterModel{
+				ID:        model.ID,
+				Token:     model.Token,
+				Type:      model.Type,
+				Title:     model.Title,
+				Body:      model.Body,
+				Data:      model.Data,
+				Attempts:  model.Attempts,
+				LastError: model.LastError,
+				CreatedAt: model.CreatedAt,
+				DeadAt:    at,
+			})
+			ids = append(ids, retry.ID)
+		}
+		if err := tx.CreateInBatches(letters, notificationInsertBatch).Error; err != nil {
+			return err
+		}
+
+		for start := 0; start < len(ids); start += maxInListSize {
+			end := min(start+maxInListSize, len(ids))
+			if err := tx.Where("id IN ?", ids[start:end]).Delete(&pushRetryModel{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
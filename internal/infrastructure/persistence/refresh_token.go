@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// refreshTokenModel is the GORM mapping of entity.RefreshToken
+type refreshTokenModel struct {
+	ID        string    `gorm:"primaryKey;size:36"`
+	FamilyID  string    `gorm:"size:36;not null;index"`
+	UserID    string    `gorm:"size:36;not null;index"`
+	DeviceID  string    `gorm:"size:100;not null"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func (refreshTokenModel) TableName() string {
+	return "refresh_tokens"
+}
+
+func newRefreshTokenModel(t *entity.RefreshToken) *refreshTokenModel {
+	return &refreshTokenModel{
+		ID:        t.ID,
+		FamilyID:  t.FamilyID,
+		UserID:    t.UserID,
+		DeviceID:  t.DeviceID,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		RevokedAt: t.RevokedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func (m *refreshTokenModel) toEntity() *entity.RefreshToken {
+	return &entity.RefreshToken{
+		ID:        m.ID,
+		FamilyID:  m.FamilyID,
+		UserID:    m.UserID,
+		DeviceID:  m.DeviceID,
+		ExpiresAt: m.ExpiresAt,
+		UsedAt:    m.UsedAt,
+		RevokedAt: m.RevokedAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type refreshTokenRepository struct {
+	db *database.DB
+}
+
+func NewRefreshTokenRepository(db *database.DB) repository.RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+func (r *refreshTokenRepository) Create(ctx context.Context, token *entity.RefreshToken) error {
+	return r.db.WithContext(ctx).Create(newRefreshTokenModel(token)).Error
+}
+
+func (r *refreshTokenRepository) GetByID(ctx context.Context, id string) (*entity.RefreshToken, error) {
+	var model refreshTokenModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRefreshTokenNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *refreshTokenRepository) MarkUsed(ctx context.Context, id string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&refreshTokenModel{}).
+		Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", id).
+		Update("used_at", time.Now().UTC())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *refreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	return r.db.WithContext(ctx).
+		Model(&refreshTokenModel{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now().UTC()).Error
+}
+
+func (r *refreshTokenRepository) RevokeAllForUser(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).
+		Model(&refreshTokenModel{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now().UTC()).Error
+}
+
+// ListSessions reads the first token of each family in a second query rather than with
+// MIN(created_at), which sqlite returns as text that cannot be scanned into a time
+func (r *refreshTokenRepository) ListSessions(ctx context.Context, userID string, now time.Time) ([]*entity.Session, error) {
+	var active []refreshTokenModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND used_at IS NULL AND revoked_at IS NULL AND expires_at > ?", userID, now.UTC()).
+		Order("created_at DESC").
+		Find(&active).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(active) == 0 {
+		return []*entity.Session{}, nil
+	}
+
+	familyIDs := make([]string, 0, len(active))
+	for _, token := range active {
+		familyIDs = append(familyIDs, token.FamilyID)
+	}
+	var history []refreshTokenModel
+	err = r.db.WithContext(ctx).
+		Select("family_id", "created_at").
+		Where("family_id IN ?", familyIDs).
+		Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	signedInAt := make(map[string]time.Time, len(active))
+	for _, token := range history {
+		if first, ok := signedInAt[token.FamilyID]; !ok || token.CreatedAt.Before(first) {
+			signedInAt[token.FamilyID] = token.CreatedAt
+		}
+	}
+
+	sessions := make([]*entity.Session, 0, len(active))
+	for _, token := range active {
+		sessions = append(sessions, &entity.Session{
+			ID:           token.FamilyID,
+			DeviceID:     token.DeviceID,
+			SignedInAt:   signedInAt[token.FamilyID],
+			LastActiveAt: token.CreatedAt,
+			ExpiresAt:    token.ExpiresAt,
+		})
+	}
+	return sessions, nil
+}
+
+func (r *refreshTokenRepository) RevokeSession(ctx context.Context, userID, familyID string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&refreshTokenModel{}).
+		Where("user_id = ? AND family_id = ? AND revoked_at IS NULL", userID, familyID).
+		Update("revoked_at", time.Now().UTC())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// IsSessionRevoked checks for any revoked token, as every revocation covers the whole family
+func (r *refreshTokenRepository) IsSessionRevoked(ctx context.Context, familyID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&refreshTokenModel{}).
+		Where("family_id = ? AND revoked_at IS NOT NULL", familyID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+func TestRefreshTokenRepositoryListSessions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRefreshTokenRepository(newTestDB(t))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	signedIn := now.Add(-48 * time.Hour)
+	rotated := now.Add(-time.Hour)
+	tokens := []*entity.RefreshToken{
+		// phone: signed in two days ago, rotated an hour ago
+		{ID: "phone-1", FamilyID: "phone", UserID: "u1", DeviceID: "phone", ExpiresAt: now.Add(24 * time.Hour), UsedAt: &rotated, CreatedAt: signedIn},
+		{ID: "phone-2", FamilyID: "phone", UserID: "u1", DeviceID: "phone", ExpiresAt: now.Add(24 * time.Hour), CreatedAt: rotated},
+		// tablet: signed in a day ago, never rotated
+		{ID: "tablet-1", FamilyID: "tablet", UserID: "u1", DeviceID: "tablet", ExpiresAt: now.Add(24 * time.Hour), CreatedAt: now.Add(-24 * time.Hour)},
+		// expired and other users' sessions are not listed
+		{ID: "old-1", FamilyID: "old", UserID: "u1", DeviceID: "old", ExpiresAt: now.Add(-time.Minute), CreatedAt: now.Add(-72 * time.Hour)},
+		{ID: "other-1", FamilyID: "other", UserID: "u2", DeviceID: "phone", ExpiresAt: now.Add(24 * time.Hour), CreatedAt: now},
+	}
+	for _, token := range tokens {
+		if err := repo.Create(ctx, token); err != nil {
+			t.Fatalf("Create(%s): %v", token.ID, err)
+		}
+	}
+
+	sessions, err := repo.ListSessions(ctx, "u1", now)
+	if err != nil {
+		t.Fatalf("ListSessions: %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("got %d sessions, want 2", len(sessions))
+	}
+
+	phone := sessions[0]
+	if phone.ID != "phone" || phone.DeviceID != "phone" {
+		t.Fatalf("first session = %s on %s, want the most recently active phone", phone.ID, phone.DeviceID)
+	}
+	if !phone.SignedInAt.Equal(signedIn) {
+		t.Errorf("SignedInAt = %v, want the first token's %v", phone.SignedInAt, signedIn)
+	}
+	if !phone.LastActiveAt.Equal(rotated) {
+		t.Errorf("LastActiveAt = %v, want the latest rotation %v", phone.LastActiveAt, rotated)
+	}
+	if sessions[1].ID != "tablet" || !sessions[1].SignedInAt.Equal(sessions[1].LastActiveAt) {
+		t.Errorf("second session = %+v, want the tablet signed in once", sessions[1])
+	}
+
+	if revoked, err := repo.RevokeSession(ctx, "u1", "phone"); err != nil || !revoked {
+		t.Fatalf("RevokeSession = %v, %v", revoked, err)
+	}
+	sessions, err = repo.ListSessions(ctx, "u1", now)
+	if err != nil {
+		t.Fatalf("ListSessions: %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].ID != "tablet" {
+		t.Errorf("after revoking the phone got %+v, want only the tablet", sessions)
+	}
+
+	for familyID, want := range map[string]bool{"phone": true, "tablet": false} {
+		revoked, err := repo.IsSessionRevoked(ctx, familyID)
+		if err != nil {
+			t.Fatalf("IsSessionRevoked(%s): %v", familyID, err)
+		}
+		if revoked != want {
+			t.Errorf("IsSessionRevoked(%s) = %v, want %v", familyID, revoked, want)
+		}
+	}
+}
+
+func TestRefreshTokenRepositoryRevokeAllForUser(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRefreshTokenRepository(newTestDB(t))
+
+	now := time.Now().UTC()
+	used := now.Add(-time.Minute)
+	tokens := []*entity.RefreshToken{
+		{ID: "phone-1", FamilyID: "phone", UserID: "u1", DeviceID: "phone", ExpiresAt: now.Add(time.Hour), UsedAt: &used, CreatedAt: now.Add(-time.Hour)},
+		{ID: "phone-2", FamilyID: "phone", UserID: "u1", DeviceID: "phone", ExpiresAt: now.Add(time.Hour), CreatedAt: now},
+		{ID: "tablet-1", FamilyID: "tablet", UserID: "u1", DeviceID: "tablet", ExpiresAt: now.Add(time.Hour), CreatedAt: now},
+		{ID: "other-1", FamilyID: "other", UserID: "u2", DeviceID: "phone", ExpiresAt: now.Add(time.Hour), CreatedAt: now},
+	}
+	for _, token := range tokens {
+		if err := repo.Create(ctx, token); err != nil {
+			t.Fatalf("Create(%s): %v", token.ID, err)
+		}
+	}
+
+	// Suspension and force logout: every session of the user ends, including its access tokens
+	if err := repo.RevokeAllForUser(ctx, "u1"); err != nil {
+		t.Fatalf("RevokeAllForUser: %v", err)
+	}
+	for familyID, want := range map[string]bool{"phone": true, "tablet": true, "other": false} {
+		revoked, err := repo.IsSessionRevoked(ctx, familyID)
+		if err != nil {
+			t.Fatalf("IsSessionRevoked(%s): %v", familyID, err)
+		}
+		if revoked != want {
+			t.Errorf("IsSessionRevoked(%s) = %v, want %v", familyID, revoked, want)
+		}
+	}
+	if used, err := repo.MarkUsed(ctx, "tablet-1"); err != nil || used {
+		t.Errorf("MarkUsed of a revoked token = %v, %v, want false", used, err)
+	}
+}
@@ -0,0 +1,436 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// roomModel is the GORM mapping of entity.Room
+type roomModel struct {
+	ID            string `gorm:"primaryKey;size:36"`
+	Name          string `gorm:"size:200;not null"` // 50 characters in UTF-8
+	Description   string `gorm:"size:2000"`         // 500 characters in UTF-8
+	Visibility    string `gorm:"size:10;not null;default:private;index"`
+	Category      string `gorm:"size:20;index"`
+	OwnerID       string `gorm:"size:36;not null;index"`
+	MemberCap     int    `gorm:"not null;default:0"`
+	ReminderTime  string `gorm:"size:5"`
+	PostPolicy    string `gorm:"size:20;not null;default:members"`
+	CoverImageKey string `gorm:"size:200"`
+	CoverImageURL string `gorm:"size:500"`
+	ArchivedAt    *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (roomModel) TableName() string {
+	return "rooms"
+}
+
+func newRoomModel(r *entity.Room) *roomModel {
+	return &roomModel{
+		ID:            r.ID,
+		Name:          r.Name,
+		Description:   r.Description,
+		Visibility:    string(r.Visibility),
+		Category:      string(r.Category),
+		OwnerID:       r.OwnerID,
+		MemberCap:     r.Settings.MemberCap,
+		ReminderTime:  r.Settings.ReminderTime,
+		PostPolicy:    string(r.Settings.PostPolicy),
+		CoverImageKey: r.CoverImageKey,
+		CoverImageURL: r.CoverImageURL,
+		ArchivedAt:    r.ArchivedAt,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+func (m *roomModel) toEntity() *entity.Room {
+	return &entity.Room{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Visibility:  entity.RoomVisibility(m.Visibility),
+		Category:    entity.RoomCategory(m.Category),
+		Tags:        []string{},
+		OwnerID:     m.OwnerID,
+		Settings: entity.RoomSettings{
+			MemberCap:    m.MemberCap,
+			ReminderTime: m.ReminderTime,
+			PostPolicy:   entity.RoomPostPolicy(m.PostPolicy),
+		},
+		CoverImageKey: m.CoverImageKey,
+		CoverImageURL: m.CoverImageURL,
+		ArchivedAt:    m.ArchivedAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+// roomTagModel is one free-form tag of a room
+// The primary key serves loading a room's tags; the tag index serves filtering by tag
+type roomTagModel struct {
+	RoomID string `gorm:"primaryKey;size:36"`
+	Tag    string `gorm:"primaryKey;size:80;index:idx_room_tags_tag"` // 20 characters in UTF-8
+}
+
+func (roomTagModel) TableName() string {
+	return "room_tags"
+}
+
+// newRoomTagModels returns the rows of the room's tags
+func newRoomTagModels(r *entity.Room) []roomTagModel {
+	models := make([]roomTagModel, 0, len(r.Tags))
+	for _, tag := range r.Tags {
+		models = append(models, roomTagModel{RoomID: r.ID, Tag: tag})
+	}
+	return models
+}
+
+type roomRepository struct {
+	db *database.DB
+}
+
+func NewRoomRepository(db *database.DB) repository.RoomRepository {
+	return &roomRepository{db: db}
+}
+
+func (r *roomRepository) Create(ctx context.Context, room *entity.Room, owner *entity.RoomMember) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newRoomModel(room)).Error; err != nil {
+			return err
+		}
+		if err := createRoomTags(tx, room); err != nil {
+			return err
+		}
+		return tx.Create(newRoomMemberModel(owner)).Error
+	})
+}
+
+func (r *roomRepository) GetByID(ctx context.Context, id string) (*entity.Room, error) {
+	var model roomModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRoomNotFound
+		}
+		return nil, err
+	}
+
+	room := model.toEntity()
+	if err := loadRoomTags(r.db.WithContext(ctx), []*entity.Room{room}); err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+func (r *roomRepository) ListByMember(ctx context.Context, userID string, filter repository.RoomFilter, after *pagination.TimeKey, limit int) ([]*entity.Room, error) {
+	var models []roomModel
+	err := r.db.WithContext(ctx).
+		Where("id IN (SELECT room_id FROM room_members WHERE user_id = ?)", userID).
+		Scopes(roomFiltered(filter), afterTimeKey("created_at", "id", after)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withTags(ctx, models)
+}
+
+func (r *roomRepository) Search(ctx context.Context, search repository.RoomSearch, after *pagination.TimeKey, limit int) ([]*entity.Room, error) {
+	// A leading wildcard cannot use an index; the visibility condition narrows the scan instead
+	pattern := likeContains(strings.ToLower(search.Query))
+
+	var models []roomModel
+	err := r.db.WithContext(ctx).
+		Where(`(LOWER(name) LIKE ? ESCAPE '!' OR LOWER(description) LIKE ? ESCAPE '!')`, pattern, pattern).
+		Where("(visibility = ? OR id IN (SELECT room_id FROM room_members WHERE user_id = ?))", string(entity.RoomPublic), search.ViewerID).
+		Scopes(
+			notBlockedBy(search.ViewerID, "owner_id"),
+			roomFiltered(search.Filter),
+			afterTimeKey("created_at", "id", after),
+		).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.withTags(ctx, models)
+}
+
+func (r *roomRepository) ListTrending(ctx context.Context, window time.Duration, limit int) ([]repository.TrendingRoom, error) {
+	db := r.db.WithContext(ctx)
+	since := time.Now().Add(-window).UTC()
+	activity := db.Raw(
+		`SELECT room_id FROM prayer_topics WHERE created_at >= ? AND deleted_at IS NULL AND private = ?
+		UNION ALL SELECT room_id FROM prayer_reactions WHERE prayed_at >= ?
+		UNION ALL SELECT room_id FROM room_members WHERE joined_at >= ?`,
+		since, false, since, since,
+	)
+
+	var rooms []repository.TrendingRoom
+	err := db.Table("(?) activity", activity).
+		Select("room_id, COUNT(*) AS score").
+		Where("room_id IN (SELECT id FROM rooms WHERE visibility = ? AND archived_at IS NULL)", string(entity.RoomPublic)).
+		Group("room_id").
+		Order("score DESC, room_id").
+		Limit(limit).
+		Scan(&rooms).Error
+	if err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+// withTags converts a page of models into rooms with their tags
+func (r *roomRepository) withTags(ctx context.Context, models []roomModel) ([]*entity.Room, error) {
+	rooms := make([]*entity.Room, 0, len(models))
+	for i := range models {
+		rooms = append(rooms, models[i].toEntity())
+	}
+	if err := loadRoomTags(r.db.WithContext(ctx), rooms); err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+func (r *roomRepository) Update(ctx context.Context, room *entity.Room) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&roomModel{}).
+			Where("id = ?", room.ID).
+			Updates(map[string]interface{}{
+				"name":        room.Name,
+				"description": room.Description,
+				"visibility":  string(room.Visibility),
+				"category":    string(room.Category),
+				"updated_at":  room.UpdatedAt.UTC(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrRoomNotFound
+		}
+
+		if err := tx.Where("room_id = ?", room.ID).Delete(&roomTagModel{}).Error; err != nil {
+			return err
+		}
+		return createRoomTags(tx, room)
+	})
+}
+
+func (r *roomRepository) UpdateSettings(ctx context.Context, room *entity.Room) error {
+	result := r.db.WithContext(ctx).
+		Model(&roomModel{}).
+		Where("id = ?", room.ID).
+		Updates(map[string]interface{}{
+			"visibility":    string(room.Visibility),
+			"member_cap":    room.Settings.MemberCap,
+			"reminder_time": room.Settings.ReminderTime,
+			"post_policy":   string(room.Settings.PostPolicy),
+			"updated_at":    room.UpdatedAt.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrRoomNotFound
+	}
+	return nil
+}
+
+func (r *roomRepository) SetCoverImage(ctx context.Context, id, key, url string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&roomModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"cover_image_key": key,
+			"cover_image_url": url,
+			"updated_at":      at.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrRoomNotFound
+	}
+	return nil
+}
+
+func (r *roomRepository) Archive(ctx context.Context, id string, at time.Time) error {
+	return r.setArchivedAt(ctx, id, "archived_at IS NULL", &at, at, entity.ErrRoomArchived)
+}
+
+func (r *roomRepository) Unarchive(ctx context.Context, id string, at time.Time) error {
+	return r.setArchivedAt(ctx, id, "archived_at IS NOT NULL", nil, at, entity.ErrRoomNotArchived)
+}
+
+// setArchivedAt changes the archive state of a room that is in the expected state
+// The state condition keeps concurrent archive and restore requests from both succeeding
+func (r *roomRepository) setArchivedAt(ctx context.Context, id, expected string, archivedAt *time.Time, at time.Time, errWrongState error) error {
+	var value interface{}
+	if archivedAt != nil {
+		value = archivedAt.UTC()
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&roomModel{}).
+		Where("id = ? AND "+expected, id).
+		Updates(map[string]interface{}{
+			"archived_at": value,
+			"updated_at":  at.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Tell a missing room apart from one in the wrong state
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return errWrongState
+	}
+	return nil
+}
+
+func (r *roomRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := deleteComments(tx, "room_id = ?", id); err != nil {
+			return err
+		}
+		for _, dependent := range roomDependents() {
+			if err := tx.Where("room_id = ?", id).Delete(dependent).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Delete(&roomModel{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrRoomNotFound
+		}
+		return nil
+	})
+}
+
+// DeleteOrphans removes, in one transaction, the records left behind by rooms that no longer exist
+func (r *roomRepository) DeleteOrphans(ctx context.Context) (map[string]int64, error) {
+	const orphaned = "room_id NOT IN (SELECT id FROM rooms)"
+	removed := map[string]int64{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		remove := func(model schema.Tabler, query string) error {
+			result := tx.Where(query).Delete(model)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				removed[model.TableName()] = result.RowsAffected
+			}
+			return nil
+		}
+
+		if err := remove(&commentMentionModel{}, "comment_id IN (SELECT id FROM prayer_comments WHERE "+orphaned+")"); err != nil {
+			return err
+		}
+		if err := remove(&prayerCommentModel{}, orphaned); err != nil {
+			return err
+		}
+		for _, dependent := range roomDependents() {
+			if err := remove(dependent, orphaned); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+// roomDependents returns the models of the records kept per room besides its comments,
+// which go with their mentions
+func roomDependents() []schema.Tabler {
+	return []schema.Tabler{
+		&roomInviteModel{},
+		&roomInvitationModel{},
+		&joinRequestModel{},
+		&announcementModel{},
+		&prayerContentModel{},
+		&prayerRevisionModel{},
+		&prayerReactionModel{},
+		&prayerTagModel{},
+		&prayerTopicModel{},
+		&roomTagModel{},
+		&roomMemberModel{},
+	}
+}
+
+// roomFiltered is a scope applying a RoomFilter to a rooms query
+// Tag matching counts the matched tags per room, so a room qualifies only with all of them
+func roomFiltered(filter repository.RoomFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filter.Archived {
+			db = db.Where("archived_at IS NOT NULL")
+		} else {
+			db = db.Where("archived_at IS NULL")
+		}
+		if filter.Category != "" {
+			db = db.Where("category = ?", string(filter.Category))
+		}
+		if len(filter.Tags) > 0 {
+			db = db.Where(
+				"id IN (SELECT room_id FROM room_tags WHERE tag IN ? GROUP BY room_id HAVING COUNT(*) = ?)",
+				filter.Tags, len(filter.Tags),
+			)
+		}
+		return db
+	}
+}
+
+// createRoomTags stores the room's tags
+func createRoomTags(tx *gorm.DB, room *entity.Room) error {
+	if len(room.Tags) == 0 {
+		return nil
+	}
+	return tx.Create(newRoomTagModels(room)).Error
+}
+
+// loadRoomTags fills in the tags of the rooms with a single query
+// Callers pass at most one page of rooms, well below maxInListSize
+func loadRoomTags(db *gorm.DB, rooms []*entity.Room) error {
+	if len(rooms) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entity.Room, len(rooms))
+	ids := make([]string, 0, len(rooms))
+	for _, room := range rooms {
+		byID[room.ID] = room
+		ids = append(ids, room.ID)
+	}
+
+	var models []roomTagModel
+	if err := db.Where("room_id IN ?", ids).Order("room_id, tag").Find(&models).Error; err != nil {
+		return err
+	}
+	for _, m := range models {
+		room := byID[m.RoomID]
+		room.Tags = append(room.Tags, m.Tag)
+	}
+	return nil
+}
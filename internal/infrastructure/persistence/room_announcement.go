@@ -0,0 +1,116 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+// announcementModel is the GORM mapping of entity.RoomAnnouncement
+type announcementModel struct {
+	ID        string    `gorm:"primaryKey;size:36"`
+	RoomID    string    `gorm:"size:36;not null;index:idx_room_announcements_room_created"`
+	AuthorID  string    `gorm:"size:36;not null;index"`
+	Body      string    `gorm:"size:4000;not null"` // 1000 characters in UTF-8
+	CreatedAt time.Time `gorm:"index:idx_room_announcements_room_created"`
+	UpdatedAt time.Time
+}
+
+func (announcementModel) TableName() string {
+	return "room_announcements"
+}
+
+func newAnnouncementModel(a *entity.RoomAnnouncement) *announcementModel {
+	return &announcementModel{
+		ID:        a.ID,
+		RoomID:    a.RoomID,
+		AuthorID:  a.AuthorID,
+		Body:      a.Body,
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
+
+func (m *announcementModel) toEntity() *entity.RoomAnnouncement {
+	return &entity.RoomAnnouncement{
+		ID:        m.ID,
+		RoomID:    m.RoomID,
+		AuthorID:  m.AuthorID,
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+type announcementRepository struct {
+	db *database.DB
+}
+
+func NewAnnouncementRepository(db *database.DB) repository.AnnouncementRepository {
+	return &announcementRepository{db: db}
+}
+
+func (r *announcementRepository) Create(ctx context.Context, announcement *entity.RoomAnnouncement) error {
+	return r.db.WithContext(ctx).Create(newAnnouncementModel(announcement)).Error
+}
+
+func (r *announcementRepository) GetByID(ctx context.Context, id string) (*entity.RoomAnnouncement, error) {
+	return r.first(r.db.WithContext(ctx).Where("id = ?", id))
+}
+
+func (r *announcementRepository) Latest(ctx context.Context, roomID string) (*entity.RoomAnnouncement, error) {
+	return r.first(r.db.WithContext(ctx).Where("room_id = ?", roomID).Order("created_at DESC, id DESC"))
+}
+
+func (r *announcementRepository) first(db *gorm.DB) (*entity.RoomAnnouncement, error) {
+	var model announcementModel
+	if err := db.First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrAnnouncementNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *announcementRepository) List(ctx context.Context, roomID string, after *pagination.TimeKey, limit int) ([]*entity.RoomAnnouncement, error) {
+	var models []announcementModel
+	err := r.db.WithContext(ctx).
+		Where("room_id = ?", roomID).
+		Scopes(afterTimeKey("created_at", "id", after)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	announcements := make([]*entity.RoomAnnouncement, 0, len(models))
+	for i := range models {
+		announcements = append(announcements, models[i].toEntity())
+	}
+	return announcements, nil
+}
+
+func (r *announcementRepository) Update(ctx context.Context, announcement *entity.RoomAnnouncement) error {
+	result := r.db.WithContext(ctx).
+		Model(&announcementModel{}).
+		Where("id = ?", announcement.ID).
+		Updates(map[string]interface{}{
+			"body":       announcement.Body,
+			"updated_at": announcement.UpdatedAt.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrAnnouncementNotFound
+	}
+	return nil
+}
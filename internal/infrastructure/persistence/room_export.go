@@ -0,0 +1,162 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// roomExportModel is the GORM mapping of entity.RoomExport
+// Rows are not removed with their room or requester; the expiry sweep deletes them with their file
+type roomExportModel struct {
+	ID          string `gorm:"primaryKey;size:36"`
+	RoomID      string `gorm:"size:36;not null;index:idx_room_exports_room_user"`
+	RequestedBy string `gorm:"size:36;not null;index:idx_room_exports_room_user"`
+	Format      string `gorm:"size:10;not null"`
+	Status      string `gorm:"size:10;not null;index"`
+	FileKey     string `gorm:"size:200"`
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time `gorm:"index"`
+}
+
+func (roomExportModel) TableName() string {
+	return "room_exports"
+}
+
+func newRoomExportModel(e *entity.RoomExport) *roomExportModel {
+	return &roomExportModel{
+		ID:          e.ID,
+		RoomID:      e.RoomID,
+		RequestedBy: e.RequestedBy,
+		Format:      string(e.Format),
+		Status:      string(e.Status),
+		FileKey:     e.FileKey,
+		StartedAt:   e.StartedAt,
+		CompletedAt: e.CompletedAt,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func (m *roomExportModel) toEntity() *entity.RoomExport {
+	return &entity.RoomExport{
+		ID:          m.ID,
+		RoomID:      m.RoomID,
+		RequestedBy: m.RequestedBy,
+		Format:      entity.ExportFormat(m.Format),
+		Status:      entity.ExportStatus(m.Status),
+		FileKey:     m.FileKey,
+		StartedAt:   m.StartedAt,
+		CompletedAt: m.CompletedAt,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+type roomExportRepository struct {
+	db *database.DB
+}
+
+func NewRoomExportRepository(db *database.DB) repository.RoomExportRepository {
+	return &roomExportRepository{db: db}
+}
+
+func (r *roomExportRepository) Create(ctx context.Context, export *entity.RoomExport) error {
+	return r.db.WithContext(ctx).Create(newRoomExportModel(export)).Error
+}
+
+func (r *roomExportRepository) GetUnfinished(ctx context.Context, roomID, userID string) (*entity.RoomExport, error) {
+	var model roomExportModel
+	err := r.db.WithContext(ctx).
+		Where("room_id = ? AND requested_by = ? AND status IN ?", roomID, userID,
+			[]string{string(entity.ExportPending), string(entity.ExportRunning)}).
+		Order("created_at DESC").
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRoomExportNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *roomExportRepository) ListRunnable(ctx context.Context, staleBefore time.Time, limit int) ([]*entity.RoomExport, error) {
+	var models []roomExportModel
+	err := r.db.WithContext(ctx).
+		Where("status = ? OR (status = ? AND started_at < ?)",
+			string(entity.ExportPending), string(entity.ExportRunning), staleBefore.UTC()).
+		Order("created_at").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	exports := make([]*entity.RoomExport, 0, len(models))
+	for i := range models {
+		exports = append(exports, models[i].toEntity())
+	}
+	return exports, nil
+}
+
+func (r *roomExportRepository) Claim(ctx context.Context, export *entity.RoomExport, now time.Time) (bool, error) {
+	// Matching the state that was read makes concurrent runs claim each export once
+	db := r.db.WithContext(ctx).Model(&roomExportModel{}).Where("id = ? AND status = ?", export.ID, string(export.Status))
+	if export.StartedAt == nil {
+		db = db.Where("started_at IS NULL")
+	} else {
+		db = db.Where("started_at = ?", export.StartedAt.UTC())
+	}
+
+	result := db.Updates(map[string]interface{}{
+		"status":     string(entity.ExportRunning),
+		"started_at": now.UTC(),
+	})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *roomExportRepository) Finish(ctx context.Context, export *entity.RoomExport) error {
+	var completedAt interface{}
+	if export.CompletedAt != nil {
+		completedAt = export.CompletedAt.UTC()
+	}
+
+	return r.db.WithContext(ctx).
+		Model(&roomExportModel{}).
+		Where("id = ?", export.ID).
+		Updates(map[string]interface{}{
+			"status":       string(export.Status),
+			"file_key":     export.FileKey,
+			"completed_at": completedAt,
+		}).Error
+}
+
+func (r *roomExportRepository) ListExpired(ctx context.Context, cutoff time.Time, limit int) ([]*entity.RoomExport, error) {
+	var models []roomExportModel
+	err := r.db.WithContext(ctx).
+		Where("created_at < ?", cutoff.UTC()).
+		Order("created_at").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	exports := make([]*entity.RoomExport, 0, len(models))
+	for i := range models {
+		exports = append(exports, models[i].toEntity())
+	}
+	return exports, nil
+}
+
+func (r *roomExportRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&roomExportModel{}).Error
+}
@@ -0,0 +1,165 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+// roomInvitationModel is the GORM mapping of entity.RoomInvitation
+type roomInvitationModel struct {
+	ID          string    `gorm:"primaryKey;size:36"`
+	RoomID      string    `gorm:"size:36;not null;index:idx_room_invitations_room"`
+	InviterID   string    `gorm:"size:36;not null"`
+	InviteeID   string    `gorm:"size:36;not null;index:idx_room_invitations_invitee;index:idx_room_invitations_room"`
+	Status      string    `gorm:"size:10;not null;index:idx_room_invitations_invitee;index:idx_room_invitations_room"`
+	ExpiresAt   time.Time `gorm:"not null"`
+	RespondedAt *time.Time
+	CreatedAt   time.Time `gorm:"index:idx_room_invitations_invitee"`
+}
+
+func (roomInvitationModel) TableName() string {
+	return "room_invitations"
+}
+
+func newRoomInvitationModel(i *entity.RoomInvitation) *roomInvitationModel {
+	return &roomInvitationModel{
+		ID:          i.ID,
+		RoomID:      i.RoomID,
+		InviterID:   i.InviterID,
+		InviteeID:   i.InviteeID,
+		Status:      string(i.Status),
+		ExpiresAt:   i.ExpiresAt,
+		RespondedAt: i.RespondedAt,
+		CreatedAt:   i.CreatedAt,
+	}
+}
+
+func (m *roomInvitationModel) toEntity() *entity.RoomInvitation {
+	return &entity.RoomInvitation{
+		ID:          m.ID,
+		RoomID:      m.RoomID,
+		InviterID:   m.InviterID,
+		InviteeID:   m.InviteeID,
+		Status:      entity.InvitationStatus(m.Status),
+		ExpiresAt:   m.ExpiresAt,
+		RespondedAt: m.RespondedAt,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+type roomInvitationRepository struct {
+	db *database.DB
+}
+
+func NewRoomInvitationRepository(db *database.DB) repository.RoomInvitationRepository {
+	return &roomInvitationRepository{db: db}
+}
+
+func (r *roomInvitationRepository) Create(ctx context.Context, invitation *entity.RoomInvitation) error {
+	return r.db.WithContext(ctx).Create(newRoomInvitationModel(invitation)).Error
+}
+
+func (r *roomInvitationRepository) GetByID(ctx context.Context, id string) (*entity.RoomInvitation, error) {
+	var model roomInvitationModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrInvitationNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *roomInvitationRepository) HasPending(ctx context.Context, roomID, inviteeID string, now time.Time) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&roomInvitationModel{}).
+		Where("room_id = ? AND invitee_id = ?", roomID, inviteeID).
+		Scopes(invitationStatus(entity.InvitationPending, now)).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *roomInvitationRepository) ListByInvitee(ctx context.Context, inviteeID string, status entity.InvitationStatus, now time.Time, after *pagination.TimeKey, limit int) ([]*entity.RoomInvitation, error) {
+	var models []roomInvitationModel
+	err := r.db.WithContext(ctx).
+		Where("invitee_id = ?", inviteeID).
+		Scopes(invitationStatus(status, now), afterTimeKey("created_at", "id", after)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*entity.RoomInvitation, 0, len(models))
+	for i := range models {
+		invitations = append(invitations, models[i].toEntity())
+	}
+	return invitations, nil
+}
+
+func (r *roomInvitationRepository) CountPending(ctx context.Context, inviteeID string, now time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&roomInvitationModel{}).
+		Where("invitee_id = ?", inviteeID).
+		Scopes(invitationStatus(entity.InvitationPending, now)).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *roomInvitationRepository) Accept(ctx context.Context, id string, member *entity.RoomMember, now time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := answerInvitation(tx, id, entity.InvitationAccepted, now); err != nil {
+			return err
+		}
+		return addRoomMember(tx, member)
+	})
+}
+
+func (r *roomInvitationRepository) Reject(ctx context.Context, id string, now time.Time) error {
+	return answerInvitation(r.db.WithContext(ctx), id, entity.InvitationRejected, now)
+}
+
+// invitationStatus is a scope matching invitations in the status at now
+// Expired invitations are stored as pending, so the stored status alone is matched against the
+// invitee index and the expiry tells pending and expired apart
+func invitationStatus(status entity.InvitationStatus, now time.Time) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		switch status {
+		case entity.InvitationPending:
+			return db.Where("status = ? AND expires_at > ?", string(entity.InvitationPending), now.UTC())
+		case entity.InvitationExpired:
+			return db.Where("status = ? AND expires_at <= ?", string(entity.InvitationPending), now.UTC())
+		default:
+			return db.Where("status = ?", string(status))
+		}
+	}
+}
+
+// answerInvitation moves a pending, unexpired invitation to its final status
+// The status condition makes concurrent answers to the same invitation mutually exclusive
+func answerInvitation(db *gorm.DB, id string, status entity.InvitationStatus, now time.Time) error {
+	result := db.Model(&roomInvitationModel{}).
+		Where("id = ?", id).
+		Scopes(invitationStatus(entity.InvitationPending, now)).
+		Updates(map[string]interface{}{
+			"status":       string(status),
+			"responded_at": now.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrInvitationNotPending
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+func TestRoomInvitationRepositoryStatusFilters(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewRoomInvitationRepository(db)
+
+	room, err := entity.NewRoom("owner", "새벽기도", "", entity.RoomPrivate, entity.RoomCategoryChurch, nil)
+	if err != nil {
+		t.Fatalf("NewRoom: %v", err)
+	}
+	room.ID = "room-1"
+	owner := &entity.RoomMember{RoomID: room.ID, UserID: "owner", Role: entity.RoomRoleOwner, JoinedAt: room.CreatedAt}
+	if err := NewRoomRepository(db).Create(ctx, room, owner); err != nil {
+		t.Fatalf("Create room: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	invitations := []struct {
+		id, invitee string
+		age         time.Duration
+		expiresIn   time.Duration
+	}{
+		{id: "pending-old", invitee: "grace", age: 3 * time.Hour, expiresIn: time.Hour},
+		{id: "pending-new", invitee: "grace", age: time.Hour, expiresIn: time.Hour},
+		{id: "expired", invitee: "grace", age: 4 * time.Hour, expiresIn: -time.Minute},
+		{id: "accepted", invitee: "grace", age: 2 * time.Hour, expiresIn: time.Hour},
+		{id: "rejected", invitee: "grace", age: 5 * time.Hour, expiresIn: time.Hour},
+		{id: "someone-else", invitee: "john", age: time.Hour, expiresIn: time.Hour},
+	}
+	for _, i := range invitations {
+		err := repo.Create(ctx, &entity.RoomInvitation{
+			ID: i.id, RoomID: room.ID, InviterID: "owner", InviteeID: i.invitee,
+			Status: entity.InvitationPending, ExpiresAt: now.Add(i.expiresIn), CreatedAt: now.Add(-i.age),
+		})
+		if err != nil {
+			t.Fatalf("Create(%s): %v", i.id, err)
+		}
+	}
+
+	member := &entity.RoomMember{RoomID: room.ID, UserID: "grace", Role: entity.RoomRoleMember, InvitedBy: "owner", JoinedAt: now}
+	if err := repo.Accept(ctx, "accepted", member, now); err != nil {
+		t.Fatalf("Accept: %v", err)
+	}
+	if err := repo.Reject(ctx, "rejected", now); err != nil {
+		t.Fatalf("Reject: %v", err)
+	}
+	if _, err := NewRoomMemberRepository(db).Get(ctx, room.ID, "grace"); err != nil {
+		t.Errorf("accepting did not add the member: %v", err)
+	}
+	for _, id := range []string{"accepted", "expired"} {
+		if err := repo.Reject(ctx, id, now); !errors.Is(err, entity.ErrInvitationNotPending) {
+			t.Errorf("Reject(%s): err = %v, want ErrInvitationNotPending", id, err)
+		}
+	}
+
+	tests := []struct {
+		status entity.InvitationStatus
+		want   []string
+	}{
+		{status: entity.InvitationPending, want: []string{"pending-new", "pending-old"}},
+		{status: entity.InvitationAccepted, want: []string{"accepted"}},
+		{status: entity.InvitationRejected, want: []string{"rejected"}},
+		{status: entity.InvitationExpired, want: []string{"expired"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			list, err := repo.ListByInvitee(ctx, "grace", tt.status, now, nil, 10)
+			if err != nil {
+				t.Fatalf("ListByInvitee: %v", err)
+			}
+			ids := make([]string, 0, len(list))
+			for _, i := range list {
+				ids = append(ids, i.ID)
+				if got := i.StatusAt(now); got != tt.status {
+					t.Errorf("%s reads as %s, want %s", i.ID, got, tt.status)
+				}
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("invitations = %v, want %v newest first", ids, tt.want)
+			}
+		})
+	}
+
+	count, err := repo.CountPending(ctx, "grace", now)
+	if err != nil {
+		t.Fatalf("CountPending: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("CountPending = %d, want 2", count)
+	}
+	// Once the pending invitations expire they leave the count
+	if count, err := repo.CountPending(ctx, "grace", now.Add(time.Hour)); err != nil || count != 0 {
+		t.Errorf("CountPending an hour later = %d, %v, want 0", count, err)
+	}
+}
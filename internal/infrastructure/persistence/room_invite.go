@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// roomInviteModel is the GORM mapping of entity.RoomInvite
+type roomInviteModel struct {
+	ID        string `gorm:"primaryKey;size:36"`
+	RoomID    string `gorm:"size:36;not null;index"`
+	Code      string `gorm:"size:16;not null;uniqueIndex"`
+	CreatedBy string `gorm:"size:36;not null;index"`
+	MaxUses   int    `gorm:"not null"`
+	UseCount  int    `gorm:"not null"`
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (roomInviteModel) TableName() string {
+	return "room_invites"
+}
+
+func newRoomInviteModel(i *entity.RoomInvite) *roomInviteModel {
+	return &roomInviteModel{
+		ID:        i.ID,
+		RoomID:    i.RoomID,
+		Code:      i.Code,
+		CreatedBy: i.CreatedBy,
+		MaxUses:   i.MaxUses,
+		UseCount:  i.UseCount,
+		ExpiresAt: i.ExpiresAt,
+		CreatedAt: i.CreatedAt,
+	}
+}
+
+func (m *roomInviteModel) toEntity() *entity.RoomInvite {
+	return &entity.RoomInvite{
+		ID:        m.ID,
+		RoomID:    m.RoomID,
+		Code:      m.Code,
+		CreatedBy: m.CreatedBy,
+		MaxUses:   m.MaxUses,
+		UseCount:  m.UseCount,
+		ExpiresAt: m.ExpiresAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type roomInviteRepository struct {
+	db *database.DB
+}
+
+func NewRoomInviteRepository(db *database.DB) repository.RoomInviteRepository {
+	return &roomInviteRepository{db: db}
+}
+
+func (r *roomInviteRepository) Create(ctx context.Context, invite *entity.RoomInvite) error {
+	err := r.db.WithContext(ctx).Create(newRoomInviteModel(invite)).Error
+	if isUniqueViolation(err) {
+		return entity.ErrInviteCodeTaken
+	}
+	return err
+}
+
+func (r *roomInviteRepository) GetByCode(ctx context.Context, code string) (*entity.RoomInvite, error) {
+	var model roomInviteModel
+	if err := r.db.WithContext(ctx).Where("code = ?", code).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrInviteNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *roomInviteRepository) Redeem(ctx context.Context, inviteID string, member *entity.RoomMember, now time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Conditional increment: concurrent accepts cannot exceed max_uses
+		result := tx.Model(&roomInviteModel{}).
+			Where("id = ? AND expires_at > ? AND (max_uses = 0 OR use_count < max_uses)", inviteID, now.UTC()).
+			Update("use_count", gorm.Expr("use_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrInviteExpired
+		}
+
+		return addRoomMember(tx, member)
+	})
+}
@@ -0,0 +1,230 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// roomMemberModel is the GORM mapping of entity.RoomMember
+type roomMemberModel struct {
+	RoomID     string `gorm:"primaryKey;size:36"`
+	UserID     string `gorm:"primaryKey;size:36;index"`
+	Role       string `gorm:"size:20;not null;default:member"`
+	InvitedBy  string `gorm:"size:36"`
+	Muted      bool   `gorm:"not null;default:0"`
+	JoinedAt   time.Time
+	LastSeenAt *time.Time `gorm:"index:idx_room_members_room_seen"`
+}
+
+func (roomMemberModel) TableName() string {
+	return "room_members"
+}
+
+func newRoomMemberModel(m *entity.RoomMember) *roomMemberModel {
+	return &roomMemberModel{
+		RoomID:     m.RoomID,
+		UserID:     m.UserID,
+		Role:       string(m.Role),
+		InvitedBy:  m.InvitedBy,
+		Muted:      m.Muted,
+		JoinedAt:   m.JoinedAt,
+		LastSeenAt: m.LastSeenAt,
+	}
+}
+
+func (m *roomMemberModel) toEntity() *entity.RoomMember {
+	return &entity.RoomMember{
+		RoomID:     m.RoomID,
+		UserID:     m.UserID,
+		Role:       entity.RoomRole(m.Role),
+		InvitedBy:  m.InvitedBy,
+		Muted:      m.Muted,
+		JoinedAt:   m.JoinedAt,
+		LastSeenAt: m.LastSeenAt,
+	}
+}
+
+// addRoomMember inserts a membership within tx, respecting the room's member cap
+// The room row is locked first so concurrent joins cannot overshoot the cap
+// Returns entity.ErrAlreadyRoomMember or entity.ErrRoomFull without inserting
+func addRoomMember(tx *gorm.DB, member *entity.RoomMember) error {
+	var room roomModel
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", member.RoomID).
+		First(&room).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity.ErrRoomNotFound
+		}
+		return err
+	}
+
+	var exists int64
+	err = tx.Model(&roomMemberModel{}).
+		Where("room_id = ? AND user_id = ?", member.RoomID, member.UserID).
+		Count(&exists).Error
+	if err != nil {
+		return err
+	}
+	if exists > 0 {
+		return entity.ErrAlreadyRoomMember
+	}
+
+	if room.MemberCap > 0 {
+		var count int64
+		if err := tx.Model(&roomMemberModel{}).Where("room_id = ?", member.RoomID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= int64(room.MemberCap) {
+			return entity.ErrRoomFull
+		}
+	}
+
+	if err := tx.Create(newRoomMemberModel(member)).Error; err != nil {
+		if isUniqueViolation(err) {
+			return entity.ErrAlreadyRoomMember
+		}
+		return err
+	}
+	return nil
+}
+
+type roomMemberRepository struct {
+	db *database.DB
+}
+
+func NewRoomMemberRepository(db *database.DB) repository.RoomMemberRepository {
+	return &roomMemberRepository{db: db}
+}
+
+func (r *roomMemberRepository) Get(ctx context.Context, roomID, userID string) (*entity.RoomMember, error) {
+	var model roomMemberModel
+	err := r.db.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrNotRoomMember
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *roomMemberRepository) List(ctx context.Context, roomID string) ([]*entity.RoomMember, error) {
+	var models []roomMemberModel
+	err := r.db.WithContext(ctx).
+		Where("room_id = ?", roomID).
+		Order("joined_at, user_id").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*entity.RoomMember, 0, len(models))
+	for i := range models {
+		members = append(members, models[i].toEntity())
+	}
+	return members, nil
+}
+
+func (r *roomMemberRepository) ListActive(ctx context.Context, roomID string, since time.Time) ([]*entity.RoomMember, error) {
+	var models []roomMemberModel
+	err := r.db.WithContext(ctx).
+		Where("room_id = ? AND last_seen_at >= ?", roomID, since.UTC()).
+		Order("last_seen_at DESC, user_id").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*entity.RoomMember, 0, len(models))
+	for i := range models {
+		members = append(members, models[i].toEntity())
+	}
+	return members, nil
+}
+
+func (r *roomMemberRepository) TouchLastSeen(ctx context.Context, roomID, userID string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&roomMemberModel{}).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		Update("last_seen_at", at.UTC()).Error
+}
+
+func (r *roomMemberRepository) ListRoomIDs(ctx context.Context, userID string) ([]string, error) {
+	var roomIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&roomMemberModel{}).
+		Where("user_id = ?", userID).
+		Pluck("room_id", &roomIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return roomIDs, nil
+}
+
+func (r *roomMemberRepository) Add(ctx context.Context, member *entity.RoomMember) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return addRoomMember(tx, member)
+	})
+}
+
+func (r *roomMemberRepository) UpdateRole(ctx context.Context, roomID, userID string, role entity.RoomRole) error {
+	result := r.db.WithContext(ctx).
+		Model(&roomMemberModel{}).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		Update("role", string(role))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrNotRoomMember
+	}
+	return nil
+}
+
+func (r *roomMemberRepository) SetMuted(ctx context.Context, roomID, userID string, muted bool) error {
+	result := r.db.WithContext(ctx).
+		Model(&roomMemberModel{}).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		Update("muted", muted)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrNotRoomMember
+	}
+	return nil
+}
+
+func (r *roomMemberRepository) ListReminderRecipients(ctx context.Context, afterRoomID, afterUserID string, limit int) ([]repository.ReminderRecipient, error) {
+	query := r.db.WithContext(ctx).
+		Table("room_members m").
+		Select("m.room_id, r.name AS room_name, r.reminder_time, m.user_id, u.timezone").
+		Joins("JOIN rooms r ON r.id = m.room_id").
+		Joins("JOIN users u ON u.id = m.user_id").
+		Where("r.reminder_time IS NOT NULL AND r.archived_at IS NULL").
+		Where("m.muted = ? AND u.mute_reminders = ? AND u.purge_at IS NULL", false, false)
+	// Oracle stores '' as NULL, so the first page must not compare against the empty key
+	if afterRoomID != "" {
+		query = query.Where("(m.room_id > ? OR (m.room_id = ? AND m.user_id > ?))", afterRoomID, afterRoomID, afterUserID)
+	}
+
+	var recipients []repository.ReminderRecipient
+	err := query.
+		Order("m.room_id, m.user_id").
+		Limit(limit).
+		Scan(&recipients).Error
+	if err != nil {
+		return nil, err
+	}
+	return recipients, nil
+}
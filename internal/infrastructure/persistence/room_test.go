@@ -0,0 +1,183 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+func TestRoomRepositoryListTrending(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	rooms := NewRoomRepository(db)
+	members := NewRoomMemberRepository(db)
+	topics := NewPrayerTopicRepository(db)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	old := now.Add(-30 * 24 * time.Hour)
+	// create stores a room made at created, with its owner joining then
+	create := func(id string, visibility entity.RoomVisibility, created time.Time) {
+		t.Helper()
+		room, err := entity.NewRoom("owner-"+id, id, "", visibility, entity.RoomCategoryChurch, nil)
+		if err != nil {
+			t.Fatalf("NewRoom: %v", err)
+		}
+		room.ID = id
+		room.CreatedAt = created
+		owner := &entity.RoomMember{RoomID: id, UserID: "owner-" + id, Role: entity.RoomRoleOwner, JoinedAt: created}
+		if err := rooms.Create(ctx, room, owner); err != nil {
+			t.Fatalf("Create(%s): %v", id, err)
+		}
+	}
+	join := func(roomID, userID string, at time.Time) {
+		t.Helper()
+		if err := members.Add(ctx, &entity.RoomMember{RoomID: roomID, UserID: userID, Role: entity.RoomRoleMember, JoinedAt: at}); err != nil {
+			t.Fatalf("Add(%s, %s): %v", roomID, userID, err)
+		}
+	}
+	post := func(roomID, topicID string, at time.Time) {
+		t.Helper()
+		if err := topics.Create(ctx, &entity.PrayerTopic{ID: topicID, RoomID: roomID, AuthorID: "owner-" + roomID, Title: topicID, CreatedAt: at}); err != nil {
+			t.Fatalf("Create(%s): %v", topicID, err)
+		}
+	}
+
+	create("busy", entity.RoomPublic, old)
+	join("busy", "grace", now)
+	join("busy", "john", now)
+	post("busy", "b1", now)
+
+	create("quiet", entity.RoomPublic, old)
+	join("quiet", "maria", now)
+	post("quiet", "q1", old)
+
+	create("hidden", entity.RoomPrivate, now)
+	join("hidden", "grace", now)
+	post("hidden", "h1", now)
+
+	create("dormant", entity.RoomPublic, old)
+	post("dormant", "d1", old)
+
+	got, err := rooms.ListTrending(ctx, 7*24*time.Hour, 10)
+	if err != nil {
+		t.Fatalf("ListTrending: %v", err)
+	}
+	want := []repository.TrendingRoom{{RoomID: "busy", Score: 3}, {RoomID: "quiet", Score: 1}}
+	if !slices.Equal(got, want) {
+		t.Errorf("trending = %+v, want %+v without private or dormant rooms", got, want)
+	}
+}
+
+func TestRoomMemberRepositoryListActive(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	members := NewRoomMemberRepository(db)
+
+	room, err := entity.NewRoom("owner", "새벽기도", "", entity.RoomPrivate, entity.RoomCategoryChurch, nil)
+	if err != nil {
+		t.Fatalf("NewRoom: %v", err)
+	}
+	room.ID = "room-1"
+	owner := &entity.RoomMember{RoomID: room.ID, UserID: "owner", Role: entity.RoomRoleOwner, JoinedAt: room.CreatedAt}
+	if err := NewRoomRepository(db).Create(ctx, room, owner); err != nil {
+		t.Fatalf("Create room: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, userID := range []string{"grace", "john", "maria"} {
+		if err := members.Add(ctx, &entity.RoomMember{RoomID: room.ID, UserID: userID, Role: entity.RoomRoleMember, JoinedAt: now}); err != nil {
+			t.Fatalf("Add(%s): %v", userID, err)
+		}
+	}
+	for userID, seen := range map[string]time.Time{"grace": now.Add(-2 * time.Minute), "john": now, "maria": now.Add(-time.Hour)} {
+		if err := members.TouchLastSeen(ctx, room.ID, userID, seen); err != nil {
+			t.Fatalf("TouchLastSeen(%s): %v", userID, err)
+		}
+	}
+
+	active, err := members.ListActive(ctx, room.ID, now.Add(-10*time.Minute))
+	if err != nil {
+		t.Fatalf("ListActive: %v", err)
+	}
+	var got []string
+	for _, m := range active {
+		got = append(got, m.UserID)
+	}
+	if want := []string{"john", "grace"}; !slices.Equal(got, want) {
+		t.Errorf("active = %v, want %v, most recent first without maria or the never seen owner", got, want)
+	}
+}
+
+func TestRoomRepositoryDeleteOrphans(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	rooms := NewRoomRepository(db)
+	members := NewRoomMemberRepository(db)
+	topics := NewPrayerTopicRepository(db)
+	comments := NewPrayerCommentRepository(db)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	// seed stores a room with a member, a topic and a comment mentioning the member
+	seed := func(id string) {
+		t.Helper()
+		room, err := entity.NewRoom("owner-"+id, id, "", entity.RoomPrivate, entity.RoomCategoryChurch, nil)
+		if err != nil {
+			t.Fatalf("NewRoom: %v", err)
+		}
+		room.ID = id
+		owner := &entity.RoomMember{RoomID: id, UserID: "owner-" + id, Role: entity.RoomRoleOwner, JoinedAt: now}
+		if err := rooms.Create(ctx, room, owner); err != nil {
+			t.Fatalf("Create(%s): %v", id, err)
+		}
+		if err := members.Add(ctx, &entity.RoomMember{RoomID: id, UserID: "grace", Role: entity.RoomRoleMember, JoinedAt: now}); err != nil {
+			t.Fatalf("Add(%s): %v", id, err)
+		}
+		topic := &entity.PrayerTopic{ID: "topic-" + id, RoomID: id, AuthorID: "grace", Title: id, CreatedAt: now}
+		if err := topics.Create(ctx, topic); err != nil {
+			t.Fatalf("Create topic(%s): %v", id, err)
+		}
+		comment := &entity.PrayerComment{ID: "comment-" + id, TopicID: topic.ID, RoomID: id, AuthorID: "owner-" + id, Body: "@grace 아멘", MentionIDs: []string{"grace"}, CreatedAt: now, UpdatedAt: now}
+		if err := comments.Create(ctx, comment); err != nil {
+			t.Fatalf("Create comment(%s): %v", id, err)
+		}
+	}
+	seed("kept")
+	seed("purged")
+	// Remove only the room row, as a purge that bypassed Delete would
+	if err := db.Delete(&roomModel{}, "id = ?", "purged").Error; err != nil {
+		t.Fatalf("delete room row: %v", err)
+	}
+
+	removed, err := rooms.DeleteOrphans(ctx)
+	if err != nil {
+		t.Fatalf("DeleteOrphans: %v", err)
+	}
+	want := map[string]int64{"prayer_comment_mentions": 1, "prayer_comments": 1, "prayer_topics": 1, "room_members": 2}
+	for table, count := range want {
+		if removed[table] != count {
+			t.Errorf("removed[%s] = %d, want %d", table, removed[table], count)
+		}
+	}
+
+	if _, err := topics.GetByID(ctx, "topic-purged"); !errors.Is(err, entity.ErrPrayerTopicNotFound) {
+		t.Errorf("orphaned topic: err = %v, want ErrPrayerTopicNotFound", err)
+	}
+	if list, err := members.List(ctx, "kept"); err != nil || len(list) != 2 {
+		t.Errorf("kept members = %d, err = %v, want both left alone", len(list), err)
+	}
+	if _, err := topics.GetByID(ctx, "topic-kept"); err != nil {
+		t.Errorf("kept topic: %v", err)
+	}
+	if comment, err := comments.GetByID(ctx, "comment-kept"); err != nil || len(comment.MentionIDs) != 1 {
+		t.Errorf("kept comment = %+v, err = %v, want it with its mention", comment, err)
+	}
+
+	if removed, err := rooms.DeleteOrphans(ctx); err != nil || len(removed) != 0 {
+		t.Errorf("second run: removed = %v, err = %v, want nothing left to remove", removed, err)
+	}
+}
@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+)
+
+// socialAccountModel is the GORM mapping of entity.SocialAccount
+type socialAccountModel struct {
+	ID             string `gorm:"primaryKey;size:36"`
+	UserID         string `gorm:"size:36;not null;uniqueIndex:idx_social_user_provider"`
+	Provider       string `gorm:"size:20;not null;uniqueIndex:idx_social_provider_subject;uniqueIndex:idx_social_user_provider"`
+	ProviderUserID string `gorm:"size:255;not null;uniqueIndex:idx_social_provider_subject"`
+	Email          string `gorm:"size:255"`
+	CreatedAt      time.Time
+}
+
+func (socialAccountModel) TableName() string {
+	return "social_accounts"
+}
+
+func newSocialAccountModel(a *entity.SocialAccount) *socialAccountModel {
+	return &socialAccountModel{
+		ID:             a.ID,
+		UserID:         a.UserID,
+		Provider:       string(a.Provider),
+		ProviderUserID: a.ProviderUserID,
+		Email:          a.Email,
+		CreatedAt:      a.CreatedAt,
+	}
+}
+
+func (m *socialAccountModel) toEntity() *entity.SocialAccount {
+	return &entity.SocialAccount{
+		ID:             m.ID,
+		UserID:         m.UserID,
+		Provider:       entity.SocialProvider(m.Provider),
+		ProviderUserID: m.ProviderUserID,
+		Email:          m.Email,
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+type socialAccountRepository struct {
+	db *database.DB
+}
+
+func NewSocialAccountRepository(db *database.DB) repository.SocialAccountRepository {
+	return &socialAccountRepository{db: db}
+}
+
+func (r *socialAccountRepository) Create(ctx context.Context, account *entity.SocialAccount) error {
+	if err := r.db.WithContext(ctx).Create(newSocialAccountModel(account)).Error; err != nil {
+		if isUniqueViolation(err) {
+			return entity.ErrSocialAccountAlreadyLinked
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *socialAccountRepository) CreateWithUser(ctx context.Context, user *entity.User, account *entity.SocialAccount) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newUserModel(user)).Error; err != nil {
+			if isUniqueViolation(err) {
+				return userUniqueError(err)
+			}
+			return err
+		}
+		if err := tx.Create(newSocialAccountModel(account)).Error; err != nil {
+			if isUniqueViolation(err) {
+				return entity.ErrSocialAccountAlreadyLinked
+			}
+			return err
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *socialAccountRepository) GetByProviderUserID(ctx context.Context, provider entity.SocialProvider, providerUserID string) (*entity.SocialAccount, error) {
+	var model socialAccountModel
+	err := r.db.WithContext(ctx).
+		Where("provider = ? AND provider_user_id = ?", string(provider), providerUserID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrSocialAccountNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *socialAccountRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.SocialAccount, error) {
+	var models []socialAccountModel
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*entity.SocialAccount, 0, len(models))
+	for i := range models {
+		accounts = append(accounts, models[i].toEntity())
+	}
+	return accounts, nil
+}
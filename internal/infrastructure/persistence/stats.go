@@ -0,0 +1,111 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+)
+
+// roomVisibleTopics selects the topics that count toward a room's statistics
+// It takes the room ID and false as arguments
+const roomVisibleTopics = "SELECT id FROM prayer_topics WHERE room_id = ? AND deleted_at IS NULL AND private = ?"
+
+// topicCountsRow is a row of the topic aggregate queries
+type topicCountsRow struct {
+	Created   int
+	Answered  int
+	Reactions int
+}
+
+type statsRepository struct {
+	db *database.DB
+}
+
+func NewStatsRepository(db *database.DB) repository.StatsRepository {
+	return &statsRepository{db: db}
+}
+
+func (r *statsRepository) RoomStats(ctx context.Context, roomID string) (*repository.RoomStats, error) {
+	db := r.db.WithContext(ctx)
+
+	var counts topicCountsRow
+	err := db.Raw(
+		`SELECT COUNT(*) AS created, COUNT(answered_at) AS answered, COALESCE(SUM(reaction_count), 0) AS reactions
+		FROM prayer_topics WHERE room_id = ? AND deleted_at IS NULL AND private = ?`,
+		roomID, false,
+	).Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var activeDays int
+	err = db.Raw(
+		`SELECT COUNT(DISTINCT `+dayOf(r.db, "created_at")+`) FROM (
+			SELECT created_at FROM prayer_topics WHERE room_id = ? AND deleted_at IS NULL AND private = ?
+			UNION ALL SELECT created_at FROM prayer_contents WHERE topic_id IN (`+roomVisibleTopics+`)
+			UNION ALL SELECT created_at FROM prayer_comments WHERE topic_id IN (`+roomVisibleTopics+`)
+			UNION ALL SELECT created_at FROM prayer_reactions WHERE topic_id IN (`+roomVisibleTopics+`)
+		) activity`,
+		roomID, false, roomID, false, roomID, false, roomID, false,
+	).Scan(&activeDays).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.RoomStats{
+		PrayersCreated:  counts.Created,
+		PrayersAnswered: counts.Answered,
+		Reactions:       counts.Reactions,
+		ActiveDays:      activeDays,
+	}, nil
+}
+
+func (r *statsRepository) UserStats(ctx context.Context, userID string) (*repository.UserStats, error) {
+	db := r.db.WithContext(ctx)
+
+	var counts topicCountsRow
+	err := db.Raw(
+		`SELECT COUNT(*) AS created, COUNT(answered_at) AS answered, COALESCE(SUM(reaction_count), 0) AS reactions
+		FROM prayer_topics WHERE author_id = ? AND deleted_at IS NULL`,
+		userID,
+	).Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var given int
+	if err := db.Raw("SELECT COUNT(*) FROM prayer_reactions WHERE user_id = ?", userID).Scan(&given).Error; err != nil {
+		return nil, err
+	}
+
+	var activeDays int
+	err = db.Raw(
+		`SELECT COUNT(DISTINCT `+dayOf(r.db, "created_at")+`) FROM (
+			SELECT created_at FROM prayer_topics WHERE author_id = ?
+			UNION ALL SELECT created_at FROM prayer_contents WHERE author_id = ?
+			UNION ALL SELECT created_at FROM prayer_comments WHERE author_id = ?
+			UNION ALL SELECT created_at FROM prayer_reactions WHERE user_id = ?
+		) activity`,
+		userID, userID, userID, userID,
+	).Scan(&activeDays).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.UserStats{
+		PrayersCreated:    counts.Created,
+		PrayersAnswered:   counts.Answered,
+		ReactionsGiven:    given,
+		ReactionsReceived: counts.Reactions,
+		ActiveDays:        activeDays,
+	}, nil
+}
+
+// dayOf returns the SQL expression truncating the timestamp column to its (UTC) day
+func dayOf(db *database.DB, column string) string {
+	if db.Driver() == database.DriverOracle {
+		return "TRUNC(" + column + ")"
+	}
+	return "DATE(" + column + ")"
+}
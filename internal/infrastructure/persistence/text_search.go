@@ -0,0 +1,32 @@
+package persistence
+
+import (
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+)
+
+// containsQuery builds an Oracle Text query matching text that contains every term
+// Braces make each term literal, so operators and reserved words in user input have no effect
+func containsQuery(terms []string) string {
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, "{"+strings.ReplaceAll(term, "}", "}}")+"}")
+	}
+	return strings.Join(quoted, " AND ")
+}
+
+// textMatch returns the condition matching column values that contain every term, with its arguments
+// Oracle uses the text indexes created by the initial migration; elsewhere a case-insensitive LIKE per term stands in for it
+func textMatch(db *database.DB, column string, terms []string) (string, []interface{}) {
+	if db.Driver() == database.DriverOracle {
+		return "CONTAINS(" + column + ", ?) > 0", []interface{}{containsQuery(terms)}
+	}
+	conditions := make([]string, 0, len(terms))
+	args := make([]interface{}, 0, len(terms))
+	for _, term := range terms {
+		conditions = append(conditions, "LOWER("+column+") LIKE ? ESCAPE '!'")
+		args = append(args, likeContains(strings.ToLower(term)))
+	}
+	return strings.Join(conditions, " AND "), args
+}
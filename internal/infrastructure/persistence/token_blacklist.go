@@ -0,0 +1,54 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+)
+
+// revokedTokenModel is a blacklisted access token (by jti)
+type revokedTokenModel struct {
+	TokenID   string    `gorm:"primaryKey;size:36"`
+	UserID    string    `gorm:"size:36;not null;index"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	CreatedAt time.Time
+}
+
+func (revokedTokenModel) TableName() string {
+	return "revoked_tokens"
+}
+
+type tokenBlacklistRepository struct {
+	db *database.DB
+}
+
+func NewTokenBlacklistRepository(db *database.DB) repository.TokenBlacklistRepository {
+	return &tokenBlacklistRepository{db: db}
+}
+
+func (r *tokenBlacklistRepository) Revoke(ctx context.Context, tokenID, userID string, expiresAt time.Time) error {
+	err := r.db.WithContext(ctx).Create(&revokedTokenModel{
+		TokenID:   tokenID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	}).Error
+	if err != nil && isUniqueViolation(err) {
+		// Already revoked (e.g. logout retried)
+		return nil
+	}
+	return err
+}
+
+func (r *tokenBlacklistRepository) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&revokedTokenModel{}).
+		Where("token_id = ? AND expires_at > ?", tokenID, time.Now().UTC()).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,21 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+)
+
+type transactor struct {
+	db *database.DB
+}
+
+// NewTransactor returns the unit of work of the repositories in this package
+func NewTransactor(db *database.DB) repository.Transactor {
+	return &transactor{db: db}
+}
+
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return t.db.InTransaction(ctx, fn)
+}
@@ -0,0 +1,199 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// twoFactorModel is the GORM mapping of entity.TwoFactor
+type twoFactorModel struct {
+	UserID         string `gorm:"primaryKey;size:36"`
+	Secret         string `gorm:"size:64;not null"`
+	EnabledAt      *time.Time
+	LastUsedStep   int64 `gorm:"not null;default:0"`
+	FailedAttempts int   `gorm:"not null;default:0"`
+	LockedUntil    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (twoFactorModel) TableName() string {
+	return "user_two_factor"
+}
+
+func (m *twoFactorModel) toEntity() *entity.TwoFactor {
+	return &entity.TwoFactor{
+		UserID:         m.UserID,
+		Secret:         m.Secret,
+		EnabledAt:      m.EnabledAt,
+		LastUsedStep:   m.LastUsedStep,
+		FailedAttempts: m.FailedAttempts,
+		LockedUntil:    m.LockedUntil,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+// backupCodeModel is a hashed single-use recovery code
+type backupCodeModel struct {
+	ID        string `gorm:"primaryKey;size:36"`
+	UserID    string `gorm:"size:36;not null;index"`
+	CodeHash  string `gorm:"size:64;not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (backupCodeModel) TableName() string {
+	return "two_factor_backup_codes"
+}
+
+type twoFactorRepository struct {
+	db *database.DB
+}
+
+func NewTwoFactorRepository(db *database.DB) repository.TwoFactorRepository {
+	return &twoFactorRepository{db: db}
+}
+
+func (r *twoFactorRepository) Get(ctx context.Context, userID string) (*entity.TwoFactor, error) {
+	var model twoFactorModel
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrTwoFactorNotEnrolled
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *twoFactorRepository) SavePending(ctx context.Context, tf *entity.TwoFactor) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Never overwrite a confirmed enrollment
+		result := tx.Where("user_id = ? AND enabled_at IS NULL", tf.UserID).Delete(&twoFactorModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		err := tx.Create(&twoFactorModel{
+			UserID:    tf.UserID,
+			Secret:    tf.Secret,
+			CreatedAt: tf.CreatedAt,
+			UpdatedAt: tf.UpdatedAt,
+		}).Error
+		if isUniqueViolation(err) {
+			return entity.ErrTwoFactorAlreadyEnabled
+		}
+		return err
+	})
+}
+
+func (r *twoFactorRepository) Enable(ctx context.Context, userID string, step int64, backupCodeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		result := tx.Model(&twoFactorModel{}).
+			Where("user_id = ? AND enabled_at IS NULL", userID).
+			Updates(map[string]interface{}{
+				"enabled_at":      now,
+				"last_used_step":  step,
+				"failed_attempts": 0,
+				"locked_until":    nil,
+				"updated_at":      now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrTwoFactorAlreadyEnabled
+		}
+
+		if err := tx.Where("user_id = ?", userID).Delete(&backupCodeModel{}).Error; err != nil {
+			return err
+		}
+
+		codes := make([]backupCodeModel, 0, len(backupCodeHashes))
+		for _, hash := range backupCodeHashes {
+			codes = append(codes, backupCodeModel{
+				ID:        uuid.New().String(),
+				UserID:    userID,
+				CodeHash:  hash,
+				CreatedAt: now,
+			})
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+func (r *twoFactorRepository) Delete(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&backupCodeModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&twoFactorModel{}).Error
+	})
+}
+
+func (r *twoFactorRepository) AcceptStep(ctx context.Context, userID string, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&twoFactorModel{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Updates(map[string]interface{}{
+			"last_used_step":  step,
+			"failed_attempts": 0,
+			"updated_at":      time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *twoFactorRepository) UseBackupCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	var used bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		result := tx.Model(&backupCodeModel{}).
+			Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+			Update("used_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		used = true
+		return tx.Model(&twoFactorModel{}).
+			Where("user_id = ?", userID).
+			Updates(map[string]interface{}{
+				"failed_attempts": 0,
+				"updated_at":      now,
+			}).Error
+	})
+	return used, err
+}
+
+func (r *twoFactorRepository) RecordFailure(ctx context.Context, userID string, maxAttempts int, lockUntil time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&twoFactorModel{}).
+			Where("user_id = ?", userID).
+			Update("failed_attempts", gorm.Expr("failed_attempts + 1")).Error
+		if err != nil {
+			return err
+		}
+
+		// Lock and start counting again once the limit is reached
+		return tx.Model(&twoFactorModel{}).
+			Where("user_id = ? AND failed_attempts >= ?", userID, maxAttempts).
+			Updates(map[string]interface{}{
+				"failed_attempts": 0,
+				"locked_until":    lockUntil.UTC(),
+			}).Error
+	})
+}
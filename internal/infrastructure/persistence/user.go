@@ -0,0 +1,576 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userModel is the GORM mapping of entity.User
+type userModel struct {
+	ID               string `gorm:"primaryKey;size:36"`
+	Email            string `gorm:"size:255;uniqueIndex"` // NULL for social-only users without email
+	Nickname         string `gorm:"size:40;not null"`
+	NicknameKey      string `gorm:"size:80;uniqueIndex:idx_users_nickname_key"` // entity.NormalizeNickname(Nickname)
+	PasswordHash     string `gorm:"size:100"`                                   // NULL for social-only users
+	Role             string `gorm:"size:20;not null;default:user"`
+	Bio              string `gorm:"size:800"` // 200 characters in UTF-8
+	ProfileImageURL  string `gorm:"size:500"`
+	Timezone         string `gorm:"size:64;not null;default:Asia/Seoul"`
+	Locale           string `gorm:"size:35;not null;default:ko-KR"`
+	HiddenFromSearch bool   `gorm:"not null;default:0"`
+	// Notification settings are stored as opt-outs so existing and new rows default to everything on
+	MuteInvites       bool   `gorm:"not null;default:0"`
+	MuteAnswered      bool   `gorm:"not null;default:0"`
+	MuteComments      bool   `gorm:"not null;default:0"`
+	MuteReminders     bool   `gorm:"not null;default:0"`
+	MuteAnnouncements bool   `gorm:"not null;default:0"`
+	DigestFrequency   string `gorm:"size:10;not null;default:off"`
+	SnoozedUntil      *time.Time
+	EmailVerifiedAt   *time.Time
+	PurgeAt           *time.Time `gorm:"index"`
+	SuspendedAt       *time.Time
+	SuspensionReason  string `gorm:"size:2000"` // 500 characters in UTF-8
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (userModel) TableName() string {
+	return "users"
+}
+
+// userUniqueError maps a unique violation on users to the domain error for the offending column
+func userUniqueError(err error) error {
+	if isUniqueViolationOf(err, "idx_users_nickname_key") {
+		return entity.ErrNicknameTaken
+	}
+	return entity.ErrEmailAlreadyExists
+}
+
+func newUserModel(u *entity.User) *userModel {
+	return &userModel{
+		ID:                u.ID,
+		Email:             u.Email,
+		Nickname:          u.Nickname,
+		NicknameKey:       entity.NormalizeNickname(u.Nickname),
+		PasswordHash:      u.PasswordHash,
+		Role:              string(u.Role),
+		Bio:               u.Bio,
+		ProfileImageURL:   u.ProfileImageURL,
+		Timezone:          u.Timezone,
+		Locale:            u.Locale,
+		HiddenFromSearch:  u.HiddenFromSearch,
+		MuteInvites:       !u.NotificationSettings.Invites,
+		MuteAnswered:      !u.NotificationSettings.PrayerAnswered,
+		MuteComments:      !u.NotificationSettings.Comments,
+		MuteReminders:     !u.NotificationSettings.Reminders,
+		MuteAnnouncements: !u.NotificationSettings.Announcements,
+		DigestFrequency:   string(u.NotificationSettings.Digest),
+		SnoozedUntil:      u.NotificationSettings.SnoozedUntil,
+		EmailVerifiedAt:   u.EmailVerifiedAt,
+		PurgeAt:           u.PurgeAt,
+		SuspendedAt:       u.SuspendedAt,
+		SuspensionReason:  u.SuspensionReason,
+		CreatedAt:         u.CreatedAt,
+		UpdatedAt:         u.UpdatedAt,
+	}
+}
+
+func (m *userModel) toEntity() *entity.User {
+	return &entity.User{
+		ID:               m.ID,
+		Email:            m.Email,
+		Nickname:         m.Nickname,
+		PasswordHash:     m.PasswordHash,
+		Role:             entity.Role(m.Role),
+		Bio:              m.Bio,
+		ProfileImageURL:  m.ProfileImageURL,
+		Timezone:         m.Timezone,
+		Locale:           m.Locale,
+		HiddenFromSearch: m.HiddenFromSearch,
+		NotificationSettings: entity.NotificationSettings{
+			Invites:        !m.MuteInvites,
+			PrayerAnswered: !m.MuteAnswered,
+			Comments:       !m.MuteComments,
+			Reminders:      !m.MuteReminders,
+			Announcements:  !m.MuteAnnouncements,
+			Digest:         entity.DigestFrequency(m.DigestFrequency),
+			SnoozedUntil:   m.SnoozedUntil,
+		},
+		EmailVerifiedAt:  m.EmailVerifiedAt,
+		PurgeAt:          m.PurgeAt,
+		SuspendedAt:      m.SuspendedAt,
+		SuspensionReason: m.SuspensionReason,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
+
+type userRepository struct {
+	db *database.DB
+}
+
+func NewUserRepository(db *database.DB) repository.UserRepository {
+	return &userRepository{db: db}
+}
+
+func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
+	model := newUserModel(user)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return userUniqueError(err)
+		}
+		return err
+	}
+
+	user.CreatedAt = model.CreatedAt
+	user.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+func (r *userRepository) GetByID(ctx context.Context, id string) (*entity.User, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+	return r.findOne(ctx, "email = ?", email)
+}
+
+func (r *userRepository) GetByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	users := make([]*entity.User, 0, len(ids))
+	// Oracle allows at most 1000 expressions in an IN list
+	for start := 0; start < len(ids); start += maxInListSize {
+		end := min(start+maxInListSize, len(ids))
+
+		var models []userModel
+		if err := r.db.WithContext(ctx).Where("id IN ?", ids[start:end]).Find(&models).Error; err != nil {
+			return nil, err
+		}
+		for i := range models {
+			users = append(users, models[i].toEntity())
+		}
+	}
+	return users, nil
+}
+
+func (r *userRepository) GetByNicknames(ctx context.Context, nicknames []string) ([]*entity.User, error) {
+	if len(nicknames) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(nicknames))
+	for _, nickname := range nicknames {
+		keys = append(keys, entity.NormalizeNickname(nickname))
+	}
+
+	var models []userModel
+	if err := r.db.WithContext(ctx).Where("nickname_key IN ?", keys).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	users := make([]*entity.User, 0, len(models))
+	for i := range models {
+		users = append(users, models[i].toEntity())
+	}
+	return users, nil
+}
+
+func (r *userRepository) UpdatePassword(ctx context.Context, id, passwordHash string) error {
+	result := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"password_hash": passwordHash,
+			"updated_at":    time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrUserNotFound
+	}
+	return nil
+}
+
+// MarkEmailVerified sets the verification time, keeping the first one if already verified
+func (r *userRepository) MarkEmailVerified(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	result := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"email_verified_at": gorm.Expr("COALESCE(email_verified_at, ?)", now),
+			"updated_at":        now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) IsNicknameTaken(ctx context.Context, nickname string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("nickname_key = ?", entity.NormalizeNickname(nickname)).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *userRepository) UpdateProfile(ctx context.Context, user *entity.User) error {
+	result := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("id = ?", user.ID).
+		Updates(map[string]interface{}{
+			"nickname":           user.Nickname,
+			"nickname_key":       entity.NormalizeNickname(user.Nickname),
+			"bio":                user.Bio,
+			"profile_image_url":  user.ProfileImageURL,
+			"timezone":           user.Timezone,
+			"locale":             user.Locale,
+			"hidden_from_search": user.HiddenFromSearch,
+			"updated_at":         user.UpdatedAt.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) UpdateNotificationSettings(ctx context.Context, user *entity.User) error {
+	s := user.NotificationSettings
+	var snoozedUntil any
+	if s.SnoozedUntil != nil {
+		snoozedUntil = s.SnoozedUntil.UTC()
+	}
+	result := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("id = ?", user.ID).
+		Updates(map[string]interface{}{
+			"mute_invites":       !s.Invites,
+			"mute_answered":      !s.PrayerAnswered,
+			"mute_comments":      !s.Comments,
+			"mute_reminders":     !s.Reminders,
+			"mute_announcements": !s.Announcements,
+			"digest_frequency":   string(s.Digest),
+			"snoozed_until":      snoozedUntil,
+			"updated_at":         user.UpdatedAt.UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) Search(ctx context.Context, query repository.UserSearch) ([]*entity.User, error) {
+	match := r.db.WithContext(ctx).
+		Where(`nickname_key LIKE ? ESCAPE '!'`, likePrefix(entity.NormalizeNickname(query.NicknamePrefix)))
+	if query.EmailPrefix != "" {
+		match = match.Or(`email LIKE ? ESCAPE '!'`, likePrefix(entity.NormalizeEmail(query.EmailPrefix)))
+	}
+
+	db := r.db.WithContext(ctx).
+		Where(match).
+		Where("role <> ? AND purge_at IS NULL AND suspended_at IS NULL AND hidden_from_search = ?", string(entity.RoleGuest), false)
+	// Oracle treats '' as NULL, so "id <> ''" would match nothing
+	if query.ViewerID != "" {
+		db = db.Where("id <> ?", query.ViewerID).Scopes(notBlockedBy(query.ViewerID, "id"))
+	}
+
+	var models []userModel
+	err := db.Order("nickname_key, id").
+		Offset(query.Offset).
+		Limit(query.Limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*entity.User, 0, len(models))
+	for i := range models {
+		users = append(users, models[i].toEntity())
+	}
+	return users, nil
+}
+
+func (r *userRepository) List(ctx context.Context, filter repository.UserFilter) ([]*entity.User, error) {
+	db := r.db.WithContext(ctx)
+	if filter.Query != "" {
+		db = db.Where(
+			r.db.WithContext(ctx).
+				Where("id = ?", filter.Query).
+				Or(`nickname_key LIKE ? ESCAPE '!'`, likePrefix(entity.NormalizeNickname(filter.Query))).
+				Or(`email LIKE ? ESCAPE '!'`, likePrefix(entity.NormalizeEmail(filter.Query))),
+		)
+	}
+	if filter.Role != "" {
+		db = db.Where("role = ?", string(filter.Role))
+	}
+	if filter.Suspended != nil {
+		if *filter.Suspended {
+			db = db.Where("suspended_at IS NOT NULL")
+		} else {
+			db = db.Where("suspended_at IS NULL")
+		}
+	}
+
+	var models []userModel
+	err := db.Order("created_at DESC, id").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*entity.User, 0, len(models))
+	for i := range models {
+		users = append(users, models[i].toEntity())
+	}
+	return users, nil
+}
+
+func (r *userRepository) Suspend(ctx context.Context, id, reason string, at time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"suspended_at":      gorm.Expr("COALESCE(suspended_at, ?)", at.UTC()),
+			"suspension_reason": reason,
+			"updated_at":        time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) Reinstate(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"suspended_at":      nil,
+			"suspension_reason": "",
+			"updated_at":        time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) UpgradeGuest(ctx context.Context, user *entity.User) error {
+	now := time.Now().UTC()
+	result := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("id = ? AND role = ?", user.ID, string(entity.RoleGuest)).
+		Updates(map[string]interface{}{
+			"email":         user.Email,
+			"nickname":      user.Nickname,
+			"nickname_key":  entity.NormalizeNickname(user.Nickname),
+			"password_hash": user.PasswordHash,
+			"role":          string(entity.RoleUser),
+			"updated_at":    now,
+		})
+	if result.Error != nil {
+		if isUniqueViolation(result.Error) {
+			return userUniqueError(result.Error)
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrNotGuest
+	}
+
+	user.Role = entity.RoleUser
+	user.UpdatedAt = now
+	return nil
+}
+
+func (r *userRepository) ScheduleDeletion(ctx context.Context, id string, purgeAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"purge_at":   gorm.Expr("COALESCE(purge_at, ?)", purgeAt.UTC()),
+			"updated_at": time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) CancelDeletion(ctx context.Context, id string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("id = ? AND purge_at IS NOT NULL", id).
+		Updates(map[string]interface{}{
+			"purge_at":   nil,
+			"updated_at": time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *userRepository) ListDueForPurge(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Model(&userModel{}).
+		Where("purge_at <= ?", now.UTC()).
+		Order("purge_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// Purge removes the user's dependent rows first and the user last, in one transaction
+func (r *userRepository) Purge(ctx context.Context, id string, now time.Time) (bool, error) {
+	purged := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the user row so a concurrent cancellation either wins or waits
+		var model userModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND purge_at <= ?", id, now.UTC()).
+			First(&model).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, dependent := range []interface{}{
+			&refreshTokenModel{},
+			&socialAccountModel{},
+			&passwordResetModel{},
+			&emailVerificationModel{},
+			&backupCodeModel{},
+			&twoFactorModel{},
+			&roomMemberModel{},
+			&joinRequestModel{},
+			&commentMentionModel{},
+			&deviceModel{},
+			&notificationModel{},
+			&idempotencyModel{},
+			&apiKeyModel{},
+		} {
+			if err := tx.Where("user_id = ?", id).Delete(dependent).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("blocker_id = ? OR blocked_id = ?", id, id).Delete(&blockModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("created_by = ?", id).Delete(&roomInviteModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("inviter_id = ? OR invitee_id = ?", id, id).Delete(&roomInvitationModel{}).Error; err != nil {
+			return err
+		}
+		// Entries, comments and reactions under the user's topics go with them, whoever wrote them
+		if err := deleteComments(tx, "topic_id IN (SELECT id FROM prayer_topics WHERE author_id = ?)", id); err != nil {
+			return err
+		}
+		for _, dependent := range []interface{}{
+			&prayerContentModel{},
+			&prayerRevisionModel{},
+			&prayerReactionModel{},
+			&prayerTagModel{},
+		} {
+			if err := tx.Where("topic_id IN (SELECT id FROM prayer_topics WHERE author_id = ?)", id).Delete(dependent).Error; err != nil {
+				return err
+			}
+		}
+		if err := deleteCommentsBy(tx, id); err != nil {
+			return err
+		}
+		if err := deleteReactionsBy(tx, id); err != nil {
+			return err
+		}
+		// Earlier versions of the user's entries go with them, wherever they were written
+		if err := tx.Where("content_id IN (SELECT id FROM prayer_contents WHERE author_id = ?)", id).Delete(&prayerRevisionModel{}).Error; err != nil {
+			return err
+		}
+		for _, authored := range []interface{}{
+			&announcementModel{},
+			&prayerContentModel{},
+			&prayerTopicModel{},
+		} {
+			if err := tx.Where("author_id = ?", id).Delete(authored).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&roomMemberModel{}).Where("invited_by = ?", id).Update("invited_by", nil).Error; err != nil {
+			return err
+		}
+
+		// Rooms cannot outlive their owner; ownership transfer is not supported
+		if err := deleteComments(tx, "room_id IN (SELECT id FROM rooms WHERE owner_id = ?)", id); err != nil {
+			return err
+		}
+		for _, dependent := range []interface{}{
+			&roomInviteModel{},
+			&roomInvitationModel{},
+			&joinRequestModel{},
+			&announcementModel{},
+			&prayerContentModel{},
+			&prayerRevisionModel{},
+			&prayerReactionModel{},
+			&prayerTagModel{},
+			&prayerTopicModel{},
+			&roomTagModel{},
+			&roomMemberModel{},
+		} {
+			if err := tx.Where("room_id IN (SELECT id FROM rooms WHERE owner_id = ?)", id).Delete(dependent).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("owner_id = ?", id).Delete(&roomModel{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&userModel{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+		purged = true
+		return nil
+	})
+	return purged, err
+}
+
+func (r *userRepository) findOne(ctx context.Context, query string, args ...interface{}) (*entity.User, error) {
+	var model userModel
+	if err := r.db.WithContext(ctx).Where(query, args...).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
@@ -0,0 +1,357 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	httpTimeout    = 10 * time.Second
+	messagingScope = "https://www.googleapis.com/auth/firebase.messaging"
+	// sendConcurrency bounds the requests in flight for one notification; FCM v1 takes one token per request
+	sendConcurrency = 8
+	// tokenRefreshMargin renews the access token before it expires mid-send
+	tokenRefreshMargin = 5 * time.Minute
+	// topicBatchSize is the most tokens the Instance ID API takes in one batch request
+	topicBatchSize = 1000
+)
+
+// New returns an FCM pusher, or a log-only pusher when no FCM credentials are configured
+func New(cfg *config.Config) service.Pusher {
+	if cfg.Push.PrivateKey == nil {
+		slog.Warn("FCM credentials not configured, push notifications will only be logged")
+		return &logPusher{}
+	}
+	return newFCMPusher(cfg)
+}
+
+// NewTopicSubscriber returns an FCM topic subscriber, or a log-only one when no FCM credentials are configured
+func NewTopicSubscriber(cfg *config.Config) service.TopicSubscriber {
+	if cfg.Push.PrivateKey == nil {
+		return &logPusher{}
+	}
+	return newFCMPusher(cfg)
+}
+
+func newFCMPusher(cfg *config.Config) *fcmPusher {
+	return &fcmPusher{
+		cfg:     cfg.Push,
+		client:  &http.Client{Timeout: httpTimeout, Transport: otelhttp.NewTransport(nil)},
+		sendURL: fmt.Sprintf("https://fcm.googleapis.com/v1/projects/%s/messages:send", cfg.Push.ProjectID),
+		iidURL:  "https://iid.googleapis.com/iid/v1",
+	}
+}
+
+// fcmPusher sends through the FCM HTTP v1 API, authenticated as a service account
+// Topic subscriptions go through the Instance ID API, which takes the same access token
+type fcmPusher struct {
+	cfg     config.PushConfig
+	client  *http.Client
+	sendURL string
+	iidURL  string
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// HealthCheck obtains an access token for the FCM API, which proves the service account works
+// The token is cached, so Google is only asked again when it is due for renewal
+func (p *fcmPusher) HealthCheck(ctx context.Context) error {
+	_, err := p.token(ctx)
+	return err
+}
+
+func (p *fcmPusher) Push(ctx context.Context, tokens []string, n entity.Notification) service.PushResult {
+	var result service.PushResult
+	if len(tokens) == 0 {
+		return result
+	}
+	accessToken, err := p.token(ctx)
+	if err != nil {
+		// Without an access token nothing was sent; the next attempt may get one
+		for _, token := range tokens {
+			result.Failed = append(result.Failed, service.PushFailure{Token: token, Err: err, Temporary: true})
+		}
+		stats.Add("failed", int64(len(tokens)))
+		return result
+	}
+	msg := newFCMMessage(n)
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	sem := make(chan struct{}, sendConcurrency)
+	for _, token := range tokens {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			err := p.send(ctx, accessToken, token, msg)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				stats.Add("sent", 1)
+			case errors.Is(err, errUnregistered):
+				stats.Add("unregistered", 1)
+				result.Invalid = append(result.Invalid, token)
+			default:
+				stats.Add("failed", 1)
+				result.Failed = append(result.Failed, service.PushFailure{
+					Token:     token,
+					Err:       err,
+					Temporary: errors.Is(err, errTemporary),
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	return result
+}
+
+var (
+	// errUnregistered is returned for tokens FCM will never deliver to again
+	errUnregistered = errors.New("device token is no longer registered")
+	// errTemporary marks failures that may succeed on retry
+	errTemporary = errors.New("temporary push failure")
+)
+
+// fcmMessage is the request body of messages:send
+type fcmMessage struct {
+	Message struct {
+		Token        string            `json:"token"`
+		Notification fcmNotification   `json:"notification"`
+		Data         map[string]string `json:"data,omitempty"`
+	} `json:"message"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+func newFCMMessage(n entity.Notification) fcmMessage {
+	var m fcmMessage
+	m.Message.Notification = fcmNotification{Title: n.Title, Body: n.Body}
+	// The type lets the app route a tap without parsing the text
+	m.Message.Data = map[string]string{"type": string(n.Type)}
+	for k, v := range n.Data {
+		m.Message.Data[k] = v
+	}
+	return m
+}
+
+// send delivers the message to one device
+func (p *fcmPusher) send(ctx context.Context, accessToken, token string, msg fcmMessage) error {
+	msg.Message.Token = token
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.sendURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send push notification: %w: %w", errTemporary, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	var fcmErr struct {
+		Error struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+			Details []struct {
+				ErrorCode string `json:"errorCode"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&fcmErr)
+	for _, d := range fcmErr.Error.Details {
+		// SENDER_ID_MISMATCH means the token belongs to another Firebase project, so it is as useless
+		if d.ErrorCode == "UNREGISTERED" || d.ErrorCode == "SENDER_ID_MISMATCH" {
+			return errUnregistered
+		}
+	}
+	err = fmt.Errorf("FCM returned %d %s: %s", resp.StatusCode, fcmErr.Error.Status, fcmErr.Error.Message)
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		// The access token was revoked or expired early; fetch a new one for the retry
+		p.mu.Lock()
+		p.accessToken = ""
+		p.mu.Unlock()
+		return fmt.Errorf("%w: %w", errTemporary, err)
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("%w: %w", errTemporary, err)
+	default:
+		return err
+	}
+}
+
+func (p *fcmPusher) Subscribe(ctx context.Context, topic string, tokens []string) error {
+	return p.updateTopic(ctx, "batchAdd", topic, tokens)
+}
+
+func (p *fcmPusher) Unsubscribe(ctx context.Context, topic string, tokens []string) error {
+	return p.updateTopic(ctx, "batchRemove", topic, tokens)
+}
+
+// updateTopic runs the Instance ID batch operation for the tokens, topicBatchSize at a time
+// Tokens the API rejects one by one, such as unregistered ones, are logged rather than failing the rest
+func (p *fcmPusher) updateTopic(ctx context.Context, op, topic string, tokens []string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	accessToken, err := p.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	for batch := range slices.Chunk(tokens, topicBatchSize) {
+		payload, err := json.Marshal(map[string]any{
+			"to":                  "/topics/" + topic,
+			"registration_tokens": batch,
+		})
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.iidURL+":"+op, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("access_token_auth", "true")
+
+		resp, err := p.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to update topic subscriptions: %w", err)
+		}
+		var result struct {
+			Results []struct {
+				Error string `json:"error"`
+			} `json:"results"`
+		}
+		err = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&result)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to update topic subscriptions: status %d", resp.StatusCode)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to decode topic subscription results: %w", err)
+		}
+
+		rejected := 0
+		for _, r := range result.Results {
+			if r.Error != "" {
+				rejected++
+			}
+		}
+		if rejected > 0 {
+			slog.WarnContext(ctx, "FCM rejected topic subscription changes", "operation", op, "topic", topic, "count", rejected)
+		}
+	}
+	return nil
+}
+
+// token returns a cached OAuth access token, exchanging a signed service account assertion when it runs out
+func (p *fcmPusher) token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.accessToken != "" && time.Until(p.expiresAt) > tokenRefreshMargin {
+		return p.accessToken, nil
+	}
+
+	now := time.Now()
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   p.cfg.ClientEmail,
+		"scope": messagingScope,
+		"aud":   p.cfg.TokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(p.cfg.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign FCM assertion: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get FCM access token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get FCM access token: status %d", resp.StatusCode)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode FCM access token: %w", err)
+	}
+	p.accessToken = token.AccessToken
+	p.expiresAt = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+	return p.accessToken, nil
+}
+
+// logPusher logs push notifications instead of sending them (local development)
+type logPusher struct{}
+
+func (p *logPusher) Push(ctx context.Context, tokens []string, n entity.Notification) service.PushResult {
+	slog.InfoContext(ctx, "Push notification (not sent, FCM disabled)",
+		"devices", len(tokens),
+		"type", n.Type,
+		"title", n.Title,
+	)
+	return service.PushResult{}
+}
+
+func (p *logPusher) Subscribe(ctx context.Context, topic string, tokens []string) error {
+	slog.InfoContext(ctx, "Topic subscription (not sent, FCM disabled)", "topic", topic, "devices", len(tokens))
+	return nil
+}
+
+func (p *logPusher) Unsubscribe(ctx context.Context, topic string, tokens []string) error {
+	slog.InfoContext(ctx, "Topic unsubscription (not sent, FCM disabled)", "topic", topic, "devices", len(tokens))
+	return nil
+}
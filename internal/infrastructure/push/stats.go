@@ -0,0 +1,27 @@
+package push
+
+import "expvar"
+
+// stats counts FCM send outcomes per device since startup, published as "push" on the expvar endpoint
+// Retries are counted as sends of their own, so the success rate covers every attempt
+// Unregistered tokens are left out of the rate: they are pruned, not failed deliveries
+var stats = expvar.NewMap("push")
+
+func init() {
+	stats.Set("success_rate", expvar.Func(func() any {
+		sent := counter("sent")
+		total := sent + counter("failed")
+		if total == 0 {
+			return 1.0
+		}
+		return float64(sent) / float64(total)
+	}))
+}
+
+// counter reads one of the stats counters, zero before its first increment
+func counter(name string) int64 {
+	if v, ok := stats.Get(name).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+// New returns a storage that keeps files on the local disk under cfg.Storage.Dir
+func New(cfg *config.Config) service.Storage {
+	return &diskStorage{
+		dir:        cfg.Storage.Dir,
+		publicURL:  cfg.Storage.PublicURL,
+		signingKey: []byte(cfg.Storage.SigningKey),
+	}
+}
+
+type diskStorage struct {
+	dir        string
+	publicURL  string
+	signingKey []byte
+}
+
+func (s *diskStorage) Put(ctx context.Context, key, contentType string, data []byte) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	target, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to store file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to store file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to store file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", fmt.Errorf("failed to store file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return "", fmt.Errorf("failed to store file: %w", err)
+	}
+
+	return s.publicURL + "/" + key, nil
+}
+
+func (s *diskStorage) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+func (s *diskStorage) SignedURL(key string, expiresAt time.Time) string {
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	query := url.Values{
+		"expires":   {expires},
+		"signature": {sign(s.signingKey, key, expires)},
+	}
+	return s.publicURL + "/" + key + "?" + query.Encode()
+}
+
+// NewFileServer serves the files under cfg.Storage.Dir, mounted at the path of cfg.Storage.PublicURL
+// Private files are only served with an unexpired signature from SignedURL, and never without a
+// signing key, as anyone could compute a signature with an empty one
+func NewFileServer(cfg *config.Config) http.Handler {
+	signingKey := []byte(cfg.Storage.SigningKey)
+	files := http.FileServer(filesOnly{http.Dir(cfg.Storage.Dir)})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+		if strings.HasPrefix(key, service.PrivateKeyPrefix) {
+			if !cfg.Storage.PrivateFilesEnabled() {
+				http.NotFound(w, r)
+				return
+			}
+			expires := r.URL.Query().Get("expires")
+			unix, err := strconv.ParseInt(expires, 10, 64)
+			valid := hmac.Equal([]byte(r.URL.Query().Get("signature")), []byte(sign(signingKey, key, expires)))
+			if err != nil || !valid || time.Now().Unix() > unix {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Cache-Control", "private, no-store")
+		}
+		files.ServeHTTP(w, r)
+	})
+}
+
+// filesOnly hides directories, so their contents are never listed
+type filesOnly struct {
+	fs http.FileSystem
+}
+
+func (f filesOnly) Open(name string) (http.File, error) {
+	file, err := f.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		file.Close()
+		return nil, fs.ErrNotExist
+	}
+	return file, nil
+}
+
+// sign authenticates a key together with its expiry time
+func sign(signingKey []byte, key, expires string) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(key + "\n" + expires))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// path maps a key to a file inside the storage directory
+// Keys are generated by the server, but are still checked so none can escape the directory
+func (s *diskStorage) path(key string) (string, error) {
+	if key == "" || path.IsAbs(key) || path.Clean(key) != key || strings.HasPrefix(key, "../") || key == ".." {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+)
+
+const testSigningKey = "abcdefghijabcdefghijabcdefghij12"
+
+// get requests the URL's path and query from the file server mounted like the router does
+func get(t *testing.T, cfg *config.Config, rawURL string) *httptest.ResponseRecorder {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", rawURL, err)
+	}
+	rec := httptest.NewRecorder()
+	http.StripPrefix("/uploads", NewFileServer(cfg)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	return rec
+}
+
+func TestSignedURL(t *testing.T) {
+	cfg := &config.Config{Storage: config.StorageConfig{
+		Dir:        t.TempDir(),
+		PublicURL:  "http://localhost:8080/uploads",
+		SigningKey: testSigningKey,
+	}}
+	store := New(cfg)
+	key := "private/exports/e1.csv"
+	publicURL, err := store.Put(context.Background(), key, "text/csv", []byte("id\n1\n"))
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	signed := store.SignedURL(key, time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name       string
+		url        string
+		cfg        *config.Config
+		wantStatus int
+	}{
+		{name: "signed", url: signed, cfg: cfg, wantStatus: http.StatusOK},
+		{name: "unsigned", url: publicURL, cfg: cfg, wantStatus: http.StatusNotFound},
+		{name: "tampered", url: strings.Replace(signed, "e1.csv", "e2.csv", 1), cfg: cfg, wantStatus: http.StatusNotFound},
+		{name: "expired", url: store.SignedURL(key, time.Now().Add(-time.Second)), cfg: cfg, wantStatus: http.StatusNotFound},
+		{
+			name: "no signing key",
+			url:  New(&config.Config{Storage: config.StorageConfig{PublicURL: cfg.Storage.PublicURL}}).SignedURL(key, time.Now().Add(time.Hour)),
+			cfg: &config.Config{Storage: config.StorageConfig{
+				Dir:       cfg.Storage.Dir,
+				PublicURL: cfg.Storage.PublicURL,
+			}},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := get(t, tt.cfg, tt.url)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && rec.Body.String() != "id\n1\n" {
+				t.Errorf("body = %q, want the stored file", rec.Body.String())
+			}
+		})
+	}
+}
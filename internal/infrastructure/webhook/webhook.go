@@ -0,0 +1,110 @@
+// Package webhook posts notification events to the notification webhook
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	httpTimeout = 10 * time.Second
+
+	// EventIDHeader repeats the event ID of the body, so receivers can drop redeliveries before parsing it
+	EventIDHeader = "X-PrayTogether-Event-Id"
+	// TimestampHeader is when the request was signed, in Unix seconds
+	TimestampHeader = "X-PrayTogether-Timestamp"
+	// SignatureHeader is "sha256=" and the hex HMAC-SHA256 of the timestamp, a dot and the body,
+	// keyed with the webhook secret; receivers should also reject old timestamps to stop replays
+	SignatureHeader = "X-PrayTogether-Signature"
+)
+
+// New returns a publisher to the configured webhook, or nil when none is configured
+func New(cfg *config.Config) service.WebhookPublisher {
+	if cfg.Push.WebhookURL == "" {
+		return nil
+	}
+	return &httpPublisher{
+		url:    cfg.Push.WebhookURL,
+		secret: []byte(cfg.Push.WebhookSecret),
+		client: &http.Client{Timeout: httpTimeout, Transport: otelhttp.NewTransport(nil)},
+	}
+}
+
+type httpPublisher struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// payload is the request body; it carries the untranslated notification, as the receiver has no locale
+type payload struct {
+	ID        string            `json:"id"`
+	Type      string            `json:"type"`
+	Template  string            `json:"template,omitempty"`
+	Params    map[string]string `json:"params,omitempty"`
+	Data      map[string]string `json:"data,omitempty"`
+	RoomID    string            `json:"roomId"`
+	ActorID   string            `json:"actorId,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+func (p *httpPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	body, err := json.Marshal(payload{
+		ID:        event.ID,
+		Type:      string(event.Notification.Type),
+		Template:  event.Notification.Template,
+		Params:    event.Notification.Params,
+		Data:      event.Notification.Data,
+		RoomID:    event.RoomID,
+		ActorID:   event.ActorID,
+		CreatedAt: event.CreatedAt.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, event.ID)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(p.secret, timestamp, body))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign computes the hex signature of a webhook request, as receivers should to verify it
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
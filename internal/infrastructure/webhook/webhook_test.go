@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+const testSecret = "abcdefghijabcdefghijabcdefghij12"
+
+func TestPublish(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	status := http.StatusNoContent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	publisher := New(&config.Config{Push: config.PushConfig{WebhookURL: srv.URL, WebhookSecret: testSecret}})
+	event := &entity.OutboxEvent{
+		ID:           "event-1",
+		Destination:  entity.OutboxToWebhook,
+		Notification: entity.Notification{Type: entity.NotificationPrayerAnswered, Data: map[string]string{"topic_id": "topic-1"}},
+		RoomID:       "room-1",
+		CreatedAt:    time.Now(),
+	}
+	if err := publisher.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	if got.Header.Get(EventIDHeader) != "event-1" {
+		t.Errorf("%s = %q, want event-1", EventIDHeader, got.Header.Get(EventIDHeader))
+	}
+	want := "sha256=" + Sign([]byte(testSecret), got.Header.Get(TimestampHeader), body)
+	if got.Header.Get(SignatureHeader) != want {
+		t.Errorf("%s = %q, want %q", SignatureHeader, got.Header.Get(SignatureHeader), want)
+	}
+	var decoded payload
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if decoded.ID != "event-1" || decoded.Type != string(entity.NotificationPrayerAnswered) || decoded.RoomID != "room-1" || decoded.Data["topic_id"] != "topic-1" {
+		t.Errorf("body = %s, want the event", body)
+	}
+
+	status = http.StatusBadGateway
+	if err := publisher.Publish(context.Background(), event); err == nil {
+		t.Error("Publish succeeded on a 502, want an error so the event is retried")
+	}
+}
+
+func TestNewWithoutURL(t *testing.T) {
+	if publisher := New(&config.Config{}); publisher != nil {
+		t.Errorf("New = %T, want nil without a webhook URL", publisher)
+	}
+}
@@ -1,33 +1,507 @@
 package router
 
 import (
-	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"expvar"
 	"net/http"
 
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/audit"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/cache"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/database"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/mailer"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/notifier"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/oauth"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/persistence"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/push"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/infrastructure/storage"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/account"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/admin"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/auth"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/prayer"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/seed"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/server"
 	"github.com/gin-gonic/gin"
 )
 
 // Setup configures all application-specific routes using dependency injection
 // This follows Clean Architecture principles where dependencies are injected
-func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
+func Setup(router *gin.Engine, cfg *config.Config, db *database.DB, readiness *server.Readiness) {
 	// Initialize repositories
+	userRepo := persistence.NewUserRepository(db)
+	socialAccountRepo := persistence.NewSocialAccountRepository(db)
+	refreshTokenRepo := persistence.NewRefreshTokenRepository(db)
+	tokenBlacklistRepo := persistence.NewTokenBlacklistRepository(db)
+	passwordResetRepo := persistence.NewPasswordResetRepository(db)
+	emailVerificationRepo := persistence.NewEmailVerificationRepository(db)
+	apiKeyRepo := persistence.NewAPIKeyRepository(db)
+	twoFactorRepo := persistence.NewTwoFactorRepository(db)
+	blockRepo := persistence.NewBlockRepository(db)
+	roomRepo := persistence.NewRoomRepository(db)
+	roomMemberRepo := persistence.NewRoomMemberRepository(db)
+	roomInviteRepo := persistence.NewRoomInviteRepository(db)
+	joinRequestRepo := persistence.NewJoinRequestRepository(db)
+	invitationRepo := persistence.NewRoomInvitationRepository(db)
+	announcementRepo := persistence.NewAnnouncementRepository(db)
+	prayerTopicRepo := persistence.NewPrayerTopicRepository(db)
+	prayerContentRepo := persistence.NewPrayerContentRepository(db)
+	prayerRevisionRepo := persistence.NewPrayerRevisionRepository(db)
+	prayerCommentRepo := persistence.NewPrayerCommentRepository(db)
+	prayerReactionRepo := persistence.NewPrayerReactionRepository(db)
+	statsRepo := persistence.NewStatsRepository(db)
+	roomExportRepo := persistence.NewRoomExportRepository(db)
+	deviceRepo := persistence.NewDeviceRepository(db)
+	notificationRepo := persistence.NewNotificationRepository(db)
+	outboxRepo := persistence.NewOutboxRepository(db)
+	transactor := persistence.NewTransactor(db)
+
+	// Rooms and memberships are read on every room-scoped request
+	var readCache service.Cache
+	if cfg.Cache.TTL > 0 {
+		readCache = cache.New(cfg)
+		roomRepo = cache.NewRoomRepository(roomRepo, readCache, cfg.Cache.TTL)
+		roomMemberRepo = cache.NewRoomMemberRepository(roomMemberRepo, readCache, cfg.Cache.TTL)
+	}
 
 	// Initialize service
+	idTokenVerifier := oauth.NewVerifier(cfg)
+	tokenIssuer := middleware.NewTokenIssuer(cfg)
+	mailService := mailer.New(cfg)
+	pushService := push.New(cfg)
+	topicSubscriber := push.NewTopicSubscriber(cfg)
+	notificationService := notifier.New(mailService, pushService, userRepo, deviceRepo, notificationRepo, persistence.NewPushRetryRepository(db))
+	fileStorage := storage.New(cfg)
+	auditor := audit.New(persistence.NewAuditLogRepository(db))
 
 	// Initialize use case
+	roomAuthz := room.NewAuthorizer(roomRepo, roomMemberRepo)
+	roomTopics := room.NewTopics(deviceRepo, topicSubscriber)
+	sendVerificationUC := auth.NewSendEmailVerificationUseCase(userRepo, emailVerificationRepo, mailService, cfg.App.WebURL, cfg.Auth.EmailVerificationTTL)
+	verifyEmailUC := auth.NewVerifyEmailUseCase(userRepo, emailVerificationRepo)
+	getProfileUC := account.NewGetProfileUseCase(userRepo)
+	updateProfileUC := account.NewUpdateProfileUseCase(userRepo)
+	getPublicProfileUC := account.NewGetPublicProfileUseCase(userRepo)
+	checkNicknameUC := account.NewCheckNicknameUseCase(userRepo)
+	searchUsersUC := account.NewSearchUsersUseCase(userRepo)
+	deleteAccountUC := account.NewDeleteAccountUseCase(userRepo, refreshTokenRepo, cfg.Auth.AccountDeletionGracePeriod)
+	cancelDeletionUC := account.NewCancelAccountDeletionUseCase(userRepo)
+	listSessionsUC := account.NewListSessionsUseCase(refreshTokenRepo)
+	revokeSessionUC := account.NewRevokeSessionUseCase(refreshTokenRepo)
+	blockUserUC := account.NewBlockUserUseCase(userRepo, blockRepo)
+	unblockUserUC := account.NewUnblockUserUseCase(blockRepo)
+	listBlockedUsersUC := account.NewListBlockedUsersUseCase(userRepo, blockRepo)
+	registerDeviceUC := account.NewRegisterDeviceUseCase(deviceRepo)
+	unregisterDeviceUC := account.NewUnregisterDeviceUseCase(deviceRepo)
+	getNotificationSettingsUC := account.NewGetNotificationSettingsUseCase(userRepo)
+	updateNotificationSettingsUC := account.NewUpdateNotificationSettingsUseCase(userRepo)
+	snoozeNotificationsUC := account.NewSnoozeNotificationsUseCase(userRepo)
+	unsnoozeNotificationsUC := account.NewUnsnoozeNotificationsUseCase(userRepo)
+	listNotificationsUC := account.NewListNotificationsUseCase(notificationRepo)
+	unreadCountsUC := account.NewUnreadCountsUseCase(notificationRepo)
+	readNotificationUC := account.NewReadNotificationUseCase(notificationRepo)
+	readAllNotificationsUC := account.NewReadAllNotificationsUseCase(notificationRepo)
+	listInboxUC := account.NewListInboxUseCase(notificationRepo, invitationRepo)
+	onboarding := auth.NewOnboarding(cfg.Onboarding.DefaultRoomID, roomRepo, roomMemberRepo, transactor, notificationService, roomTopics)
+	signupUC := auth.NewSignupUseCase(userRepo, sendVerificationUC, onboarding)
+	loginUC := auth.NewLoginUseCase(userRepo, auditor)
+	socialLoginUC := auth.NewSocialLoginUseCase(userRepo, socialAccountRepo, idTokenVerifier, auditor, onboarding)
+	linkSocialUC := auth.NewLinkSocialAccountUseCase(socialAccountRepo, idTokenVerifier)
+	issueTokensUC := auth.NewIssueTokensUseCase(refreshTokenRepo, tokenIssuer, cfg.JWT.Expiry, cfg.JWT.RefreshExpiry, cfg.JWT.SlidingRefresh)
+	refreshTokenUC := auth.NewRefreshTokenUseCase(userRepo, refreshTokenRepo, tokenIssuer, issueTokensUC)
+	logoutUC := auth.NewLogoutUseCase(tokenBlacklistRepo, refreshTokenRepo, tokenIssuer)
+	forgotPasswordUC := auth.NewRequestPasswordResetUseCase(userRepo, passwordResetRepo, mailService, cfg.App.WebURL, cfg.Auth.PasswordResetTTL, cfg.RateLimit.ResetEmails, cfg.RateLimit.ResetEmailWindow)
+	resetPasswordUC := auth.NewResetPasswordUseCase(userRepo, passwordResetRepo, refreshTokenRepo, transactor)
+	createAPIKeyUC := auth.NewCreateAPIKeyUseCase(apiKeyRepo)
+	listAPIKeysUC := auth.NewListAPIKeysUseCase(apiKeyRepo)
+	rotateAPIKeyUC := auth.NewRotateAPIKeyUseCase(apiKeyRepo)
+	revokeAPIKeyUC := auth.NewRevokeAPIKeyUseCase(apiKeyRepo)
+	authenticateAPIKeyUC := auth.NewAuthenticateAPIKeyUseCase(apiKeyRepo)
+	createUserAPIKeyUC := auth.NewCreateUserAPIKeyUseCase(apiKeyRepo)
+	listUserAPIKeysUC := auth.NewListUserAPIKeysUseCase(apiKeyRepo)
+	revokeUserAPIKeyUC := auth.NewRevokeUserAPIKeyUseCase(apiKeyRepo)
+	authenticateUserAPIKeyUC := auth.NewAuthenticateUserAPIKeyUseCase(apiKeyRepo, userRepo)
+	enrollTwoFactorUC := auth.NewEnrollTwoFactorUseCase(userRepo, twoFactorRepo, cfg.Auth.TOTPIssuer)
+	enableTwoFactorUC := auth.NewEnableTwoFactorUseCase(twoFactorRepo)
+	disableTwoFactorUC := auth.NewDisableTwoFactorUseCase(twoFactorRepo)
+	beginTwoFactorUC := auth.NewBeginTwoFactorLoginUseCase(twoFactorRepo, tokenIssuer)
+	verifyTwoFactorUC := auth.NewVerifyTwoFactorLoginUseCase(userRepo, twoFactorRepo, tokenIssuer)
+	guestLoginUC := auth.NewGuestLoginUseCase(userRepo)
+	upgradeGuestUC := auth.NewUpgradeGuestUseCase(userRepo, refreshTokenRepo, sendVerificationUC)
+	listUsersUC := admin.NewListUsersUseCase(userRepo)
+	suspendUserUC := admin.NewSuspendUserUseCase(userRepo, refreshTokenRepo, transactor, auditor)
+	reinstateUserUC := admin.NewReinstateUserUseCase(userRepo, auditor)
+	forceLogoutUC := admin.NewForceLogoutUseCase(userRepo, refreshTokenRepo)
+	createRoomUC := room.NewCreateRoomUseCase(roomRepo)
+	getRoomUC := room.NewGetRoomUseCase(announcementRepo, roomAuthz)
+	listMyRoomsUC := room.NewListMyRoomsUseCase(roomRepo)
+	searchRoomsUC := room.NewSearchRoomsUseCase(roomRepo)
+	trendingRoomsUC := room.NewTrendingRoomsUseCase(roomRepo, roomMemberRepo, cfg.Discovery.TrendingWindow)
+	updateRoomUC := room.NewUpdateRoomUseCase(roomRepo, roomAuthz)
+	archiveRoomUC := room.NewArchiveRoomUseCase(roomRepo, roomAuthz)
+	deleteRoomUC := room.NewDeleteRoomUseCase(roomRepo, roomMemberRepo, fileStorage, roomAuthz, auditor, roomTopics)
+	setCoverImageUC := room.NewSetCoverImageUseCase(roomRepo, fileStorage, roomAuthz)
+	removeCoverImageUC := room.NewRemoveCoverImageUseCase(roomRepo, fileStorage, roomAuthz)
+	getRoomSettingsUC := room.NewGetRoomSettingsUseCase(roomAuthz)
+	updateRoomSettingsUC := room.NewUpdateRoomSettingsUseCase(roomRepo, roomAuthz)
+	listRoomMembersUC := room.NewListMembersUseCase(userRepo, roomMemberRepo, roomAuthz)
+	listActiveMembersUC := room.NewListActiveMembersUseCase(userRepo, roomMemberRepo, roomAuthz, cfg.Presence.ActiveWindow)
+	changeMemberRoleUC := room.NewChangeMemberRoleUseCase(roomMemberRepo, roomAuthz, auditor)
+	muteRoomUC := room.NewMuteRoomUseCase(roomMemberRepo, roomAuthz, roomTopics)
+	subscribeRoomUC := room.NewSubscribeRoomUseCase(roomTopics, roomAuthz)
+	unsubscribeRoomUC := room.NewUnsubscribeRoomUseCase(roomTopics)
+	createInviteUC := room.NewCreateInviteUseCase(roomInviteRepo, roomAuthz, cfg.App.WebURL)
+	getInviteUC := room.NewGetInviteUseCase(roomInviteRepo, roomRepo)
+	validateInviteUC := room.NewValidateInviteUseCase(roomInviteRepo, roomRepo)
+	acceptInviteUC := room.NewAcceptInviteUseCase(roomInviteRepo, roomRepo, roomMemberRepo, transactor, roomTopics)
+	postAnnouncementUC := room.NewPostAnnouncementUseCase(announcementRepo, roomMemberRepo, roomAuthz, notificationService)
+	editAnnouncementUC := room.NewEditAnnouncementUseCase(announcementRepo, roomAuthz)
+	listAnnouncementsUC := room.NewListAnnouncementsUseCase(announcementRepo, roomAuthz)
+	requestToJoinUC := room.NewRequestToJoinUseCase(userRepo, roomRepo, roomMemberRepo, joinRequestRepo, notificationService, roomTopics)
+	listJoinRequestsUC := room.NewListJoinRequestsUseCase(userRepo, joinRequestRepo, roomAuthz)
+	decideJoinRequestUC := room.NewDecideJoinRequestUseCase(joinRequestRepo, roomAuthz, notificationService, roomTopics)
+	inviteUserUC := room.NewInviteUserUseCase(userRepo, roomMemberRepo, invitationRepo, roomAuthz, notificationService)
+	listInvitationsUC := room.NewListInvitationsUseCase(invitationRepo)
+	countInvitationsUC := room.NewCountInvitationsUseCase(invitationRepo)
+	respondInvitationUC := room.NewRespondInvitationUseCase(invitationRepo, roomRepo, roomTopics)
+	createTopicUC := prayer.NewCreateTopicUseCase(prayerTopicRepo, roomAuthz)
+	getTopicUC := prayer.NewGetTopicUseCase(prayerTopicRepo, prayerReactionRepo, roomAuthz)
+	listTopicsUC := prayer.NewListTopicsUseCase(prayerTopicRepo, prayerReactionRepo, roomAuthz)
+	updateTopicUC := prayer.NewUpdateTopicUseCase(prayerTopicRepo, prayerRevisionRepo, roomAuthz, transactor, cfg.Prayer.MaxRevisions)
+	deleteTopicUC := prayer.NewDeleteTopicUseCase(prayerTopicRepo, roomAuthz)
+	restoreTopicUC := prayer.NewRestoreTopicUseCase(prayerTopicRepo, roomAuthz)
+	completeTopicUC := prayer.NewCompleteTopicUseCase(prayerTopicRepo, outboxRepo, roomAuthz, transactor)
+	pinTopicUC := prayer.NewPinTopicUseCase(prayerTopicRepo, outboxRepo, roomAuthz, transactor, cfg.Prayer.MaxPinnedTopics)
+	unpinTopicUC := prayer.NewUnpinTopicUseCase(prayerTopicRepo, outboxRepo, roomAuthz, transactor)
+	reorderPinsUC := prayer.NewReorderPinsUseCase(prayerTopicRepo, prayerReactionRepo, roomAuthz)
+	listAnsweredUC := prayer.NewListAnsweredUseCase(prayerTopicRepo, prayerReactionRepo, roomAuthz)
+	reactionPolicy := entity.PrayerReactionPolicy{Mode: entity.PrayerReactionMode(cfg.Prayer.ReactionMode), Window: cfg.Prayer.ReactionWindow}
+	reactUC := prayer.NewReactUseCase(prayerTopicRepo, prayerReactionRepo, roomAuthz, notificationService, reactionPolicy)
+	tagCloudUC := prayer.NewTagCloudUseCase(prayerTopicRepo, roomAuthz)
+	searchPrayersUC := prayer.NewSearchPrayersUseCase(prayerTopicRepo, roomAuthz)
+	journalUC := prayer.NewJournalUseCase(prayerTopicRepo, prayerReactionRepo)
+	myPrayersUC := prayer.NewMyPrayersUseCase(prayerTopicRepo, prayerReactionRepo)
+	reorderMyPrayersUC := prayer.NewReorderMyPrayersUseCase(prayerTopicRepo, prayerReactionRepo)
+	pauseRecurrenceUC := prayer.NewPauseRecurrenceUseCase(prayerTopicRepo, roomAuthz)
+	cancelRecurrenceUC := prayer.NewCancelRecurrenceUseCase(prayerTopicRepo, roomAuthz)
+	roomStatsUC := prayer.NewRoomStatsUseCase(statsRepo, roomAuthz)
+	userStatsUC := prayer.NewUserStatsUseCase(statsRepo)
+	requestExportUC := prayer.NewRequestExportUseCase(roomExportRepo, roomAuthz, auditor)
+	createContentUC := prayer.NewCreateContentUseCase(prayerTopicRepo, prayerContentRepo, roomAuthz)
+	listContentsUC := prayer.NewListContentsUseCase(prayerTopicRepo, prayerContentRepo, roomAuthz)
+	updateContentUC := prayer.NewUpdateContentUseCase(prayerTopicRepo, prayerContentRepo, prayerRevisionRepo, roomAuthz, transactor, cfg.Prayer.MaxRevisions)
+	deleteContentUC := prayer.NewDeleteContentUseCase(prayerTopicRepo, prayerContentRepo, roomAuthz)
+	topicHistoryUC := prayer.NewTopicHistoryUseCase(prayerTopicRepo, prayerRevisionRepo, roomAuthz)
+	listReactionsUC := prayer.NewListReactionsUseCase(prayerTopicRepo, prayerReactionRepo, userRepo, roomAuthz)
+	createCommentUC := prayer.NewCreateCommentUseCase(prayerTopicRepo, prayerCommentRepo, userRepo, roomMemberRepo, roomAuthz, notificationService)
+	listCommentsUC := prayer.NewListCommentsUseCase(prayerTopicRepo, prayerCommentRepo, roomAuthz)
+	updateCommentUC := prayer.NewUpdateCommentUseCase(prayerTopicRepo, prayerCommentRepo, userRepo, roomMemberRepo, roomAuthz, notificationService)
+	deleteCommentUC := prayer.NewDeleteCommentUseCase(prayerTopicRepo, prayerCommentRepo, roomAuthz)
 
-	// Health check endpoints (moved from bootstrap to maintain Clean Architecture)
+	// Initialize handlers
+	authHandler := handler.NewAuthHandler(signupUC, loginUC, beginTwoFactorUC, issueTokensUC, refreshTokenUC, logoutUC, forgotPasswordUC, resetPasswordUC, sendVerificationUC, verifyEmailUC)
+	oauthHandler := handler.NewOAuthHandler(socialLoginUC, linkSocialUC, beginTwoFactorUC, issueTokensUC)
+	guestHandler := handler.NewGuestHandler(guestLoginUC, upgradeGuestUC, issueTokensUC)
+	twoFactorHandler := handler.NewTwoFactorHandler(enrollTwoFactorUC, enableTwoFactorUC, disableTwoFactorUC, verifyTwoFactorUC, issueTokensUC)
+	userHandler := handler.NewUserHandler(getProfileUC, updateProfileUC, getPublicProfileUC, checkNicknameUC, searchUsersUC, deleteAccountUC, cancelDeletionUC, listSessionsUC, revokeSessionUC)
+	blockHandler := handler.NewBlockHandler(blockUserUC, unblockUserUC, listBlockedUsersUC)
+	deviceHandler := handler.NewDeviceHandler(registerDeviceUC, unregisterDeviceUC)
+	notificationSettingsHandler := handler.NewNotificationSettingsHandler(getNotificationSettingsUC, updateNotificationSettingsUC, snoozeNotificationsUC, unsnoozeNotificationsUC)
+	notificationHandler := handler.NewNotificationHandler(listNotificationsUC, unreadCountsUC, readNotificationUC, readAllNotificationsUC)
+	inboxHandler := handler.NewInboxHandler(listInboxUC)
+	apiKeyHandler := handler.NewAPIKeyHandler(createAPIKeyUC, listAPIKeysUC, rotateAPIKeyUC, revokeAPIKeyUC)
+	userAPIKeyHandler := handler.NewUserAPIKeyHandler(createUserAPIKeyUC, listUserAPIKeysUC, revokeUserAPIKeyUC)
+	roomHandler := handler.NewRoomHandler(createRoomUC, getRoomUC, listMyRoomsUC, searchRoomsUC, trendingRoomsUC, updateRoomUC, archiveRoomUC, deleteRoomUC, listRoomMembersUC, listActiveMembersUC, changeMemberRoleUC, muteRoomUC)
+	roomCoverHandler := handler.NewRoomCoverHandler(setCoverImageUC, removeCoverImageUC)
+	roomSettingsHandler := handler.NewRoomSettingsHandler(getRoomSettingsUC, updateRoomSettingsUC)
+	roomSubscriptionHandler := handler.NewRoomSubscriptionHandler(subscribeRoomUC, unsubscribeRoomUC)
+	inviteHandler := handler.NewInviteHandler(createInviteUC, getInviteUC, validateInviteUC, acceptInviteUC)
+	announcementHandler := handler.NewAnnouncementHandler(postAnnouncementUC, editAnnouncementUC, listAnnouncementsUC)
+	joinRequestHandler := handler.NewJoinRequestHandler(requestToJoinUC, listJoinRequestsUC, decideJoinRequestUC)
+	invitationHandler := handler.NewInvitationHandler(inviteUserUC, listInvitationsUC, countInvitationsUC, respondInvitationUC)
+	prayerHandler := handler.NewPrayerHandler(createTopicUC, getTopicUC, listTopicsUC, updateTopicUC, deleteTopicUC, restoreTopicUC, completeTopicUC, listAnsweredUC, reactUC, tagCloudUC, searchPrayersUC, journalUC, pauseRecurrenceUC, cancelRecurrenceUC, pinTopicUC, unpinTopicUC, reorderPinsUC)
+	prayerContentHandler := handler.NewPrayerContentHandler(createContentUC, listContentsUC, updateContentUC, deleteContentUC)
+	prayerRevisionHandler := handler.NewPrayerRevisionHandler(topicHistoryUC)
+	myPrayerHandler := handler.NewMyPrayerHandler(myPrayersUC, reorderMyPrayersUC)
+	prayerReactionHandler := handler.NewPrayerReactionHandler(listReactionsUC)
+	prayerCommentHandler := handler.NewPrayerCommentHandler(createCommentUC, listCommentsUC, updateCommentUC, deleteCommentUC)
+	statsHandler := handler.NewStatsHandler(roomStatsUC, userStatsUC)
+	roomExportHandler := handler.NewRoomExportHandler(requestExportUC)
+	adminUserHandler := handler.NewAdminUserHandler(listUsersUC, suspendUserUC, reinstateUserUC, forceLogoutUC)
+	metaHandler := handler.NewMetaHandler(cfg)
+	seedHandler := handler.NewSeedHandler(seed.NewSeedUseCase(userRepo, roomRepo, roomMemberRepo, roomInviteRepo, prayerTopicRepo, prayerContentRepo, transactor))
+	// Only the database is critical: without Redis, SMTP or FCM requests are still served,
+	// falling back to the database, or with emails and pushes failing until they recover
+	healthHandler := handler.NewHealthHandler(readiness,
+		handler.Dependency{Name: "database", Checker: db, Critical: true},
+		handler.Dependency{Name: "redis", Checker: healthChecker(readCache)},
+		handler.Dependency{Name: "smtp", Checker: healthChecker(mailService)},
+		handler.Dependency{Name: "fcm", Checker: healthChecker(pushService)},
+	)
+	jwksHandler := handler.NewJWKSHandler(cfg)
+
+	// Authentication middleware
+	// A personal key in "Authorization: ApiKey <key>" stands in for an access token
+	requireAuth := middleware.APIKeyAuth(authenticateUserAPIKeyUC, middleware.JWT(cfg, tokenBlacklistRepo, refreshTokenRepo))
+	// Account security and admin work need a signed-in session, not a personal key
+	rejectAPIKey := middleware.RejectAPIKey()
+	// Registered after requireAuth wherever it is used, as it counts requests per user
+	limitUser := middleware.UserRateLimit(cfg)
+	// One limiter for every way to sign in or redeem an emailed token, so attempts are counted together
+	limitLogin := middleware.LoginRateLimit(cfg)
+	// Every signup creates a user row, guest accounts included
+	limitSignup := middleware.SignupRateLimit(cfg)
+	requireAdmin := middleware.RequireRole(string(entity.RoleAdmin))
+	// Guests may browse but not change anything beyond signing up or out
+	guestReadOnly := middleware.GuestReadOnly()
+	requireVerifiedEmail := middleware.RequireVerifiedEmail()
+	requireAPIKey := middleware.APIKey(authenticateAPIKeyUC)
+	// Retried POSTs carrying an Idempotency-Key get the first response instead of running twice
+	idempotent := middleware.Idempotency(persistence.NewIdempotencyRepository(db), cfg.Server.IdempotencyTTL)
+	// Polled feeds answer an unchanged page with 304 Not Modified
+	etag := middleware.ETag()
 
-	// API v1 routes
-	v1 := router.Group("/api/v1")
+	// Health check endpoints (moved from bootstrap to maintain Clean Architecture)
+	health := router.Group("", middleware.HealthAuth(cfg))
 	{
-		// Example endpoint
-		v1.GET("/ping", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{
-				"message": "pong",
+		health.GET("/health", healthHandler.Health)
+		health.GET("/ready", healthHandler.Ready)
+		// Runtime counters such as push delivery outcomes reveal internals, so they are only
+		// served when the health token protects them
+		if cfg.Server.HealthAuthToken != "" {
+			health.GET("/metrics", healthHandler.Metrics)
+			health.GET("/debug/vars", gin.WrapH(expvar.Handler()))
+		}
+	}
+
+	// Public keys for verifying our tokens
+	router.GET("/.well-known/jwks.json", jwksHandler.JWKS)
+
+	// Uploaded files such as room covers; file names are random, so they are safe to serve publicly
+	// Private files such as exports need a signed URL
+	files := gin.WrapH(http.StripPrefix("/uploads", storage.NewFileServer(cfg)))
+	router.GET("/uploads/*filepath", files)
+	router.HEAD("/uploads/*filepath", files)
+
+	// Demo data for frontend development, never routed in production
+	if cfg.IsDevelopment() {
+		router.POST("/dev/seed", seedHandler.Seed)
+	}
+
+	// Versioned API routes, one tree per served version
+	for _, version := range apiVersions {
+		api := mountAPIVersion(router, version)
+		{
+			// Example endpoint
+			api.GET("/ping", func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{
+					"message": "pong",
+				})
 			})
-		})
 
+			// Authentication (public)
+			authGroup := api.Group("/auth")
+			{
+				authGroup.POST("/signup", limitSignup, authHandler.Signup)
+				authGroup.POST("/login", limitLogin, authHandler.Login)
+				authGroup.POST("/refresh", authHandler.Refresh)
+				authGroup.POST("/social/:provider", limitLogin, oauthHandler.Login)
+				authGroup.POST("/logout", requireAuth, rejectAPIKey, limitUser, authHandler.Logout)
+				authGroup.POST("/password/forgot", limitLogin, authHandler.ForgotPassword)
+				authGroup.POST("/password/reset", limitLogin, authHandler.ResetPassword)
+				authGroup.POST("/verify-email", limitLogin, authHandler.VerifyEmail)
+				authGroup.POST("/verify-email/resend", requireAuth, rejectAPIKey, limitUser, guestReadOnly, authHandler.ResendVerificationEmail)
+				authGroup.POST("/social/:provider/link", requireAuth, rejectAPIKey, limitUser, guestReadOnly, oauthHandler.Link)
+				authGroup.POST("/2fa/verify", limitLogin, twoFactorHandler.Verify)
+				authGroup.POST("/2fa/enroll", requireAuth, rejectAPIKey, limitUser, guestReadOnly, twoFactorHandler.Enroll)
+				authGroup.POST("/2fa/enable", requireAuth, rejectAPIKey, limitUser, guestReadOnly, twoFactorHandler.Enable)
+				authGroup.POST("/2fa/disable", requireAuth, rejectAPIKey, limitUser, guestReadOnly, twoFactorHandler.Disable)
+				authGroup.POST("/guest", limitSignup, guestHandler.Login)
+				authGroup.POST("/guest/upgrade", requireAuth, rejectAPIKey, limitUser, guestHandler.Upgrade)
+			}
+
+			// Current user account
+			me := api.Group("/users/me", requireAuth, limitUser, guestReadOnly, idempotent)
+			{
+				me.GET("", userHandler.GetMe)
+				me.PATCH("", userHandler.UpdateMe)
+				me.DELETE("", userHandler.DeleteMe)
+				me.DELETE("/deletion", userHandler.CancelDeletion)
+				me.POST("/api-keys", rejectAPIKey, userAPIKeyHandler.Create)
+				me.GET("/api-keys", userAPIKeyHandler.List)
+				me.DELETE("/api-keys/:id", userAPIKeyHandler.Revoke)
+				me.GET("/sessions", userHandler.ListSessions)
+				me.DELETE("/sessions/:id", userHandler.RevokeSession)
+				me.GET("/blocks", blockHandler.List)
+				me.PUT("/blocks/:id", blockHandler.Block)
+				me.DELETE("/blocks/:id", blockHandler.Unblock)
+				me.POST("/devices", deviceHandler.Register)
+				me.DELETE("/devices/:deviceId", deviceHandler.Unregister)
+				me.GET("/notification-settings", notificationSettingsHandler.Get)
+				me.PATCH("/notification-settings", notificationSettingsHandler.Update)
+				me.POST("/notifications/snooze", notificationSettingsHandler.Snooze)
+				me.DELETE("/notifications/snooze", notificationSettingsHandler.Unsnooze)
+				me.GET("/journal", prayerHandler.Journal)
+				me.GET("/prayers", myPrayerHandler.List)
+				me.PUT("/prayers/order", myPrayerHandler.Reorder)
+				me.GET("/stats", statsHandler.Me)
+			}
+
+			// Notification inbox of the signed-in user
+			notifications := api.Group("/notifications", requireAuth, limitUser, idempotent)
+			{
+				notifications.GET("", notificationHandler.List)
+				notifications.GET("/unread-counts", notificationHandler.UnreadCounts)
+				notifications.POST("/read-all", notificationHandler.ReadAll)
+				notifications.POST("/:id/read", notificationHandler.Read)
+			}
+
+			// Pending invitations and notifications of the signed-in user in one feed
+			api.GET("/inbox", requireAuth, limitUser, inboxHandler.List)
+
+			// Other users
+			api.GET("/users/nickname-check", userHandler.CheckNickname) // public, used during signup
+			users := api.Group("/users", requireAuth, limitUser, idempotent)
+			{
+				users.GET("/search", userHandler.SearchUsers)
+				users.GET("/:id", userHandler.GetUser)
+			}
+
+			// Prayer rooms
+			rooms := api.Group("/rooms", requireAuth, limitUser, guestReadOnly, idempotent)
+			{
+				rooms.POST("", requireVerifiedEmail, roomHandler.Create)
+				rooms.GET("", etag, roomHandler.List)
+				rooms.GET("/search", roomHandler.Search)
+				rooms.GET("/trending", roomHandler.Trending)
+				rooms.GET("/categories", roomHandler.Categories)
+				rooms.GET("/:id", roomHandler.Get)
+				rooms.PATCH("/:id", roomHandler.Update)
+				rooms.DELETE("/:id", roomHandler.Delete)
+				rooms.POST("/:id/archive", roomHandler.Archive)
+				rooms.POST("/:id/unarchive", roomHandler.Unarchive)
+				rooms.PUT("/:id/cover", middleware.BodyLimit(cfg.Server.MaxUploadBodySize), roomCoverHandler.Set)
+				rooms.DELETE("/:id/cover", roomCoverHandler.Remove)
+				rooms.GET("/:id/settings", roomSettingsHandler.Get)
+				rooms.PATCH("/:id/settings", roomSettingsHandler.Update)
+				rooms.GET("/:id/stats", statsHandler.Room)
+				// Exports are private files, which need the storage signing key
+				if cfg.Storage.PrivateFilesEnabled() {
+					rooms.POST("/:id/export", roomExportHandler.Create)
+				}
+				rooms.GET("/:id/members", roomHandler.ListMembers)
+				rooms.GET("/:id/members/active", roomHandler.ListActiveMembers)
+				rooms.PATCH("/:id/members/:userId", roomHandler.ChangeMemberRole)
+				rooms.PUT("/:id/mute", roomHandler.Mute)
+				rooms.POST("/:id/subscribe", roomSubscriptionHandler.Subscribe)
+				rooms.POST("/:id/unsubscribe", roomSubscriptionHandler.Unsubscribe)
+				rooms.POST("/:id/invites", inviteHandler.Create)
+				rooms.GET("/:id/announcements", announcementHandler.List)
+				rooms.POST("/:id/announcements", announcementHandler.Post)
+				rooms.PATCH("/:id/announcements/:announcementId", announcementHandler.Edit)
+				rooms.POST("/:id/join-requests", joinRequestHandler.Create)
+				rooms.GET("/:id/join-requests", joinRequestHandler.List)
+				rooms.POST("/:id/join-requests/:requestId/approve", joinRequestHandler.Approve)
+				rooms.POST("/:id/join-requests/:requestId/reject", joinRequestHandler.Reject)
+				rooms.POST("/:id/invitations", invitationHandler.Create)
+				rooms.GET("/:id/prayers", etag, prayerHandler.List)
+				rooms.GET("/:id/prayers/tags", prayerHandler.Tags)
+				rooms.GET("/:id/prayers/answered", prayerHandler.Answered)
+				rooms.GET("/:id/prayers/search", prayerHandler.Search)
+				rooms.POST("/:id/prayers", prayerHandler.Create)
+				if cfg.Prayer.MaxPinnedTopics > 0 {
+					rooms.PUT("/:id/pins/order", prayerHandler.ReorderPins)
+				}
+			}
+
+			// Prayer topics, addressed directly once posted
+			prayers := api.Group("/prayers", requireAuth, limitUser, guestReadOnly, idempotent)
+			{
+				prayers.GET("/:id", prayerHandler.Get)
+				prayers.PATCH("/:id", prayerHandler.Update)
+				prayers.DELETE("/:id", prayerHandler.Delete)
+				prayers.POST("/:id/restore", prayerHandler.Restore)
+				if cfg.Prayer.MaxRevisions > 0 {
+					prayers.GET("/:id/history", prayerRevisionHandler.History)
+				}
+				prayers.PUT("/:id/recurrence/pause", prayerHandler.PauseRecurrence)
+				prayers.DELETE("/:id/recurrence", prayerHandler.CancelRecurrence)
+				prayers.POST("/:id/complete", prayerHandler.Complete)
+				if cfg.Prayer.MaxPinnedTopics > 0 {
+					prayers.PUT("/:id/pin", prayerHandler.Pin)
+					prayers.DELETE("/:id/pin", prayerHandler.Unpin)
+				}
+				prayers.PUT("/:id/reaction", prayerHandler.React)
+				prayers.DELETE("/:id/reaction", prayerHandler.Unreact)
+				prayers.GET("/:id/completions", prayerReactionHandler.List)
+				prayers.GET("/:id/contents", prayerContentHandler.List)
+				prayers.POST("/:id/contents", prayerContentHandler.Create)
+				prayers.PATCH("/:id/contents/:contentId", prayerContentHandler.Update)
+				prayers.DELETE("/:id/contents/:contentId", prayerContentHandler.Delete)
+				prayers.GET("/:id/comments", prayerCommentHandler.List)
+				prayers.POST("/:id/comments", prayerCommentHandler.Create)
+				prayers.PATCH("/:id/comments/:commentId", prayerCommentHandler.Update)
+				prayers.DELETE("/:id/comments/:commentId", prayerCommentHandler.Delete)
+			}
+
+			// Room invites, opened from deep links
+			invites := api.Group("/invites", requireAuth, limitUser, guestReadOnly, idempotent)
+			{
+				invites.GET("/:code", inviteHandler.Get)
+				invites.POST("/:code/accept", inviteHandler.Accept)
+			}
+
+			// Checks a shared code before the app shows its join screen; nothing is redeemed
+			api.GET("/invite-codes/:code/validate", requireAuth, limitUser, inviteHandler.Validate)
+
+			// Invitations addressed to the signed-in user
+			invitations := api.Group("/invitations", requireAuth, limitUser, guestReadOnly, idempotent)
+			{
+				invitations.GET("", invitationHandler.List)
+				invitations.GET("/count", invitationHandler.Count)
+				invitations.POST("/:id/accept", invitationHandler.Accept)
+				invitations.POST("/:id/reject", invitationHandler.Reject)
+			}
+
+			// Administration
+			adminGroup := api.Group("/admin", requireAuth, rejectAPIKey, limitUser, requireAdmin, idempotent)
+			{
+				adminGroup.POST("/api-keys", apiKeyHandler.Create)
+				adminGroup.GET("/api-keys", apiKeyHandler.List)
+				adminGroup.POST("/api-keys/:id/rotate", apiKeyHandler.Rotate)
+				adminGroup.DELETE("/api-keys/:id", apiKeyHandler.Revoke)
+				adminGroup.GET("/users", adminUserHandler.List)
+				adminGroup.POST("/users/:id/suspend", adminUserHandler.Suspend)
+				adminGroup.POST("/users/:id/reinstate", adminUserHandler.Reinstate)
+				adminGroup.POST("/users/:id/logout", adminUserHandler.ForceLogout)
+			}
+
+			// Service accounts (X-API-Key)
+			svc := api.Group("/service", requireAPIKey)
+			{
+				// Lets integrations check their credentials
+				svc.GET("/ping", func(c *gin.Context) {
+					c.JSON(http.StatusOK, gin.H{
+						"message": "pong",
+					})
+				})
+			}
+
+			// Server metadata
+			meta := api.Group("/meta")
+			{
+				meta.GET("/capabilities", metaHandler.Capabilities)
+			}
+		}
 	}
 }
+
+// healthChecker returns the dependency's health check, nil for the in-process stand-ins
+// used when the real service is not configured
+func healthChecker(dependency any) handler.HealthChecker {
+	checker, _ := dependency.(handler.HealthChecker)
+	return checker
+}
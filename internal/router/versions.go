@@ -0,0 +1,32 @@
+package router
+
+import (
+	"strconv"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// apiVersion is a version of the API, mounted under /api/v<Number>
+type apiVersion struct {
+	Number int
+	// Deprecation, when set, is announced on every response of the version
+	Deprecation *middleware.Deprecation
+}
+
+// apiVersions are served side by side so old app versions keep working while payloads evolve
+// A version is added together with its first payload change, which the handler maps by checking
+// middleware.GetAPIVersion; every version gets the same routes unless their registration checks
+// the version. Once a newer version is served, set Deprecation on the ones it replaces
+var apiVersions = []apiVersion{
+	{Number: 1},
+}
+
+// mountAPIVersion returns the route group of version v
+func mountAPIVersion(router *gin.Engine, v apiVersion) *gin.RouterGroup {
+	handlers := []gin.HandlerFunc{middleware.APIVersion(v.Number)}
+	if v.Deprecation != nil {
+		handlers = append(handlers, middleware.Deprecated(*v.Deprecation))
+	}
+	return router.Group("/api/v"+strconv.Itoa(v.Number), handlers...)
+}
@@ -0,0 +1,51 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func TestMountAPIVersion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	versions := []apiVersion{
+		{Number: 1, Deprecation: &middleware.Deprecation{Since: since}},
+		{Number: 2},
+	}
+	for _, v := range versions {
+		mountAPIVersion(router, v).GET("/ping", func(c *gin.Context) {
+			c.String(http.StatusOK, strconv.Itoa(middleware.GetAPIVersion(c)))
+		})
+	}
+
+	for _, v := range versions {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v"+strconv.Itoa(v.Number)+"/ping", nil))
+
+		if rec.Body.String() != strconv.Itoa(v.Number) {
+			t.Errorf("v%d: handler saw version %s", v.Number, rec.Body.String())
+		}
+		if deprecated := rec.Header().Get("Deprecation") != ""; deprecated != (v.Deprecation != nil) {
+			t.Errorf("v%d: Deprecation header = %q", v.Number, rec.Header().Get("Deprecation"))
+		}
+	}
+}
+
+func TestServedAPIVersions(t *testing.T) {
+	// Only versions whose payloads differ are served, starting from v1
+	for i, v := range apiVersions {
+		if v.Number != i+1 {
+			t.Errorf("apiVersions[%d] = v%d, want v%d", i, v.Number, i+1)
+		}
+	}
+	if len(apiVersions) == 0 || apiVersions[len(apiVersions)-1].Deprecation != nil {
+		t.Error("the newest served version must not be deprecated")
+	}
+}
@@ -0,0 +1,99 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+type BlockUserUseCase struct {
+	userRepo  repository.UserRepository
+	blockRepo repository.BlockRepository
+}
+
+func NewBlockUserUseCase(userRepo repository.UserRepository, blockRepo repository.BlockRepository) *BlockUserUseCase {
+	return &BlockUserUseCase{
+		userRepo:  userRepo,
+		blockRepo: blockRepo,
+	}
+}
+
+// Execute blocks another user; blocking twice is harmless
+func (uc *BlockUserUseCase) Execute(ctx context.Context, userID, blockedID string) error {
+	block, err := entity.NewBlock(userID, blockedID)
+	if err != nil {
+		return err
+	}
+
+	// Only existing users can be blocked, but blocking someone pending deletion is fine
+	if _, err := uc.userRepo.GetByID(ctx, blockedID); err != nil {
+		return err
+	}
+
+	return uc.blockRepo.Create(ctx, block)
+}
+
+type UnblockUserUseCase struct {
+	blockRepo repository.BlockRepository
+}
+
+func NewUnblockUserUseCase(blockRepo repository.BlockRepository) *UnblockUserUseCase {
+	return &UnblockUserUseCase{
+		blockRepo: blockRepo,
+	}
+}
+
+// Execute lifts a block; unblocking a user who is not blocked is harmless
+func (uc *UnblockUserUseCase) Execute(ctx context.Context, userID, blockedID string) error {
+	return uc.blockRepo.Delete(ctx, userID, blockedID)
+}
+
+// BlockedUser is a blocked account together with when it was blocked
+type BlockedUser struct {
+	User      *entity.User
+	BlockedAt time.Time
+}
+
+type ListBlockedUsersUseCase struct {
+	userRepo  repository.UserRepository
+	blockRepo repository.BlockRepository
+}
+
+func NewListBlockedUsersUseCase(userRepo repository.UserRepository, blockRepo repository.BlockRepository) *ListBlockedUsersUseCase {
+	return &ListBlockedUsersUseCase{
+		userRepo:  userRepo,
+		blockRepo: blockRepo,
+	}
+}
+
+// Execute returns the users the user blocked, most recently blocked first
+func (uc *ListBlockedUsersUseCase) Execute(ctx context.Context, userID string) ([]BlockedUser, error) {
+	blocks, err := uc.blockRepo.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		ids = append(ids, b.BlockedID)
+	}
+	users, err := uc.userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*entity.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	// Blocks keep the repository's order; purged users are skipped
+	blocked := make([]BlockedUser, 0, len(blocks))
+	for _, b := range blocks {
+		if u, ok := byID[b.BlockedID]; ok {
+			blocked = append(blocked, BlockedUser{User: u, BlockedAt: b.CreatedAt})
+		}
+	}
+	return blocked, nil
+}
@@ -0,0 +1,111 @@
+package account
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+// purgeBatchSize bounds how many accounts a single purge run removes
+const purgeBatchSize = 100
+
+type DeleteAccountUseCase struct {
+	userRepo    repository.UserRepository
+	tokenRepo   repository.RefreshTokenRepository
+	gracePeriod time.Duration
+}
+
+func NewDeleteAccountUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.RefreshTokenRepository,
+	gracePeriod time.Duration,
+) *DeleteAccountUseCase {
+	return &DeleteAccountUseCase{
+		userRepo:    userRepo,
+		tokenRepo:   tokenRepo,
+		gracePeriod: gracePeriod,
+	}
+}
+
+// Execute soft-deletes the account and signs it out everywhere
+// Repeating the request keeps the original purge time
+func (uc *DeleteAccountUseCase) Execute(ctx context.Context, userID string) (time.Time, error) {
+	if err := uc.userRepo.ScheduleDeletion(ctx, userID, time.Now().Add(uc.gracePeriod)); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := uc.tokenRepo.RevokeAllForUser(ctx, userID); err != nil {
+		return time.Time{}, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return *user.PurgeAt, nil
+}
+
+type CancelAccountDeletionUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewCancelAccountDeletionUseCase(userRepo repository.UserRepository) *CancelAccountDeletionUseCase {
+	return &CancelAccountDeletionUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute restores an account that is still inside its grace period
+func (uc *CancelAccountDeletionUseCase) Execute(ctx context.Context, userID string) error {
+	cancelled, err := uc.userRepo.CancelDeletion(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return entity.ErrAccountDeletionNotScheduled
+	}
+	return nil
+}
+
+type PurgeDeletedAccountsUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewPurgeDeletedAccountsUseCase(userRepo repository.UserRepository) *PurgeDeletedAccountsUseCase {
+	return &PurgeDeletedAccountsUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute hard-deletes accounts whose grace period has ended
+// Each account is purged in its own transaction so one failure doesn't block the rest
+func (uc *PurgeDeletedAccountsUseCase) Execute(ctx context.Context) error {
+	now := time.Now()
+	ids, err := uc.userRepo.ListDueForPurge(ctx, now, purgeBatchSize)
+	if err != nil {
+		return err
+	}
+
+	purged := 0
+	for _, id := range ids {
+		ok, err := uc.userRepo.Purge(ctx, id, now)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			slog.ErrorContext(ctx, "Failed to purge deleted account", "user_id", id, "error", err)
+			continue
+		}
+		if ok {
+			purged++
+		}
+	}
+
+	if purged > 0 {
+		slog.InfoContext(ctx, "Purged deleted accounts", "count", purged)
+	}
+	return nil
+}
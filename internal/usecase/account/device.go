@@ -0,0 +1,49 @@
+package account
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type RegisterDeviceUseCase struct {
+	deviceRepo repository.DeviceRepository
+}
+
+func NewRegisterDeviceUseCase(deviceRepo repository.DeviceRepository) *RegisterDeviceUseCase {
+	return &RegisterDeviceUseCase{
+		deviceRepo: deviceRepo,
+	}
+}
+
+// Execute registers the FCM token of one of the user's app installations for push notifications
+// Registering an installation again replaces its token, so apps call this on every launch
+func (uc *RegisterDeviceUseCase) Execute(ctx context.Context, userID, deviceID, token string, platform entity.DevicePlatform, appVersion, locale string) (*entity.Device, error) {
+	device, err := entity.NewDevice(userID, deviceID, token, platform, appVersion, locale)
+	if err != nil {
+		return nil, err
+	}
+	device.ID = uuid.New().String()
+
+	if err := uc.deviceRepo.Register(ctx, device); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+type UnregisterDeviceUseCase struct {
+	deviceRepo repository.DeviceRepository
+}
+
+func NewUnregisterDeviceUseCase(deviceRepo repository.DeviceRepository) *UnregisterDeviceUseCase {
+	return &UnregisterDeviceUseCase{
+		deviceRepo: deviceRepo,
+	}
+}
+
+// Execute stops push notifications to one of the user's installations, such as on sign-out
+func (uc *UnregisterDeviceUseCase) Execute(ctx context.Context, userID, deviceID string) error {
+	return uc.deviceRepo.Delete(ctx, userID, deviceID)
+}
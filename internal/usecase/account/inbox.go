@@ -0,0 +1,111 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// InboxItem is one entry of the combined inbox: exactly one of its fields is set
+type InboxItem struct {
+	Invitation   *entity.RoomInvitation
+	Notification *entity.InboxNotification
+}
+
+// key returns the item's position in the feed, newest first
+func (i InboxItem) key() pagination.TimeKey {
+	if i.Invitation != nil {
+		return pagination.TimeKey{Time: i.Invitation.CreatedAt, ID: i.Invitation.ID}
+	}
+	return pagination.TimeKey{Time: i.Notification.CreatedAt, ID: i.Notification.ID}
+}
+
+// InboxFeed is a page of the combined inbox with the count of everything still awaiting the user
+type InboxFeed struct {
+	Items []InboxItem
+	// UnreadCount is the user's unread notifications plus their pending invitations
+	UnreadCount int64
+	Page        pagination.Meta
+}
+
+type ListInboxUseCase struct {
+	notificationRepo repository.NotificationRepository
+	invitationRepo   repository.RoomInvitationRepository
+}
+
+func NewListInboxUseCase(notificationRepo repository.NotificationRepository, invitationRepo repository.RoomInvitationRepository) *ListInboxUseCase {
+	return &ListInboxUseCase{
+		notificationRepo: notificationRepo,
+		invitationRepo:   invitationRepo,
+	}
+}
+
+// Execute returns a page of the user's pending invitations and notifications merged newest first
+// Pending invitations await an answer, so they count as unread; with unreadOnly notifications
+// already read are left out
+func (uc *ListInboxUseCase) Execute(ctx context.Context, userID string, unreadOnly bool, cursor string, limit int) (*InboxFeed, error) {
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both sources are ordered like the feed, so the first limit+1 of each hold the first limit+1 of the merge
+	now := time.Now()
+	limit = pagination.ClampLimit(limit)
+	invitations, err := uc.invitationRepo.ListByInvitee(ctx, userID, entity.InvitationPending, now, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	notifications, err := uc.notificationRepo.List(ctx, userID, unreadOnly, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	items := mergeInbox(invitations, notifications, limit+1)
+	items, page, err := pagination.Page(items, limit, func(i InboxItem) (string, error) {
+		return pagination.Encode(i.key())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := uc.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := uc.invitationRepo.CountPending(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	return &InboxFeed{Items: items, UnreadCount: unread + pending, Page: page}, nil
+}
+
+// mergeInbox merges two lists ordered newest first, with the ID as tie-breaker, into up to n items
+func mergeInbox(invitations []*entity.RoomInvitation, notifications []*entity.InboxNotification, n int) []InboxItem {
+	items := make([]InboxItem, 0, min(n, len(invitations)+len(notifications)))
+	for len(items) < n {
+		switch {
+		case len(invitations) > 0 && (len(notifications) == 0 ||
+			newer(InboxItem{Invitation: invitations[0]}.key(), InboxItem{Notification: notifications[0]}.key())):
+			items = append(items, InboxItem{Invitation: invitations[0]})
+			invitations = invitations[1:]
+		case len(notifications) > 0:
+			items = append(items, InboxItem{Notification: notifications[0]})
+			notifications = notifications[1:]
+		default:
+			return items
+		}
+	}
+	return items
+}
+
+// newer reports whether a comes before b in a feed ordered newest first
+func newer(a, b pagination.TimeKey) bool {
+	if !a.Time.Equal(b.Time) {
+		return a.Time.After(b.Time)
+	}
+	return a.ID > b.ID
+}
@@ -0,0 +1,120 @@
+package account
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// before reports whether an item at (t, id) comes after the key in a feed ordered newest first
+func before(key *pagination.TimeKey, t time.Time, id string) bool {
+	return key == nil || t.Before(key.Time) || (t.Equal(key.Time) && id < key.ID)
+}
+
+// notificationList serves notifications stored newest first
+type notificationList struct {
+	repository.NotificationRepository
+	notifications []*entity.InboxNotification
+}
+
+func (f notificationList) List(_ context.Context, _ string, unreadOnly bool, after *pagination.TimeKey, limit int) ([]*entity.InboxNotification, error) {
+	var page []*entity.InboxNotification
+	for _, n := range f.notifications {
+		if len(page) < limit && before(after, n.CreatedAt, n.ID) && (!unreadOnly || n.ReadAt == nil) {
+			page = append(page, n)
+		}
+	}
+	return page, nil
+}
+
+func (f notificationList) CountUnread(context.Context, string) (int64, error) {
+	var count int64
+	for _, n := range f.notifications {
+		if n.ReadAt == nil {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// invitationList serves pending invitations stored newest first
+type invitationList struct {
+	repository.RoomInvitationRepository
+	invitations []*entity.RoomInvitation
+}
+
+func (f invitationList) ListByInvitee(_ context.Context, _ string, _ entity.InvitationStatus, _ time.Time, after *pagination.TimeKey, limit int) ([]*entity.RoomInvitation, error) {
+	var page []*entity.RoomInvitation
+	for _, i := range f.invitations {
+		if len(page) < limit && before(after, i.CreatedAt, i.ID) {
+			page = append(page, i)
+		}
+	}
+	return page, nil
+}
+
+func (f invitationList) CountPending(context.Context, string, time.Time) (int64, error) {
+	return int64(len(f.invitations)), nil
+}
+
+func TestListInboxMergesNewestFirst(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	read := now.Add(-time.Minute)
+	uc := NewListInboxUseCase(
+		notificationList{notifications: []*entity.InboxNotification{
+			{ID: "n3", CreatedAt: now.Add(-1 * time.Hour)},
+			{ID: "n2", CreatedAt: now.Add(-3 * time.Hour), ReadAt: &read},
+			{ID: "n1", CreatedAt: now.Add(-5 * time.Hour)},
+		}},
+		invitationList{invitations: []*entity.RoomInvitation{
+			{ID: "i2", CreatedAt: now.Add(-1 * time.Hour)}, // same time as n3: the ID breaks the tie
+			{ID: "i1", CreatedAt: now.Add(-4 * time.Hour)},
+		}},
+	)
+	ctx := context.Background()
+
+	// ids follows the cursor through every page of the feed
+	ids := func(unreadOnly bool, limit int) []string {
+		t.Helper()
+		var ids []string
+		cursor := ""
+		for range 10 {
+			feed, err := uc.Execute(ctx, "grace", unreadOnly, cursor, limit)
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if feed.UnreadCount != 4 {
+				t.Errorf("UnreadCount = %d, want 2 unread notifications and 2 invitations", feed.UnreadCount)
+			}
+			for _, item := range feed.Items {
+				if item.Invitation != nil {
+					ids = append(ids, item.Invitation.ID)
+				} else {
+					ids = append(ids, item.Notification.ID)
+				}
+			}
+			if !feed.Page.HasMore {
+				return ids
+			}
+			cursor = feed.Page.NextCursor
+		}
+		t.Fatal("the feed never ended")
+		return nil
+	}
+
+	want := []string{"n3", "i2", "n2", "i1", "n1"}
+	if got := ids(false, 20); !slices.Equal(got, want) {
+		t.Errorf("feed = %v, want %v", got, want)
+	}
+	if got := ids(false, 2); !slices.Equal(got, want) {
+		t.Errorf("feed in pages of 2 = %v, want %v", got, want)
+	}
+	if got := ids(true, 2); !slices.Equal(got, []string{"n3", "i2", "i1", "n1"}) {
+		t.Errorf("unread feed = %v, want the read notification left out and the invitations kept", got)
+	}
+}
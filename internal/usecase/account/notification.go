@@ -0,0 +1,98 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// Inbox is a page of the user's notifications with the count of all their unread ones
+type Inbox struct {
+	Notifications []*entity.InboxNotification
+	UnreadCount   int64
+	Page          pagination.Meta
+}
+
+type ListNotificationsUseCase struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewListNotificationsUseCase(notificationRepo repository.NotificationRepository) *ListNotificationsUseCase {
+	return &ListNotificationsUseCase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Execute returns a page of the user's notifications, newest first
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, userID, cursor string, limit int) (*Inbox, error) {
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	notifications, err := uc.notificationRepo.List(ctx, userID, false, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	notifications, page, err := pagination.Page(notifications, limit, func(n *entity.InboxNotification) (string, error) {
+		return pagination.Encode(pagination.TimeKey{Time: n.CreatedAt, ID: n.ID})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := uc.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &Inbox{Notifications: notifications, UnreadCount: unread, Page: page}, nil
+}
+
+type UnreadCountsUseCase struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewUnreadCountsUseCase(notificationRepo repository.NotificationRepository) *UnreadCountsUseCase {
+	return &UnreadCountsUseCase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Execute returns the user's unread notification counts by room ID; rooms with none are left out
+func (uc *UnreadCountsUseCase) Execute(ctx context.Context, userID string) (map[string]int64, error) {
+	return uc.notificationRepo.CountUnreadByRoom(ctx, userID)
+}
+
+type ReadNotificationUseCase struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewReadNotificationUseCase(notificationRepo repository.NotificationRepository) *ReadNotificationUseCase {
+	return &ReadNotificationUseCase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Execute marks one of the user's notifications read; reading it again changes nothing
+func (uc *ReadNotificationUseCase) Execute(ctx context.Context, userID, notificationID string) error {
+	return uc.notificationRepo.MarkRead(ctx, userID, notificationID, time.Now())
+}
+
+type ReadAllNotificationsUseCase struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewReadAllNotificationsUseCase(notificationRepo repository.NotificationRepository) *ReadAllNotificationsUseCase {
+	return &ReadAllNotificationsUseCase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Execute marks all of the user's notifications read
+func (uc *ReadAllNotificationsUseCase) Execute(ctx context.Context, userID string) error {
+	return uc.notificationRepo.MarkAllRead(ctx, userID, time.Now())
+}
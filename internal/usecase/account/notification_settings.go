@@ -0,0 +1,106 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+type GetNotificationSettingsUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewGetNotificationSettingsUseCase(userRepo repository.UserRepository) *GetNotificationSettingsUseCase {
+	return &GetNotificationSettingsUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute returns which notifications the user wants
+func (uc *GetNotificationSettingsUseCase) Execute(ctx context.Context, userID string) (entity.NotificationSettings, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return entity.NotificationSettings{}, err
+	}
+	return user.NotificationSettings, nil
+}
+
+type UpdateNotificationSettingsUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewUpdateNotificationSettingsUseCase(userRepo repository.UserRepository) *UpdateNotificationSettingsUseCase {
+	return &UpdateNotificationSettingsUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute turns groups of notifications on or off and returns the resulting settings
+// The notifier consults them before sending anything to the user
+func (uc *UpdateNotificationSettingsUseCase) Execute(ctx context.Context, userID string, update entity.NotificationSettingsUpdate) (entity.NotificationSettings, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return entity.NotificationSettings{}, err
+	}
+
+	if err := user.UpdateNotificationSettings(update); err != nil {
+		return entity.NotificationSettings{}, err
+	}
+	if err := uc.userRepo.UpdateNotificationSettings(ctx, user); err != nil {
+		return entity.NotificationSettings{}, err
+	}
+	return user.NotificationSettings, nil
+}
+
+type SnoozeNotificationsUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewSnoozeNotificationsUseCase(userRepo repository.UserRepository) *SnoozeNotificationsUseCase {
+	return &SnoozeNotificationsUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute holds back the user's pushes until the given time and returns the resulting settings
+// Notifications arriving meanwhile still reach the inbox
+func (uc *SnoozeNotificationsUseCase) Execute(ctx context.Context, userID string, until time.Time) (entity.NotificationSettings, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return entity.NotificationSettings{}, err
+	}
+
+	if err := user.SnoozeNotifications(until, time.Now()); err != nil {
+		return entity.NotificationSettings{}, err
+	}
+	if err := uc.userRepo.UpdateNotificationSettings(ctx, user); err != nil {
+		return entity.NotificationSettings{}, err
+	}
+	return user.NotificationSettings, nil
+}
+
+type UnsnoozeNotificationsUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewUnsnoozeNotificationsUseCase(userRepo repository.UserRepository) *UnsnoozeNotificationsUseCase {
+	return &UnsnoozeNotificationsUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute ends the user's snooze, if any, and returns the resulting settings
+func (uc *UnsnoozeNotificationsUseCase) Execute(ctx context.Context, userID string) (entity.NotificationSettings, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return entity.NotificationSettings{}, err
+	}
+
+	user.UnsnoozeNotifications()
+	if err := uc.userRepo.UpdateNotificationSettings(ctx, user); err != nil {
+		return entity.NotificationSettings{}, err
+	}
+	return user.NotificationSettings, nil
+}
@@ -0,0 +1,107 @@
+package account
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+type GetProfileUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewGetProfileUseCase(userRepo repository.UserRepository) *GetProfileUseCase {
+	return &GetProfileUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute returns the user's own profile
+func (uc *GetProfileUseCase) Execute(ctx context.Context, userID string) (*entity.User, error) {
+	return uc.userRepo.GetByID(ctx, userID)
+}
+
+type UpdateProfileUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewUpdateProfileUseCase(userRepo repository.UserRepository) *UpdateProfileUseCase {
+	return &UpdateProfileUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute applies a partial profile update and returns the updated user
+func (uc *UpdateProfileUseCase) Execute(ctx context.Context, userID string, update entity.ProfileUpdate) (*entity.User, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := entity.NormalizeNickname(user.Nickname)
+	if err := user.UpdateProfile(update); err != nil {
+		return nil, err
+	}
+
+	// Changing only the case or width of one's own nickname is allowed
+	if entity.NormalizeNickname(user.Nickname) != previous {
+		if taken, err := uc.userRepo.IsNicknameTaken(ctx, user.Nickname); err != nil {
+			return nil, err
+		} else if taken {
+			return nil, entity.ErrNicknameTaken
+		}
+	}
+
+	if err := uc.userRepo.UpdateProfile(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+type GetPublicProfileUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewGetPublicProfileUseCase(userRepo repository.UserRepository) *GetPublicProfileUseCase {
+	return &GetPublicProfileUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute returns another user's profile
+// Accounts pending deletion are hidden as if they no longer existed
+func (uc *GetPublicProfileUseCase) Execute(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsDeletionScheduled() {
+		return nil, entity.ErrUserNotFound
+	}
+	return user, nil
+}
+
+type CheckNicknameUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewCheckNicknameUseCase(userRepo repository.UserRepository) *CheckNicknameUseCase {
+	return &CheckNicknameUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute reports whether the nickname is valid and not yet taken
+// The answer is advisory; signup and profile updates re-check atomically
+func (uc *CheckNicknameUseCase) Execute(ctx context.Context, nickname string) (bool, error) {
+	if err := entity.ValidateNickname(nickname); err != nil {
+		return false, err
+	}
+
+	taken, err := uc.userRepo.IsNicknameTaken(ctx, nickname)
+	if err != nil {
+		return false, err
+	}
+	return !taken, nil
+}
@@ -0,0 +1,71 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+const (
+	minSearchQueryLength = 2
+	maxSearchQueryLength = 50
+
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 50
+)
+
+var ErrInvalidSearchQuery = errors.New("search query must be between 2 and 50 characters")
+
+type SearchUsersUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewSearchUsersUseCase(userRepo repository.UserRepository) *SearchUsersUseCase {
+	return &SearchUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute finds users whose nickname starts with query, skipping the searching user and users they blocked
+// Emails are only matched when the query contains '@', so addresses cannot be enumerated by a few letters
+// hasMore reports whether another page follows at offset+limit
+func (uc *SearchUsersUseCase) Execute(ctx context.Context, userID, query string, offset, limit int) (users []*entity.User, hasMore bool, err error) {
+	query = strings.TrimSpace(query)
+	if n := utf8.RuneCountInString(query); n < minSearchQueryLength || n > maxSearchQueryLength {
+		return nil, false, ErrInvalidSearchQuery
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+
+	search := repository.UserSearch{
+		NicknamePrefix: query,
+		ViewerID:       userID,
+		Offset:         offset,
+		// One extra row tells whether there is a next page
+		Limit: limit + 1,
+	}
+	if strings.Contains(query, "@") {
+		search.EmailPrefix = query
+	}
+
+	users, err = uc.userRepo.Search(ctx, search)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(users) > limit {
+		return users[:limit], true, nil
+	}
+	return users, false, nil
+}
@@ -0,0 +1,46 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+type ListSessionsUseCase struct {
+	tokenRepo repository.RefreshTokenRepository
+}
+
+func NewListSessionsUseCase(tokenRepo repository.RefreshTokenRepository) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Execute returns the devices the user is currently signed in on
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, userID string) ([]*entity.Session, error) {
+	return uc.tokenRepo.ListSessions(ctx, userID, time.Now())
+}
+
+type RevokeSessionUseCase struct {
+	tokenRepo repository.RefreshTokenRepository
+}
+
+func NewRevokeSessionUseCase(tokenRepo repository.RefreshTokenRepository) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Execute signs the device out: its refresh and access tokens stop working immediately
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, userID, sessionID string) error {
+	revoked, err := uc.tokenRepo.RevokeSession(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return entity.ErrSessionNotFound
+	}
+	return nil
+}
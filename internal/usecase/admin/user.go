@@ -0,0 +1,167 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+const (
+	DefaultUserListLimit = 50
+	MaxUserListLimit     = 200
+)
+
+type ListUsersUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewListUsersUseCase(userRepo repository.UserRepository) *ListUsersUseCase {
+	return &ListUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute lists users for operators, newest first
+// hasMore reports whether another page follows at filter.Offset+filter.Limit
+func (uc *ListUsersUseCase) Execute(ctx context.Context, filter repository.UserFilter) (users []*entity.User, hasMore bool, err error) {
+	filter.Query = strings.TrimSpace(filter.Query)
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultUserListLimit
+	}
+	if filter.Limit > MaxUserListLimit {
+		filter.Limit = MaxUserListLimit
+	}
+
+	limit := filter.Limit
+	// One extra row tells whether there is a next page
+	filter.Limit++
+
+	users, err = uc.userRepo.List(ctx, filter)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(users) > limit {
+		return users[:limit], true, nil
+	}
+	return users, false, nil
+}
+
+type SuspendUserUseCase struct {
+	userRepo   repository.UserRepository
+	tokenRepo  repository.RefreshTokenRepository
+	transactor repository.Transactor
+	auditor    service.Auditor
+}
+
+func NewSuspendUserUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.RefreshTokenRepository,
+	transactor repository.Transactor,
+	auditor service.Auditor,
+) *SuspendUserUseCase {
+	return &SuspendUserUseCase{
+		userRepo:   userRepo,
+		tokenRepo:  tokenRepo,
+		transactor: transactor,
+		auditor:    auditor,
+	}
+}
+
+// Execute locks the account and signs it out everywhere
+// Revoking its sessions also ends the access tokens issued with them, which the JWT middleware
+// checks on every request
+func (uc *SuspendUserUseCase) Execute(ctx context.Context, adminID, userID, reason string) (*entity.User, error) {
+	if adminID == userID {
+		return nil, entity.ErrCannotSuspendSelf
+	}
+	reason = strings.TrimSpace(reason)
+	if err := entity.ValidateSuspensionReason(reason); err != nil {
+		return nil, err
+	}
+
+	// A suspended account must not keep a session it could refresh
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Suspend(ctx, userID, reason, time.Now()); err != nil {
+			return err
+		}
+		if err := uc.tokenRepo.RevokeAllForUser(ctx, userID); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Audited once committed, outside the transaction, which a failed append would otherwise spoil
+	uc.auditor.Record(ctx, entity.AuditEntry{
+		Action:     entity.AuditUserSuspended,
+		ActorID:    adminID,
+		TargetType: entity.AuditTargetUser,
+		TargetID:   userID,
+		Details:    map[string]string{"reason": reason},
+	})
+	return uc.userRepo.GetByID(ctx, userID)
+}
+
+type ReinstateUserUseCase struct {
+	userRepo repository.UserRepository
+	auditor  service.Auditor
+}
+
+func NewReinstateUserUseCase(userRepo repository.UserRepository, auditor service.Auditor) *ReinstateUserUseCase {
+	return &ReinstateUserUseCase{
+		userRepo: userRepo,
+		auditor:  auditor,
+	}
+}
+
+// Execute lifts a suspension; reinstating an active user is harmless
+func (uc *ReinstateUserUseCase) Execute(ctx context.Context, adminID, userID string) (*entity.User, error) {
+	if err := uc.userRepo.Reinstate(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	uc.auditor.Record(ctx, entity.AuditEntry{
+		Action:     entity.AuditUserReinstated,
+		ActorID:    adminID,
+		TargetType: entity.AuditTargetUser,
+		TargetID:   userID,
+	})
+	return uc.userRepo.GetByID(ctx, userID)
+}
+
+type ForceLogoutUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.RefreshTokenRepository
+}
+
+func NewForceLogoutUseCase(userRepo repository.UserRepository, tokenRepo repository.RefreshTokenRepository) *ForceLogoutUseCase {
+	return &ForceLogoutUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Execute signs the user out of every device without locking the account
+// As with a suspension, the access tokens of the revoked sessions stop working immediately
+func (uc *ForceLogoutUseCase) Execute(ctx context.Context, adminID, userID string) error {
+	if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+		return err
+	}
+	if err := uc.tokenRepo.RevokeAllForUser(ctx, userID); err != nil {
+		return err
+	}
+
+	slog.Info("User force-logged out", "user_id", userID, "admin_id", adminID)
+	return nil
+}
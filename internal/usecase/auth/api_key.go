@@ -0,0 +1,281 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// apiKeyPrefix marks our keys so leaked secrets are easy to recognize in scanners
+	apiKeyPrefix = "ptk_"
+	// apiKeyDisplayLength is how much of the key is kept in clear for listings
+	apiKeyDisplayLength = len(apiKeyPrefix) + 8
+	// apiKeyTouchInterval throttles last_used_at writes for busy keys
+	apiKeyTouchInterval = time.Minute
+)
+
+// newAPIKeySecret generates a key with its display prefix and stored hash
+func newAPIKeySecret() (raw, prefix, hash string, err error) {
+	token, _, err := newOpaqueToken()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	raw = apiKeyPrefix + token
+	return raw, raw[:apiKeyDisplayLength], hashOpaqueToken(raw), nil
+}
+
+type CreateAPIKeyUseCase struct {
+	keyRepo repository.APIKeyRepository
+}
+
+func NewCreateAPIKeyUseCase(keyRepo repository.APIKeyRepository) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		keyRepo: keyRepo,
+	}
+}
+
+// Execute creates a key and returns its plaintext, which is never retrievable again
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, name, createdBy string) (*entity.APIKey, string, error) {
+	key, err := entity.NewAPIKey(name, createdBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	raw, prefix, hash, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key.ID = uuid.New().String()
+	key.Prefix = prefix
+	key.KeyHash = hash
+
+	if err := uc.keyRepo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+	return key, raw, nil
+}
+
+type ListAPIKeysUseCase struct {
+	keyRepo repository.APIKeyRepository
+}
+
+func NewListAPIKeysUseCase(keyRepo repository.APIKeyRepository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		keyRepo: keyRepo,
+	}
+}
+
+// Execute returns every key, including revoked ones
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context) ([]*entity.APIKey, error) {
+	return uc.keyRepo.List(ctx)
+}
+
+type RotateAPIKeyUseCase struct {
+	keyRepo repository.APIKeyRepository
+}
+
+func NewRotateAPIKeyUseCase(keyRepo repository.APIKeyRepository) *RotateAPIKeyUseCase {
+	return &RotateAPIKeyUseCase{
+		keyRepo: keyRepo,
+	}
+}
+
+// Execute replaces the key's secret; the previous secret stops working immediately
+func (uc *RotateAPIKeyUseCase) Execute(ctx context.Context, id string) (*entity.APIKey, string, error) {
+	raw, prefix, hash, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := uc.keyRepo.Rotate(ctx, id, prefix, hash); err != nil {
+		return nil, "", err
+	}
+
+	key, err := uc.keyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, raw, nil
+}
+
+type RevokeAPIKeyUseCase struct {
+	keyRepo repository.APIKeyRepository
+}
+
+func NewRevokeAPIKeyUseCase(keyRepo repository.APIKeyRepository) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		keyRepo: keyRepo,
+	}
+}
+
+// Execute permanently disables the key
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, id string) error {
+	return uc.keyRepo.Revoke(ctx, id)
+}
+
+type AuthenticateAPIKeyUseCase struct {
+	keyRepo repository.APIKeyRepository
+}
+
+func NewAuthenticateAPIKeyUseCase(keyRepo repository.APIKeyRepository) *AuthenticateAPIKeyUseCase {
+	return &AuthenticateAPIKeyUseCase{
+		keyRepo: keyRepo,
+	}
+}
+
+// Execute resolves a presented service key to its active record
+// Personal keys are rejected so they never reach the service API
+func (uc *AuthenticateAPIKeyUseCase) Execute(ctx context.Context, rawKey string) (*entity.APIKey, error) {
+	key, err := activeAPIKey(ctx, uc.keyRepo, rawKey)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsPersonal() {
+		return nil, entity.ErrInvalidAPIKey
+	}
+
+	touchAPIKey(ctx, uc.keyRepo, key)
+	return key, nil
+}
+
+// activeAPIKey looks up a presented key, answering ErrInvalidAPIKey for unknown and revoked ones
+func activeAPIKey(ctx context.Context, keyRepo repository.APIKeyRepository, rawKey string) (*entity.APIKey, error) {
+	key, err := keyRepo.GetByHash(ctx, hashOpaqueToken(rawKey))
+	if err != nil {
+		if errors.Is(err, entity.ErrAPIKeyNotFound) {
+			return nil, entity.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+	if key.IsRevoked() {
+		return nil, entity.ErrInvalidAPIKey
+	}
+	return key, nil
+}
+
+// touchAPIKey records that the key was used, at most once per apiKeyTouchInterval
+func touchAPIKey(ctx context.Context, keyRepo repository.APIKeyRepository, key *entity.APIKey) {
+	now := time.Now()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := keyRepo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			// Usage tracking must never block an authenticated call
+			slog.WarnContext(ctx, "Failed to record api key usage", "api_key_id", key.ID, "error", err)
+		}
+	}
+}
+
+type CreateUserAPIKeyUseCase struct {
+	keyRepo repository.APIKeyRepository
+}
+
+func NewCreateUserAPIKeyUseCase(keyRepo repository.APIKeyRepository) *CreateUserAPIKeyUseCase {
+	return &CreateUserAPIKeyUseCase{
+		keyRepo: keyRepo,
+	}
+}
+
+// Execute creates a personal key of the user and returns its plaintext, which is never retrievable again
+func (uc *CreateUserAPIKeyUseCase) Execute(ctx context.Context, userID, name string, scopes []entity.APIKeyScope) (*entity.APIKey, string, error) {
+	key, err := entity.NewUserAPIKey(name, userID, scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	raw, prefix, hash, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key.ID = uuid.New().String()
+	key.Prefix = prefix
+	key.KeyHash = hash
+
+	if err := uc.keyRepo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+	return key, raw, nil
+}
+
+type ListUserAPIKeysUseCase struct {
+	keyRepo repository.APIKeyRepository
+}
+
+func NewListUserAPIKeysUseCase(keyRepo repository.APIKeyRepository) *ListUserAPIKeysUseCase {
+	return &ListUserAPIKeysUseCase{
+		keyRepo: keyRepo,
+	}
+}
+
+// Execute returns the user's personal keys, including revoked ones
+func (uc *ListUserAPIKeysUseCase) Execute(ctx context.Context, userID string) ([]*entity.APIKey, error) {
+	return uc.keyRepo.ListByUser(ctx, userID)
+}
+
+type RevokeUserAPIKeyUseCase struct {
+	keyRepo repository.APIKeyRepository
+}
+
+func NewRevokeUserAPIKeyUseCase(keyRepo repository.APIKeyRepository) *RevokeUserAPIKeyUseCase {
+	return &RevokeUserAPIKeyUseCase{
+		keyRepo: keyRepo,
+	}
+}
+
+// Execute permanently disables one of the user's personal keys
+// Keys of anyone else answer ErrAPIKeyNotFound so their IDs cannot be probed
+func (uc *RevokeUserAPIKeyUseCase) Execute(ctx context.Context, userID, id string) error {
+	key, err := uc.keyRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if key.UserID != userID {
+		return entity.ErrAPIKeyNotFound
+	}
+	return uc.keyRepo.Revoke(ctx, id)
+}
+
+type AuthenticateUserAPIKeyUseCase struct {
+	keyRepo  repository.APIKeyRepository
+	userRepo repository.UserRepository
+}
+
+func NewAuthenticateUserAPIKeyUseCase(keyRepo repository.APIKeyRepository, userRepo repository.UserRepository) *AuthenticateUserAPIKeyUseCase {
+	return &AuthenticateUserAPIKeyUseCase{
+		keyRepo:  keyRepo,
+		userRepo: userRepo,
+	}
+}
+
+// Execute resolves a presented personal key to its active record and owner
+// Service keys, and keys of suspended, guest or deletion-scheduled accounts, are rejected
+func (uc *AuthenticateUserAPIKeyUseCase) Execute(ctx context.Context, rawKey string) (*entity.APIKey, *entity.User, error) {
+	key, err := activeAPIKey(ctx, uc.keyRepo, rawKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !key.IsPersonal() {
+		return nil, nil, entity.ErrInvalidAPIKey
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, key.UserID)
+	if err != nil {
+		if errors.Is(err, entity.ErrUserNotFound) {
+			return nil, nil, entity.ErrInvalidAPIKey
+		}
+		return nil, nil, err
+	}
+	if user.IsSuspended() || user.IsDeletionScheduled() || user.IsGuest() {
+		return nil, nil, entity.ErrInvalidAPIKey
+	}
+
+	touchAPIKey(ctx, uc.keyRepo, key)
+	return key, user, nil
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+// memoryAPIKeys is an in-memory APIKeyRepository
+type memoryAPIKeys struct {
+	repository.APIKeyRepository
+	byID map[string]*entity.APIKey
+}
+
+func (f *memoryAPIKeys) Create(_ context.Context, key *entity.APIKey) error {
+	f.byID[key.ID] = key
+	return nil
+}
+
+func (f *memoryAPIKeys) GetByID(_ context.Context, id string) (*entity.APIKey, error) {
+	if key, ok := f.byID[id]; ok {
+		return key, nil
+	}
+	return nil, entity.ErrAPIKeyNotFound
+}
+
+func (f *memoryAPIKeys) GetByHash(_ context.Context, hash string) (*entity.APIKey, error) {
+	for _, key := range f.byID {
+		if key.KeyHash == hash {
+			return key, nil
+		}
+	}
+	return nil, entity.ErrAPIKeyNotFound
+}
+
+func (f *memoryAPIKeys) Revoke(_ context.Context, id string) error {
+	now := time.Now()
+	f.byID[id].RevokedAt = &now
+	return nil
+}
+
+func (f *memoryAPIKeys) TouchLastUsed(_ context.Context, id string, at time.Time) error {
+	f.byID[id].LastUsedAt = &at
+	return nil
+}
+
+func TestUserAPIKeyLifecycle(t *testing.T) {
+	ctx := context.Background()
+	keys := &memoryAPIKeys{byID: map[string]*entity.APIKey{}}
+	grace := &entity.User{ID: "grace", Email: "grace@example.com", Role: entity.RoleUser}
+	authenticate := NewAuthenticateUserAPIKeyUseCase(keys, usersByID{user: grace})
+
+	if _, _, err := NewCreateUserAPIKeyUseCase(keys).Execute(ctx, "grace", "sync", []entity.APIKeyScope{"admin"}); !errors.Is(err, entity.ErrInvalidAPIKeyScopes) {
+		t.Fatalf("Create with an unknown scope: err = %v, want ErrInvalidAPIKeyScopes", err)
+	}
+
+	key, raw, err := NewCreateUserAPIKeyUseCase(keys).Execute(ctx, "grace", "sync", []entity.APIKeyScope{entity.APIKeyScopeRead})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if key.UserID != "grace" || key.KeyHash == raw || raw[:len(key.Prefix)] != key.Prefix {
+		t.Errorf("key = %+v, want grace's key storing only a hash and the prefix of %q", key, raw)
+	}
+
+	got, user, err := authenticate.Execute(ctx, raw)
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if got.ID != key.ID || user.ID != "grace" {
+		t.Errorf("authenticated key %s as %s, want %s as grace", got.ID, user.ID, key.ID)
+	}
+	if !got.Allows(entity.APIKeyScopeRead) || got.Allows(entity.APIKeyScopeWrite) {
+		t.Errorf("scopes = %v, want read only", got.Scopes)
+	}
+	if got.LastUsedAt == nil {
+		t.Error("last used time was not recorded")
+	}
+	if _, err := NewAuthenticateAPIKeyUseCase(keys).Execute(ctx, raw); !errors.Is(err, entity.ErrInvalidAPIKey) {
+		t.Errorf("service authentication with a personal key: err = %v, want ErrInvalidAPIKey", err)
+	}
+
+	if err := NewRevokeUserAPIKeyUseCase(keys).Execute(ctx, "john", key.ID); !errors.Is(err, entity.ErrAPIKeyNotFound) {
+		t.Errorf("Revoke by another user: err = %v, want ErrAPIKeyNotFound", err)
+	}
+	if err := NewRevokeUserAPIKeyUseCase(keys).Execute(ctx, "grace", key.ID); err != nil {
+		t.Fatalf("Revoke: %v", err)
+	}
+	if _, _, err := authenticate.Execute(ctx, raw); !errors.Is(err, entity.ErrInvalidAPIKey) {
+		t.Errorf("Authenticate after revoking: err = %v, want ErrInvalidAPIKey", err)
+	}
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidVerificationToken = errors.New("invalid or expired email verification token")
+	ErrEmailAlreadyVerified     = errors.New("email is already verified")
+)
+
+type SendEmailVerificationUseCase struct {
+	userRepo   repository.UserRepository
+	verifyRepo repository.EmailVerificationRepository
+	mailer     service.Mailer
+	webURL     string
+	ttl        time.Duration
+}
+
+func NewSendEmailVerificationUseCase(
+	userRepo repository.UserRepository,
+	verifyRepo repository.EmailVerificationRepository,
+	mailer service.Mailer,
+	webURL string,
+	ttl time.Duration,
+) *SendEmailVerificationUseCase {
+	return &SendEmailVerificationUseCase{
+		userRepo:   userRepo,
+		verifyRepo: verifyRepo,
+		mailer:     mailer,
+		webURL:     webURL,
+		ttl:        ttl,
+	}
+}
+
+// Execute (re)sends a verification link to the user's current email
+func (uc *SendEmailVerificationUseCase) Execute(ctx context.Context, userID string) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.IsEmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+	if user.Email == "" {
+		return entity.ErrInvalidEmail
+	}
+
+	return uc.send(ctx, user)
+}
+
+// send stores a new token for the user's email and mails the link
+// Earlier tokens stay valid until they expire, so a delayed first email still works
+func (uc *SendEmailVerificationUseCase) send(ctx context.Context, user *entity.User) error {
+	token, hash, err := newOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := uc.verifyRepo.Create(ctx, &entity.EmailVerificationToken{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		Email:     user.Email,
+		TokenHash: hash,
+		ExpiresAt: now.Add(uc.ttl),
+		CreatedAt: now,
+	}); err != nil {
+		return fmt.Errorf("failed to store email verification token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", uc.webURL, url.QueryEscape(token))
+	return uc.mailer.Send(ctx, service.Email{
+		To:      user.Email,
+		Subject: "[PrayTogether] 이메일 인증 안내",
+		Body: fmt.Sprintf("%s님, PrayTogether에 가입해 주셔서 감사합니다.\n\n아래 링크를 눌러 이메일 인증을 완료해 주세요. 링크는 %d시간 동안 유효합니다.\n\n%s",
+			user.Nickname, int(uc.ttl.Hours()), link),
+	})
+}
+
+type VerifyEmailUseCase struct {
+	userRepo   repository.UserRepository
+	verifyRepo repository.EmailVerificationRepository
+}
+
+func NewVerifyEmailUseCase(
+	userRepo repository.UserRepository,
+	verifyRepo repository.EmailVerificationRepository,
+) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		userRepo:   userRepo,
+		verifyRepo: verifyRepo,
+	}
+}
+
+// Execute consumes the token and marks the user's email as verified
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, token string) error {
+	record, err := uc.verifyRepo.GetByTokenHash(ctx, hashOpaqueToken(token))
+	if err != nil {
+		if errors.Is(err, entity.ErrEmailVerificationTokenNotFound) {
+			return ErrInvalidVerificationToken
+		}
+		return err
+	}
+	if !record.IsUsable(time.Now()) {
+		return ErrInvalidVerificationToken
+	}
+
+	// The token only proves ownership of the address it was sent to
+	user, err := uc.userRepo.GetByID(ctx, record.UserID)
+	if err != nil {
+		return err
+	}
+	if user.Email != record.Email {
+		return ErrInvalidVerificationToken
+	}
+
+	marked, err := uc.verifyRepo.MarkUsed(ctx, record.ID)
+	if err != nil {
+		return err
+	}
+	if !marked {
+		return ErrInvalidVerificationToken
+	}
+
+	return uc.userRepo.MarkEmailVerified(ctx, user.ID)
+}
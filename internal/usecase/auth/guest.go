@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type GuestLoginUseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewGuestLoginUseCase(userRepo repository.UserRepository) *GuestLoginUseCase {
+	return &GuestLoginUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute creates an anonymous guest user
+func (uc *GuestLoginUseCase) Execute(ctx context.Context) (*entity.User, error) {
+	id := uuid.New().String()
+	user := entity.NewGuestUser(strings.ReplaceAll(id, "-", "")[:6])
+	user.ID = id
+
+	if err := uc.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+type UpgradeGuestUseCase struct {
+	userRepo     repository.UserRepository
+	tokenRepo    repository.RefreshTokenRepository
+	verification *SendEmailVerificationUseCase
+}
+
+func NewUpgradeGuestUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.RefreshTokenRepository,
+	verification *SendEmailVerificationUseCase,
+) *UpgradeGuestUseCase {
+	return &UpgradeGuestUseCase{
+		userRepo:     userRepo,
+		tokenRepo:    tokenRepo,
+		verification: verification,
+	}
+}
+
+// Execute converts the guest into a full account under the same user ID,
+// so everything the guest created stays with the account
+// Guest sessions are revoked; the caller issues tokens with the new role
+func (uc *UpgradeGuestUseCase) Execute(ctx context.Context, userID, email, nickname, password string) (*entity.User, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsGuest() {
+		return nil, entity.ErrNotGuest
+	}
+
+	// Same validation as signup
+	input, err := entity.NewUser(email, nickname, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.userRepo.GetByEmail(ctx, input.Email); err == nil {
+		return nil, entity.ErrEmailAlreadyExists
+	} else if !errors.Is(err, entity.ErrUserNotFound) {
+		return nil, err
+	}
+
+	if taken, err := uc.userRepo.IsNicknameTaken(ctx, input.Nickname); err != nil {
+		return nil, err
+	} else if taken && entity.NormalizeNickname(input.Nickname) != entity.NormalizeNickname(user.Nickname) {
+		return nil, entity.ErrNicknameTaken
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user.Email = input.Email
+	user.Nickname = input.Nickname
+	user.PasswordHash = string(hash)
+
+	if err := uc.userRepo.UpgradeGuest(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if err := uc.tokenRepo.RevokeAllForUser(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.verification.send(ctx, user); err != nil {
+		slog.ErrorContext(ctx, "Failed to send verification email", "user_id", user.ID, "error", err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrInvalidCredentials = errors.New("invalid email or password")
+
+// dummyHash is compared against when the user does not exist so both paths take similar time
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("pray-together-dummy-password"), bcrypt.DefaultCost)
+
+type LoginUseCase struct {
+	userRepo repository.UserRepository
+	auditor  service.Auditor
+}
+
+func NewLoginUseCase(userRepo repository.UserRepository, auditor service.Auditor) *LoginUseCase {
+	return &LoginUseCase{
+		userRepo: userRepo,
+		auditor:  auditor,
+	}
+}
+
+// Execute verifies the credentials and returns the authenticated user
+// Logins to existing accounts are audited, failed ones too; guesses at unknown emails are not
+func (uc *LoginUseCase) Execute(ctx context.Context, email, password string) (*entity.User, error) {
+	user, err := uc.userRepo.GetByEmail(ctx, entity.NormalizeEmail(email))
+	if err != nil {
+		if errors.Is(err, entity.ErrUserNotFound) {
+			_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	// Social-only accounts have no password to log in with
+	if !user.HasPassword() {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return nil, ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		uc.auditor.Record(ctx, entity.AuditEntry{Action: entity.AuditLoginFailed, TargetType: entity.AuditTargetUser, TargetID: user.ID})
+		return nil, ErrInvalidCredentials
+	}
+
+	uc.auditor.Record(ctx, entity.AuditEntry{Action: entity.AuditLogin, ActorID: user.ID, TargetType: entity.AuditTargetUser, TargetID: user.ID})
+	return user, nil
+}
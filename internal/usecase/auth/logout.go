@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+type LogoutUseCase struct {
+	blacklistRepo repository.TokenBlacklistRepository
+	tokenRepo     repository.RefreshTokenRepository
+	issuer        TokenIssuer
+}
+
+func NewLogoutUseCase(blacklistRepo repository.TokenBlacklistRepository, tokenRepo repository.RefreshTokenRepository, issuer TokenIssuer) *LogoutUseCase {
+	return &LogoutUseCase{
+		blacklistRepo: blacklistRepo,
+		tokenRepo:     tokenRepo,
+		issuer:        issuer,
+	}
+}
+
+// Execute blacklists the current access token and, when given, revokes the refresh token's family
+func (uc *LogoutUseCase) Execute(ctx context.Context, userID, accessTokenID string, accessExpiresAt time.Time, refreshToken string) error {
+	if accessTokenID != "" {
+		if err := uc.blacklistRepo.Revoke(ctx, accessTokenID, userID, accessExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	tokenID, err := uc.issuer.ParseRefreshToken(refreshToken)
+	if err != nil {
+		// An invalid or expired refresh token has nothing left to revoke
+		return nil
+	}
+
+	record, err := uc.tokenRepo.GetByID(ctx, tokenID)
+	if err != nil {
+		if errors.Is(err, entity.ErrRefreshTokenNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// Never let one user revoke another user's session
+	if record.UserID != userID {
+		return nil
+	}
+
+	return uc.tokenRepo.RevokeFamily(ctx, record.FamilyID)
+}
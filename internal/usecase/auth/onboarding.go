@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+)
+
+// Onboarding places newly registered users in the configured default room
+// Without a default room it only creates the user
+type Onboarding struct {
+	defaultRoomID string
+	roomRepo      repository.RoomRepository
+	memberRepo    repository.RoomMemberRepository
+	transactor    repository.Transactor
+	notifier      service.Notifier
+	topics        *room.Topics
+}
+
+func NewOnboarding(
+	defaultRoomID string,
+	roomRepo repository.RoomRepository,
+	memberRepo repository.RoomMemberRepository,
+	transactor repository.Transactor,
+	notifier service.Notifier,
+	topics *room.Topics,
+) *Onboarding {
+	return &Onboarding{
+		defaultRoomID: defaultRoomID,
+		roomRepo:      roomRepo,
+		memberRepo:    memberRepo,
+		transactor:    transactor,
+		notifier:      notifier,
+		topics:        topics,
+	}
+}
+
+// register stores the user with create and makes them a member of the default room in the
+// same transaction, then welcomes them once both are committed
+// A default room that is gone, archived or full is skipped so a misconfiguration never blocks signups
+func (o *Onboarding) register(ctx context.Context, user *entity.User, create func(ctx context.Context) error) error {
+	if o.defaultRoomID == "" {
+		return create(ctx)
+	}
+
+	var joined *entity.Room
+	err := o.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := create(ctx); err != nil {
+			return err
+		}
+		room, err := o.join(ctx, user.ID)
+		joined = room
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if joined != nil {
+		o.topics.Joined(ctx, joined.ID, user.ID)
+		n := entity.Notification{
+			Type:   entity.NotificationRoomWelcome,
+			Params: map[string]string{"room": joined.Name},
+			Data:   map[string]string{"room_id": joined.ID},
+		}
+		if err := o.notifier.Notify(ctx, []string{user.ID}, n); err != nil {
+			slog.ErrorContext(ctx, "Failed to send notification", "type", n.Type, "error", err)
+		}
+	}
+	return nil
+}
+
+// join adds the user to the default room and returns it, or nil when the room cannot take them
+func (o *Onboarding) join(ctx context.Context, userID string) (*entity.Room, error) {
+	room, err := o.roomRepo.GetByID(ctx, o.defaultRoomID)
+	if errors.Is(err, entity.ErrRoomNotFound) {
+		slog.WarnContext(ctx, "Default room not found", "room_id", o.defaultRoomID)
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get default room: %w", err)
+	}
+	if room.IsArchived() {
+		return nil, nil
+	}
+
+	member := &entity.RoomMember{RoomID: room.ID, UserID: userID, Role: entity.RoomRoleMember, JoinedAt: time.Now()}
+	if err := o.memberRepo.Add(ctx, member); err != nil {
+		if errors.Is(err, entity.ErrRoomFull) {
+			slog.WarnContext(ctx, "Default room is full", "room_id", room.ID)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to join default room: %w", err)
+	}
+	return room, nil
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/ratelimit"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrInvalidResetToken = errors.New("invalid or expired password reset token")
+
+type RequestPasswordResetUseCase struct {
+	userRepo  repository.UserRepository
+	resetRepo repository.PasswordResetRepository
+	mailer    service.Mailer
+	webURL    string
+	ttl       time.Duration
+	// perEmail bounds the reset emails sent to one address; nil when unlimited
+	perEmail *ratelimit.Window
+}
+
+// NewRequestPasswordResetUseCase sends at most emailLimit reset emails to an address every
+// emailWindow; a zero emailLimit leaves them unlimited
+func NewRequestPasswordResetUseCase(
+	userRepo repository.UserRepository,
+	resetRepo repository.PasswordResetRepository,
+	mailer service.Mailer,
+	webURL string,
+	ttl time.Duration,
+	emailLimit int,
+	emailWindow time.Duration,
+) *RequestPasswordResetUseCase {
+	uc := &RequestPasswordResetUseCase{
+		userRepo:  userRepo,
+		resetRepo: resetRepo,
+		mailer:    mailer,
+		webURL:    webURL,
+		ttl:       ttl,
+	}
+	if emailLimit > 0 {
+		uc.perEmail = ratelimit.NewWindow(emailLimit, emailWindow)
+	}
+	return uc
+}
+
+// Execute emails a single-use reset link when the email belongs to a user
+// It succeeds silently for unknown emails so accounts cannot be enumerated, and likewise
+// once the address has been sent its quota of reset emails
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, email string) error {
+	email = entity.NormalizeEmail(email)
+	// Counted before the lookup, so throttling does not reveal whether the account exists
+	if uc.perEmail != nil {
+		if ok, _ := uc.perEmail.Allow(email, time.Now()); !ok {
+			slog.WarnContext(ctx, "Password reset email throttled")
+			return nil
+		}
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, entity.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	token, hash, err := newOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := uc.resetRepo.Create(ctx, &entity.PasswordResetToken{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		TokenHash: hash,
+		ExpiresAt: now.Add(uc.ttl),
+		CreatedAt: now,
+	}); err != nil {
+		return fmt.Errorf("failed to store password reset token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", uc.webURL, url.QueryEscape(token))
+	err = uc.mailer.Send(ctx, service.Email{
+		To:      user.Email,
+		Subject: "[PrayTogether] 비밀번호 재설정 안내",
+		Body: fmt.Sprintf("%s님, 안녕하세요.\n\n아래 링크에서 비밀번호를 재설정해 주세요. 링크는 %d분 동안 한 번만 사용할 수 있습니다.\n\n%s\n\n요청하지 않으셨다면 이 메일을 무시하셔도 됩니다.",
+			user.Nickname, int(uc.ttl.Minutes()), link),
+	})
+	if err != nil {
+		// Don't reveal delivery failures to the caller; the user can simply retry
+		slog.ErrorContext(ctx, "Failed to send password reset email", "user_id", user.ID, "error", err)
+	}
+
+	return nil
+}
+
+type ResetPasswordUseCase struct {
+	userRepo   repository.UserRepository
+	resetRepo  repository.PasswordResetRepository
+	tokenRepo  repository.RefreshTokenRepository
+	transactor repository.Transactor
+}
+
+func NewResetPasswordUseCase(
+	userRepo repository.UserRepository,
+	resetRepo repository.PasswordResetRepository,
+	tokenRepo repository.RefreshTokenRepository,
+	transactor repository.Transactor,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userRepo:   userRepo,
+		resetRepo:  resetRepo,
+		tokenRepo:  tokenRepo,
+		transactor: transactor,
+	}
+}
+
+// Execute consumes the reset token, sets the new password and signs out every device
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, token, newPassword string) error {
+	// Validate first so a typo doesn't burn the single-use token
+	if err := entity.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	record, err := uc.resetRepo.GetByTokenHash(ctx, hashOpaqueToken(token))
+	if err != nil {
+		if errors.Is(err, entity.ErrPasswordResetTokenNotFound) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+	if !record.IsUsable(time.Now()) {
+		return ErrInvalidResetToken
+	}
+
+	// Hashed before the transaction, which then stays short
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// The token is only used up if the password change goes through
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		marked, err := uc.resetRepo.MarkUsed(ctx, record.ID)
+		if err != nil {
+			return err
+		}
+		if !marked {
+			return ErrInvalidResetToken
+		}
+
+		if err := uc.userRepo.UpdatePassword(ctx, record.UserID, string(hash)); err != nil {
+			return err
+		}
+		return uc.tokenRepo.RevokeAllForUser(ctx, record.UserID)
+	})
+}
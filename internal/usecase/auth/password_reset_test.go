@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+type fakeResets struct {
+	repository.PasswordResetRepository
+	created int
+}
+
+func (f *fakeResets) Create(context.Context, *entity.PasswordResetToken) error {
+	f.created++
+	return nil
+}
+
+// sentMail records the emails sent during a test
+type sentMail struct {
+	emails []service.Email
+}
+
+func (m *sentMail) Send(_ context.Context, email service.Email) error {
+	m.emails = append(m.emails, email)
+	return nil
+}
+
+func TestRequestPasswordResetThrottlesPerAddress(t *testing.T) {
+	users := &fakeUsers{byEmail: map[string]*entity.User{"kim@example.com": {ID: "u1", Email: "kim@example.com"}}}
+	resets := &fakeResets{}
+	mail := &sentMail{}
+	uc := NewRequestPasswordResetUseCase(users, resets, mail, "https://app.example.com", time.Hour, 2, time.Hour)
+	ctx := context.Background()
+
+	for _, email := range []string{"kim@example.com", " KIM@example.com", "kim@example.com"} {
+		if err := uc.Execute(ctx, email); err != nil {
+			t.Fatalf("Execute(%q): %v", email, err)
+		}
+	}
+	if len(mail.emails) != 2 || resets.created != 2 {
+		t.Errorf("sent %d emails and created %d tokens, want 2 of each for the address", len(mail.emails), resets.created)
+	}
+
+	// Unknown addresses are throttled the same way, and other addresses are unaffected
+	for range 3 {
+		if err := uc.Execute(ctx, "nobody@example.com"); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	}
+	users.byEmail["lee@example.com"] = &entity.User{ID: "u2", Email: "lee@example.com"}
+	if err := uc.Execute(ctx, "lee@example.com"); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(mail.emails) != 3 || mail.emails[2].To != "lee@example.com" {
+		t.Errorf("emails = %+v, want the third one sent to lee@example.com", mail.emails)
+	}
+}
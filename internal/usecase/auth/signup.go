@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type SignupUseCase struct {
+	userRepo     repository.UserRepository
+	verification *SendEmailVerificationUseCase
+	onboarding   *Onboarding
+}
+
+func NewSignupUseCase(userRepo repository.UserRepository, verification *SendEmailVerificationUseCase, onboarding *Onboarding) *SignupUseCase {
+	return &SignupUseCase{
+		userRepo:     userRepo,
+		verification: verification,
+		onboarding:   onboarding,
+	}
+}
+
+// Execute registers a new user with a bcrypt-hashed password and adds them to the default room, if any
+func (uc *SignupUseCase) Execute(ctx context.Context, email, nickname, password string) (*entity.User, error) {
+	// 1. 도메인 엔티티 생성 (입력 검증)
+	user, err := entity.NewUser(email, nickname, password)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 중복 체크 (unique index가 최종 보장)
+	if _, err := uc.userRepo.GetByEmail(ctx, user.Email); err == nil {
+		return nil, entity.ErrEmailAlreadyExists
+	} else if !errors.Is(err, entity.ErrUserNotFound) {
+		return nil, err
+	}
+
+	// 닉네임 중복 체크 (unique index가 최종 보장)
+	if taken, err := uc.userRepo.IsNicknameTaken(ctx, user.Nickname); err != nil {
+		return nil, err
+	} else if taken {
+		return nil, entity.ErrNicknameTaken
+	}
+
+	// 3. 패스워드 해싱
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// 4. ID 생성 및 저장
+	user.ID = uuid.New().String()
+	user.PasswordHash = string(hash)
+
+	err = uc.onboarding.register(ctx, user, func(ctx context.Context) error {
+		return uc.userRepo.Create(ctx, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. 인증 메일 발송 (실패해도 가입은 유지, 재발송 가능)
+	if err := uc.verification.send(ctx, user); err != nil {
+		slog.ErrorContext(ctx, "Failed to send verification email", "user_id", user.ID, "error", err)
+	}
+
+	return user, nil
+}
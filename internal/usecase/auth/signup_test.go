@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+)
+
+// signupUsers stores created users by email on top of fakeUsers
+type signupUsers struct {
+	*fakeUsers
+}
+
+func (f signupUsers) IsNicknameTaken(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+func (f signupUsers) Create(_ context.Context, user *entity.User) error {
+	f.byEmail[user.Email] = user
+	return nil
+}
+
+type fakeVerifications struct {
+	repository.EmailVerificationRepository
+}
+
+func (fakeVerifications) Create(context.Context, *entity.EmailVerificationToken) error {
+	return nil
+}
+
+type inlineTransactor struct{}
+
+func (inlineTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeRooms struct {
+	repository.RoomRepository
+	room *entity.Room
+}
+
+func (f fakeRooms) GetByID(_ context.Context, id string) (*entity.Room, error) {
+	if f.room == nil || f.room.ID != id {
+		return nil, entity.ErrRoomNotFound
+	}
+	return f.room, nil
+}
+
+// joinedMembers records the memberships added during a test
+type joinedMembers struct {
+	repository.RoomMemberRepository
+	added []*entity.RoomMember
+}
+
+func (f *joinedMembers) Add(_ context.Context, member *entity.RoomMember) error {
+	f.added = append(f.added, member)
+	return nil
+}
+
+// sentNotifications records the notifications sent during a test
+type sentNotifications struct {
+	service.Notifier
+	sent []entity.Notification
+}
+
+func (f *sentNotifications) Notify(_ context.Context, _ []string, n entity.Notification) error {
+	f.sent = append(f.sent, n)
+	return nil
+}
+
+// oneDevicePerUser gives every user a single device whose token is their ID
+type oneDevicePerUser struct {
+	repository.DeviceRepository
+}
+
+func (oneDevicePerUser) ListByUsers(_ context.Context, userIDs []string) ([]*entity.Device, error) {
+	devices := make([]*entity.Device, 0, len(userIDs))
+	for _, id := range userIDs {
+		devices = append(devices, &entity.Device{UserID: id, Token: id})
+	}
+	return devices, nil
+}
+
+// topicSubscriptions records the tokens subscribed to each topic during a test
+type topicSubscriptions struct {
+	service.TopicSubscriber
+	subscribed map[string][]string
+}
+
+func (f *topicSubscriptions) Subscribe(_ context.Context, topic string, tokens []string) error {
+	f.subscribed[topic] = append(f.subscribed[topic], tokens...)
+	return nil
+}
+
+func TestSignupJoinsDefaultRoom(t *testing.T) {
+	rooms := fakeRooms{room: &entity.Room{ID: "welcome-room", Name: "새가족 기도방"}}
+
+	tests := []struct {
+		name          string
+		defaultRoomID string
+		wantJoined    bool
+	}{
+		{name: "default room set", defaultRoomID: "welcome-room", wantJoined: true},
+		{name: "no default room", defaultRoomID: ""},
+		{name: "default room gone", defaultRoomID: "deleted-room"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := signupUsers{&fakeUsers{byEmail: map[string]*entity.User{}}}
+			members := &joinedMembers{}
+			notifications := &sentNotifications{}
+			subscriptions := &topicSubscriptions{subscribed: map[string][]string{}}
+			verification := NewSendEmailVerificationUseCase(users, fakeVerifications{}, &sentMail{}, "https://app.example.com", time.Hour)
+			onboarding := NewOnboarding(tt.defaultRoomID, rooms, members, inlineTransactor{}, notifications, room.NewTopics(oneDevicePerUser{}, subscriptions))
+			uc := NewSignupUseCase(users, verification, onboarding)
+
+			user, err := uc.Execute(context.Background(), "kim@example.com", "김집사", "password123!")
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if _, ok := users.byEmail["kim@example.com"]; !ok {
+				t.Fatal("user was not created")
+			}
+
+			if !tt.wantJoined {
+				if len(members.added) != 0 || len(notifications.sent) != 0 || len(subscriptions.subscribed) != 0 {
+					t.Errorf("added %d memberships, sent %d notifications and subscribed %v, want none", len(members.added), len(notifications.sent), subscriptions.subscribed)
+				}
+				return
+			}
+			if len(members.added) != 1 {
+				t.Fatalf("added %d memberships, want 1", len(members.added))
+			}
+			if m := members.added[0]; m.RoomID != "welcome-room" || m.UserID != user.ID || m.Role != entity.RoomRoleMember {
+				t.Errorf("membership = %+v, want the new user as a member of the default room", m)
+			}
+			if len(notifications.sent) != 1 || notifications.sent[0].Type != entity.NotificationRoomWelcome {
+				t.Errorf("notifications = %+v, want one welcome", notifications.sent)
+			}
+			if got := subscriptions.subscribed["room-welcome-room"]; len(got) != 1 || got[0] != user.ID {
+				t.Errorf("room topic subscriptions = %v, want the new user's device", got)
+			}
+		})
+	}
+}
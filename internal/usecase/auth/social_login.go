@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+// maxNicknameAttempts bounds retries when a generated nickname is already taken
+const maxNicknameAttempts = 3
+
+type SocialLoginUseCase struct {
+	userRepo   repository.UserRepository
+	socialRepo repository.SocialAccountRepository
+	verifier   service.IDTokenVerifier
+	auditor    service.Auditor
+	onboarding *Onboarding
+}
+
+func NewSocialLoginUseCase(
+	userRepo repository.UserRepository,
+	socialRepo repository.SocialAccountRepository,
+	verifier service.IDTokenVerifier,
+	auditor service.Auditor,
+	onboarding *Onboarding,
+) *SocialLoginUseCase {
+	return &SocialLoginUseCase{
+		userRepo:   userRepo,
+		socialRepo: socialRepo,
+		verifier:   verifier,
+		auditor:    auditor,
+		onboarding: onboarding,
+	}
+}
+
+// Execute exchanges a provider ID token for our user
+// 1. Known provider identity -> its user
+// 2. Verified email matching an existing user -> link the provider to that user, if the user
+// verified the email too; otherwise ErrSocialLinkRequired, as the address may not be theirs
+// 3. Otherwise -> create a new user with the provider linked, in the default room if any
+func (uc *SocialLoginUseCase) Execute(ctx context.Context, provider entity.SocialProvider, idToken, nickname string) (*entity.User, error) {
+	identity, err := uc.verifier.Verify(ctx, provider, idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.resolveUser(ctx, identity, nickname)
+	if err != nil {
+		return nil, err
+	}
+	uc.auditor.Record(ctx, entity.AuditEntry{
+		Action:     entity.AuditSocialLogin,
+		ActorID:    user.ID,
+		TargetType: entity.AuditTargetUser,
+		TargetID:   user.ID,
+		Details:    map[string]string{"provider": string(identity.Provider)},
+	})
+	return user, nil
+}
+
+// resolveUser finds or creates the user of a verified provider identity
+func (uc *SocialLoginUseCase) resolveUser(ctx context.Context, identity *entity.SocialIdentity, nickname string) (*entity.User, error) {
+
+	account, err := uc.socialRepo.GetByProviderUserID(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		return uc.userRepo.GetByID(ctx, account.UserID)
+	}
+	if !errors.Is(err, entity.ErrSocialAccountNotFound) {
+		return nil, err
+	}
+
+	// Only trust the provider's email for linking when the provider verified it
+	email := ""
+	if identity.EmailVerified {
+		email = entity.NormalizeEmail(identity.Email)
+	}
+
+	if email != "" {
+		existing, err := uc.userRepo.GetByEmail(ctx, email)
+		if err == nil {
+			// An unconfirmed address may have been registered by someone else ahead of its
+			// owner, who links the provider explicitly after signing in instead
+			if !existing.IsEmailVerified() {
+				return nil, entity.ErrSocialLinkRequired
+			}
+			if err := uc.socialRepo.Create(ctx, newSocialAccount(existing.ID, identity)); err != nil {
+				return nil, err
+			}
+			return existing, nil
+		}
+		if !errors.Is(err, entity.ErrUserNotFound) {
+			return nil, err
+		}
+	}
+
+	base := socialNickname(nickname, identity)
+	user, err := entity.NewSocialUser(email, base)
+	if err != nil {
+		return nil, err
+	}
+	user.ID = uuid.New().String()
+
+	// Provider names collide often; fall back to a suffixed nickname the user can change later
+	for attempt := 1; ; attempt++ {
+		err := uc.onboarding.register(ctx, user, func(ctx context.Context) error {
+			return uc.socialRepo.CreateWithUser(ctx, user, newSocialAccount(user.ID, identity))
+		})
+		if err == nil {
+			return user, nil
+		}
+		if !errors.Is(err, entity.ErrNicknameTaken) || attempt == maxNicknameAttempts {
+			return nil, err
+		}
+		user.Nickname = suffixedNickname(base)
+	}
+}
+
+type LinkSocialAccountUseCase struct {
+	socialRepo repository.SocialAccountRepository
+	verifier   service.IDTokenVerifier
+}
+
+func NewLinkSocialAccountUseCase(socialRepo repository.SocialAccountRepository, verifier service.IDTokenVerifier) *LinkSocialAccountUseCase {
+	return &LinkSocialAccountUseCase{
+		socialRepo: socialRepo,
+		verifier:   verifier,
+	}
+}
+
+// Execute links an additional provider identity to the authenticated user
+func (uc *LinkSocialAccountUseCase) Execute(ctx context.Context, userID string, provider entity.SocialProvider, idToken string) (*entity.SocialAccount, error) {
+	identity, err := uc.verifier.Verify(ctx, provider, idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.socialRepo.GetByProviderUserID(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		if existing.UserID != userID {
+			return nil, entity.ErrSocialAccountAlreadyLinked
+		}
+		return existing, nil
+	}
+	if !errors.Is(err, entity.ErrSocialAccountNotFound) {
+		return nil, err
+	}
+
+	account := newSocialAccount(userID, identity)
+	if err := uc.socialRepo.Create(ctx, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+func newSocialAccount(userID string, identity *entity.SocialIdentity) *entity.SocialAccount {
+	return &entity.SocialAccount{
+		ID:             uuid.New().String(),
+		UserID:         userID,
+		Provider:       identity.Provider,
+		ProviderUserID: identity.Subject,
+		Email:          identity.Email,
+	}
+}
+
+// socialNickname picks the client-supplied nickname, then the provider's name, then a generated one
+func socialNickname(requested string, identity *entity.SocialIdentity) string {
+	for _, candidate := range []string{requested, identity.Name} {
+		candidate = strings.TrimSpace(candidate)
+		if utf8.RuneCountInString(candidate) >= entity.MinNicknameLength {
+			return truncateRunes(candidate, entity.MaxNicknameLength)
+		}
+	}
+	return "user-" + uuid.New().String()[:8]
+}
+
+// suffixedNickname appends a short random suffix, truncating base to stay within the limit
+func suffixedNickname(base string) string {
+	suffix := "-" + uuid.New().String()[:4]
+	return truncateRunes(base, entity.MaxNicknameLength-len(suffix)) + suffix
+}
+
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
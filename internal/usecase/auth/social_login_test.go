@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+// fakeUsers serves users by email; other UserRepository methods are not used by social login
+type fakeUsers struct {
+	repository.UserRepository
+	byEmail map[string]*entity.User
+}
+
+func (f *fakeUsers) GetByEmail(_ context.Context, email string) (*entity.User, error) {
+	if user, ok := f.byEmail[email]; ok {
+		return user, nil
+	}
+	return nil, entity.ErrUserNotFound
+}
+
+// fakeSocialAccounts records the accounts linked during a test
+type fakeSocialAccounts struct {
+	repository.SocialAccountRepository
+	linked  []*entity.SocialAccount
+	created []*entity.User
+}
+
+func (f *fakeSocialAccounts) GetByProviderUserID(_ context.Context, _ entity.SocialProvider, _ string) (*entity.SocialAccount, error) {
+	return nil, entity.ErrSocialAccountNotFound
+}
+
+func (f *fakeSocialAccounts) Create(_ context.Context, account *entity.SocialAccount) error {
+	f.linked = append(f.linked, account)
+	return nil
+}
+
+func (f *fakeSocialAccounts) CreateWithUser(_ context.Context, user *entity.User, account *entity.SocialAccount) error {
+	f.created = append(f.created, user)
+	f.linked = append(f.linked, account)
+	return nil
+}
+
+type fakeVerifier struct {
+	identity *entity.SocialIdentity
+}
+
+func (f fakeVerifier) Verify(_ context.Context, _ entity.SocialProvider, _ string) (*entity.SocialIdentity, error) {
+	return f.identity, nil
+}
+
+type nopAuditor struct{}
+
+func (nopAuditor) Record(context.Context, entity.AuditEntry) {}
+
+func TestSocialLoginLinksByEmail(t *testing.T) {
+	verifiedAt := time.Now()
+	verified := &entity.User{ID: "verified", Email: "owner@example.com", EmailVerifiedAt: &verifiedAt}
+	unverified := &entity.User{ID: "unverified", Email: "victim@example.com"}
+
+	tests := []struct {
+		name          string
+		email         string
+		emailVerified bool // by the provider
+		wantUserID    string
+		wantErr       error
+		wantCreated   bool
+	}{
+		{name: "verified account is linked", email: "owner@example.com", emailVerified: true, wantUserID: "verified"},
+		{name: "unverified account needs an explicit link", email: "victim@example.com", emailVerified: true, wantErr: entity.ErrSocialLinkRequired},
+		{name: "unverified provider email never links", email: "owner@example.com", emailVerified: false, wantCreated: true},
+		{name: "unknown email creates a user", email: "new@example.com", emailVerified: true, wantCreated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeUsers{byEmail: map[string]*entity.User{verified.Email: verified, unverified.Email: unverified}}
+			accounts := &fakeSocialAccounts{}
+			identity := &entity.SocialIdentity{
+				Provider:      entity.ProviderGoogle,
+				Subject:       "google-1",
+				Email:         tt.email,
+				EmailVerified: tt.emailVerified,
+				Name:          "Provider Name",
+			}
+			uc := NewSocialLoginUseCase(users, accounts, fakeVerifier{identity: identity}, nopAuditor{}, NewOnboarding("", nil, nil, nil, nil, nil))
+
+			user, err := uc.Execute(context.Background(), entity.ProviderGoogle, "id-token", "")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				if len(accounts.linked) != 0 {
+					t.Errorf("linked %d accounts, want none", len(accounts.linked))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if tt.wantCreated {
+				if len(accounts.created) != 1 || user.ID == verified.ID || user.ID == unverified.ID {
+					t.Fatalf("got user %s, want a new user", user.ID)
+				}
+			} else if user.ID != tt.wantUserID {
+				t.Fatalf("got user %s, want %s", user.ID, tt.wantUserID)
+			}
+			if len(accounts.linked) != 1 || accounts.linked[0].UserID != user.ID {
+				t.Errorf("linked %+v, want the provider linked to %s", accounts.linked, user.ID)
+			}
+		})
+	}
+}
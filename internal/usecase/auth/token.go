@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected, please log in again")
+)
+
+// TokenIssuer signs and parses tokens (implemented by the JWT helpers)
+type TokenIssuer interface {
+	IssueAccessToken(user *entity.User, sessionID string) (string, error)
+	IssueRefreshToken(token *entity.RefreshToken) (string, error)
+	// ParseRefreshToken validates a signed refresh token and returns its token ID
+	ParseRefreshToken(raw string) (string, error)
+}
+
+// TokenPair is an access token with its rotating refresh token
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+}
+
+type IssueTokensUseCase struct {
+	tokenRepo  repository.RefreshTokenRepository
+	issuer     TokenIssuer
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	sliding    bool
+}
+
+// NewIssueTokensUseCase creates the token issuer; with sliding set every refresh extends the
+// session by refreshTTL, otherwise it ends refreshTTL after login
+func NewIssueTokensUseCase(tokenRepo repository.RefreshTokenRepository, issuer TokenIssuer, accessTTL, refreshTTL time.Duration, sliding bool) *IssueTokensUseCase {
+	return &IssueTokensUseCase{
+		tokenRepo:  tokenRepo,
+		issuer:     issuer,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+		sliding:    sliding,
+	}
+}
+
+// Execute starts a new refresh token family for the user's device (login/signup)
+func (uc *IssueTokensUseCase) Execute(ctx context.Context, user *entity.User, deviceID string) (*TokenPair, error) {
+	return uc.issue(ctx, user, deviceID, uuid.New().String(), time.Now().Add(uc.refreshTTL))
+}
+
+// rotate issues the token pair that replaces a consumed refresh token of the family
+func (uc *IssueTokensUseCase) rotate(ctx context.Context, user *entity.User, consumed *entity.RefreshToken) (*TokenPair, error) {
+	expiresAt := consumed.ExpiresAt
+	if uc.sliding {
+		expiresAt = time.Now().Add(uc.refreshTTL)
+	}
+	return uc.issue(ctx, user, consumed.DeviceID, consumed.FamilyID, expiresAt)
+}
+
+// issue stores a refresh token in the given family and signs the token pair
+// Every login, refresh and upgrade ends here, so suspended accounts are refused here
+func (uc *IssueTokensUseCase) issue(ctx context.Context, user *entity.User, deviceID, familyID string, expiresAt time.Time) (*TokenPair, error) {
+	if user.IsSuspended() {
+		return nil, entity.ErrAccountSuspended
+	}
+
+	now := time.Now()
+	record := &entity.RefreshToken{
+		ID:        uuid.New().String(),
+		FamilyID:  familyID,
+		UserID:    user.ID,
+		DeviceID:  deviceID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+
+	if err := uc.tokenRepo.Create(ctx, record); err != nil {
+		return nil, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	accessToken, err := uc.issuer.IssueAccessToken(user, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := uc.issuer.IssueRefreshToken(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    uc.accessTTL,
+	}, nil
+}
+
+type RefreshTokenUseCase struct {
+	userRepo  repository.UserRepository
+	tokenRepo repository.RefreshTokenRepository
+	issuer    TokenIssuer
+	issueUC   *IssueTokensUseCase
+}
+
+func NewRefreshTokenUseCase(
+	userRepo repository.UserRepository,
+	tokenRepo repository.RefreshTokenRepository,
+	issuer TokenIssuer,
+	issueUC *IssueTokensUseCase,
+) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		issuer:    issuer,
+		issueUC:   issueUC,
+	}
+}
+
+// Execute rotates a refresh token: the presented token is consumed and a new one
+// in the same family is issued. Presenting an already-used token revokes the family
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, rawToken string) (*TokenPair, error) {
+	tokenID, err := uc.issuer.ParseRefreshToken(rawToken)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := uc.tokenRepo.GetByID(ctx, tokenID)
+	if err != nil {
+		if errors.Is(err, entity.ErrRefreshTokenNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	if record.IsRevoked() || record.IsExpired(time.Now()) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if record.IsUsed() {
+		return nil, uc.revokeOnReuse(ctx, record)
+	}
+
+	// Conditional update guards against two concurrent exchanges of the same token
+	marked, err := uc.tokenRepo.MarkUsed(ctx, record.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !marked {
+		return nil, uc.revokeOnReuse(ctx, record)
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, record.UserID)
+	if err != nil {
+		if errors.Is(err, entity.ErrUserNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	return uc.issueUC.rotate(ctx, user, record)
+}
+
+// revokeOnReuse revokes the whole family after a replayed token
+func (uc *RefreshTokenUseCase) revokeOnReuse(ctx context.Context, record *entity.RefreshToken) error {
+	slog.Warn("Refresh token reuse detected, revoking family",
+		"user_id", record.UserID,
+		"device_id", record.DeviceID,
+		"family_id", record.FamilyID,
+	)
+
+	if err := uc.tokenRepo.RevokeFamily(ctx, record.FamilyID); err != nil {
+		return fmt.Errorf("failed to revoke token family: %w", err)
+	}
+	return ErrRefreshTokenReused
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+// fakeRefreshTokens keeps refresh tokens by ID; the signed token is the ID itself
+type fakeRefreshTokens struct {
+	repository.RefreshTokenRepository
+	tokens map[string]*entity.RefreshToken
+	last   *entity.RefreshToken
+}
+
+func (f *fakeRefreshTokens) Create(_ context.Context, token *entity.RefreshToken) error {
+	f.tokens[token.ID] = token
+	f.last = token
+	return nil
+}
+
+func (f *fakeRefreshTokens) GetByID(_ context.Context, id string) (*entity.RefreshToken, error) {
+	if token, ok := f.tokens[id]; ok {
+		return token, nil
+	}
+	return nil, entity.ErrRefreshTokenNotFound
+}
+
+func (f *fakeRefreshTokens) MarkUsed(_ context.Context, id string) (bool, error) {
+	now := time.Now()
+	f.tokens[id].UsedAt = &now
+	return true, nil
+}
+
+type plainIssuer struct{}
+
+func (plainIssuer) IssueAccessToken(user *entity.User, _ string) (string, error) {
+	return "access-" + user.ID, nil
+}
+
+func (plainIssuer) IssueRefreshToken(token *entity.RefreshToken) (string, error) {
+	return token.ID, nil
+}
+
+func (plainIssuer) ParseRefreshToken(raw string) (string, error) {
+	return raw, nil
+}
+
+type usersByID struct {
+	repository.UserRepository
+	user *entity.User
+}
+
+func (f usersByID) GetByID(context.Context, string) (*entity.User, error) {
+	return f.user, nil
+}
+
+func TestRefreshTokenExpiry(t *testing.T) {
+	const ttl = 7 * 24 * time.Hour
+	for _, sliding := range []bool{true, false} {
+		tokens := &fakeRefreshTokens{tokens: map[string]*entity.RefreshToken{}}
+		user := &entity.User{ID: "u1"}
+		issueUC := NewIssueTokensUseCase(tokens, plainIssuer{}, time.Hour, ttl, sliding)
+		refreshUC := NewRefreshTokenUseCase(usersByID{user: user}, tokens, plainIssuer{}, issueUC)
+		ctx := context.Background()
+
+		pair, err := issueUC.Execute(ctx, user, "phone")
+		if err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+		// The login is two days old by the time the app refreshes
+		login := tokens.last
+		login.ExpiresAt = login.ExpiresAt.Add(-48 * time.Hour)
+
+		refreshed, err := refreshUC.Execute(ctx, pair.RefreshToken)
+		if err != nil {
+			t.Fatalf("sliding %v: Refresh: %v", sliding, err)
+		}
+		rotated := tokens.tokens[refreshed.RefreshToken]
+		if rotated.FamilyID != login.FamilyID || rotated.DeviceID != "phone" {
+			t.Errorf("sliding %v: rotated token = %+v, want the login's family and device", sliding, rotated)
+		}
+
+		if sliding {
+			if remaining := time.Until(rotated.ExpiresAt); remaining < ttl-time.Minute {
+				t.Errorf("sliding: rotated token expires in %v, want a full %v", remaining, ttl)
+			}
+		} else if !rotated.ExpiresAt.Equal(login.ExpiresAt) {
+			t.Errorf("fixed: rotated token expires at %v, want the login's %v", rotated.ExpiresAt, login.ExpiresAt)
+		}
+	}
+}
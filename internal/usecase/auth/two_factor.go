@@ -0,0 +1,304 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/totp"
+)
+
+const (
+	backupCodeCount  = 10
+	backupCodeLength = 10 // characters, rendered as two groups of five
+	// backupCodeAlphabet avoids characters that are easy to confuse (0/O, 1/l/I)
+	backupCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+	// totpSkew accepts the previous and next code to tolerate clock drift
+	totpSkew = 1
+
+	maxTwoFactorAttempts = 5
+	twoFactorLockout     = 15 * time.Minute
+)
+
+// MFATokenIssuer signs and parses the intermediate login token
+type MFATokenIssuer interface {
+	IssueMFAToken(userID, deviceID string) (string, error)
+	// ParseMFAToken validates the token and returns the user and device it was issued for
+	ParseMFAToken(raw string) (string, string, error)
+}
+
+// TwoFactorEnrollment is what an authenticator app needs to add the account
+type TwoFactorEnrollment struct {
+	Secret string
+	URI    string // otpauth:// URI, usually shown as a QR code
+}
+
+type EnrollTwoFactorUseCase struct {
+	userRepo repository.UserRepository
+	tfRepo   repository.TwoFactorRepository
+	issuer   string
+}
+
+func NewEnrollTwoFactorUseCase(userRepo repository.UserRepository, tfRepo repository.TwoFactorRepository, issuer string) *EnrollTwoFactorUseCase {
+	return &EnrollTwoFactorUseCase{
+		userRepo: userRepo,
+		tfRepo:   tfRepo,
+		issuer:   issuer,
+	}
+}
+
+// Execute starts (or restarts) enrollment with a fresh secret
+// 2FA stays off until EnableTwoFactorUseCase confirms a code from the app
+func (uc *EnrollTwoFactorUseCase) Execute(ctx context.Context, userID string) (*TwoFactorEnrollment, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tf, err := uc.tfRepo.Get(ctx, userID); err == nil && tf.IsEnabled() {
+		return nil, entity.ErrTwoFactorAlreadyEnabled
+	} else if err != nil && !errors.Is(err, entity.ErrTwoFactorNotEnrolled) {
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := uc.tfRepo.SavePending(ctx, &entity.TwoFactor{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+
+	account := user.Email
+	if account == "" {
+		account = user.Nickname
+	}
+
+	return &TwoFactorEnrollment{
+		Secret: secret,
+		URI:    totp.URI(uc.issuer, account, secret),
+	}, nil
+}
+
+type EnableTwoFactorUseCase struct {
+	tfRepo repository.TwoFactorRepository
+}
+
+func NewEnableTwoFactorUseCase(tfRepo repository.TwoFactorRepository) *EnableTwoFactorUseCase {
+	return &EnableTwoFactorUseCase{
+		tfRepo: tfRepo,
+	}
+}
+
+// Execute confirms the pending enrollment with a code and returns new backup codes
+// The backup codes are shown once; only their hashes are stored
+func (uc *EnableTwoFactorUseCase) Execute(ctx context.Context, userID, code string) ([]string, error) {
+	tf, err := uc.tfRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf.IsEnabled() {
+		return nil, entity.ErrTwoFactorAlreadyEnabled
+	}
+
+	step, ok := totp.Validate(tf.Secret, normalizeCode(code), time.Now(), totpSkew)
+	if !ok {
+		return nil, entity.ErrInvalidTwoFactorCode
+	}
+
+	codes, hashes, err := newBackupCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.tfRepo.Enable(ctx, userID, step, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+type DisableTwoFactorUseCase struct {
+	tfRepo repository.TwoFactorRepository
+}
+
+func NewDisableTwoFactorUseCase(tfRepo repository.TwoFactorRepository) *DisableTwoFactorUseCase {
+	return &DisableTwoFactorUseCase{
+		tfRepo: tfRepo,
+	}
+}
+
+// Execute turns 2FA off after checking a current code or a backup code
+func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, userID, code string) error {
+	tf, err := uc.tfRepo.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrTwoFactorNotEnrolled) {
+			return entity.ErrTwoFactorNotEnabled
+		}
+		return err
+	}
+	if !tf.IsEnabled() {
+		return entity.ErrTwoFactorNotEnabled
+	}
+
+	if err := verifySecondFactor(ctx, uc.tfRepo, tf, code); err != nil {
+		return err
+	}
+
+	return uc.tfRepo.Delete(ctx, userID)
+}
+
+type BeginTwoFactorLoginUseCase struct {
+	tfRepo repository.TwoFactorRepository
+	issuer MFATokenIssuer
+}
+
+func NewBeginTwoFactorLoginUseCase(tfRepo repository.TwoFactorRepository, issuer MFATokenIssuer) *BeginTwoFactorLoginUseCase {
+	return &BeginTwoFactorLoginUseCase{
+		tfRepo: tfRepo,
+		issuer: issuer,
+	}
+}
+
+// Execute returns an intermediate token when the user must still enter a code
+// An empty token means 2FA is off and the caller can issue tokens right away
+func (uc *BeginTwoFactorLoginUseCase) Execute(ctx context.Context, user *entity.User, deviceID string) (string, error) {
+	tf, err := uc.tfRepo.Get(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrTwoFactorNotEnrolled) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !tf.IsEnabled() {
+		return "", nil
+	}
+
+	return uc.issuer.IssueMFAToken(user.ID, deviceID)
+}
+
+type VerifyTwoFactorLoginUseCase struct {
+	userRepo repository.UserRepository
+	tfRepo   repository.TwoFactorRepository
+	issuer   MFATokenIssuer
+}
+
+func NewVerifyTwoFactorLoginUseCase(
+	userRepo repository.UserRepository,
+	tfRepo repository.TwoFactorRepository,
+	issuer MFATokenIssuer,
+) *VerifyTwoFactorLoginUseCase {
+	return &VerifyTwoFactorLoginUseCase{
+		userRepo: userRepo,
+		tfRepo:   tfRepo,
+		issuer:   issuer,
+	}
+}
+
+// Execute completes a login started by BeginTwoFactorLoginUseCase
+// It returns the user and the device the login was started on
+func (uc *VerifyTwoFactorLoginUseCase) Execute(ctx context.Context, mfaToken, code string) (*entity.User, string, error) {
+	userID, deviceID, err := uc.issuer.ParseMFAToken(mfaToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tf, err := uc.tfRepo.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrTwoFactorNotEnrolled) {
+			return nil, "", entity.ErrTwoFactorNotEnabled
+		}
+		return nil, "", err
+	}
+	if !tf.IsEnabled() {
+		return nil, "", entity.ErrTwoFactorNotEnabled
+	}
+
+	if err := verifySecondFactor(ctx, uc.tfRepo, tf, code); err != nil {
+		return nil, "", err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	return user, deviceID, nil
+}
+
+// verifySecondFactor accepts a TOTP code or an unused backup code
+// Failures are counted so the 6-digit space cannot be brute-forced
+func verifySecondFactor(ctx context.Context, tfRepo repository.TwoFactorRepository, tf *entity.TwoFactor, code string) error {
+	now := time.Now()
+	if tf.IsLocked(now) {
+		return entity.ErrTwoFactorLocked
+	}
+
+	code = normalizeCode(code)
+	if len(code) == totp.Digits {
+		if step, ok := totp.Validate(tf.Secret, code, now, totpSkew); ok {
+			accepted, err := tfRepo.AcceptStep(ctx, tf.UserID, step)
+			if err != nil {
+				return err
+			}
+			if accepted {
+				return nil
+			}
+			// Same code replayed within its window
+		}
+	} else if len(code) == backupCodeLength {
+		used, err := tfRepo.UseBackupCode(ctx, tf.UserID, hashOpaqueToken(code))
+		if err != nil {
+			return err
+		}
+		if used {
+			return nil
+		}
+	}
+
+	if err := tfRepo.RecordFailure(ctx, tf.UserID, maxTwoFactorAttempts, now.Add(twoFactorLockout)); err != nil {
+		return err
+	}
+	return entity.ErrInvalidTwoFactorCode
+}
+
+// normalizeCode strips the separators users type or paste along with a code
+func normalizeCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// newBackupCodes returns codes formatted for display and their hashes
+func newBackupCodes() ([]string, []string, error) {
+	codes := make([]string, 0, backupCodeCount)
+	hashes := make([]string, 0, backupCodeCount)
+
+	buf := make([]byte, backupCodeLength)
+	for i := 0; i < backupCodeCount; i++ {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate backup codes: %w", err)
+		}
+
+		var sb strings.Builder
+		for _, b := range buf {
+			// 256 is not a multiple of the alphabet size; the bias is negligible for recovery codes
+			sb.WriteByte(backupCodeAlphabet[int(b)%len(backupCodeAlphabet)])
+		}
+		raw := sb.String()
+
+		codes = append(codes, raw[:backupCodeLength/2]+"-"+raw[backupCodeLength/2:])
+		hashes = append(hashes, hashOpaqueToken(raw))
+	}
+	return codes, hashes, nil
+}
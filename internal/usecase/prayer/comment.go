@@ -0,0 +1,280 @@
+package prayer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/google/uuid"
+)
+
+// mentioner resolves and notifies the mentions of comments
+type mentioner struct {
+	userRepo   repository.UserRepository
+	memberRepo repository.RoomMemberRepository
+	notifier   service.Notifier
+}
+
+// resolve fills in MentionIDs with the mentioned nicknames that belong to members of the comment's room
+// The author is never mentioned; unknown nicknames are plain text
+// Nobody is mentioned on a private topic, since nobody else may open it
+func (m *mentioner) resolve(ctx context.Context, topic *entity.PrayerTopic, comment *entity.PrayerComment) error {
+	comment.MentionIDs = nil
+	if topic.Private {
+		return nil
+	}
+	users, err := m.userRepo.GetByNicknames(ctx, comment.Mentions())
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		if comment.IsAuthoredBy(u.ID) {
+			continue
+		}
+		if _, err := m.memberRepo.Get(ctx, comment.RoomID, u.ID); err != nil {
+			if errors.Is(err, entity.ErrNotRoomMember) {
+				continue
+			}
+			return err
+		}
+		comment.MentionIDs = append(comment.MentionIDs, u.ID)
+	}
+	slices.Sort(comment.MentionIDs)
+	return nil
+}
+
+// notify tells the mentioned members, except those in skip, about the comment
+// Mentions are addressed to one person, so room mutes do not apply
+func (m *mentioner) notify(ctx context.Context, comment *entity.PrayerComment, skip []string) {
+	var recipients []string
+	for _, id := range comment.MentionIDs {
+		if !slices.Contains(skip, id) {
+			recipients = append(recipients, id)
+		}
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	author, err := m.userRepo.GetByID(ctx, comment.AuthorID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load comment author", "comment_id", comment.ID, "error", err)
+		return
+	}
+	room.Notify(ctx, m.notifier, recipients, entity.Notification{
+		Type:   entity.NotificationCommentMention,
+		Params: map[string]string{"nickname": author.Nickname, "comment": comment.Body},
+		Data:   map[string]string{"room_id": comment.RoomID, "prayer_id": comment.TopicID, "comment_id": comment.ID},
+	})
+}
+
+type CreateCommentUseCase struct {
+	topicRepo   repository.PrayerTopicRepository
+	commentRepo repository.PrayerCommentRepository
+	authz       *room.Authorizer
+	mentions    *mentioner
+}
+
+func NewCreateCommentUseCase(
+	topicRepo repository.PrayerTopicRepository,
+	commentRepo repository.PrayerCommentRepository,
+	userRepo repository.UserRepository,
+	memberRepo repository.RoomMemberRepository,
+	authz *room.Authorizer,
+	notifier service.Notifier,
+) *CreateCommentUseCase {
+	return &CreateCommentUseCase{
+		topicRepo:   topicRepo,
+		commentRepo: commentRepo,
+		authz:       authz,
+		mentions:    &mentioner{userRepo: userRepo, memberRepo: memberRepo, notifier: notifier},
+	}
+}
+
+// Execute comments on a live topic, or replies to a top-level comment when parentID is set
+// Any member may comment; mentioned members are notified
+func (uc *CreateCommentUseCase) Execute(ctx context.Context, userID, topicID, parentID, body string) (*entity.PrayerComment, error) {
+	topic, err := liveTopic(ctx, uc.topicRepo, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := uc.authz.Writable(ctx, userID, topic.RoomID); err != nil {
+		return nil, hideRoom(err)
+	}
+
+	var parent *entity.PrayerComment
+	if parentID != "" {
+		if parent, err = uc.commentRepo.GetByID(ctx, parentID); err != nil {
+			return nil, err
+		}
+	}
+
+	comment, err := entity.NewPrayerComment(topic, parent, userID, body)
+	if err != nil {
+		return nil, err
+	}
+	comment.ID = uuid.New().String()
+	if err := uc.mentions.resolve(ctx, topic, comment); err != nil {
+		return nil, err
+	}
+
+	if err := uc.commentRepo.Create(ctx, comment); err != nil {
+		return nil, err
+	}
+	uc.mentions.notify(ctx, comment, nil)
+	return comment, nil
+}
+
+type ListCommentsUseCase struct {
+	topicRepo   repository.PrayerTopicRepository
+	commentRepo repository.PrayerCommentRepository
+	authz       *room.Authorizer
+}
+
+func NewListCommentsUseCase(topicRepo repository.PrayerTopicRepository, commentRepo repository.PrayerCommentRepository, authz *room.Authorizer) *ListCommentsUseCase {
+	return &ListCommentsUseCase{
+		topicRepo:   topicRepo,
+		commentRepo: commentRepo,
+		authz:       authz,
+	}
+}
+
+// Execute returns a page of a live topic's top-level comments, or of the replies to parentID,
+// newest first, leaving out authors the user blocked
+func (uc *ListCommentsUseCase) Execute(ctx context.Context, userID, topicID, parentID, cursor string, limit int) ([]*entity.PrayerComment, pagination.Meta, error) {
+	topic, err := liveTopic(ctx, uc.topicRepo, userID, topicID)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	if _, err := uc.authz.Readable(ctx, userID, topic.RoomID); err != nil {
+		return nil, pagination.Meta{}, hideRoom(err)
+	}
+
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	filter := repository.PrayerCommentFilter{ParentID: parentID, ViewerID: userID}
+	comments, err := uc.commentRepo.List(ctx, topic.ID, filter, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	return pagination.Page(comments, limit, commentCursor)
+}
+
+// commentCursor points after the comment in lists ordered by creation time
+func commentCursor(c *entity.PrayerComment) (string, error) {
+	return pagination.Encode(pagination.TimeKey{Time: c.CreatedAt, ID: c.ID})
+}
+
+type UpdateCommentUseCase struct {
+	topicRepo   repository.PrayerTopicRepository
+	commentRepo repository.PrayerCommentRepository
+	authz       *room.Authorizer
+	mentions    *mentioner
+}
+
+func NewUpdateCommentUseCase(
+	topicRepo repository.PrayerTopicRepository,
+	commentRepo repository.PrayerCommentRepository,
+	userRepo repository.UserRepository,
+	memberRepo repository.RoomMemberRepository,
+	authz *room.Authorizer,
+	notifier service.Notifier,
+) *UpdateCommentUseCase {
+	return &UpdateCommentUseCase{
+		topicRepo:   topicRepo,
+		commentRepo: commentRepo,
+		authz:       authz,
+		mentions:    &mentioner{userRepo: userRepo, memberRepo: memberRepo, notifier: notifier},
+	}
+}
+
+// Execute replaces the body of the user's own comment
+// Only members newly mentioned by the edit are notified
+func (uc *UpdateCommentUseCase) Execute(ctx context.Context, userID, topicID, commentID, body string) (*entity.PrayerComment, error) {
+	topic, comment, _, err := topicComment(ctx, uc.topicRepo, uc.commentRepo, uc.authz, userID, topicID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if !comment.IsAuthoredBy(userID) {
+		return nil, entity.ErrRoomPermissionDenied
+	}
+
+	previous := comment.MentionIDs
+	if err := comment.Edit(body); err != nil {
+		return nil, err
+	}
+	if err := uc.mentions.resolve(ctx, topic, comment); err != nil {
+		return nil, err
+	}
+
+	if err := uc.commentRepo.Update(ctx, comment); err != nil {
+		return nil, err
+	}
+	uc.mentions.notify(ctx, comment, previous)
+	return comment, nil
+}
+
+type DeleteCommentUseCase struct {
+	topicRepo   repository.PrayerTopicRepository
+	commentRepo repository.PrayerCommentRepository
+	authz       *room.Authorizer
+}
+
+func NewDeleteCommentUseCase(topicRepo repository.PrayerTopicRepository, commentRepo repository.PrayerCommentRepository, authz *room.Authorizer) *DeleteCommentUseCase {
+	return &DeleteCommentUseCase{
+		topicRepo:   topicRepo,
+		commentRepo: commentRepo,
+		authz:       authz,
+	}
+}
+
+// Execute deletes a comment together with its replies; its author or a moderator may do this
+func (uc *DeleteCommentUseCase) Execute(ctx context.Context, userID, topicID, commentID string) error {
+	_, comment, member, err := topicComment(ctx, uc.topicRepo, uc.commentRepo, uc.authz, userID, topicID, commentID)
+	if err != nil {
+		return err
+	}
+	if !comment.IsAuthoredBy(userID) && !member.Can(entity.PermRemovePrayer) {
+		return entity.ErrRoomPermissionDenied
+	}
+	return uc.commentRepo.Delete(ctx, comment.ID)
+}
+
+// topicComment loads a comment of a live topic together with the topic and the user's membership
+// in a room that accepts changes
+func topicComment(
+	ctx context.Context,
+	topicRepo repository.PrayerTopicRepository,
+	commentRepo repository.PrayerCommentRepository,
+	authz *room.Authorizer,
+	userID, topicID, commentID string,
+) (*entity.PrayerTopic, *entity.PrayerComment, *entity.RoomMember, error) {
+	topic, err := liveTopic(ctx, topicRepo, userID, topicID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	comment, err := commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if comment.TopicID != topic.ID {
+		return nil, nil, nil, entity.ErrPrayerCommentNotFound
+	}
+
+	_, member, err := authz.Writable(ctx, userID, topic.RoomID)
+	if err != nil {
+		return nil, nil, nil, hideRoom(err)
+	}
+	return topic, comment, member, nil
+}
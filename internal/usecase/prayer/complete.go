@@ -0,0 +1,134 @@
+package prayer
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/google/uuid"
+)
+
+type CompleteTopicUseCase struct {
+	topicRepo  repository.PrayerTopicRepository
+	outboxRepo repository.OutboxRepository
+	authz      *room.Authorizer
+	transactor repository.Transactor
+}
+
+func NewCompleteTopicUseCase(
+	topicRepo repository.PrayerTopicRepository,
+	outboxRepo repository.OutboxRepository,
+	authz *room.Authorizer,
+	transactor repository.Transactor,
+) *CompleteTopicUseCase {
+	return &CompleteTopicUseCase{
+		topicRepo:  topicRepo,
+		outboxRepo: outboxRepo,
+		authz:      authz,
+		transactor: transactor,
+	}
+}
+
+// Execute marks a live topic answered, optionally with a testimony (응답 간증), and tells the other
+// members who have not muted the room
+// Its author or a moderator may do this, once; nobody is told about private topics
+// The notification goes through the outbox, written with the answer, so it is sent exactly when
+// the answer is saved
+func (uc *CompleteTopicUseCase) Execute(ctx context.Context, userID, topicID, testimony string) (*entity.PrayerTopic, error) {
+	testimony, err := entity.NormalizeTestimony(testimony)
+	if err != nil {
+		return nil, err
+	}
+
+	topic, err := modifiableTopic(ctx, uc.topicRepo, uc.authz, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+	if topic.IsDeleted() {
+		return nil, entity.ErrPrayerTopicNotFound
+	}
+	if topic.IsAnswered() {
+		return nil, entity.ErrPrayerTopicAnswered
+	}
+
+	now := time.Now()
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.topicRepo.MarkAnswered(ctx, topic.ID, testimony, now); err != nil {
+			return err
+		}
+		if topic.Private {
+			return nil
+		}
+
+		n := entity.Notification{
+			Type:   entity.NotificationPrayerAnswered,
+			Params: map[string]string{"title": topic.Title},
+			Data:   map[string]string{"room_id": topic.RoomID, "prayer_id": topic.ID},
+		}
+		if testimony != "" {
+			n.Template = entity.TemplateAnsweredWithTestimony
+		}
+		event := entity.NewRoomOutboxEvent(topic.RoomID, userID, n, now)
+		event.ID = uuid.New().String()
+		return uc.outboxRepo.Append(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topic.AnsweredAt = &now
+	topic.Testimony = testimony
+	topic.UpdatedAt = now
+	return topic, nil
+}
+
+type ListAnsweredUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	reactionRepo repository.PrayerReactionRepository
+	authz        *room.Authorizer
+}
+
+func NewListAnsweredUseCase(topicRepo repository.PrayerTopicRepository, reactionRepo repository.PrayerReactionRepository, authz *room.Authorizer) *ListAnsweredUseCase {
+	return &ListAnsweredUseCase{
+		topicRepo:    topicRepo,
+		reactionRepo: reactionRepo,
+		authz:        authz,
+	}
+}
+
+// Execute returns a page of the room's answered topics with their testimonies, most recently
+// answered first; the room's feed lists only the topics still being prayed for
+func (uc *ListAnsweredUseCase) Execute(ctx context.Context, userID, roomID, cursor string, limit int) ([]TopicView, pagination.Meta, error) {
+	if _, err := uc.authz.Readable(ctx, userID, roomID); err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	topics, err := uc.topicRepo.ListAnswered(ctx, roomID, userID, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	topics, meta, err := pagination.Page(topics, limit, answeredCursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	views, err := topicViews(ctx, uc.reactionRepo, userID, topics)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	return views, meta, nil
+}
+
+// answeredCursor points after the topic in lists ordered by answer time
+func answeredCursor(t *entity.PrayerTopic) (string, error) {
+	return pagination.Encode(pagination.TimeKey{Time: *t.AnsweredAt, ID: t.ID})
+}
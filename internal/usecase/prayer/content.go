@@ -0,0 +1,213 @@
+package prayer
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/google/uuid"
+)
+
+type CreateContentUseCase struct {
+	topicRepo   repository.PrayerTopicRepository
+	contentRepo repository.PrayerContentRepository
+	authz       *room.Authorizer
+}
+
+func NewCreateContentUseCase(topicRepo repository.PrayerTopicRepository, contentRepo repository.PrayerContentRepository, authz *room.Authorizer) *CreateContentUseCase {
+	return &CreateContentUseCase{
+		topicRepo:   topicRepo,
+		contentRepo: contentRepo,
+		authz:       authz,
+	}
+}
+
+// Execute writes an entry under a live topic; members who may post prayers may add to any topic
+func (uc *CreateContentUseCase) Execute(ctx context.Context, userID, topicID, body string) (*entity.PrayerContent, error) {
+	topic, err := liveTopic(ctx, uc.topicRepo, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := entity.NewPrayerContent(topic, userID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := uc.authz.Require(ctx, userID, topic.RoomID, entity.PermPostPrayer); err != nil {
+		return nil, hideRoom(err)
+	}
+
+	content.ID = uuid.New().String()
+	if err := uc.contentRepo.Create(ctx, content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+type ListContentsUseCase struct {
+	topicRepo   repository.PrayerTopicRepository
+	contentRepo repository.PrayerContentRepository
+	authz       *room.Authorizer
+}
+
+func NewListContentsUseCase(topicRepo repository.PrayerTopicRepository, contentRepo repository.PrayerContentRepository, authz *room.Authorizer) *ListContentsUseCase {
+	return &ListContentsUseCase{
+		topicRepo:   topicRepo,
+		contentRepo: contentRepo,
+		authz:       authz,
+	}
+}
+
+// Execute returns a page of a live topic's entries, newest first, leaving out authors the user blocked
+func (uc *ListContentsUseCase) Execute(ctx context.Context, userID, topicID, cursor string, limit int) ([]*entity.PrayerContent, pagination.Meta, error) {
+	topic, err := liveTopic(ctx, uc.topicRepo, userID, topicID)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	if _, err := uc.authz.Readable(ctx, userID, topic.RoomID); err != nil {
+		return nil, pagination.Meta{}, hideRoom(err)
+	}
+
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	contents, err := uc.contentRepo.List(ctx, topic.ID, userID, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	return pagination.Page(contents, limit, contentCursor)
+}
+
+// contentCursor points after the entry in lists ordered by creation time
+func contentCursor(c *entity.PrayerContent) (string, error) {
+	return pagination.Encode(pagination.TimeKey{Time: c.CreatedAt, ID: c.ID})
+}
+
+type UpdateContentUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	contentRepo  repository.PrayerContentRepository
+	revisionRepo repository.PrayerRevisionRepository
+	authz        *room.Authorizer
+	transactor   repository.Transactor
+	maxRevisions int
+}
+
+func NewUpdateContentUseCase(
+	topicRepo repository.PrayerTopicRepository,
+	contentRepo repository.PrayerContentRepository,
+	revisionRepo repository.PrayerRevisionRepository,
+	authz *room.Authorizer,
+	transactor repository.Transactor,
+	maxRevisions int,
+) *UpdateContentUseCase {
+	return &UpdateContentUseCase{
+		topicRepo:    topicRepo,
+		contentRepo:  contentRepo,
+		revisionRepo: revisionRepo,
+		authz:        authz,
+		transactor:   transactor,
+		maxRevisions: maxRevisions,
+	}
+}
+
+// Execute replaces the body of an entry; its author or a moderator may do this
+// A changed body keeps the previous one in the topic's edit history
+func (uc *UpdateContentUseCase) Execute(ctx context.Context, userID, topicID, contentID, body string) (*entity.PrayerContent, error) {
+	content, err := modifiableContent(ctx, uc.topicRepo, uc.contentRepo, uc.authz, userID, topicID, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := entity.NewContentRevision(content, userID)
+	if err := content.Edit(body); err != nil {
+		return nil, err
+	}
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.contentRepo.Update(ctx, content); err != nil {
+			return err
+		}
+		if content.Body == revision.Previous {
+			return nil
+		}
+		return recordRevision(ctx, uc.revisionRepo, revision, uc.maxRevisions)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+type DeleteContentUseCase struct {
+	topicRepo   repository.PrayerTopicRepository
+	contentRepo repository.PrayerContentRepository
+	authz       *room.Authorizer
+}
+
+func NewDeleteContentUseCase(topicRepo repository.PrayerTopicRepository, contentRepo repository.PrayerContentRepository, authz *room.Authorizer) *DeleteContentUseCase {
+	return &DeleteContentUseCase{
+		topicRepo:   topicRepo,
+		contentRepo: contentRepo,
+		authz:       authz,
+	}
+}
+
+// Execute deletes an entry; its author or a moderator may do this
+// Unlike topics, entries are removed for good
+func (uc *DeleteContentUseCase) Execute(ctx context.Context, userID, topicID, contentID string) error {
+	content, err := modifiableContent(ctx, uc.topicRepo, uc.contentRepo, uc.authz, userID, topicID, contentID)
+	if err != nil {
+		return err
+	}
+	return uc.contentRepo.Delete(ctx, content.ID)
+}
+
+// liveTopic loads a topic that is not soft-deleted and that the user may see
+// Other people's private topics are reported as not found
+func liveTopic(ctx context.Context, topicRepo repository.PrayerTopicRepository, userID, topicID string) (*entity.PrayerTopic, error) {
+	topic, err := topicRepo.GetByID(ctx, topicID)
+	if err != nil {
+		return nil, err
+	}
+	if topic.IsDeleted() || !topic.IsVisibleTo(userID) {
+		return nil, entity.ErrPrayerTopicNotFound
+	}
+	return topic, nil
+}
+
+// modifiableContent loads an entry of a live topic that the user may change: their own,
+// or any entry when their room role allows removing other people's prayers
+func modifiableContent(
+	ctx context.Context,
+	topicRepo repository.PrayerTopicRepository,
+	contentRepo repository.PrayerContentRepository,
+	authz *room.Authorizer,
+	userID, topicID, contentID string,
+) (*entity.PrayerContent, error) {
+	topic, err := liveTopic(ctx, topicRepo, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := contentRepo.GetByID(ctx, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if content.TopicID != topic.ID {
+		return nil, entity.ErrPrayerContentNotFound
+	}
+
+	_, member, err := authz.Writable(ctx, userID, topic.RoomID)
+	if err != nil {
+		return nil, hideRoom(err)
+	}
+	if !content.IsAuthoredBy(userID) && !member.Can(entity.PermRemovePrayer) {
+		return nil, entity.ErrRoomPermissionDenied
+	}
+	return content, nil
+}
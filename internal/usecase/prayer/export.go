@@ -0,0 +1,387 @@
+package prayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pdf"
+	"github.com/google/uuid"
+)
+
+const (
+	// exportLinkTTL is how long the download link sent to the requester works
+	exportLinkTTL = 24 * time.Hour
+	// exportRetention is how long generated files are kept before the sweep deletes them
+	exportRetention = 7 * 24 * time.Hour
+	// exportStaleAfter is when a run that never finished is assumed dead and retried
+	exportStaleAfter = 10 * time.Minute
+	exportBatchSize  = 10
+	// maxExportTopics bounds the size of one export; rooms rarely come close
+	maxExportTopics = 5000
+	exportPageSize  = pagination.MaxLimit
+)
+
+type RequestExportUseCase struct {
+	exportRepo repository.RoomExportRepository
+	authz      *room.Authorizer
+	auditor    service.Auditor
+}
+
+func NewRequestExportUseCase(exportRepo repository.RoomExportRepository, authz *room.Authorizer, auditor service.Auditor) *RequestExportUseCase {
+	return &RequestExportUseCase{
+		exportRepo: exportRepo,
+		authz:      authz,
+		auditor:    auditor,
+	}
+}
+
+// Execute queues an export of the room's prayer history for a member; the file is generated
+// in the background and its download link sent to the member
+// A member's unfinished export of the room is returned instead of queueing another
+func (uc *RequestExportUseCase) Execute(ctx context.Context, userID, roomID string, format entity.ExportFormat) (*entity.RoomExport, error) {
+	export, err := entity.NewRoomExport(roomID, userID, format)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := uc.authz.Member(ctx, userID, roomID); err != nil {
+		return nil, err
+	}
+
+	unfinished, err := uc.exportRepo.GetUnfinished(ctx, roomID, userID)
+	if err == nil {
+		return unfinished, nil
+	}
+	if !errors.Is(err, entity.ErrRoomExportNotFound) {
+		return nil, err
+	}
+
+	export.ID = uuid.New().String()
+	if err := uc.exportRepo.Create(ctx, export); err != nil {
+		return nil, err
+	}
+	uc.auditor.Record(ctx, entity.AuditEntry{
+		Action:     entity.AuditExportRequested,
+		ActorID:    userID,
+		TargetType: entity.AuditTargetRoom,
+		TargetID:   roomID,
+		Details:    map[string]string{"export_id": export.ID, "format": string(export.Format)},
+	})
+	return export, nil
+}
+
+type RunExportsUseCase struct {
+	exportRepo  repository.RoomExportRepository
+	roomRepo    repository.RoomRepository
+	memberRepo  repository.RoomMemberRepository
+	topicRepo   repository.PrayerTopicRepository
+	contentRepo repository.PrayerContentRepository
+	userRepo    repository.UserRepository
+	storage     service.Storage
+	notifier    service.Notifier
+}
+
+func NewRunExportsUseCase(
+	exportRepo repository.RoomExportRepository,
+	roomRepo repository.RoomRepository,
+	memberRepo repository.RoomMemberRepository,
+	topicRepo repository.PrayerTopicRepository,
+	contentRepo repository.PrayerContentRepository,
+	userRepo repository.UserRepository,
+	storage service.Storage,
+	notifier service.Notifier,
+) *RunExportsUseCase {
+	return &RunExportsUseCase{
+		exportRepo:  exportRepo,
+		roomRepo:    roomRepo,
+		memberRepo:  memberRepo,
+		topicRepo:   topicRepo,
+		contentRepo: contentRepo,
+		userRepo:    userRepo,
+		storage:     storage,
+		notifier:    notifier,
+	}
+}
+
+// Execute generates the queued exports and deletes the files of expired ones
+// Each export is claimed first, so several instances can run this side by side
+func (uc *RunExportsUseCase) Execute(ctx context.Context) error {
+	now := time.Now()
+	if err := uc.sweep(ctx, now); err != nil {
+		return err
+	}
+
+	exports, err := uc.exportRepo.ListRunnable(ctx, now.Add(-exportStaleAfter), exportBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, export := range exports {
+		ok, err := uc.exportRepo.Claim(ctx, export, time.Now())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		uc.run(ctx, export)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// sweep deletes expired exports with their files
+func (uc *RunExportsUseCase) sweep(ctx context.Context, now time.Time) error {
+	expired, err := uc.exportRepo.ListExpired(ctx, now.Add(-exportRetention), exportBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, export := range expired {
+		if export.FileKey != "" {
+			if err := uc.storage.Delete(ctx, export.FileKey); err != nil {
+				return err
+			}
+		}
+		if err := uc.exportRepo.Delete(ctx, export.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run generates one claimed export and tells the requester how it went
+func (uc *RunExportsUseCase) run(ctx context.Context, export *entity.RoomExport) {
+	roomName, url, err := uc.generate(ctx, export)
+	now := time.Now()
+	export.CompletedAt = &now
+	export.Status = entity.ExportDone
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to export room", "export_id", export.ID, "room_id", export.RoomID, "error", err)
+		export.Status = entity.ExportFailed
+	}
+	if err := uc.exportRepo.Finish(ctx, export); err != nil {
+		slog.ErrorContext(ctx, "Failed to record room export", "export_id", export.ID, "error", err)
+		return
+	}
+
+	data := map[string]string{"room_id": export.RoomID, "export_id": export.ID}
+	if export.Status == entity.ExportFailed {
+		room.Notify(ctx, uc.notifier, []string{export.RequestedBy}, entity.Notification{
+			Type: entity.NotificationExportFailed,
+			Data: data,
+		})
+		return
+	}
+
+	data["url"] = url
+	room.Notify(ctx, uc.notifier, []string{export.RequestedBy}, entity.Notification{
+		Type: entity.NotificationExportReady,
+		Params: map[string]string{
+			"room":  roomName,
+			"hours": strconv.Itoa(int(exportLinkTTL.Hours())),
+			"url":   url,
+		},
+		Data: data,
+	})
+}
+
+// generate writes the export file and returns the room's name and a signed link to the file
+// The requester must still be a member, and sees the history as they would in the app
+func (uc *RunExportsUseCase) generate(ctx context.Context, export *entity.RoomExport) (string, string, error) {
+	r, err := uc.roomRepo.GetByID(ctx, export.RoomID)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := uc.memberRepo.Get(ctx, r.ID, export.RequestedBy); err != nil {
+		return "", "", err
+	}
+	requester, err := uc.userRepo.GetByID(ctx, export.RequestedBy)
+	if err != nil {
+		return "", "", err
+	}
+	loc, err := time.LoadLocation(requester.Timezone)
+	if err != nil {
+		loc, _ = time.LoadLocation(entity.DefaultTimezone)
+	}
+
+	history, err := uc.history(ctx, r.ID, requester.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	var data []byte
+	if export.Format == entity.ExportPDF {
+		data = history.pdf(r, loc)
+	} else {
+		if data, err = history.csv(loc); err != nil {
+			return "", "", err
+		}
+	}
+
+	export.FileKey = fmt.Sprintf("%sexports/%s.%s", service.PrivateKeyPrefix, export.ID, export.Format)
+	if _, err := uc.storage.Put(ctx, export.FileKey, export.Format.ContentType(), data); err != nil {
+		return "", "", err
+	}
+	return r.Name, uc.storage.SignedURL(export.FileKey, time.Now().Add(exportLinkTTL)), nil
+}
+
+// prayerHistory is what a room export contains, oldest topic first
+type prayerHistory struct {
+	topics   []*entity.PrayerTopic
+	contents map[string][]*entity.PrayerContent // by topic ID, oldest first
+	authors  map[string]string                  // nicknames by user ID
+}
+
+// history loads the room's live topics and their entries as viewerID sees them
+func (uc *RunExportsUseCase) history(ctx context.Context, roomID, viewerID string) (*prayerHistory, error) {
+	h := &prayerHistory{contents: map[string][]*entity.PrayerContent{}}
+	authorIDs := map[string]bool{}
+
+	filter := repository.PrayerTopicFilter{ViewerID: viewerID}
+	var after *pagination.TimeKey
+	for len(h.topics) < maxExportTopics {
+		topics, err := uc.topicRepo.List(ctx, roomID, filter, after, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range topics {
+			h.topics = append(h.topics, t)
+			authorIDs[t.AuthorID] = true
+
+			contents, err := uc.topicContents(ctx, t.ID, viewerID)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range contents {
+				authorIDs[c.AuthorID] = true
+			}
+			h.contents[t.ID] = contents
+		}
+		if len(topics) < exportPageSize {
+			break
+		}
+		last := topics[len(topics)-1]
+		after = &pagination.TimeKey{Time: last.CreatedAt, ID: last.ID}
+	}
+	slices.Reverse(h.topics)
+
+	ids := make([]string, 0, len(authorIDs))
+	for id := range authorIDs {
+		ids = append(ids, id)
+	}
+	users, err := uc.userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	h.authors = make(map[string]string, len(users))
+	for _, u := range users {
+		h.authors[u.ID] = u.Nickname
+	}
+	return h, nil
+}
+
+// topicContents loads every entry of a topic, oldest first
+func (uc *RunExportsUseCase) topicContents(ctx context.Context, topicID, viewerID string) ([]*entity.PrayerContent, error) {
+	var all []*entity.PrayerContent
+	var after *pagination.TimeKey
+	for {
+		contents, err := uc.contentRepo.List(ctx, topicID, viewerID, after, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, contents...)
+		if len(contents) < exportPageSize {
+			break
+		}
+		last := contents[len(contents)-1]
+		after = &pagination.TimeKey{Time: last.CreatedAt, ID: last.ID}
+	}
+	slices.Reverse(all)
+	return all, nil
+}
+
+// author returns the nickname of a user, or a placeholder for deleted accounts
+func (h *prayerHistory) author(id string) string {
+	if nickname, ok := h.authors[id]; ok {
+		return nickname
+	}
+	return "(알 수 없음)"
+}
+
+// exportTimeLayout is how times appear in exports, in the requester's time zone
+const exportTimeLayout = "2006-01-02 15:04"
+
+// csv renders one row per topic followed by one row per entry
+// A byte order mark makes spreadsheet apps read the Korean text as UTF-8
+func (h *prayerHistory) csv(loc *time.Location) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("\ufeff")
+	w := csv.NewWriter(&buf)
+
+	rows := [][]string{{"구분", "기도제목 ID", "작성일", "작성자", "제목/내용", "태그", "응답일", "응답 간증", "함께 기도", "댓글"}}
+	for _, t := range h.topics {
+		answered := ""
+		if t.AnsweredAt != nil {
+			answered = t.AnsweredAt.In(loc).Format(exportTimeLayout)
+		}
+		rows = append(rows, []string{
+			"기도제목", t.ID, t.CreatedAt.In(loc).Format(exportTimeLayout), h.author(t.AuthorID), t.Title,
+			strings.Join(t.Tags, " "), answered, t.Testimony, strconv.Itoa(t.ReactionCount), strconv.Itoa(t.CommentCount),
+		})
+		for _, c := range h.contents[t.ID] {
+			rows = append(rows, []string{
+				"기도내용", t.ID, c.CreatedAt.In(loc).Format(exportTimeLayout), h.author(c.AuthorID), c.Body,
+				"", "", "", "", "",
+			})
+		}
+	}
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// pdf renders the history as a document with a section per topic
+func (h *prayerHistory) pdf(r *entity.Room, loc *time.Location) []byte {
+	doc := pdf.New()
+	doc.Heading(fmt.Sprintf("%s 기도 기록", r.Name))
+	doc.Text(fmt.Sprintf("내보낸 날짜: %s · 기도제목 %d개", time.Now().In(loc).Format(exportTimeLayout), len(h.topics)))
+
+	for _, t := range h.topics {
+		doc.Space()
+		doc.Title(t.Title)
+
+		meta := []string{h.author(t.AuthorID), t.CreatedAt.In(loc).Format(exportTimeLayout)}
+		if len(t.Tags) > 0 {
+			meta = append(meta, "#"+strings.Join(t.Tags, " #"))
+		}
+		if t.AnsweredAt != nil {
+			meta = append(meta, "응답 "+t.AnsweredAt.In(loc).Format(exportTimeLayout))
+		}
+		meta = append(meta, fmt.Sprintf("함께 기도 %d", t.ReactionCount))
+		doc.Text(strings.Join(meta, " · "))
+
+		if t.Testimony != "" {
+			doc.Text("응답 간증: " + t.Testimony)
+		}
+		for _, c := range h.contents[t.ID] {
+			doc.Text(fmt.Sprintf("- %s (%s, %s)", c.Body, h.author(c.AuthorID), c.CreatedAt.In(loc).Format(exportTimeLayout)))
+		}
+	}
+	return doc.Bytes()
+}
@@ -0,0 +1,235 @@
+package prayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// fakeExports serves one runnable export and records how its run finished
+type fakeExports struct {
+	repository.RoomExportRepository
+	export   *entity.RoomExport
+	finished *entity.RoomExport
+}
+
+func (f *fakeExports) ListExpired(context.Context, time.Time, int) ([]*entity.RoomExport, error) {
+	return nil, nil
+}
+
+func (f *fakeExports) ListRunnable(context.Context, time.Time, int) ([]*entity.RoomExport, error) {
+	return []*entity.RoomExport{f.export}, nil
+}
+
+func (f *fakeExports) Claim(_ context.Context, export *entity.RoomExport, now time.Time) (bool, error) {
+	export.Status = entity.ExportRunning
+	export.StartedAt = &now
+	return true, nil
+}
+
+func (f *fakeExports) Finish(_ context.Context, export *entity.RoomExport) error {
+	f.finished = export
+	return nil
+}
+
+type fakeRooms struct {
+	repository.RoomRepository
+	room *entity.Room
+}
+
+func (f *fakeRooms) GetByID(context.Context, string) (*entity.Room, error) {
+	return f.room, nil
+}
+
+type fakeMembers struct {
+	repository.RoomMemberRepository
+	members map[string]bool
+}
+
+func (f *fakeMembers) Get(_ context.Context, roomID, userID string) (*entity.RoomMember, error) {
+	if !f.members[userID] {
+		return nil, entity.ErrNotRoomMember
+	}
+	return &entity.RoomMember{RoomID: roomID, UserID: userID}, nil
+}
+
+func (f *fakeMembers) TouchLastSeen(context.Context, string, string, time.Time) error {
+	return nil
+}
+
+// fakeTopics returns the topics newest first in a single page, as the repository does
+type fakeTopics struct {
+	repository.PrayerTopicRepository
+	topics []*entity.PrayerTopic
+}
+
+func (f *fakeTopics) List(_ context.Context, _ string, _ repository.PrayerTopicFilter, _ *pagination.TimeKey, _ int) ([]*entity.PrayerTopic, error) {
+	return f.topics, nil
+}
+
+type fakeContents struct {
+	repository.PrayerContentRepository
+	byTopic map[string][]*entity.PrayerContent
+}
+
+func (f *fakeContents) List(_ context.Context, topicID, _ string, _ *pagination.TimeKey, _ int) ([]*entity.PrayerContent, error) {
+	return f.byTopic[topicID], nil
+}
+
+type fakeUsers struct {
+	repository.UserRepository
+	users map[string]*entity.User
+}
+
+func (f *fakeUsers) GetByID(_ context.Context, id string) (*entity.User, error) {
+	if u, ok := f.users[id]; ok {
+		return u, nil
+	}
+	return nil, entity.ErrUserNotFound
+}
+
+func (f *fakeUsers) GetByIDs(_ context.Context, ids []string) ([]*entity.User, error) {
+	var users []*entity.User
+	for _, id := range ids {
+		if u, ok := f.users[id]; ok {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+type fakeStorage struct {
+	key         string
+	contentType string
+	data        []byte
+}
+
+func (f *fakeStorage) Put(_ context.Context, key, contentType string, data []byte) (string, error) {
+	f.key, f.contentType, f.data = key, contentType, data
+	return "https://files.example.com/" + key, nil
+}
+
+func (f *fakeStorage) Delete(context.Context, string) error {
+	return nil
+}
+
+func (f *fakeStorage) SignedURL(key string, _ time.Time) string {
+	return "https://files.example.com/" + key + "?signature=sig"
+}
+
+type fakeNotifier struct {
+	service.Notifier
+	sent []entity.Notification
+	to   []string
+}
+
+func (f *fakeNotifier) Notify(_ context.Context, userIDs []string, n entity.Notification) error {
+	f.sent = append(f.sent, n)
+	f.to = append(f.to, userIDs...)
+	return nil
+}
+
+// newExportRun returns a run over one export of a room with an answered topic and an entry
+// by a user whose account is gone
+func newExportRun(format entity.ExportFormat, requesterIsMember bool) (*RunExportsUseCase, *fakeExports, *fakeStorage, *fakeNotifier) {
+	created := time.Date(2026, 3, 1, 0, 30, 0, 0, time.UTC)
+	answered := created.Add(48 * time.Hour)
+	exports := &fakeExports{export: &entity.RoomExport{ID: "e1", RoomID: "r1", RequestedBy: "grace", Format: format, Status: entity.ExportPending}}
+	topics := &fakeTopics{topics: []*entity.PrayerTopic{
+		{ID: "t2", RoomID: "r1", AuthorID: "grace", Title: "새 학기", CreatedAt: created.Add(time.Hour)},
+		{ID: "t1", RoomID: "r1", AuthorID: "grace", Title: "어머니의 건강", Tags: []string{"치유"}, AnsweredAt: &answered, Testimony: "회복되셨습니다", ReactionCount: 3, CreatedAt: created},
+	}}
+	contents := &fakeContents{byTopic: map[string][]*entity.PrayerContent{
+		"t1": {{ID: "c1", TopicID: "t1", AuthorID: "gone", Body: "함께 기도합니다", CreatedAt: created.Add(time.Hour)}},
+	}}
+	users := &fakeUsers{users: map[string]*entity.User{
+		"grace": {ID: "grace", Nickname: "은혜", Timezone: "Asia/Seoul"},
+	}}
+	members := &fakeMembers{members: map[string]bool{"grace": requesterIsMember}}
+	storage := &fakeStorage{}
+	notifier := &fakeNotifier{}
+	uc := NewRunExportsUseCase(exports, &fakeRooms{room: &entity.Room{ID: "r1", Name: "새벽기도"}}, members, topics, contents, users, storage, notifier)
+	return uc, exports, storage, notifier
+}
+
+func TestRunExportsCSV(t *testing.T) {
+	uc, exports, storage, notifier := newExportRun(entity.ExportCSV, true)
+	if err := uc.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	if exports.finished == nil || exports.finished.Status != entity.ExportDone {
+		t.Fatalf("finished = %+v, want the export done", exports.finished)
+	}
+	if storage.key != "private/exports/e1.csv" || exports.finished.FileKey != storage.key {
+		t.Errorf("stored under %q, export has %q, want private/exports/e1.csv", storage.key, exports.finished.FileKey)
+	}
+	if storage.contentType != entity.ExportCSV.ContentType() {
+		t.Errorf("content type = %q", storage.contentType)
+	}
+
+	if !bytes.HasPrefix(storage.data, []byte("\ufeff")) {
+		t.Error("CSV has no byte order mark")
+	}
+	rows, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(storage.data, []byte("\ufeff")))).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	want := [][]string{
+		{"기도제목", "t1", "2026-03-01 09:30", "은혜", "어머니의 건강", "치유", "2026-03-03 09:30", "회복되셨습니다", "3", "0"},
+		{"기도내용", "t1", "2026-03-01 10:30", "(알 수 없음)", "함께 기도합니다", "", "", "", "", ""},
+		{"기도제목", "t2", "2026-03-01 10:30", "은혜", "새 학기", "", "", "", "0", "0"},
+	}
+	if len(rows) != len(want)+1 {
+		t.Fatalf("got %d rows, want a header and %d rows:\n%s", len(rows), len(want), storage.data)
+	}
+	for i, row := range want {
+		if strings.Join(rows[i+1], "|") != strings.Join(row, "|") {
+			t.Errorf("row %d = %v, want %v", i+1, rows[i+1], row)
+		}
+	}
+
+	if len(notifier.sent) != 1 || notifier.sent[0].Type != entity.NotificationExportReady || notifier.to[0] != "grace" {
+		t.Fatalf("sent %+v to %v, want the export ready notification to the requester", notifier.sent, notifier.to)
+	}
+	if url := notifier.sent[0].Data["url"]; url != "https://files.example.com/private/exports/e1.csv?signature=sig" {
+		t.Errorf("url = %q, want the signed URL", url)
+	}
+}
+
+func TestRunExportsPDF(t *testing.T) {
+	uc, exports, storage, _ := newExportRun(entity.ExportPDF, true)
+	if err := uc.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if exports.finished.Status != entity.ExportDone || storage.key != "private/exports/e1.pdf" {
+		t.Fatalf("finished %s under %q, want done under private/exports/e1.pdf", exports.finished.Status, storage.key)
+	}
+	if !bytes.HasPrefix(storage.data, []byte("%PDF-")) || !bytes.Contains(storage.data, []byte("%%EOF")) {
+		t.Errorf("file is not a PDF: %.40q", storage.data)
+	}
+}
+
+func TestRunExportsFailsForFormerMembers(t *testing.T) {
+	uc, exports, storage, notifier := newExportRun(entity.ExportCSV, false)
+	if err := uc.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if exports.finished.Status != entity.ExportFailed {
+		t.Errorf("status = %s, want failed", exports.finished.Status)
+	}
+	if storage.data != nil {
+		t.Error("stored a file for a requester who left the room")
+	}
+	if len(notifier.sent) != 1 || notifier.sent[0].Type != entity.NotificationExportFailed {
+		t.Errorf("sent %+v, want the export failed notification", notifier.sent)
+	}
+}
@@ -0,0 +1,45 @@
+package prayer
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+type JournalUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	reactionRepo repository.PrayerReactionRepository
+}
+
+func NewJournalUseCase(topicRepo repository.PrayerTopicRepository, reactionRepo repository.PrayerReactionRepository) *JournalUseCase {
+	return &JournalUseCase{
+		topicRepo:    topicRepo,
+		reactionRepo: reactionRepo,
+	}
+}
+
+// Execute returns a page of the user's private journal: their live private topics across
+// the rooms they still belong to, newest first
+func (uc *JournalUseCase) Execute(ctx context.Context, userID, cursor string, limit int) ([]TopicView, pagination.Meta, error) {
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	topics, err := uc.topicRepo.ListPrivate(ctx, userID, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	topics, meta, err := pagination.Page(topics, limit, topicCursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	views, err := topicViews(ctx, uc.reactionRepo, userID, topics)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	return views, meta, nil
+}
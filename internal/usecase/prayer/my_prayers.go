@@ -0,0 +1,79 @@
+package prayer
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+type MyPrayersUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	reactionRepo repository.PrayerReactionRepository
+}
+
+func NewMyPrayersUseCase(topicRepo repository.PrayerTopicRepository, reactionRepo repository.PrayerReactionRepository) *MyPrayersUseCase {
+	return &MyPrayersUseCase{
+		topicRepo:    topicRepo,
+		reactionRepo: reactionRepo,
+	}
+}
+
+// Execute returns a page of the user's own live topics across the rooms they still belong to
+// The topics they put in order head the first page in that order; the rest follow newest first
+func (uc *MyPrayersUseCase) Execute(ctx context.Context, userID, cursor string, limit int) ([]TopicView, pagination.Meta, error) {
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	topics, err := uc.topicRepo.ListUnorderedByAuthor(ctx, userID, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	topics, meta, err := pagination.Page(topics, limit, topicCursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	if after == nil {
+		ordered, err := uc.topicRepo.ListOrderedByAuthor(ctx, userID)
+		if err != nil {
+			return nil, pagination.Meta{}, err
+		}
+		topics = append(ordered, topics...)
+	}
+
+	views, err := topicViews(ctx, uc.reactionRepo, userID, topics)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	return views, meta, nil
+}
+
+type ReorderMyPrayersUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	reactionRepo repository.PrayerReactionRepository
+}
+
+func NewReorderMyPrayersUseCase(topicRepo repository.PrayerTopicRepository, reactionRepo repository.PrayerReactionRepository) *ReorderMyPrayersUseCase {
+	return &ReorderMyPrayersUseCase{
+		topicRepo:    topicRepo,
+		reactionRepo: reactionRepo,
+	}
+}
+
+// Execute puts the given topics, all the user's own, at the top of their "my prayers" list in
+// that order and returns them; topics left out go back to newest first, so an empty list resets it
+func (uc *ReorderMyPrayersUseCase) Execute(ctx context.Context, userID string, topicIDs []string) ([]TopicView, error) {
+	if err := uc.topicRepo.ReorderByAuthor(ctx, userID, topicIDs); err != nil {
+		return nil, err
+	}
+
+	topics, err := uc.topicRepo.ListOrderedByAuthor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return topicViews(ctx, uc.reactionRepo, userID, topics)
+}
@@ -0,0 +1,76 @@
+package prayer
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// orderedTopics serves one author's topics: those with a UserOrder in order, the rest newest first
+type orderedTopics struct {
+	repository.PrayerTopicRepository
+	topics []*entity.PrayerTopic
+}
+
+func (f orderedTopics) ListOrderedByAuthor(context.Context, string) ([]*entity.PrayerTopic, error) {
+	var ordered []*entity.PrayerTopic
+	for _, t := range f.topics {
+		if t.UserOrder > 0 {
+			ordered = append(ordered, t)
+		}
+	}
+	slices.SortFunc(ordered, func(a, b *entity.PrayerTopic) int { return a.UserOrder - b.UserOrder })
+	return ordered, nil
+}
+
+func (f orderedTopics) ListUnorderedByAuthor(_ context.Context, _ string, after *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error) {
+	var rest []*entity.PrayerTopic
+	for _, t := range f.topics {
+		if t.UserOrder == 0 && (after == nil || t.CreatedAt.Before(after.Time)) {
+			rest = append(rest, t)
+		}
+	}
+	slices.SortFunc(rest, func(a, b *entity.PrayerTopic) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return rest[:min(limit, len(rest))], nil
+}
+
+func TestMyPrayersPutsOrderedTopicsFirst(t *testing.T) {
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	topics := orderedTopics{}
+	for i, id := range []string{"t1", "t2", "t3", "t4"} {
+		topics.topics = append(topics.topics, &entity.PrayerTopic{ID: id, AuthorID: "grace", CreatedAt: created.Add(time.Duration(i) * time.Hour)})
+	}
+	topics.topics[0].UserOrder = 2
+	topics.topics[1].UserOrder = 1
+	uc := NewMyPrayersUseCase(topics, noReactions{})
+	ctx := context.Background()
+
+	ids := func(views []TopicView) []string {
+		var ids []string
+		for _, v := range views {
+			ids = append(ids, v.Topic.ID)
+		}
+		return ids
+	}
+
+	first, page, err := uc.Execute(ctx, "grace", "", 1)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if got := ids(first); !slices.Equal(got, []string{"t2", "t1", "t4"}) {
+		t.Errorf("first page = %v, want the ordered topics, then the newest other one", got)
+	}
+
+	next, _, err := uc.Execute(ctx, "grace", page.NextCursor, 1)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if got := ids(next); !slices.Equal(got, []string{"t3"}) {
+		t.Errorf("second page = %v, want t3 without the ordered topics again", got)
+	}
+}
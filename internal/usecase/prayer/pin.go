@@ -0,0 +1,161 @@
+package prayer
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/google/uuid"
+)
+
+type PinTopicUseCase struct {
+	topicRepo  repository.PrayerTopicRepository
+	outboxRepo repository.OutboxRepository
+	authz      *room.Authorizer
+	transactor repository.Transactor
+	maxPinned  int
+}
+
+func NewPinTopicUseCase(
+	topicRepo repository.PrayerTopicRepository,
+	outboxRepo repository.OutboxRepository,
+	authz *room.Authorizer,
+	transactor repository.Transactor,
+	maxPinned int,
+) *PinTopicUseCase {
+	return &PinTopicUseCase{
+		topicRepo:  topicRepo,
+		outboxRepo: outboxRepo,
+		authz:      authz,
+		transactor: transactor,
+		maxPinned:  maxPinned,
+	}
+}
+
+// Execute pins a live topic to the top of its room's feed, after the topics pinned before it,
+// and tells the other members who have not muted the room
+// Moderators may pin up to maxPinned topics per room; private topics cannot be pinned
+func (uc *PinTopicUseCase) Execute(ctx context.Context, userID, topicID string) (*entity.PrayerTopic, error) {
+	topic, err := liveTopic(ctx, uc.topicRepo, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+
+	rm, _, err := uc.authz.Require(ctx, userID, topic.RoomID, entity.PermPinPrayer)
+	if err != nil {
+		return nil, hideRoom(err)
+	}
+
+	now := time.Now()
+	if err := topic.Pin(userID, now); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.topicRepo.Pin(ctx, topic, uc.maxPinned); err != nil {
+			return err
+		}
+
+		event := entity.NewRoomOutboxEvent(topic.RoomID, userID, entity.Notification{
+			Type:   entity.NotificationPrayerPinned,
+			Params: map[string]string{"room": rm.Name, "title": topic.Title},
+			Data:   map[string]string{"room_id": topic.RoomID, "prayer_id": topic.ID},
+		}, now)
+		event.ID = uuid.New().String()
+		return uc.outboxRepo.Append(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return topic, nil
+}
+
+type UnpinTopicUseCase struct {
+	topicRepo  repository.PrayerTopicRepository
+	outboxRepo repository.OutboxRepository
+	authz      *room.Authorizer
+	transactor repository.Transactor
+}
+
+func NewUnpinTopicUseCase(
+	topicRepo repository.PrayerTopicRepository,
+	outboxRepo repository.OutboxRepository,
+	authz *room.Authorizer,
+	transactor repository.Transactor,
+) *UnpinTopicUseCase {
+	return &UnpinTopicUseCase{
+		topicRepo:  topicRepo,
+		outboxRepo: outboxRepo,
+		authz:      authz,
+		transactor: transactor,
+	}
+}
+
+// Execute returns a pinned topic to its place in the feed
+// Members are not notified; the room activity event goes to the notification webhook only
+func (uc *UnpinTopicUseCase) Execute(ctx context.Context, userID, topicID string) (*entity.PrayerTopic, error) {
+	topic, err := liveTopic(ctx, uc.topicRepo, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := uc.authz.Require(ctx, userID, topic.RoomID, entity.PermPinPrayer); err != nil {
+		return nil, hideRoom(err)
+	}
+
+	if err := topic.Unpin(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.topicRepo.Unpin(ctx, topic.ID); err != nil {
+			return err
+		}
+
+		event := entity.NewWebhookOutboxEvent(topic.RoomID, userID, entity.Notification{
+			Type: entity.NotificationPrayerUnpinned,
+			Data: map[string]string{"room_id": topic.RoomID, "prayer_id": topic.ID},
+		}, now)
+		event.ID = uuid.New().String()
+		return uc.outboxRepo.Append(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return topic, nil
+}
+
+type ReorderPinsUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	reactionRepo repository.PrayerReactionRepository
+	authz        *room.Authorizer
+}
+
+func NewReorderPinsUseCase(topicRepo repository.PrayerTopicRepository, reactionRepo repository.PrayerReactionRepository, authz *room.Authorizer) *ReorderPinsUseCase {
+	return &ReorderPinsUseCase{
+		topicRepo:    topicRepo,
+		reactionRepo: reactionRepo,
+		authz:        authz,
+	}
+}
+
+// Execute puts the room's pinned topics in the given order, which must list each of them once,
+// and returns them as the user now sees them
+func (uc *ReorderPinsUseCase) Execute(ctx context.Context, userID, roomID string, topicIDs []string) ([]TopicView, error) {
+	if _, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermPinPrayer); err != nil {
+		return nil, err
+	}
+
+	if err := uc.topicRepo.ReorderPins(ctx, roomID, topicIDs); err != nil {
+		return nil, err
+	}
+
+	topics, err := uc.topicRepo.ListPinned(ctx, roomID, repository.PrayerTopicFilter{ViewerID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return topicViews(ctx, uc.reactionRepo, userID, topics)
+}
@@ -0,0 +1,221 @@
+package prayer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// pinTopics keeps the topics of one room and the order of its pins in memory
+type pinTopics struct {
+	repository.PrayerTopicRepository
+	topics     map[string]*entity.PrayerTopic
+	pins       []string
+	listFilter repository.PrayerTopicFilter
+	listedPins bool
+}
+
+func (f *pinTopics) GetByID(_ context.Context, id string) (*entity.PrayerTopic, error) {
+	topic, ok := f.topics[id]
+	if !ok {
+		return nil, entity.ErrPrayerTopicNotFound
+	}
+	copied := *topic
+	return &copied, nil
+}
+
+func (f *pinTopics) Pin(_ context.Context, topic *entity.PrayerTopic, max int) error {
+	if len(f.pins) >= max {
+		return entity.ErrPinLimitReached
+	}
+	f.pins = append(f.pins, topic.ID)
+	topic.PinPosition = len(f.pins)
+	f.topics[topic.ID] = topic
+	return nil
+}
+
+func (f *pinTopics) Unpin(_ context.Context, id string) error {
+	f.pins = slices.DeleteFunc(f.pins, func(pinned string) bool { return pinned == id })
+	f.topics[id].PinnedAt = nil
+	return nil
+}
+
+func (f *pinTopics) ListPinned(context.Context, string, repository.PrayerTopicFilter) ([]*entity.PrayerTopic, error) {
+	f.listedPins = true
+	topics := make([]*entity.PrayerTopic, 0, len(f.pins))
+	for _, id := range f.pins {
+		topics = append(topics, f.topics[id])
+	}
+	return topics, nil
+}
+
+// List returns the topics newest first, leaving out pinned ones when asked to
+func (f *pinTopics) List(_ context.Context, _ string, filter repository.PrayerTopicFilter, _ *pagination.TimeKey, limit int) ([]*entity.PrayerTopic, error) {
+	f.listFilter = filter
+	var topics []*entity.PrayerTopic
+	for _, topic := range f.topics {
+		if !filter.Unpinned || !topic.IsPinned() {
+			topics = append(topics, topic)
+		}
+	}
+	slices.SortFunc(topics, func(a, b *entity.PrayerTopic) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return topics[:min(limit, len(topics))], nil
+}
+
+type recordingOutbox struct {
+	repository.OutboxRepository
+	events []*entity.OutboxEvent
+}
+
+func (f *recordingOutbox) Append(_ context.Context, event *entity.OutboxEvent) error {
+	f.events = append(f.events, event)
+	return nil
+}
+
+type inlineTransactor struct{}
+
+func (inlineTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type roleMembers struct {
+	repository.RoomMemberRepository
+	roles map[string]entity.RoomRole
+}
+
+func (f *roleMembers) Get(_ context.Context, roomID, userID string) (*entity.RoomMember, error) {
+	role, ok := f.roles[userID]
+	if !ok {
+		return nil, entity.ErrNotRoomMember
+	}
+	return &entity.RoomMember{RoomID: roomID, UserID: userID, Role: role}, nil
+}
+
+func (f *roleMembers) TouchLastSeen(context.Context, string, string, time.Time) error {
+	return nil
+}
+
+type noReactions struct {
+	repository.PrayerReactionRepository
+}
+
+func (noReactions) Reacted(context.Context, string, []string) (map[string]bool, error) {
+	return map[string]bool{}, nil
+}
+
+// newPinRoom returns a room with a moderator, a member and three topics, t3 the newest
+func newPinRoom() (*pinTopics, *room.Authorizer) {
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	topics := &pinTopics{topics: map[string]*entity.PrayerTopic{}}
+	for i, id := range []string{"t1", "t2", "t3"} {
+		topics.topics[id] = &entity.PrayerTopic{ID: id, RoomID: "r1", AuthorID: "member", Title: "기도제목 " + id, CreatedAt: created.Add(time.Duration(i) * time.Hour)}
+	}
+	members := &roleMembers{roles: map[string]entity.RoomRole{"moderator": entity.RoomRoleModerator, "member": entity.RoomRoleMember}}
+	authz := room.NewAuthorizer(&fakeRooms{room: &entity.Room{ID: "r1", Name: "새벽기도"}}, members)
+	return topics, authz
+}
+
+func TestPinTopic(t *testing.T) {
+	topics, authz := newPinRoom()
+	outbox := &recordingOutbox{}
+	uc := NewPinTopicUseCase(topics, outbox, authz, inlineTransactor{}, 1)
+	ctx := context.Background()
+
+	if _, err := uc.Execute(ctx, "member", "t1"); !errors.Is(err, entity.ErrRoomPermissionDenied) {
+		t.Errorf("member pinning: err = %v, want ErrRoomPermissionDenied", err)
+	}
+
+	topic, err := uc.Execute(ctx, "moderator", "t1")
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if !topic.IsPinned() || topic.PinnedBy != "moderator" || topic.PinPosition != 1 {
+		t.Errorf("topic = pinned %v at %d by %q, want pinned first by the moderator", topic.IsPinned(), topic.PinPosition, topic.PinnedBy)
+	}
+	if len(outbox.events) != 1 {
+		t.Fatalf("appended %d events, want 1", len(outbox.events))
+	}
+	event := outbox.events[0]
+	if event.Destination != entity.OutboxToMembers || event.Notification.Type != entity.NotificationPrayerPinned || event.ActorID != "moderator" {
+		t.Errorf("event = %+v, want the pinned notification to the members", event)
+	}
+	if event.Notification.Params["room"] != "새벽기도" || event.Notification.Data["prayer_id"] != "t1" {
+		t.Errorf("notification = %+v, want the room name and the topic", event.Notification)
+	}
+
+	if _, err := uc.Execute(ctx, "moderator", "t2"); !errors.Is(err, entity.ErrPinLimitReached) {
+		t.Errorf("pinning past the limit: err = %v, want ErrPinLimitReached", err)
+	}
+	if _, err := uc.Execute(ctx, "moderator", "t1"); !errors.Is(err, entity.ErrPrayerTopicPinned) {
+		t.Errorf("pinning again: err = %v, want ErrPrayerTopicPinned", err)
+	}
+	if len(outbox.events) != 1 {
+		t.Errorf("appended %d events, want none for the rejected pins", len(outbox.events))
+	}
+}
+
+func TestUnpinTopicPublishesToWebhookOnly(t *testing.T) {
+	topics, authz := newPinRoom()
+	outbox := &recordingOutbox{}
+	ctx := context.Background()
+	if _, err := NewPinTopicUseCase(topics, &recordingOutbox{}, authz, inlineTransactor{}, 3).Execute(ctx, "moderator", "t1"); err != nil {
+		t.Fatalf("Pin: %v", err)
+	}
+
+	uc := NewUnpinTopicUseCase(topics, outbox, authz, inlineTransactor{})
+	topic, err := uc.Execute(ctx, "moderator", "t1")
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if topic.IsPinned() || len(topics.pins) != 0 {
+		t.Errorf("topic pinned = %v, pins = %v, want it unpinned", topic.IsPinned(), topics.pins)
+	}
+	if len(outbox.events) != 1 || outbox.events[0].Destination != entity.OutboxToWebhook || outbox.events[0].Notification.Type != entity.NotificationPrayerUnpinned {
+		t.Fatalf("events = %+v, want one unpinned event for the webhook", outbox.events)
+	}
+
+	if _, err := uc.Execute(ctx, "moderator", "t1"); !errors.Is(err, entity.ErrPrayerTopicNotPinned) {
+		t.Errorf("unpinning again: err = %v, want ErrPrayerTopicNotPinned", err)
+	}
+}
+
+func TestListTopicsPutsPinnedFirst(t *testing.T) {
+	topics, authz := newPinRoom()
+	ctx := context.Background()
+	if _, err := NewPinTopicUseCase(topics, &recordingOutbox{}, authz, inlineTransactor{}, 3).Execute(ctx, "moderator", "t1"); err != nil {
+		t.Fatalf("Pin: %v", err)
+	}
+	uc := NewListTopicsUseCase(topics, noReactions{}, authz)
+
+	views, meta, err := uc.Execute(ctx, "member", "r1", repository.PrayerTopicFilter{Active: true}, "", 1)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	var ids []string
+	for _, v := range views {
+		ids = append(ids, v.Topic.ID)
+	}
+	if !slices.Equal(ids, []string{"t1", "t3"}) {
+		t.Errorf("first page = %v, want the pinned t1 and then the newest t3", ids)
+	}
+	if !topics.listFilter.Unpinned || !meta.HasMore {
+		t.Errorf("filter = %+v, hasMore = %v, want the page of unpinned topics to continue", topics.listFilter, meta.HasMore)
+	}
+
+	// Later pages do not repeat the pins
+	topics.listedPins = false
+	views, _, err = uc.Execute(ctx, "member", "r1", repository.PrayerTopicFilter{Active: true}, meta.NextCursor, 1)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if topics.listedPins || len(views) != 1 || views[0].Topic.IsPinned() {
+		t.Errorf("second page listed pins = %v, got %d topics, want only unpinned topics", topics.listedPins, len(views))
+	}
+}
@@ -0,0 +1,165 @@
+package prayer
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+type ReactUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	reactionRepo repository.PrayerReactionRepository
+	authz        *room.Authorizer
+	notifier     service.Notifier
+	policy       entity.PrayerReactionPolicy
+}
+
+func NewReactUseCase(
+	topicRepo repository.PrayerTopicRepository,
+	reactionRepo repository.PrayerReactionRepository,
+	authz *room.Authorizer,
+	notifier service.Notifier,
+	policy entity.PrayerReactionPolicy,
+) *ReactUseCase {
+	return &ReactUseCase{
+		topicRepo:    topicRepo,
+		reactionRepo: reactionRepo,
+		authz:        authz,
+		notifier:     notifier,
+		policy:       policy,
+	}
+}
+
+// Execute records or withdraws the member's "함께 기도" on a live topic and returns the topic's count
+// with how many of the member's prayers for it were counted
+// Both directions are idempotent, and praying again only counts as the policy allows; the author
+// is told when the count reaches a milestone
+// anonymous keeps the member's name out of the topic's list of who prayed when they first pray for it
+func (uc *ReactUseCase) Execute(ctx context.Context, userID, topicID string, react, anonymous bool) (count, prayed int, err error) {
+	topic, err := liveTopic(ctx, uc.topicRepo, userID, topicID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, _, err := uc.authz.Writable(ctx, userID, topic.RoomID); err != nil {
+		return 0, 0, hideRoom(err)
+	}
+
+	if !react {
+		count, err := uc.reactionRepo.Remove(ctx, topic.ID, userID)
+		return count, 0, err
+	}
+
+	reaction := entity.NewPrayerReaction(topic, userID, anonymous)
+	count, err = uc.reactionRepo.Add(ctx, reaction, uc.policy)
+	if errors.Is(err, entity.ErrAlreadyReacted) {
+		return topic.ReactionCount, reaction.Count, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	if reaction.Count > 1 {
+		// A repeated prayer leaves the count of members, and so the milestones, as they were
+		return count, reaction.Count, nil
+	}
+
+	if entity.IsReactionMilestone(count) && !topic.IsAuthoredBy(userID) {
+		room.Notify(ctx, uc.notifier, []string{topic.AuthorID}, entity.Notification{
+			Type:   entity.NotificationReactionMilestone,
+			Params: map[string]string{"count": strconv.Itoa(count), "title": topic.Title},
+			Data:   map[string]string{"room_id": topic.RoomID, "prayer_id": topic.ID},
+		})
+	}
+	return count, reaction.Count, nil
+}
+
+// ReactionView is one member's "함께 기도" on a topic; User is nil for anonymous members and
+// accounts pending deletion
+type ReactionView struct {
+	Reaction *entity.PrayerReaction
+	User     *entity.User
+}
+
+// ReactionPage is a page of who prayed for a topic
+type ReactionPage struct {
+	// Total counts every member who prayed, anonymous ones included
+	Total     int
+	Reactions []ReactionView
+	Page      pagination.Meta
+}
+
+type ListReactionsUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	reactionRepo repository.PrayerReactionRepository
+	userRepo     repository.UserRepository
+	authz        *room.Authorizer
+}
+
+func NewListReactionsUseCase(
+	topicRepo repository.PrayerTopicRepository,
+	reactionRepo repository.PrayerReactionRepository,
+	userRepo repository.UserRepository,
+	authz *room.Authorizer,
+) *ListReactionsUseCase {
+	return &ListReactionsUseCase{
+		topicRepo:    topicRepo,
+		reactionRepo: reactionRepo,
+		userRepo:     userRepo,
+		authz:        authz,
+	}
+}
+
+// Execute returns a page of the members who prayed for a topic, most recent first
+// Only the topic's author and the room's owner may see it; anonymous members are counted and
+// listed without their name
+func (uc *ListReactionsUseCase) Execute(ctx context.Context, userID, topicID, cursor string, limit int) (*ReactionPage, error) {
+	topic, err := ownedTopic(ctx, uc.topicRepo, uc.authz, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	reactions, err := uc.reactionRepo.ListByTopic(ctx, topic.ID, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	reactions, page, err := pagination.Page(reactions, limit, func(r *entity.PrayerReaction) (string, error) {
+		return pagination.Encode(pagination.TimeKey{Time: r.CreatedAt, ID: r.UserID})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, r := range reactions {
+		if !r.Anonymous {
+			ids = append(ids, r.UserID)
+		}
+	}
+	users, err := uc.userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*entity.User, len(users))
+	for _, u := range users {
+		if !u.IsDeletionScheduled() {
+			byID[u.ID] = u
+		}
+	}
+
+	views := make([]ReactionView, 0, len(reactions))
+	for _, r := range reactions {
+		views = append(views, ReactionView{Reaction: r, User: byID[r.UserID]})
+	}
+	return &ReactionPage{Total: topic.ReactionCount, Reactions: views, Page: page}, nil
+}
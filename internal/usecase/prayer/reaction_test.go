@@ -0,0 +1,77 @@
+package prayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// reactionList serves reactions stored newest first
+type reactionList struct {
+	repository.PrayerReactionRepository
+	reactions []*entity.PrayerReaction
+}
+
+func (f reactionList) ListByTopic(_ context.Context, _ string, after *pagination.TimeKey, limit int) ([]*entity.PrayerReaction, error) {
+	var page []*entity.PrayerReaction
+	for _, r := range f.reactions {
+		if len(page) < limit && (after == nil || r.CreatedAt.Before(after.Time)) {
+			page = append(page, r)
+		}
+	}
+	return page, nil
+}
+
+func TestListReactionsHidesAnonymousMembers(t *testing.T) {
+	topics, authz := newEditRoom()
+	topics.topics["t1"].ReactionCount = 3
+	now := time.Now()
+	reactions := reactionList{reactions: []*entity.PrayerReaction{
+		{TopicID: "t1", UserID: "moderator", Count: 2, CreatedAt: now.Add(-time.Hour)},
+		{TopicID: "t1", UserID: "other", Anonymous: true, Count: 1, CreatedAt: now.Add(-2 * time.Hour)},
+		{TopicID: "t1", UserID: "owner", Count: 1, CreatedAt: now.Add(-3 * time.Hour)},
+	}}
+	users := &fakeUsers{users: map[string]*entity.User{
+		"moderator": {ID: "moderator", Nickname: "요한"},
+		"other":     {ID: "other", Nickname: "마리아"},
+		"owner":     {ID: "owner", Nickname: "베드로"},
+	}}
+	uc := NewListReactionsUseCase(topics, reactions, users, authz)
+	ctx := context.Background()
+
+	page, err := uc.Execute(ctx, "member", "t1", "", 2)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if page.Total != 3 {
+		t.Errorf("Total = %d, want the anonymous member counted", page.Total)
+	}
+	if len(page.Reactions) != 2 || !page.Page.HasMore {
+		t.Fatalf("listed %d with more = %v, want a first page of 2", len(page.Reactions), page.Page.HasMore)
+	}
+	if u := page.Reactions[0].User; u == nil || u.Nickname != "요한" {
+		t.Errorf("first = %+v, want the moderator named", u)
+	}
+	if anonymous := page.Reactions[1]; anonymous.User != nil || !anonymous.Reaction.Anonymous {
+		t.Errorf("second = %+v, want the anonymous member listed without a name", anonymous.User)
+	}
+
+	next, err := uc.Execute(ctx, "owner", "t1", page.Page.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("Execute as owner: %v", err)
+	}
+	if len(next.Reactions) != 1 || next.Reactions[0].User == nil || next.Reactions[0].User.ID != "owner" {
+		t.Errorf("second page = %+v, want the owner's own prayer", next.Reactions)
+	}
+
+	for _, userID := range []string{"moderator", "other"} {
+		if _, err := uc.Execute(ctx, userID, "t1", "", 20); !errors.Is(err, entity.ErrRoomPermissionDenied) {
+			t.Errorf("%s: err = %v, want ErrRoomPermissionDenied", userID, err)
+		}
+	}
+}
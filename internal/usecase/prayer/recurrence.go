@@ -0,0 +1,139 @@
+package prayer
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/google/uuid"
+)
+
+// recurBatchSize bounds the topics re-posted per run; the rest wait for the next tick
+const recurBatchSize = 100
+
+type PauseRecurrenceUseCase struct {
+	topicRepo repository.PrayerTopicRepository
+	authz     *room.Authorizer
+}
+
+func NewPauseRecurrenceUseCase(topicRepo repository.PrayerTopicRepository, authz *room.Authorizer) *PauseRecurrenceUseCase {
+	return &PauseRecurrenceUseCase{
+		topicRepo: topicRepo,
+		authz:     authz,
+	}
+}
+
+// Execute pauses or resumes the recurrence of a live topic; its author or a moderator may do this
+// Occurrences that fall due while paused are skipped, not posted on resume
+func (uc *PauseRecurrenceUseCase) Execute(ctx context.Context, userID, topicID string, paused bool) (*entity.PrayerTopic, error) {
+	topic, err := modifiableTopic(ctx, uc.topicRepo, uc.authz, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+	if topic.IsDeleted() {
+		return nil, entity.ErrPrayerTopicNotFound
+	}
+
+	if err := topic.PauseRecurrence(paused, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.topicRepo.Update(ctx, topic); err != nil {
+		return nil, err
+	}
+	return topic, nil
+}
+
+type CancelRecurrenceUseCase struct {
+	topicRepo repository.PrayerTopicRepository
+	authz     *room.Authorizer
+}
+
+func NewCancelRecurrenceUseCase(topicRepo repository.PrayerTopicRepository, authz *room.Authorizer) *CancelRecurrenceUseCase {
+	return &CancelRecurrenceUseCase{
+		topicRepo: topicRepo,
+		authz:     authz,
+	}
+}
+
+// Execute stops a live topic from recurring; its author or a moderator may do this
+// Occurrences already posted stay as they are
+func (uc *CancelRecurrenceUseCase) Execute(ctx context.Context, userID, topicID string) (*entity.PrayerTopic, error) {
+	topic, err := modifiableTopic(ctx, uc.topicRepo, uc.authz, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+	if topic.IsDeleted() {
+		return nil, entity.ErrPrayerTopicNotFound
+	}
+
+	if err := topic.CancelRecurrence(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.topicRepo.Update(ctx, topic); err != nil {
+		return nil, err
+	}
+	return topic, nil
+}
+
+type RecurTopicsUseCase struct {
+	topicRepo  repository.PrayerTopicRepository
+	memberRepo repository.RoomMemberRepository
+}
+
+func NewRecurTopicsUseCase(topicRepo repository.PrayerTopicRepository, memberRepo repository.RoomMemberRepository) *RecurTopicsUseCase {
+	return &RecurTopicsUseCase{
+		topicRepo:  topicRepo,
+		memberRepo: memberRepo,
+	}
+}
+
+// Execute re-posts the recurring topics that are due, each as a new topic in the same room
+// Topics whose author left the room stop recurring, since the author could not post there anymore
+func (uc *RecurTopicsUseCase) Execute(ctx context.Context) error {
+	now := time.Now()
+	topics, err := uc.topicRepo.ListDueRecurrences(ctx, now, recurBatchSize)
+	if err != nil {
+		return err
+	}
+
+	posted := 0
+	for _, topic := range topics {
+		ok, err := uc.recur(ctx, topic, now)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			slog.ErrorContext(ctx, "Failed to re-post recurring prayer topic", "prayer_id", topic.ID, "error", err)
+			continue
+		}
+		if ok {
+			posted++
+		}
+	}
+
+	if posted > 0 {
+		slog.InfoContext(ctx, "Re-posted recurring prayer topics", "count", posted)
+	}
+	return nil
+}
+
+// recur posts the next occurrence of one topic, reporting false when nothing was posted
+func (uc *RecurTopicsUseCase) recur(ctx context.Context, topic *entity.PrayerTopic, now time.Time) (bool, error) {
+	if _, err := uc.memberRepo.Get(ctx, topic.RoomID, topic.AuthorID); err != nil {
+		if !errors.Is(err, entity.ErrNotRoomMember) {
+			return false, err
+		}
+		if err := topic.CancelRecurrence(now); err != nil {
+			return false, err
+		}
+		return false, uc.topicRepo.Update(ctx, topic)
+	}
+
+	next := topic.Recur(now)
+	next.ID = uuid.New().String()
+	return uc.topicRepo.Recur(ctx, topic, next)
+}
@@ -0,0 +1,114 @@
+package prayer
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+)
+
+// ReminderMaxDelay is how late a reminder may still go out after an outage; older ones are dropped
+const ReminderMaxDelay = 10 * time.Minute
+
+// reminderPageSize bounds the recipients loaded per query
+const reminderPageSize = 500
+
+type SendRemindersUseCase struct {
+	memberRepo repository.RoomMemberRepository
+	notifier   service.Notifier
+}
+
+func NewSendRemindersUseCase(memberRepo repository.RoomMemberRepository, notifier service.Notifier) *SendRemindersUseCase {
+	return &SendRemindersUseCase{
+		memberRepo: memberRepo,
+		notifier:   notifier,
+	}
+}
+
+// Execute sends the daily reminder to every member whose room's reminder time,
+// read in the member's own timezone, fell in (from, to]
+func (uc *SendRemindersUseCase) Execute(ctx context.Context, from, to time.Time) error {
+	locations := make(map[string]*time.Location)
+	var batch reminderBatch
+	sent := 0
+
+	afterRoomID, afterUserID := "", ""
+	for {
+		recipients, err := uc.memberRepo.ListReminderRecipients(ctx, afterRoomID, afterUserID, reminderPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range recipients {
+			loc, ok := locations[r.Timezone]
+			if !ok {
+				if loc, err = time.LoadLocation(r.Timezone); err != nil {
+					loc, _ = time.LoadLocation(entity.DefaultTimezone)
+				}
+				locations[r.Timezone] = loc
+			}
+			if !reminderDue(r.ReminderTime, loc, from, to) {
+				continue
+			}
+
+			// Recipients come ordered by room, so a room's batch is complete once the next room starts
+			if r.RoomID != batch.roomID {
+				sent += uc.send(ctx, batch)
+				batch = reminderBatch{roomID: r.RoomID, roomName: r.RoomName}
+			}
+			batch.userIDs = append(batch.userIDs, r.UserID)
+		}
+
+		if len(recipients) < reminderPageSize {
+			break
+		}
+		last := recipients[len(recipients)-1]
+		afterRoomID, afterUserID = last.RoomID, last.UserID
+	}
+	sent += uc.send(ctx, batch)
+
+	if sent > 0 {
+		slog.InfoContext(ctx, "Sent daily prayer reminders", "count", sent)
+	}
+	return nil
+}
+
+// reminderBatch collects the members of one room due a reminder
+type reminderBatch struct {
+	roomID   string
+	roomName string
+	userIDs  []string
+}
+
+// send delivers the batch's reminder and returns how many members it was addressed to
+func (uc *SendRemindersUseCase) send(ctx context.Context, batch reminderBatch) int {
+	if len(batch.userIDs) == 0 {
+		return 0
+	}
+	room.Notify(ctx, uc.notifier, batch.userIDs, entity.Notification{
+		Type:   entity.NotificationPrayerReminder,
+		Params: map[string]string{"room": batch.roomName},
+		Data:   map[string]string{"room_id": batch.roomID},
+	})
+	return len(batch.userIDs)
+}
+
+// reminderDue reports whether the daily "HH:MM" reminder in loc occurred in (from, to]
+// The window is short, so only the local dates of its ends can hold an occurrence
+func reminderDue(reminderTime string, loc *time.Location, from, to time.Time) bool {
+	clock, err := time.Parse("15:04", reminderTime)
+	if err != nil {
+		return false
+	}
+	for _, t := range []time.Time{from.In(loc), to.In(loc)} {
+		at := time.Date(t.Year(), t.Month(), t.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+		if at.After(from) && !at.After(to) {
+			return true
+		}
+	}
+	return false
+}
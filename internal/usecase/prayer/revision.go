@@ -0,0 +1,44 @@
+package prayer
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/google/uuid"
+)
+
+// recordRevision keeps the previous version of an edit, up to keep versions per topic
+// A keep of 0 turns the edit history off
+func recordRevision(ctx context.Context, revisionRepo repository.PrayerRevisionRepository, revision *entity.PrayerRevision, keep int) error {
+	if keep == 0 {
+		return nil
+	}
+	revision.ID = uuid.New().String()
+	return revisionRepo.Create(ctx, revision, keep)
+}
+
+type TopicHistoryUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	revisionRepo repository.PrayerRevisionRepository
+	authz        *room.Authorizer
+}
+
+func NewTopicHistoryUseCase(topicRepo repository.PrayerTopicRepository, revisionRepo repository.PrayerRevisionRepository, authz *room.Authorizer) *TopicHistoryUseCase {
+	return &TopicHistoryUseCase{
+		topicRepo:    topicRepo,
+		revisionRepo: revisionRepo,
+		authz:        authz,
+	}
+}
+
+// Execute returns the earlier versions of a topic's title and entries, newest first
+// Only the topic's author and the room's owner may see them
+func (uc *TopicHistoryUseCase) Execute(ctx context.Context, userID, topicID string) ([]*entity.PrayerRevision, error) {
+	topic, err := ownedTopic(ctx, uc.topicRepo, uc.authz, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.revisionRepo.ListByTopic(ctx, topic.ID)
+}
@@ -0,0 +1,104 @@
+package prayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+)
+
+// editTopics stores topic updates on top of pinTopics
+type editTopics struct {
+	*pinTopics
+}
+
+func (f editTopics) Update(_ context.Context, topic *entity.PrayerTopic) error {
+	f.topics[topic.ID] = topic
+	return nil
+}
+
+// revisionLog keeps revisions newest first, like the repository returns them
+type revisionLog struct {
+	repository.PrayerRevisionRepository
+	revisions []*entity.PrayerRevision
+}
+
+func (f *revisionLog) Create(_ context.Context, revision *entity.PrayerRevision, keep int) error {
+	f.revisions = append([]*entity.PrayerRevision{revision}, f.revisions...)
+	f.revisions = f.revisions[:min(keep, len(f.revisions))]
+	return nil
+}
+
+func (f *revisionLog) ListByTopic(context.Context, string) ([]*entity.PrayerRevision, error) {
+	return f.revisions, nil
+}
+
+// newEditRoom returns newPinRoom's topics with an owner added to the room
+func newEditRoom() (editTopics, *room.Authorizer) {
+	topics, _ := newPinRoom()
+	members := &roleMembers{roles: map[string]entity.RoomRole{
+		"owner": entity.RoomRoleOwner, "moderator": entity.RoomRoleModerator, "member": entity.RoomRoleMember, "other": entity.RoomRoleMember,
+	}}
+	return editTopics{topics}, room.NewAuthorizer(&fakeRooms{room: &entity.Room{ID: "r1", Name: "새벽기도"}}, members)
+}
+
+func TestUpdateTopicRecordsTitleRevision(t *testing.T) {
+	topics, authz := newEditRoom()
+	revisions := &revisionLog{}
+	uc := NewUpdateTopicUseCase(topics, revisions, authz, inlineTransactor{}, 2)
+	ctx := context.Background()
+
+	title := "가족의 건강"
+	if _, err := uc.Execute(ctx, "moderator", "t1", entity.PrayerTopicUpdate{Title: &title}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(revisions.revisions) != 1 {
+		t.Fatalf("recorded %d revisions, want 1", len(revisions.revisions))
+	}
+	r := revisions.revisions[0]
+	if r.Field != entity.PrayerRevisionTitle || r.Previous != "기도제목 t1" || r.EditorID != "moderator" || r.TopicID != "t1" || r.ID == "" {
+		t.Errorf("revision = %+v, want the previous title edited by the moderator", r)
+	}
+
+	tags := []string{"가족"}
+	if _, err := uc.Execute(ctx, "member", "t1", entity.PrayerTopicUpdate{Title: &title, Tags: &tags}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(revisions.revisions) != 1 {
+		t.Errorf("recorded %d revisions, want none for an unchanged title", len(revisions.revisions))
+	}
+
+	off := NewUpdateTopicUseCase(topics, revisions, authz, inlineTransactor{}, 0)
+	other := "새 직장"
+	if _, err := off.Execute(ctx, "member", "t1", entity.PrayerTopicUpdate{Title: &other}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(revisions.revisions) != 1 {
+		t.Errorf("recorded %d revisions, want none with the history turned off", len(revisions.revisions))
+	}
+}
+
+func TestTopicHistoryAllowsAuthorAndOwner(t *testing.T) {
+	topics, authz := newEditRoom()
+	revisions := &revisionLog{revisions: []*entity.PrayerRevision{{ID: "rev-1", TopicID: "t1", Field: entity.PrayerRevisionTitle}}}
+	uc := NewTopicHistoryUseCase(topics, revisions, authz)
+	ctx := context.Background()
+
+	for _, userID := range []string{"member", "owner"} {
+		got, err := uc.Execute(ctx, userID, "t1")
+		if err != nil || len(got) != 1 {
+			t.Errorf("%s: %d revisions, err = %v, want the history", userID, len(got), err)
+		}
+	}
+	for _, userID := range []string{"moderator", "other"} {
+		if _, err := uc.Execute(ctx, userID, "t1"); !errors.Is(err, entity.ErrRoomPermissionDenied) {
+			t.Errorf("%s: err = %v, want ErrRoomPermissionDenied", userID, err)
+		}
+	}
+	if _, err := uc.Execute(ctx, "stranger", "t1"); !errors.Is(err, entity.ErrPrayerTopicNotFound) {
+		t.Errorf("stranger: err = %v, want ErrPrayerTopicNotFound", err)
+	}
+}
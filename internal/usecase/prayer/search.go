@@ -0,0 +1,199 @@
+package prayer
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+const (
+	minSearchQueryLength = 2
+	maxSearchQueryLength = 50
+	maxSearchTerms       = 5
+	// snippetLength is the number of characters shown around the first match
+	snippetLength = 80
+)
+
+// Highlight marks a match in a snippet, in characters (not bytes) from its start
+type Highlight struct {
+	Start int
+	End   int
+}
+
+// SearchHit is a topic found by a search, with the matching text to show
+type SearchHit struct {
+	Topic *entity.PrayerTopic
+	// ContentID is the entry the snippet comes from; empty when it is the title
+	ContentID  string
+	Snippet    string
+	Highlights []Highlight
+}
+
+type SearchPrayersUseCase struct {
+	topicRepo repository.PrayerTopicRepository
+	authz     *room.Authorizer
+}
+
+func NewSearchPrayersUseCase(topicRepo repository.PrayerTopicRepository, authz *room.Authorizer) *SearchPrayersUseCase {
+	return &SearchPrayersUseCase{
+		topicRepo: topicRepo,
+		authz:     authz,
+	}
+}
+
+// Execute finds live topics of a room the user belongs to whose title or entries contain
+// every word of query, newest first
+func (uc *SearchPrayersUseCase) Execute(ctx context.Context, userID, roomID, query, cursor string, limit int) ([]SearchHit, pagination.Meta, error) {
+	query = strings.TrimSpace(query)
+	if n := utf8.RuneCountInString(query); n < minSearchQueryLength || n > maxSearchQueryLength {
+		return nil, pagination.Meta{}, room.ErrInvalidSearchQuery
+	}
+	terms := searchTerms(query)
+
+	if _, _, err := uc.authz.Member(ctx, userID, roomID); err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	search := repository.PrayerSearch{Terms: terms, ViewerID: userID}
+	found, err := uc.topicRepo.Search(ctx, roomID, search, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	found, meta, err := pagination.Page(found, limit, func(h repository.PrayerSearchHit) (string, error) {
+		return topicCursor(h.Topic)
+	})
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	hits := make([]SearchHit, 0, len(found))
+	for _, h := range found {
+		hit := SearchHit{Topic: h.Topic}
+		text := h.Topic.Title
+		if h.Content != nil {
+			hit.ContentID = h.Content.ID
+			text = h.Content.Body
+		}
+		hit.Snippet, hit.Highlights = snippet(text, terms)
+		hits = append(hits, hit)
+	}
+	return hits, meta, nil
+}
+
+// searchTerms splits the query into distinct lower-cased words, keeping the first maxSearchTerms
+func searchTerms(query string) []string {
+	var terms []string
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if !slices.Contains(terms, word) {
+			terms = append(terms, word)
+		}
+		if len(terms) == maxSearchTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// snippet cuts about snippetLength characters of text around the first term it contains
+// and marks every occurrence of the terms inside the cut
+// Matching is case-insensitive; the lexer may also match word forms this misses, which are left unmarked
+func snippet(text string, terms []string) (string, []Highlight) {
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	if len(lower) != len(runes) {
+		// Lower-casing changed the length, so offsets would not line up; show the start unmarked
+		return cut(runes, 0), nil
+	}
+
+	first := -1
+	for _, term := range terms {
+		if i := runeIndex(lower, []rune(term), 0); i >= 0 && (first < 0 || i < first) {
+			first = i
+		}
+	}
+
+	start := 0
+	if first > snippetLength/4 {
+		start = first - snippetLength/4
+	}
+	end := min(start+snippetLength, len(runes))
+
+	var highlights []Highlight
+	for _, term := range terms {
+		t := []rune(term)
+		for i := runeIndex(lower[:end], t, start); i >= 0; i = runeIndex(lower[:end], t, i+len(t)) {
+			highlights = append(highlights, Highlight{Start: i - start, End: i - start + len(t)})
+		}
+	}
+	highlights = mergeHighlights(highlights)
+
+	s := string(runes[start:end])
+	if start > 0 {
+		s = "…" + s
+		for i := range highlights {
+			highlights[i].Start++
+			highlights[i].End++
+		}
+	}
+	if end < len(runes) {
+		s += "…"
+	}
+	return s, highlights
+}
+
+// cut returns snippetLength characters of runes from start
+func cut(runes []rune, start int) string {
+	if len(runes)-start <= snippetLength {
+		return string(runes[start:])
+	}
+	return string(runes[start:start+snippetLength]) + "…"
+}
+
+// runeIndex returns the index of the first occurrence of sub in s at or after from, or -1
+func runeIndex(s, sub []rune, from int) int {
+	if len(sub) == 0 {
+		return -1
+	}
+	for i := from; i+len(sub) <= len(s); i++ {
+		match := true
+		for j := range sub {
+			if s[i+j] != sub[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
+
+// mergeHighlights sorts the highlights and joins the ones that overlap, as terms can overlap
+func mergeHighlights(highlights []Highlight) []Highlight {
+	slices.SortFunc(highlights, func(a, b Highlight) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	merged := highlights[:0]
+	for _, h := range highlights {
+		if n := len(merged); n > 0 && h.Start <= merged[n-1].End {
+			merged[n-1].End = max(merged[n-1].End, h.End)
+			continue
+		}
+		merged = append(merged, h)
+	}
+	return merged
+}
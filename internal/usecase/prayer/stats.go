@@ -0,0 +1,96 @@
+package prayer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+)
+
+// statsCacheTTL bounds how stale statistics may be; they are aggregates over whole tables,
+// and screens showing them are opened far more often than the numbers change
+const statsCacheTTL = time.Minute
+
+// statsCache keeps computed statistics by room or user ID for statsCacheTTL
+type statsCache[T any] struct {
+	mu      sync.Mutex
+	entries map[string]cachedStats[T]
+}
+
+type cachedStats[T any] struct {
+	stats     *T
+	expiresAt time.Time
+}
+
+func newStatsCache[T any]() *statsCache[T] {
+	return &statsCache[T]{entries: map[string]cachedStats[T]{}}
+}
+
+// get returns the cached statistics for id, computing and storing them when missing or expired
+// Expired entries are swept whenever a new entry is stored, so the map stays bounded by recent use
+func (c *statsCache[T]) get(ctx context.Context, id string, compute func(context.Context, string) (*T, error)) (*T, error) {
+	now := time.Now()
+	c.mu.Lock()
+	entry, ok := c.entries[id]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.stats, nil
+	}
+
+	stats, err := compute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	c.entries[id] = cachedStats[T]{stats: stats, expiresAt: now.Add(statsCacheTTL)}
+	return stats, nil
+}
+
+type RoomStatsUseCase struct {
+	statsRepo repository.StatsRepository
+	authz     *room.Authorizer
+	cache     *statsCache[repository.RoomStats]
+}
+
+func NewRoomStatsUseCase(statsRepo repository.StatsRepository, authz *room.Authorizer) *RoomStatsUseCase {
+	return &RoomStatsUseCase{
+		statsRepo: statsRepo,
+		authz:     authz,
+		cache:     newStatsCache[repository.RoomStats](),
+	}
+}
+
+// Execute returns the statistics of a room the user may read
+// Private topics are not counted, so the numbers are the same for every reader
+func (uc *RoomStatsUseCase) Execute(ctx context.Context, userID, roomID string) (*repository.RoomStats, error) {
+	if _, err := uc.authz.Readable(ctx, userID, roomID); err != nil {
+		return nil, err
+	}
+	return uc.cache.get(ctx, roomID, uc.statsRepo.RoomStats)
+}
+
+type UserStatsUseCase struct {
+	statsRepo repository.StatsRepository
+	cache     *statsCache[repository.UserStats]
+}
+
+func NewUserStatsUseCase(statsRepo repository.StatsRepository) *UserStatsUseCase {
+	return &UserStatsUseCase{
+		statsRepo: statsRepo,
+		cache:     newStatsCache[repository.UserStats](),
+	}
+}
+
+// Execute returns the user's own statistics, private topics included
+func (uc *UserStatsUseCase) Execute(ctx context.Context, userID string) (*repository.UserStats, error) {
+	return uc.cache.get(ctx, userID, uc.statsRepo.UserStats)
+}
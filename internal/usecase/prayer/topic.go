@@ -0,0 +1,369 @@
+package prayer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/usecase/room"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/google/uuid"
+)
+
+type CreateTopicUseCase struct {
+	topicRepo repository.PrayerTopicRepository
+	authz     *room.Authorizer
+}
+
+func NewCreateTopicUseCase(topicRepo repository.PrayerTopicRepository, authz *room.Authorizer) *CreateTopicUseCase {
+	return &CreateTopicUseCase{
+		topicRepo: topicRepo,
+		authz:     authz,
+	}
+}
+
+// Execute posts a prayer topic to the room; the room's post policy decides who may post
+// A private topic stays in the room but only its author ever sees it
+// A recurring topic is posted again as a new topic every week or month
+func (uc *CreateTopicUseCase) Execute(
+	ctx context.Context,
+	userID, roomID, title string,
+	tags []string,
+	private bool,
+	recurrence entity.PrayerRecurrence,
+) (*entity.PrayerTopic, error) {
+	topic, err := entity.NewPrayerTopic(roomID, userID, title, tags, private, recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermPostPrayer); err != nil {
+		return nil, err
+	}
+
+	topic.ID = uuid.New().String()
+	if err := uc.topicRepo.Create(ctx, topic); err != nil {
+		return nil, err
+	}
+	return topic, nil
+}
+
+// TopicView is a prayer topic as one user sees it
+type TopicView struct {
+	Topic *entity.PrayerTopic
+	// Reacted reports whether the user prayed for the topic ("함께 기도")
+	Reacted bool
+}
+
+type GetTopicUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	reactionRepo repository.PrayerReactionRepository
+	authz        *room.Authorizer
+}
+
+func NewGetTopicUseCase(topicRepo repository.PrayerTopicRepository, reactionRepo repository.PrayerReactionRepository, authz *room.Authorizer) *GetTopicUseCase {
+	return &GetTopicUseCase{
+		topicRepo:    topicRepo,
+		reactionRepo: reactionRepo,
+		authz:        authz,
+	}
+}
+
+// Execute returns a live topic from a room the user may read
+func (uc *GetTopicUseCase) Execute(ctx context.Context, userID, topicID string) (TopicView, error) {
+	topic, err := liveTopic(ctx, uc.topicRepo, userID, topicID)
+	if err != nil {
+		return TopicView{}, err
+	}
+
+	if _, err := uc.authz.Readable(ctx, userID, topic.RoomID); err != nil {
+		return TopicView{}, hideRoom(err)
+	}
+
+	views, err := topicViews(ctx, uc.reactionRepo, userID, []*entity.PrayerTopic{topic})
+	if err != nil {
+		return TopicView{}, err
+	}
+	return views[0], nil
+}
+
+type ListTopicsUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	reactionRepo repository.PrayerReactionRepository
+	authz        *room.Authorizer
+}
+
+func NewListTopicsUseCase(topicRepo repository.PrayerTopicRepository, reactionRepo repository.PrayerReactionRepository, authz *room.Authorizer) *ListTopicsUseCase {
+	return &ListTopicsUseCase{
+		topicRepo:    topicRepo,
+		reactionRepo: reactionRepo,
+		authz:        authz,
+	}
+}
+
+// Execute returns a page of the room's topics, pinned topics first and then newest first, leaving
+// out authors the user blocked and other people's private topics
+// Deleted topics are listed for audit only to members who may remove prayers
+func (uc *ListTopicsUseCase) Execute(ctx context.Context, userID, roomID string, filter repository.PrayerTopicFilter, cursor string, limit int) ([]TopicView, pagination.Meta, error) {
+	if filter.Tag != "" {
+		tags, err := entity.NormalizePrayerTags([]string{filter.Tag})
+		if err != nil {
+			return nil, pagination.Meta{}, err
+		}
+		filter.Tag = tags[0]
+	}
+
+	if filter.Deleted {
+		_, member, err := uc.authz.Member(ctx, userID, roomID)
+		if err != nil {
+			return nil, pagination.Meta{}, err
+		}
+		if !member.Can(entity.PermRemovePrayer) {
+			return nil, pagination.Meta{}, entity.ErrRoomPermissionDenied
+		}
+	} else if _, err := uc.authz.Readable(ctx, userID, roomID); err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	filter.ViewerID = userID
+
+	// Pinned topics head the first page of the feed in their pin order, answered or not,
+	// and are left out of the pages that follow
+	var pinned []*entity.PrayerTopic
+	if !filter.Deleted {
+		filter.Unpinned = true
+		if after == nil {
+			if pinned, err = uc.topicRepo.ListPinned(ctx, roomID, filter); err != nil {
+				return nil, pagination.Meta{}, err
+			}
+		}
+	}
+
+	topics, err := uc.topicRepo.List(ctx, roomID, filter, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	topics, meta, err := pagination.Page(topics, limit, topicCursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	views, err := topicViews(ctx, uc.reactionRepo, userID, append(pinned, topics...))
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	return views, meta, nil
+}
+
+// maxTagCloudSize bounds the tag cloud to what fits on a screen
+const maxTagCloudSize = 50
+
+type TagCloudUseCase struct {
+	topicRepo repository.PrayerTopicRepository
+	authz     *room.Authorizer
+}
+
+func NewTagCloudUseCase(topicRepo repository.PrayerTopicRepository, authz *room.Authorizer) *TagCloudUseCase {
+	return &TagCloudUseCase{
+		topicRepo: topicRepo,
+		authz:     authz,
+	}
+}
+
+// Execute returns the most used tags of the live topics the user may see in a room they may read
+func (uc *TagCloudUseCase) Execute(ctx context.Context, userID, roomID string) ([]repository.PrayerTagCount, error) {
+	if _, err := uc.authz.Readable(ctx, userID, roomID); err != nil {
+		return nil, err
+	}
+	return uc.topicRepo.TagCloud(ctx, roomID, userID, maxTagCloudSize)
+}
+
+// topicViews pairs the topics with the user's reactions using a single query
+func topicViews(ctx context.Context, reactionRepo repository.PrayerReactionRepository, userID string, topics []*entity.PrayerTopic) ([]TopicView, error) {
+	ids := make([]string, 0, len(topics))
+	for _, t := range topics {
+		ids = append(ids, t.ID)
+	}
+	reacted, err := reactionRepo.Reacted(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]TopicView, 0, len(topics))
+	for _, t := range topics {
+		views = append(views, TopicView{Topic: t, Reacted: reacted[t.ID]})
+	}
+	return views, nil
+}
+
+// topicCursor points after the topic in lists ordered by creation time
+func topicCursor(t *entity.PrayerTopic) (string, error) {
+	return pagination.Encode(pagination.TimeKey{Time: t.CreatedAt, ID: t.ID})
+}
+
+type UpdateTopicUseCase struct {
+	topicRepo    repository.PrayerTopicRepository
+	revisionRepo repository.PrayerRevisionRepository
+	authz        *room.Authorizer
+	transactor   repository.Transactor
+	maxRevisions int
+}
+
+func NewUpdateTopicUseCase(
+	topicRepo repository.PrayerTopicRepository,
+	revisionRepo repository.PrayerRevisionRepository,
+	authz *room.Authorizer,
+	transactor repository.Transactor,
+	maxRevisions int,
+) *UpdateTopicUseCase {
+	return &UpdateTopicUseCase{
+		topicRepo:    topicRepo,
+		revisionRepo: revisionRepo,
+		authz:        authz,
+		transactor:   transactor,
+		maxRevisions: maxRevisions,
+	}
+}
+
+// Execute applies a partial update to a live topic; its author or a moderator may do this
+// A changed title keeps the previous one in the topic's edit history
+func (uc *UpdateTopicUseCase) Execute(ctx context.Context, userID, topicID string, update entity.PrayerTopicUpdate) (*entity.PrayerTopic, error) {
+	topic, err := modifiableTopic(ctx, uc.topicRepo, uc.authz, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+	if topic.IsDeleted() {
+		return nil, entity.ErrPrayerTopicNotFound
+	}
+
+	revision := entity.NewTitleRevision(topic, userID)
+	if err := topic.Update(update); err != nil {
+		return nil, err
+	}
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.topicRepo.Update(ctx, topic); err != nil {
+			return err
+		}
+		if topic.Title == revision.Previous {
+			return nil
+		}
+		return recordRevision(ctx, uc.revisionRepo, revision, uc.maxRevisions)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return topic, nil
+}
+
+type DeleteTopicUseCase struct {
+	topicRepo repository.PrayerTopicRepository
+	authz     *room.Authorizer
+}
+
+func NewDeleteTopicUseCase(topicRepo repository.PrayerTopicRepository, authz *room.Authorizer) *DeleteTopicUseCase {
+	return &DeleteTopicUseCase{
+		topicRepo: topicRepo,
+		authz:     authz,
+	}
+}
+
+// Execute soft-deletes a topic, recording who deleted it; its author or a moderator may do this
+func (uc *DeleteTopicUseCase) Execute(ctx context.Context, userID, topicID string) error {
+	topic, err := modifiableTopic(ctx, uc.topicRepo, uc.authz, userID, topicID)
+	if err != nil {
+		return err
+	}
+	if topic.IsDeleted() {
+		return entity.ErrPrayerTopicNotFound
+	}
+
+	return uc.topicRepo.SoftDelete(ctx, topic.ID, userID, time.Now())
+}
+
+type RestoreTopicUseCase struct {
+	topicRepo repository.PrayerTopicRepository
+	authz     *room.Authorizer
+}
+
+func NewRestoreTopicUseCase(topicRepo repository.PrayerTopicRepository, authz *room.Authorizer) *RestoreTopicUseCase {
+	return &RestoreTopicUseCase{
+		topicRepo: topicRepo,
+		authz:     authz,
+	}
+}
+
+// Execute brings back a soft-deleted topic; its author or a moderator may do this
+func (uc *RestoreTopicUseCase) Execute(ctx context.Context, userID, topicID string) (*entity.PrayerTopic, error) {
+	topic, err := modifiableTopic(ctx, uc.topicRepo, uc.authz, userID, topicID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := uc.topicRepo.Restore(ctx, topic.ID, now); err != nil {
+		return nil, err
+	}
+	topic.DeletedAt = nil
+	topic.DeletedBy = ""
+	topic.UpdatedAt = now
+	return topic, nil
+}
+
+// modifiableTopic loads a topic the user may change: their own, or any topic when their
+// room role allows removing other people's prayers
+// Private topics are out of reach of moderators, who are told they do not exist
+func modifiableTopic(ctx context.Context, topicRepo repository.PrayerTopicRepository, authz *room.Authorizer, userID, topicID string) (*entity.PrayerTopic, error) {
+	topic, err := topicRepo.GetByID(ctx, topicID)
+	if err != nil {
+		return nil, err
+	}
+	if !topic.IsVisibleTo(userID) {
+		return nil, entity.ErrPrayerTopicNotFound
+	}
+
+	_, member, err := authz.Writable(ctx, userID, topic.RoomID)
+	if err != nil {
+		return nil, hideRoom(err)
+	}
+	if !topic.IsAuthoredBy(userID) && !member.Can(entity.PermRemovePrayer) {
+		return nil, entity.ErrRoomPermissionDenied
+	}
+	return topic, nil
+}
+
+// ownedTopic loads a topic for its author or the room's owner, the only ones who may see what is
+// kept about it, such as its edit history and who prayed for it
+func ownedTopic(ctx context.Context, topicRepo repository.PrayerTopicRepository, authz *room.Authorizer, userID, topicID string) (*entity.PrayerTopic, error) {
+	topic, err := topicRepo.GetByID(ctx, topicID)
+	if err != nil {
+		return nil, err
+	}
+	if !topic.IsVisibleTo(userID) {
+		return nil, entity.ErrPrayerTopicNotFound
+	}
+
+	_, member, err := authz.Member(ctx, userID, topic.RoomID)
+	if err != nil {
+		return nil, hideRoom(err)
+	}
+	if !topic.IsAuthoredBy(userID) && member.Role != entity.RoomRoleOwner {
+		return nil, entity.ErrRoomPermissionDenied
+	}
+	return topic, nil
+}
+
+// hideRoom reports a hidden room as a missing topic, so topic IDs do not reveal private rooms
+func hideRoom(err error) error {
+	if errors.Is(err, entity.ErrRoomNotFound) {
+		return entity.ErrPrayerTopicNotFound
+	}
+	return err
+}
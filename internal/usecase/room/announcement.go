@@ -0,0 +1,147 @@
+package room
+
+import (
+	"context"
+	"log/slog"
+	"unicode/utf8"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/google/uuid"
+)
+
+// announcementPreviewLength is how much of the body goes into the notification
+const announcementPreviewLength = 100
+
+type PostAnnouncementUseCase struct {
+	announcementRepo repository.AnnouncementRepository
+	memberRepo       repository.RoomMemberRepository
+	authz            *Authorizer
+	notifier         service.Notifier
+}
+
+func NewPostAnnouncementUseCase(
+	announcementRepo repository.AnnouncementRepository,
+	memberRepo repository.RoomMemberRepository,
+	authz *Authorizer,
+	notifier service.Notifier,
+) *PostAnnouncementUseCase {
+	return &PostAnnouncementUseCase{
+		announcementRepo: announcementRepo,
+		memberRepo:       memberRepo,
+		authz:            authz,
+		notifier:         notifier,
+	}
+}
+
+// Execute posts an announcement and notifies the other members who have not muted the room
+func (uc *PostAnnouncementUseCase) Execute(ctx context.Context, userID, roomID, body string) (*entity.RoomAnnouncement, error) {
+	announcement, err := entity.NewRoomAnnouncement(roomID, userID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermAnnounce)
+	if err != nil {
+		return nil, err
+	}
+
+	announcement.ID = uuid.New().String()
+	if err := uc.announcementRepo.Create(ctx, announcement); err != nil {
+		return nil, err
+	}
+
+	members, err := uc.memberRepo.List(ctx, room.ID)
+	if err != nil {
+		// The announcement is posted; only the notification is lost
+		slog.ErrorContext(ctx, "Failed to load announcement recipients", "room_id", room.ID, "error", err)
+		return announcement, nil
+	}
+	Notify(ctx, uc.notifier, Recipients(members, userID), entity.Notification{
+		Type:   entity.NotificationAnnouncement,
+		Params: map[string]string{"room": room.Name, "preview": preview(announcement.Body, announcementPreviewLength)},
+		Data:   map[string]string{"room_id": room.ID, "announcement_id": announcement.ID},
+	})
+	return announcement, nil
+}
+
+type EditAnnouncementUseCase struct {
+	announcementRepo repository.AnnouncementRepository
+	authz            *Authorizer
+}
+
+func NewEditAnnouncementUseCase(announcementRepo repository.AnnouncementRepository, authz *Authorizer) *EditAnnouncementUseCase {
+	return &EditAnnouncementUseCase{
+		announcementRepo: announcementRepo,
+		authz:            authz,
+	}
+}
+
+// Execute replaces the body of an announcement; any member allowed to announce may edit
+// Edits do not notify members again
+func (uc *EditAnnouncementUseCase) Execute(ctx context.Context, userID, roomID, announcementID, body string) (*entity.RoomAnnouncement, error) {
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermAnnounce)
+	if err != nil {
+		return nil, err
+	}
+
+	announcement, err := uc.announcementRepo.GetByID(ctx, announcementID)
+	if err != nil {
+		return nil, err
+	}
+	if announcement.RoomID != room.ID {
+		return nil, entity.ErrAnnouncementNotFound
+	}
+
+	if err := announcement.Edit(body); err != nil {
+		return nil, err
+	}
+	if err := uc.announcementRepo.Update(ctx, announcement); err != nil {
+		return nil, err
+	}
+	return announcement, nil
+}
+
+type ListAnnouncementsUseCase struct {
+	announcementRepo repository.AnnouncementRepository
+	authz            *Authorizer
+}
+
+func NewListAnnouncementsUseCase(announcementRepo repository.AnnouncementRepository, authz *Authorizer) *ListAnnouncementsUseCase {
+	return &ListAnnouncementsUseCase{
+		announcementRepo: announcementRepo,
+		authz:            authz,
+	}
+}
+
+// Execute returns a page of the room's announcements, newest first, to anyone who may read the room
+func (uc *ListAnnouncementsUseCase) Execute(ctx context.Context, userID, roomID, cursor string, limit int) ([]*entity.RoomAnnouncement, pagination.Meta, error) {
+	room, err := uc.authz.Readable(ctx, userID, roomID)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	announcements, err := uc.announcementRepo.List(ctx, room.ID, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	return pagination.Page(announcements, limit, func(a *entity.RoomAnnouncement) (string, error) {
+		return pagination.Encode(pagination.TimeKey{Time: a.CreatedAt, ID: a.ID})
+	})
+}
+
+// preview shortens s to at most n characters for notification bodies
+func preview(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
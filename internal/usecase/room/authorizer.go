@@ -0,0 +1,109 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+// presenceTouchInterval throttles last_seen_at writes for members active in a room
+const presenceTouchInterval = time.Minute
+
+// Authorizer is the permission layer shared by every usecase acting inside a room
+type Authorizer struct {
+	roomRepo   repository.RoomRepository
+	memberRepo repository.RoomMemberRepository
+}
+
+func NewAuthorizer(roomRepo repository.RoomRepository, memberRepo repository.RoomMemberRepository) *Authorizer {
+	return &Authorizer{
+		roomRepo:   roomRepo,
+		memberRepo: memberRepo,
+	}
+}
+
+// Readable loads a room the user may see: one they belong to, or any public room
+// Private rooms of other people are reported as not found so their existence is not revealed
+func (a *Authorizer) Readable(ctx context.Context, userID, roomID string) (*entity.Room, error) {
+	room, err := a.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.IsPublic() {
+		return room, nil
+	}
+
+	if _, err := a.member(ctx, room, userID); err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+// Member loads a room together with the user's membership in it
+func (a *Authorizer) Member(ctx context.Context, userID, roomID string) (*entity.Room, *entity.RoomMember, error) {
+	room, err := a.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member, err := a.member(ctx, room, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return room, member, nil
+}
+
+// Require is Member plus a check that the user's room role, narrowed by the room's settings,
+// grants the permission
+// Archived rooms are read-only, so only permissions allowed there pass
+func (a *Authorizer) Require(ctx context.Context, userID, roomID string, perm entity.RoomPermission) (*entity.Room, *entity.RoomMember, error) {
+	room, member, err := a.Member(ctx, userID, roomID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !member.Can(perm) || !room.Settings.Permits(member.Role, perm) {
+		return nil, nil, entity.ErrRoomPermissionDenied
+	}
+	if room.IsArchived() && !perm.AllowedWhenArchived() {
+		return nil, nil, entity.ErrRoomArchived
+	}
+	return room, member, nil
+}
+
+// Writable is Member for changes that depend on the content rather than a role permission,
+// such as authors editing their own posts; archived rooms are rejected
+func (a *Authorizer) Writable(ctx context.Context, userID, roomID string) (*entity.Room, *entity.RoomMember, error) {
+	room, member, err := a.Member(ctx, userID, roomID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if room.IsArchived() {
+		return nil, nil, entity.ErrRoomArchived
+	}
+	return room, member, nil
+}
+
+// member hides private rooms from outsiders; outsiders of a public room are told they are not members
+// Members found are marked as seen in the room, the presence behind the active members list
+func (a *Authorizer) member(ctx context.Context, room *entity.Room, userID string) (*entity.RoomMember, error) {
+	member, err := a.memberRepo.Get(ctx, room.ID, userID)
+	if errors.Is(err, entity.ErrNotRoomMember) && !room.IsPublic() {
+		return nil, entity.ErrRoomNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if member.LastSeenAt == nil || now.Sub(*member.LastSeenAt) >= presenceTouchInterval {
+		if err := a.memberRepo.TouchLastSeen(ctx, room.ID, userID, now); err != nil {
+			// Presence must never block a room request
+			slog.WarnContext(ctx, "Failed to record room presence", "room_id", room.ID, "error", err)
+		}
+		member.LastSeenAt = &now
+	}
+	return member, nil
+}
@@ -0,0 +1,36 @@
+package room
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+type CleanupOrphansUseCase struct {
+	roomRepo repository.RoomRepository
+}
+
+func NewCleanupOrphansUseCase(roomRepo repository.RoomRepository) *CleanupOrphansUseCase {
+	return &CleanupOrphansUseCase{
+		roomRepo: roomRepo,
+	}
+}
+
+// Execute removes the prayers, comments, memberships and other records whose room is gone,
+// logging how many were found in each table
+// Deleting a room removes them in the same transaction, so any found point to data that
+// was written around the repository and are logged as a warning
+func (uc *CleanupOrphansUseCase) Execute(ctx context.Context) error {
+	removed, err := uc.roomRepo.DeleteOrphans(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range slices.Sorted(maps.Keys(removed)) {
+		slog.WarnContext(ctx, "Removed orphaned room records", "table", table, "count", removed[table])
+	}
+	return nil
+}
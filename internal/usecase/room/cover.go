@@ -0,0 +1,141 @@
+package room
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg" // registers the JPEG decoder for image.DecodeConfig
+	_ "image/png"  // registers the PNG decoder for image.DecodeConfig
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+const (
+	MaxCoverImageSize      = 5 << 20
+	maxCoverImageDimension = 4096
+)
+
+// coverImageExtensions lists the accepted content types, detected from the bytes rather than trusted from the client
+var coverImageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+type SetCoverImageUseCase struct {
+	roomRepo repository.RoomRepository
+	storage  service.Storage
+	authz    *Authorizer
+}
+
+func NewSetCoverImageUseCase(roomRepo repository.RoomRepository, storage service.Storage, authz *Authorizer) *SetCoverImageUseCase {
+	return &SetCoverImageUseCase{
+		roomRepo: roomRepo,
+		storage:  storage,
+		authz:    authz,
+	}
+}
+
+// Execute stores a new cover image for the room and removes the previous one; only the owner may do this
+// Each upload gets a fresh key, so cached copies of the old image are never served for the new one
+func (uc *SetCoverImageUseCase) Execute(ctx context.Context, userID, roomID string, data []byte) (*entity.Room, error) {
+	contentType, err := validateCoverImage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermUpdateRoom)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("rooms/%s/cover-%s%s", room.ID, uuid.New().String(), coverImageExtensions[contentType])
+	url, err := uc.storage.Put(ctx, key, contentType, data)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := uc.roomRepo.SetCoverImage(ctx, room.ID, key, url, now); err != nil {
+		deleteStoredFile(ctx, uc.storage, key)
+		return nil, err
+	}
+
+	deleteStoredFile(ctx, uc.storage, room.CoverImageKey)
+	room.CoverImageKey = key
+	room.CoverImageURL = url
+	room.UpdatedAt = now
+	return room, nil
+}
+
+type RemoveCoverImageUseCase struct {
+	roomRepo repository.RoomRepository
+	storage  service.Storage
+	authz    *Authorizer
+}
+
+func NewRemoveCoverImageUseCase(roomRepo repository.RoomRepository, storage service.Storage, authz *Authorizer) *RemoveCoverImageUseCase {
+	return &RemoveCoverImageUseCase{
+		roomRepo: roomRepo,
+		storage:  storage,
+		authz:    authz,
+	}
+}
+
+// Execute removes the room's cover image; only the owner may do this
+func (uc *RemoveCoverImageUseCase) Execute(ctx context.Context, userID, roomID string) (*entity.Room, error) {
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermUpdateRoom)
+	if err != nil {
+		return nil, err
+	}
+	if room.CoverImageKey == "" {
+		return room, nil
+	}
+
+	now := time.Now()
+	if err := uc.roomRepo.SetCoverImage(ctx, room.ID, "", "", now); err != nil {
+		return nil, err
+	}
+
+	deleteStoredFile(ctx, uc.storage, room.CoverImageKey)
+	room.CoverImageKey = ""
+	room.CoverImageURL = ""
+	room.UpdatedAt = now
+	return room, nil
+}
+
+// validateCoverImage checks size, format and dimensions, returning the detected content type
+// Only the image header is decoded, so oversized images are rejected without allocating their pixels
+func validateCoverImage(data []byte) (string, error) {
+	if len(data) == 0 || len(data) > MaxCoverImageSize {
+		return "", entity.ErrInvalidCoverImage
+	}
+
+	contentType := http.DetectContentType(data)
+	if _, ok := coverImageExtensions[contentType]; !ok {
+		return "", entity.ErrInvalidCoverImage
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width > maxCoverImageDimension || cfg.Height > maxCoverImageDimension {
+		return "", entity.ErrInvalidCoverImage
+	}
+	return contentType, nil
+}
+
+// deleteStoredFile removes a file that is no longer referenced
+// A failure only leaves an orphaned file behind, so it is logged rather than returned
+func deleteStoredFile(ctx context.Context, storage service.Storage, key string) {
+	if key == "" {
+		return
+	}
+	if err := storage.Delete(ctx, key); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete stored file", "key", key, "error", err)
+	}
+}
@@ -0,0 +1,130 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+// digestBatchSize bounds the users summarised per frequency and run; the rest wait for the next tick
+const digestBatchSize = 100
+
+type SendDigestsUseCase struct {
+	notificationRepo repository.NotificationRepository
+	roomRepo         repository.RoomRepository
+	notifier         service.Notifier
+}
+
+func NewSendDigestsUseCase(notificationRepo repository.NotificationRepository, roomRepo repository.RoomRepository, notifier service.Notifier) *SendDigestsUseCase {
+	return &SendDigestsUseCase{
+		notificationRepo: notificationRepo,
+		roomRepo:         roomRepo,
+		notifier:         notifier,
+	}
+}
+
+// Execute sends the digests that are due at to: one push per room for every user whose oldest
+// waiting notification is a full digest window old
+// Users who turned digests off since are sent what was still waiting right away
+// Waiting notifications carry their own age, so the start of the window is not needed
+func (uc *SendDigestsUseCase) Execute(ctx context.Context, _, to time.Time) error {
+	// Room names are looked up once per run; a nil entry is a room deleted meanwhile
+	rooms := make(map[string]*entity.Room)
+	sent := 0
+	for _, frequency := range entity.DigestFrequencies {
+		userIDs, err := uc.notificationRepo.ListDueDigests(ctx, frequency, to.Add(-frequency.Window()), digestBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, userID := range userIDs {
+			n, err := uc.send(ctx, userID, rooms)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				slog.ErrorContext(ctx, "Failed to send notification digest", "user_id", userID, "error", err)
+				continue
+			}
+			sent += n
+		}
+	}
+
+	if sent > 0 {
+		slog.InfoContext(ctx, "Sent notification digests", "count", sent)
+	}
+	return nil
+}
+
+// send delivers one user's waiting notifications, summarised per room, and returns how many pushes went out
+// Delivery is best effort: once taken, the notifications are not retried
+func (uc *SendDigestsUseCase) send(ctx context.Context, userID string, rooms map[string]*entity.Room) (int, error) {
+	notifications, err := uc.notificationRepo.TakeDigest(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Group by room in order of each room's first notification
+	var roomIDs []string
+	byRoom := make(map[string][]*entity.InboxNotification)
+	for _, n := range notifications {
+		roomID := n.Data["room_id"]
+		if _, ok := byRoom[roomID]; !ok {
+			roomIDs = append(roomIDs, roomID)
+		}
+		byRoom[roomID] = append(byRoom[roomID], n)
+	}
+
+	sent := 0
+	for _, roomID := range roomIDs {
+		summary, err := uc.summarise(ctx, roomID, byRoom[roomID], rooms)
+		if err != nil {
+			return sent, err
+		}
+		if summary == nil {
+			continue
+		}
+		if err := uc.notifier.Deliver(ctx, userID, *summary); err != nil {
+			slog.ErrorContext(ctx, "Failed to deliver notification digest", "user_id", userID, "room_id", roomID, "error", err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// summarise turns a room's waiting notifications into one; a single notification is sent as it was
+// It returns nil for rooms deleted meanwhile
+func (uc *SendDigestsUseCase) summarise(ctx context.Context, roomID string, notifications []*entity.InboxNotification, rooms map[string]*entity.Room) (*entity.Notification, error) {
+	latest := notifications[len(notifications)-1].Notification
+	if len(notifications) == 1 {
+		return &latest, nil
+	}
+
+	room, ok := rooms[roomID]
+	if !ok {
+		var err error
+		room, err = uc.roomRepo.GetByID(ctx, roomID)
+		if err != nil && !errors.Is(err, entity.ErrRoomNotFound) {
+			return nil, err
+		}
+		rooms[roomID] = room
+	}
+	if room == nil {
+		return nil, nil
+	}
+
+	count := strconv.Itoa(len(notifications))
+	return &entity.Notification{
+		Type: entity.NotificationDigest,
+		// The latest title was rendered for this user when it reached the inbox
+		Params: map[string]string{"room": room.Name, "count": count, "latest": latest.Title},
+		Data:   map[string]string{"room_id": roomID, "count": count},
+	}, nil
+}
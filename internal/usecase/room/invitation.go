@@ -0,0 +1,209 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/google/uuid"
+)
+
+type InviteUserUseCase struct {
+	userRepo       repository.UserRepository
+	memberRepo     repository.RoomMemberRepository
+	invitationRepo repository.RoomInvitationRepository
+	authz          *Authorizer
+	notifier       service.Notifier
+}
+
+func NewInviteUserUseCase(
+	userRepo repository.UserRepository,
+	memberRepo repository.RoomMemberRepository,
+	invitationRepo repository.RoomInvitationRepository,
+	authz *Authorizer,
+	notifier service.Notifier,
+) *InviteUserUseCase {
+	return &InviteUserUseCase{
+		userRepo:       userRepo,
+		memberRepo:     memberRepo,
+		invitationRepo: invitationRepo,
+		authz:          authz,
+		notifier:       notifier,
+	}
+}
+
+// Execute invites a user to the room and notifies them
+func (uc *InviteUserUseCase) Execute(ctx context.Context, userID, roomID, inviteeID string) (*entity.RoomInvitation, error) {
+	invitation, err := entity.NewRoomInvitation(roomID, userID, inviteeID)
+	if err != nil {
+		return nil, err
+	}
+
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermInviteMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	invitee, err := uc.userRepo.GetByID(ctx, inviteeID)
+	if err != nil {
+		return nil, err
+	}
+	if invitee.IsDeletionScheduled() {
+		return nil, entity.ErrUserNotFound
+	}
+
+	if _, err := uc.memberRepo.Get(ctx, roomID, inviteeID); err == nil {
+		return nil, entity.ErrAlreadyRoomMember
+	} else if !errors.Is(err, entity.ErrNotRoomMember) {
+		return nil, err
+	}
+
+	if pending, err := uc.invitationRepo.HasPending(ctx, roomID, inviteeID, invitation.CreatedAt); err != nil {
+		return nil, err
+	} else if pending {
+		return nil, entity.ErrInvitationAlreadyPending
+	}
+
+	inviter, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation.ID = uuid.New().String()
+	if err := uc.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	Notify(ctx, uc.notifier, []string{inviteeID}, entity.Notification{
+		Type:   entity.NotificationRoomInvited,
+		Params: map[string]string{"nickname": inviter.Nickname, "room": room.Name},
+		Data:   map[string]string{"room_id": room.ID, "invitation_id": invitation.ID},
+	})
+	return invitation, nil
+}
+
+// InvitationPage is a page of the user's invitations in one status
+type InvitationPage struct {
+	Invitations []*entity.RoomInvitation
+	Page        pagination.Meta
+}
+
+type ListInvitationsUseCase struct {
+	invitationRepo repository.RoomInvitationRepository
+}
+
+func NewListInvitationsUseCase(invitationRepo repository.RoomInvitationRepository) *ListInvitationsUseCase {
+	return &ListInvitationsUseCase{
+		invitationRepo: invitationRepo,
+	}
+}
+
+// Execute returns a page of the user's invitations in the status, newest first
+// An empty status lists the pending ones
+func (uc *ListInvitationsUseCase) Execute(ctx context.Context, userID, status, cursor string, limit int) (*InvitationPage, error) {
+	filter, err := entity.ParseInvitationStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	invitations, err := uc.invitationRepo.ListByInvitee(ctx, userID, filter, time.Now(), after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	invitations, page, err := pagination.Page(invitations, limit, func(i *entity.RoomInvitation) (string, error) {
+		return pagination.Encode(pagination.TimeKey{Time: i.CreatedAt, ID: i.ID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &InvitationPage{Invitations: invitations, Page: page}, nil
+}
+
+type CountInvitationsUseCase struct {
+	invitationRepo repository.RoomInvitationRepository
+}
+
+func NewCountInvitationsUseCase(invitationRepo repository.RoomInvitationRepository) *CountInvitationsUseCase {
+	return &CountInvitationsUseCase{
+		invitationRepo: invitationRepo,
+	}
+}
+
+// Execute returns how many invitations the user can still answer, for the inbox badge
+func (uc *CountInvitationsUseCase) Execute(ctx context.Context, userID string) (int64, error) {
+	return uc.invitationRepo.CountPending(ctx, userID, time.Now())
+}
+
+type RespondInvitationUseCase struct {
+	invitationRepo repository.RoomInvitationRepository
+	roomRepo       repository.RoomRepository
+	topics         *Topics
+}
+
+func NewRespondInvitationUseCase(invitationRepo repository.RoomInvitationRepository, roomRepo repository.RoomRepository, topics *Topics) *RespondInvitationUseCase {
+	return &RespondInvitationUseCase{
+		invitationRepo: invitationRepo,
+		roomRepo:       roomRepo,
+		topics:         topics,
+	}
+}
+
+// Execute accepts or rejects one of the user's pending invitations
+// Accepting adds the user as a member, invited by the inviter, in the same transaction
+func (uc *RespondInvitationUseCase) Execute(ctx context.Context, userID, invitationID string, accept bool) (*entity.RoomInvitation, error) {
+	invitation, err := uc.invitationRepo.GetByID(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	// Someone else's invitation must not be answerable, nor revealed
+	if invitation.InviteeID != userID {
+		return nil, entity.ErrInvitationNotFound
+	}
+
+	now := time.Now()
+	if !invitation.IsPending(now) {
+		return nil, entity.ErrInvitationNotPending
+	}
+
+	if accept {
+		room, err := uc.roomRepo.GetByID(ctx, invitation.RoomID)
+		if err != nil {
+			if errors.Is(err, entity.ErrRoomNotFound) {
+				return nil, entity.ErrInvitationNotFound
+			}
+			return nil, err
+		}
+		if room.IsArchived() {
+			return nil, entity.ErrRoomArchived
+		}
+
+		member := &entity.RoomMember{
+			RoomID:    room.ID,
+			UserID:    userID,
+			Role:      entity.RoomRoleMember,
+			InvitedBy: invitation.InviterID,
+			JoinedAt:  now,
+		}
+		if err := uc.invitationRepo.Accept(ctx, invitation.ID, member, now); err != nil {
+			return nil, err
+		}
+		uc.topics.Joined(ctx, room.ID, userID)
+		invitation.Status = entity.InvitationAccepted
+	} else {
+		if err := uc.invitationRepo.Reject(ctx, invitation.ID, now); err != nil {
+			return nil, err
+		}
+		invitation.Status = entity.InvitationRejected
+	}
+	invitation.RespondedAt = &now
+	return invitation, nil
+}
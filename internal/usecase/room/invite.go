@@ -0,0 +1,233 @@
+package room
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// inviteCodeAlphabet leaves out I, O, 0 and 1, which are easily confused when read aloud
+	inviteCodeAlphabet    = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	inviteCodeLength      = 8
+	maxInviteCodeAttempts = 3
+
+	DefaultInviteTTL = 7 * 24 * time.Hour
+)
+
+type CreateInviteUseCase struct {
+	inviteRepo repository.RoomInviteRepository
+	authz      *Authorizer
+	webURL     string
+}
+
+func NewCreateInviteUseCase(inviteRepo repository.RoomInviteRepository, authz *Authorizer, webURL string) *CreateInviteUseCase {
+	return &CreateInviteUseCase{
+		inviteRepo: inviteRepo,
+		authz:      authz,
+		webURL:     webURL,
+	}
+}
+
+// Execute creates an invite code for the room and returns it with its deep link
+// A zero ttl uses DefaultInviteTTL
+func (uc *CreateInviteUseCase) Execute(ctx context.Context, userID, roomID string, maxUses int, ttl time.Duration) (*entity.RoomInvite, string, error) {
+	if ttl == 0 {
+		ttl = DefaultInviteTTL
+	}
+	invite, err := entity.NewRoomInvite(roomID, userID, maxUses, ttl)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermInviteMembers); err != nil {
+		return nil, "", err
+	}
+
+	invite.ID = uuid.New().String()
+	for attempt := 1; ; attempt++ {
+		if invite.Code, err = newInviteCode(); err != nil {
+			return nil, "", err
+		}
+		err = uc.inviteRepo.Create(ctx, invite)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, entity.ErrInviteCodeTaken) || attempt == maxInviteCodeAttempts {
+			return nil, "", err
+		}
+	}
+
+	return invite, fmt.Sprintf("%s/invites/%s", uc.webURL, invite.Code), nil
+}
+
+type GetInviteUseCase struct {
+	inviteRepo repository.RoomInviteRepository
+	roomRepo   repository.RoomRepository
+}
+
+func NewGetInviteUseCase(inviteRepo repository.RoomInviteRepository, roomRepo repository.RoomRepository) *GetInviteUseCase {
+	return &GetInviteUseCase{
+		inviteRepo: inviteRepo,
+		roomRepo:   roomRepo,
+	}
+}
+
+// Execute returns the room an invite leads to, so the app can preview it before joining
+func (uc *GetInviteUseCase) Execute(ctx context.Context, code string) (*entity.RoomInvite, *entity.Room, error) {
+	invite, err := uc.inviteRepo.GetByCode(ctx, normalizeInviteCode(code))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !invite.IsUsable(time.Now()) {
+		return nil, nil, entity.ErrInviteExpired
+	}
+
+	room, err := uc.roomRepo.GetByID(ctx, invite.RoomID)
+	if err != nil {
+		if errors.Is(err, entity.ErrRoomNotFound) {
+			return nil, nil, entity.ErrInviteNotFound
+		}
+		return nil, nil, err
+	}
+	return invite, room, nil
+}
+
+// InviteCheck is the outcome of validating an invite code
+type InviteCheck struct {
+	// Invite is nil for unknown codes
+	Invite *entity.RoomInvite
+	// Room is only set when the invite can be accepted, so rejected codes reveal nothing about the room
+	Room *entity.Room
+	// Rejection is why the invite cannot be accepted; empty when it can
+	Rejection entity.InviteRejection
+}
+
+type ValidateInviteUseCase struct {
+	inviteRepo repository.RoomInviteRepository
+	roomRepo   repository.RoomRepository
+}
+
+func NewValidateInviteUseCase(inviteRepo repository.RoomInviteRepository, roomRepo repository.RoomRepository) *ValidateInviteUseCase {
+	return &ValidateInviteUseCase{
+		inviteRepo: inviteRepo,
+		roomRepo:   roomRepo,
+	}
+}
+
+// Execute reports whether an invite code can be accepted and why not, without redeeming a use
+func (uc *ValidateInviteUseCase) Execute(ctx context.Context, code string) (*InviteCheck, error) {
+	invite, err := uc.inviteRepo.GetByCode(ctx, normalizeInviteCode(code))
+	if errors.Is(err, entity.ErrInviteNotFound) {
+		return &InviteCheck{Rejection: entity.InviteRejectionNotFound}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if rejection := invite.Rejection(time.Now()); rejection != "" {
+		return &InviteCheck{Invite: invite, Rejection: rejection}, nil
+	}
+
+	room, err := uc.roomRepo.GetByID(ctx, invite.RoomID)
+	if errors.Is(err, entity.ErrRoomNotFound) {
+		return &InviteCheck{Rejection: entity.InviteRejectionNotFound}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if room.IsArchived() {
+		return &InviteCheck{Invite: invite, Rejection: entity.InviteRejectionArchived}, nil
+	}
+	return &InviteCheck{Invite: invite, Room: room}, nil
+}
+
+type AcceptInviteUseCase struct {
+	inviteRepo repository.RoomInviteRepository
+	roomRepo   repository.RoomRepository
+	memberRepo repository.RoomMemberRepository
+	transactor repository.Transactor
+	topics     *Topics
+}
+
+func NewAcceptInviteUseCase(
+	inviteRepo repository.RoomInviteRepository,
+	roomRepo repository.RoomRepository,
+	memberRepo repository.RoomMemberRepository,
+	transactor repository.Transactor,
+	topics *Topics,
+) *AcceptInviteUseCase {
+	return &AcceptInviteUseCase{
+		inviteRepo: inviteRepo,
+		roomRepo:   roomRepo,
+		memberRepo: memberRepo,
+		transactor: transactor,
+		topics:     topics,
+	}
+}
+
+// Execute joins the user to the invite's room as a member and records who invited them
+func (uc *AcceptInviteUseCase) Execute(ctx context.Context, userID, code string) (*entity.Room, error) {
+	invite, err := uc.inviteRepo.GetByCode(ctx, normalizeInviteCode(code))
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := uc.roomRepo.GetByID(ctx, invite.RoomID)
+	if err != nil {
+		if errors.Is(err, entity.ErrRoomNotFound) {
+			return nil, entity.ErrInviteNotFound
+		}
+		return nil, err
+	}
+	if room.IsArchived() {
+		return nil, entity.ErrRoomArchived
+	}
+
+	now := time.Now()
+	member := &entity.RoomMember{
+		RoomID:    room.ID,
+		UserID:    userID,
+		Role:      entity.RoomRoleMember,
+		InvitedBy: invite.CreatedBy,
+		JoinedAt:  now,
+	}
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Checked before redeeming so that members re-opening a link get a clear answer
+		if _, err := uc.memberRepo.Get(ctx, room.ID, userID); err == nil {
+			return entity.ErrAlreadyRoomMember
+		} else if !errors.Is(err, entity.ErrNotRoomMember) {
+			return err
+		}
+		return uc.inviteRepo.Redeem(ctx, invite.ID, member, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.topics.Joined(ctx, room.ID, userID)
+	return room, nil
+}
+
+// newInviteCode returns a random code; 32^8 combinations keep guessing impractical
+func newInviteCode() (string, error) {
+	buf := make([]byte, inviteCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate invite code: %w", err)
+	}
+	for i, b := range buf {
+		// The alphabet has 32 letters, so masking keeps the distribution uniform
+		buf[i] = inviteCodeAlphabet[b&31]
+	}
+	return string(buf), nil
+}
+
+// normalizeInviteCode accepts codes typed in lower case or with surrounding spaces
+func normalizeInviteCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,168 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+// fakeInvites redeems one invite and records whether it ran inside the transaction
+type fakeInvites struct {
+	repository.RoomInviteRepository
+	invite     *entity.RoomInvite
+	tx         *fakeTransactor
+	redeemed   []*entity.RoomMember
+	redeemInTx bool
+}
+
+func (f *fakeInvites) GetByCode(_ context.Context, code string) (*entity.RoomInvite, error) {
+	if code != f.invite.Code {
+		return nil, entity.ErrInviteNotFound
+	}
+	return f.invite, nil
+}
+
+func (f *fakeInvites) Redeem(_ context.Context, _ string, member *entity.RoomMember, _ time.Time) error {
+	f.redeemInTx = f.tx.active
+	f.redeemed = append(f.redeemed, member)
+	return nil
+}
+
+type roomByID struct {
+	repository.RoomRepository
+	room *entity.Room
+}
+
+func (f roomByID) GetByID(context.Context, string) (*entity.Room, error) {
+	return f.room, nil
+}
+
+// memberCheck knows one member and records whether the lookup ran inside the transaction
+type memberCheck struct {
+	repository.RoomMemberRepository
+	userID string
+	tx     *fakeTransactor
+	inTx   bool
+}
+
+func (f *memberCheck) Get(_ context.Context, roomID, userID string) (*entity.RoomMember, error) {
+	f.inTx = f.tx.active
+	if userID != f.userID {
+		return nil, entity.ErrNotRoomMember
+	}
+	return &entity.RoomMember{RoomID: roomID, UserID: userID, Role: entity.RoomRoleMember}, nil
+}
+
+func (f *memberCheck) TouchLastSeen(context.Context, string, string, time.Time) error {
+	return nil
+}
+
+func TestAcceptInviteChecksMembershipInTransaction(t *testing.T) {
+	tx := &fakeTransactor{outbox: &fakeOutbox{}}
+	invites := &fakeInvites{
+		invite: &entity.RoomInvite{ID: "i1", RoomID: "r1", Code: "ABCD2345", CreatedBy: "owner"},
+		tx:     tx,
+	}
+	members := &memberCheck{userID: "member", tx: tx}
+	uc := NewAcceptInviteUseCase(invites, roomByID{room: &entity.Room{ID: "r1"}}, members, tx, NewTopics(userDevices{}, &fakeTopicClient{topics: map[string]map[string]bool{}}))
+	ctx := context.Background()
+
+	if _, err := uc.Execute(ctx, "member", "abcd2345"); !errors.Is(err, entity.ErrAlreadyRoomMember) {
+		t.Errorf("existing member: err = %v, want ErrAlreadyRoomMember", err)
+	}
+	if len(invites.redeemed) != 0 {
+		t.Errorf("redeemed %d uses for an existing member, want none", len(invites.redeemed))
+	}
+
+	room, err := uc.Execute(ctx, "newcomer", " abcd2345 ")
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if room.ID != "r1" || len(invites.redeemed) != 1 || invites.redeemed[0].InvitedBy != "owner" {
+		t.Errorf("room = %s, redeemed = %+v, want the newcomer invited by the owner", room.ID, invites.redeemed)
+	}
+	if !members.inTx || !invites.redeemInTx {
+		t.Errorf("membership checked in transaction = %v, redeemed in transaction = %v, want both", members.inTx, invites.redeemInTx)
+	}
+}
+
+func TestValidateInvite(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name          string
+		invite        entity.RoomInvite
+		room          entity.Room
+		wantRejection entity.InviteRejection
+		wantRemaining int
+		wantLimited   bool
+	}{
+		{
+			name:          "valid",
+			invite:        entity.RoomInvite{MaxUses: 10, UseCount: 3, ExpiresAt: now.Add(time.Hour)},
+			wantRemaining: 7,
+			wantLimited:   true,
+		},
+		{
+			name:   "unlimited",
+			invite: entity.RoomInvite{ExpiresAt: now.Add(time.Hour)},
+		},
+		{
+			name:          "expired",
+			invite:        entity.RoomInvite{MaxUses: 10, UseCount: 3, ExpiresAt: now.Add(-time.Minute)},
+			wantRejection: entity.InviteRejectionExpired,
+			wantRemaining: 7,
+			wantLimited:   true,
+		},
+		{
+			name:          "exhausted",
+			invite:        entity.RoomInvite{MaxUses: 5, UseCount: 5, ExpiresAt: now.Add(time.Hour)},
+			wantRejection: entity.InviteRejectionExhausted,
+			wantLimited:   true,
+		},
+		{
+			name:          "archived room",
+			invite:        entity.RoomInvite{ExpiresAt: now.Add(time.Hour)},
+			room:          entity.Room{ArchivedAt: &now},
+			wantRejection: entity.InviteRejectionArchived,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.invite.ID, tt.invite.RoomID, tt.invite.Code = "i1", "r1", "ABCD2345"
+			tt.room.ID, tt.room.Name = "r1", "새벽기도"
+			invites := &fakeInvites{invite: &tt.invite}
+			uc := NewValidateInviteUseCase(invites, roomByID{room: &tt.room})
+
+			check, err := uc.Execute(context.Background(), "abcd2345")
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if check.Rejection != tt.wantRejection {
+				t.Errorf("rejection = %q, want %q", check.Rejection, tt.wantRejection)
+			}
+			if valid := tt.wantRejection == ""; (check.Room != nil) != valid {
+				t.Errorf("room = %v, want it only for a valid code", check.Room)
+			}
+			if remaining, limited := check.Invite.RemainingUses(); remaining != tt.wantRemaining || limited != tt.wantLimited {
+				t.Errorf("remaining uses = %d (limited %v), want %d (limited %v)", remaining, limited, tt.wantRemaining, tt.wantLimited)
+			}
+			if len(invites.redeemed) != 0 {
+				t.Errorf("redeemed %d uses, want none", len(invites.redeemed))
+			}
+		})
+	}
+
+	uc := NewValidateInviteUseCase(&fakeInvites{invite: &entity.RoomInvite{Code: "ABCD2345"}}, roomByID{})
+	check, err := uc.Execute(context.Background(), "ZZZZ9999")
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if check.Rejection != entity.InviteRejectionNotFound || check.Invite != nil {
+		t.Errorf("unknown code: check = %+v, want not_found without an invite", check)
+	}
+}
@@ -0,0 +1,233 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+type RequestToJoinUseCase struct {
+	userRepo   repository.UserRepository
+	roomRepo   repository.RoomRepository
+	memberRepo repository.RoomMemberRepository
+	joinRepo   repository.JoinRequestRepository
+	notifier   service.Notifier
+	topics     *Topics
+}
+
+func NewRequestToJoinUseCase(
+	userRepo repository.UserRepository,
+	roomRepo repository.RoomRepository,
+	memberRepo repository.RoomMemberRepository,
+	joinRepo repository.JoinRequestRepository,
+	notifier service.Notifier,
+	topics *Topics,
+) *RequestToJoinUseCase {
+	return &RequestToJoinUseCase{
+		userRepo:   userRepo,
+		roomRepo:   roomRepo,
+		memberRepo: memberRepo,
+		joinRepo:   joinRepo,
+		notifier:   notifier,
+		topics:     topics,
+	}
+}
+
+// Execute asks to join a room
+// Public rooms need no approval, so the request is approved on the spot;
+// for private rooms the owner is notified and the request stays pending
+func (uc *RequestToJoinUseCase) Execute(ctx context.Context, userID, roomID, message string) (*entity.JoinRequest, error) {
+	request, err := entity.NewJoinRequest(roomID, userID, message)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := uc.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.IsArchived() {
+		return nil, entity.ErrRoomArchived
+	}
+
+	if _, err := uc.memberRepo.Get(ctx, roomID, userID); err == nil {
+		return nil, entity.ErrAlreadyRoomMember
+	} else if !errors.Is(err, entity.ErrNotRoomMember) {
+		return nil, err
+	}
+
+	if pending, err := uc.joinRepo.HasPending(ctx, roomID, userID); err != nil {
+		return nil, err
+	} else if pending {
+		return nil, entity.ErrJoinRequestAlreadyPending
+	}
+
+	request.ID = uuid.New().String()
+	if err := uc.joinRepo.Create(ctx, request); err != nil {
+		return nil, err
+	}
+
+	if room.IsPublic() {
+		now := time.Now()
+		if err := uc.joinRepo.Approve(ctx, request.ID, "", newMember(request, now), now); err != nil {
+			// Close the request so the user can ask again once a seat frees up
+			if errors.Is(err, entity.ErrRoomFull) {
+				if rejectErr := uc.joinRepo.Reject(ctx, request.ID, "", now); rejectErr != nil {
+					return nil, rejectErr
+				}
+			}
+			return nil, err
+		}
+		uc.topics.Joined(ctx, room.ID, userID)
+		request.Status = entity.JoinRequestApproved
+		request.DecidedAt = &now
+		return request, nil
+	}
+
+	requester, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	Notify(ctx, uc.notifier, []string{room.OwnerID}, entity.Notification{
+		Type:   entity.NotificationJoinRequested,
+		Params: map[string]string{"nickname": requester.Nickname, "room": room.Name},
+		Data:   map[string]string{"room_id": room.ID, "request_id": request.ID},
+	})
+	return request, nil
+}
+
+// JoinRequestProfile is a join request together with the requesting account
+type JoinRequestProfile struct {
+	Request *entity.JoinRequest
+	User    *entity.User
+}
+
+type ListJoinRequestsUseCase struct {
+	userRepo repository.UserRepository
+	joinRepo repository.JoinRequestRepository
+	authz    *Authorizer
+}
+
+func NewListJoinRequestsUseCase(userRepo repository.UserRepository, joinRepo repository.JoinRequestRepository, authz *Authorizer) *ListJoinRequestsUseCase {
+	return &ListJoinRequestsUseCase{
+		userRepo: userRepo,
+		joinRepo: joinRepo,
+		authz:    authz,
+	}
+}
+
+// Execute returns the room's pending requests for members who manage membership
+// Requests from accounts pending deletion are left out
+func (uc *ListJoinRequestsUseCase) Execute(ctx context.Context, userID, roomID string) ([]JoinRequestProfile, error) {
+	// Listing is a read, so it stays available while the room is archived
+	_, member, err := uc.authz.Member(ctx, userID, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Can(entity.PermManageMembers) {
+		return nil, entity.ErrRoomPermissionDenied
+	}
+
+	requests, err := uc.joinRepo.ListPending(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(requests))
+	for _, r := range requests {
+		ids = append(ids, r.UserID)
+	}
+	users, err := uc.userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*entity.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	profiles := make([]JoinRequestProfile, 0, len(requests))
+	for _, r := range requests {
+		if u, ok := byID[r.UserID]; ok && !u.IsDeletionScheduled() {
+			profiles = append(profiles, JoinRequestProfile{Request: r, User: u})
+		}
+	}
+	return profiles, nil
+}
+
+type DecideJoinRequestUseCase struct {
+	joinRepo repository.JoinRequestRepository
+	authz    *Authorizer
+	notifier service.Notifier
+	topics   *Topics
+}
+
+func NewDecideJoinRequestUseCase(joinRepo repository.JoinRequestRepository, authz *Authorizer, notifier service.Notifier, topics *Topics) *DecideJoinRequestUseCase {
+	return &DecideJoinRequestUseCase{
+		joinRepo: joinRepo,
+		authz:    authz,
+		notifier: notifier,
+		topics:   topics,
+	}
+}
+
+// Execute approves or rejects a pending request and notifies the requester
+// Approval adds the requester as a member in the same transaction
+func (uc *DecideJoinRequestUseCase) Execute(ctx context.Context, userID, roomID, requestID string, approve bool) (*entity.JoinRequest, error) {
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := uc.joinRepo.GetByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	// A request ID from another room must not be decidable through this one
+	if request.RoomID != room.ID {
+		return nil, entity.ErrJoinRequestNotFound
+	}
+	if !request.IsPending() {
+		return nil, entity.ErrJoinRequestNotPending
+	}
+
+	now := time.Now()
+	n := entity.Notification{
+		Params: map[string]string{"room": room.Name},
+		Data:   map[string]string{"room_id": room.ID, "request_id": request.ID},
+	}
+	if approve {
+		if err := uc.joinRepo.Approve(ctx, request.ID, userID, newMember(request, now), now); err != nil {
+			return nil, err
+		}
+		uc.topics.Joined(ctx, room.ID, request.UserID)
+		request.Status = entity.JoinRequestApproved
+		n.Type = entity.NotificationJoinApproved
+	} else {
+		if err := uc.joinRepo.Reject(ctx, request.ID, userID, now); err != nil {
+			return nil, err
+		}
+		request.Status = entity.JoinRequestRejected
+		n.Type = entity.NotificationJoinRejected
+	}
+	request.DecidedBy = userID
+	request.DecidedAt = &now
+
+	Notify(ctx, uc.notifier, []string{request.UserID}, n)
+	return request, nil
+}
+
+// newMember is the membership an approved request grants
+func newMember(request *entity.JoinRequest, joinedAt time.Time) *entity.RoomMember {
+	return &entity.RoomMember{
+		RoomID:   request.RoomID,
+		UserID:   request.UserID,
+		Role:     entity.RoomRoleMember,
+		JoinedAt: joinedAt,
+	}
+}
@@ -0,0 +1,181 @@
+package room
+
+import (
+	"context"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+// MemberProfile is a room membership together with the member's account
+type MemberProfile struct {
+	Member *entity.RoomMember
+	User   *entity.User
+}
+
+type ListMembersUseCase struct {
+	userRepo   repository.UserRepository
+	memberRepo repository.RoomMemberRepository
+	authz      *Authorizer
+}
+
+func NewListMembersUseCase(userRepo repository.UserRepository, memberRepo repository.RoomMemberRepository, authz *Authorizer) *ListMembersUseCase {
+	return &ListMembersUseCase{
+		userRepo:   userRepo,
+		memberRepo: memberRepo,
+		authz:      authz,
+	}
+}
+
+// Execute returns the members of a room the user belongs to
+// Members whose account is pending deletion are left out
+func (uc *ListMembersUseCase) Execute(ctx context.Context, userID, roomID string) ([]MemberProfile, error) {
+	if _, _, err := uc.authz.Member(ctx, userID, roomID); err != nil {
+		return nil, err
+	}
+
+	members, err := uc.memberRepo.List(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	return memberProfiles(ctx, uc.userRepo, members)
+}
+
+type ListActiveMembersUseCase struct {
+	userRepo   repository.UserRepository
+	memberRepo repository.RoomMemberRepository
+	authz      *Authorizer
+	window     time.Duration
+}
+
+func NewListActiveMembersUseCase(userRepo repository.UserRepository, memberRepo repository.RoomMemberRepository, authz *Authorizer, window time.Duration) *ListActiveMembersUseCase {
+	return &ListActiveMembersUseCase{
+		userRepo:   userRepo,
+		memberRepo: memberRepo,
+		authz:      authz,
+		window:     window,
+	}
+}
+
+// Execute returns the members of a room the user belongs to who were seen in it within the
+// window, most recently seen first; asking counts as being seen, so the user is among them
+func (uc *ListActiveMembersUseCase) Execute(ctx context.Context, userID, roomID string) ([]MemberProfile, error) {
+	if _, _, err := uc.authz.Member(ctx, userID, roomID); err != nil {
+		return nil, err
+	}
+
+	members, err := uc.memberRepo.ListActive(ctx, roomID, time.Now().Add(-uc.window))
+	if err != nil {
+		return nil, err
+	}
+	return memberProfiles(ctx, uc.userRepo, members)
+}
+
+// memberProfiles pairs the members with their accounts, in the same order
+// Members whose account is pending deletion are left out
+func memberProfiles(ctx context.Context, userRepo repository.UserRepository, members []*entity.RoomMember) ([]MemberProfile, error) {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.UserID)
+	}
+	users, err := userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*entity.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	profiles := make([]MemberProfile, 0, len(members))
+	for _, m := range members {
+		if u, ok := byID[m.UserID]; ok && !u.IsDeletionScheduled() {
+			profiles = append(profiles, MemberProfile{Member: m, User: u})
+		}
+	}
+	return profiles, nil
+}
+
+type ChangeMemberRoleUseCase struct {
+	memberRepo repository.RoomMemberRepository
+	authz      *Authorizer
+	auditor    service.Auditor
+}
+
+func NewChangeMemberRoleUseCase(memberRepo repository.RoomMemberRepository, authz *Authorizer, auditor service.Auditor) *ChangeMemberRoleUseCase {
+	return &ChangeMemberRoleUseCase{
+		memberRepo: memberRepo,
+		authz:      authz,
+		auditor:    auditor,
+	}
+}
+
+// Execute promotes a member to moderator or demotes a moderator back to member
+// Ownership cannot be given away or taken through this usecase
+func (uc *ChangeMemberRoleUseCase) Execute(ctx context.Context, userID, roomID, memberID string, role entity.RoomRole) (*entity.RoomMember, error) {
+	if role != entity.RoomRoleModerator && role != entity.RoomRoleMember {
+		return nil, entity.ErrInvalidRoomRole
+	}
+
+	if _, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermManageMembers); err != nil {
+		return nil, err
+	}
+
+	target, err := uc.memberRepo.Get(ctx, roomID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role == entity.RoomRoleOwner {
+		return nil, entity.ErrRoomPermissionDenied
+	}
+
+	if err := uc.memberRepo.UpdateRole(ctx, roomID, memberID, role); err != nil {
+		return nil, err
+	}
+	uc.auditor.Record(ctx, entity.AuditEntry{
+		Action:     entity.AuditMemberRoleChanged,
+		ActorID:    userID,
+		TargetType: entity.AuditTargetUser,
+		TargetID:   memberID,
+		Details:    map[string]string{"room_id": roomID, "from": string(target.Role), "to": string(role)},
+	})
+	target.Role = role
+	return target, nil
+}
+
+type MuteRoomUseCase struct {
+	memberRepo repository.RoomMemberRepository
+	authz      *Authorizer
+	topics     *Topics
+}
+
+func NewMuteRoomUseCase(memberRepo repository.RoomMemberRepository, authz *Authorizer, topics *Topics) *MuteRoomUseCase {
+	return &MuteRoomUseCase{
+		memberRepo: memberRepo,
+		authz:      authz,
+		topics:     topics,
+	}
+}
+
+// Execute mutes or unmutes room-wide notifications for the user, taking their devices off the
+// room's topic or back onto it
+// Muting is a personal preference, so it also works in archived rooms
+func (uc *MuteRoomUseCase) Execute(ctx context.Context, userID, roomID string, muted bool) (*entity.RoomMember, error) {
+	_, member, err := uc.authz.Member(ctx, userID, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.memberRepo.SetMuted(ctx, roomID, userID, muted); err != nil {
+		return nil, err
+	}
+	if muted {
+		uc.topics.Left(ctx, roomID, userID)
+	} else {
+		uc.topics.Joined(ctx, roomID, userID)
+	}
+	member.Muted = muted
+	return member, nil
+}
@@ -0,0 +1,88 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+// presenceMembers keeps when each member was last seen in one room
+type presenceMembers struct {
+	repository.RoomMemberRepository
+	seen map[string]*time.Time
+}
+
+func (f *presenceMembers) Get(_ context.Context, roomID, userID string) (*entity.RoomMember, error) {
+	seen, ok := f.seen[userID]
+	if !ok {
+		return nil, entity.ErrNotRoomMember
+	}
+	return &entity.RoomMember{RoomID: roomID, UserID: userID, Role: entity.RoomRoleMember, LastSeenAt: seen}, nil
+}
+
+func (f *presenceMembers) TouchLastSeen(_ context.Context, _, userID string, at time.Time) error {
+	f.seen[userID] = &at
+	return nil
+}
+
+func (f *presenceMembers) ListActive(ctx context.Context, roomID string, since time.Time) ([]*entity.RoomMember, error) {
+	var active []*entity.RoomMember
+	for userID, seen := range f.seen {
+		if seen != nil && !seen.Before(since) {
+			member, _ := f.Get(ctx, roomID, userID)
+			active = append(active, member)
+		}
+	}
+	return active, nil
+}
+
+type profiles struct {
+	repository.UserRepository
+}
+
+func (profiles) GetByIDs(_ context.Context, ids []string) ([]*entity.User, error) {
+	users := make([]*entity.User, 0, len(ids))
+	for _, id := range ids {
+		users = append(users, &entity.User{ID: id, Nickname: id})
+	}
+	return users, nil
+}
+
+func TestListActiveMembers(t *testing.T) {
+	ctx := context.Background()
+	longAgo := time.Now().Add(-time.Hour)
+	members := &presenceMembers{seen: map[string]*time.Time{"grace": nil, "john": &longAgo}}
+
+	tests := []struct {
+		name       string
+		visibility entity.RoomVisibility
+		userID     string
+		wantErr    error
+	}{
+		{name: "outsider of a private room", visibility: entity.RoomPrivate, userID: "maria", wantErr: entity.ErrRoomNotFound},
+		{name: "outsider of a public room", visibility: entity.RoomPublic, userID: "maria", wantErr: entity.ErrNotRoomMember},
+		{name: "member", visibility: entity.RoomPrivate, userID: "grace"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authz := NewAuthorizer(roomByID{room: &entity.Room{ID: "r1", Visibility: tt.visibility}}, members)
+			uc := NewListActiveMembersUseCase(profiles{}, members, authz, 10*time.Minute)
+
+			active, err := uc.Execute(ctx, tt.userID, "r1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			// grace is seen by asking; john was last seen outside the window
+			if len(active) != 1 || active[0].User.ID != "grace" {
+				t.Errorf("active = %+v, want only grace", active)
+			}
+		})
+	}
+}
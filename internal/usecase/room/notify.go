@@ -0,0 +1,29 @@
+package room
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+// Notify delivers a notification without failing the caller's operation
+func Notify(ctx context.Context, notifier service.Notifier, userIDs []string, n entity.Notification) {
+	if err := notifier.Notify(ctx, userIDs, n); err != nil {
+		slog.ErrorContext(ctx, "Failed to send notification", "type", n.Type, "error", err)
+	}
+}
+
+// Recipients picks the members to notify about activity in their room:
+// everyone except the actor and members who muted the room
+// Every room-wide fanout must go through it so mutes are honoured
+func Recipients(members []*entity.RoomMember, actorID string) []string {
+	recipients := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.UserID != actorID && !m.Muted {
+			recipients = append(recipients, m.UserID)
+		}
+	}
+	return recipients
+}
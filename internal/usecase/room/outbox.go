@@ -0,0 +1,156 @@
+package room
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+// outboxBatchSize bounds the events published per run; the rest wait for the next tick
+const outboxBatchSize = 100
+
+type RelayOutboxUseCase struct {
+	outboxRepo repository.OutboxRepository
+	memberRepo repository.RoomMemberRepository
+	notifier   service.Notifier
+	webhook    service.WebhookPublisher
+	transactor repository.Transactor
+}
+
+func NewRelayOutboxUseCase(
+	outboxRepo repository.OutboxRepository,
+	memberRepo repository.RoomMemberRepository,
+	notifier service.Notifier,
+	webhook service.WebhookPublisher, // nil when no webhook is configured
+	transactor repository.Transactor,
+) *RelayOutboxUseCase {
+	return &RelayOutboxUseCase{
+		outboxRepo: outboxRepo,
+		memberRepo: memberRepo,
+		notifier:   notifier,
+		webhook:    webhook,
+		transactor: transactor,
+	}
+}
+
+// Execute publishes the outbox events due at to, oldest first; events carry their own schedule,
+// so from is not needed
+// An event that cannot be published is retried with backoff and dead-lettered once out of attempts
+func (uc *RelayOutboxUseCase) Execute(ctx context.Context, _, to time.Time) error {
+	events, err := uc.outboxRepo.ListDue(ctx, to, outboxBatchSize)
+	if err != nil {
+		return err
+	}
+
+	published := 0
+	for _, event := range events {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var err error
+		if event.Destination == entity.OutboxToWebhook {
+			err = uc.publishWebhook(ctx, event)
+		} else {
+			err = uc.publish(ctx, event)
+		}
+		if err == nil {
+			published++
+			continue
+		}
+
+		now := time.Now()
+		event.Fail(err.Error(), now)
+		if event.Exhausted() {
+			slog.WarnContext(ctx, "Outbox event dead-lettered",
+				"event_id", event.ID,
+				"type", event.Notification.Type,
+				"attempts", event.Attempts,
+				"error", err,
+			)
+			err = uc.outboxRepo.DeadLetter(ctx, event, now)
+		} else {
+			err = uc.outboxRepo.Reschedule(ctx, event)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to update outbox event", "event_id", event.ID, "error", err)
+		}
+	}
+
+	if published > 0 {
+		slog.InfoContext(ctx, "Published outbox events", "published", published, "failed", len(events)-published)
+	}
+	return nil
+}
+
+// publish claims the event, records the notification in the members' inboxes and queues its pushes
+// in one transaction, so it is handed over once: a failure before the commit rolls the claim back
+// and leaves the event to be retried
+// With a webhook configured, a copy of the event for the webhook is queued in the same transaction
+// Email and the queued pushes go out after the commit, so the transaction does not wait on the
+// network; their failures are only logged, so one bad address does not send the event to everyone
+// again. A push the relay never sends, because it crashed after the commit, is sent by the push
+// retrier; an email would not be, but none of the types published through the outbox is Emailed
+func (uc *RelayOutboxUseCase) publish(ctx context.Context, event *entity.OutboxEvent) error {
+	var (
+		recipients []*entity.User
+		pushes     []*entity.PushRetry
+	)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		claimed, err := uc.outboxRepo.Claim(ctx, event.ID)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			// Published meanwhile by a run that overlapped this one
+			return nil
+		}
+
+		members, err := uc.memberRepo.List(ctx, event.RoomID)
+		if err != nil {
+			return err
+		}
+		recipients, err = uc.notifier.Record(ctx, Recipients(members, event.ActorID), event.Notification)
+		if err != nil {
+			return err
+		}
+		pushes, err = uc.notifier.QueuePushes(ctx, recipients, event.Notification)
+		if err != nil {
+			return err
+		}
+
+		if uc.webhook != nil {
+			copied := event.ForWebhook(time.Now())
+			copied.ID = uuid.New().String()
+			if err := uc.outboxRepo.Append(ctx, copied); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := uc.notifier.DeliverTo(ctx, recipients, pushes, event.Notification); err != nil {
+		slog.ErrorContext(ctx, "Failed to send notification", "type", event.Notification.Type, "error", err)
+	}
+	return nil
+}
+
+// publishWebhook posts the event to the webhook and then claims it; the event is posted again if
+// the claim fails, which the webhook's receiver recognises by the event ID
+// Without a webhook configured the event is dropped
+func (uc *RelayOutboxUseCase) publishWebhook(ctx context.Context, event *entity.OutboxEvent) error {
+	if uc.webhook != nil {
+		if err := uc.webhook.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	_, err := uc.outboxRepo.Claim(ctx, event.ID)
+	return err
+}
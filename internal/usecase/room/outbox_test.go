@@ -0,0 +1,252 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+// fakeOutbox keeps the events in memory; fakeTransactor restores them when a transaction fails
+type fakeOutbox struct {
+	repository.OutboxRepository
+	events map[string]*entity.OutboxEvent
+}
+
+func (f *fakeOutbox) Append(_ context.Context, event *entity.OutboxEvent) error {
+	f.events[event.ID] = event
+	return nil
+}
+
+func (f *fakeOutbox) ListDue(_ context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	var due []*entity.OutboxEvent
+	for _, e := range f.events {
+		if !e.NextAttemptAt.After(now) {
+			copied := *e
+			due = append(due, &copied)
+		}
+	}
+	slices.SortFunc(due, func(a, b *entity.OutboxEvent) int { return a.NextAttemptAt.Compare(b.NextAttemptAt) })
+	return due[:min(limit, len(due))], nil
+}
+
+func (f *fakeOutbox) Claim(_ context.Context, id string) (bool, error) {
+	_, ok := f.events[id]
+	delete(f.events, id)
+	return ok, nil
+}
+
+func (f *fakeOutbox) Reschedule(_ context.Context, event *entity.OutboxEvent) error {
+	f.events[event.ID] = event
+	return nil
+}
+
+type fakeTransactor struct {
+	outbox *fakeOutbox
+	active bool
+}
+
+func (f *fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	snapshot := maps.Clone(f.outbox.events)
+	f.active = true
+	err := fn(ctx)
+	f.active = false
+	if err != nil {
+		f.outbox.events = snapshot
+	}
+	return err
+}
+
+type fakeMembers struct {
+	repository.RoomMemberRepository
+	members []*entity.RoomMember
+}
+
+func (f *fakeMembers) List(context.Context, string) ([]*entity.RoomMember, error) {
+	return f.members, nil
+}
+
+// fakeNotifier records who the notification was recorded, queued and delivered for, and whether
+// the pushes were queued inside the transaction and delivery happened outside it
+type fakeNotifier struct {
+	service.Notifier
+	tx          *fakeTransactor
+	recordErr   error
+	queueErr    error
+	deliverErr  error
+	recorded    []string
+	queued      []string
+	delivered   []string
+	queuedInTx  bool
+	deliverInTx bool
+}
+
+func (f *fakeNotifier) Record(_ context.Context, userIDs []string, _ entity.Notification) ([]*entity.User, error) {
+	if f.recordErr != nil {
+		return nil, f.recordErr
+	}
+	f.recorded = append(f.recorded, userIDs...)
+	users := make([]*entity.User, 0, len(userIDs))
+	for _, id := range userIDs {
+		users = append(users, &entity.User{ID: id})
+	}
+	return users, nil
+}
+
+func (f *fakeNotifier) QueuePushes(_ context.Context, users []*entity.User, n entity.Notification) ([]*entity.PushRetry, error) {
+	if f.queueErr != nil {
+		return nil, f.queueErr
+	}
+	f.queuedInTx = f.tx.active
+	pushes := make([]*entity.PushRetry, 0, len(users))
+	for _, u := range users {
+		f.queued = append(f.queued, u.ID)
+		pushes = append(pushes, entity.NewQueuedPush("token-"+u.ID, n, time.Now(), time.Now()))
+	}
+	return pushes, nil
+}
+
+func (f *fakeNotifier) DeliverTo(_ context.Context, users []*entity.User, pushes []*entity.PushRetry, _ entity.Notification) error {
+	f.deliverInTx = f.deliverInTx || f.tx.active
+	if len(pushes) != len(users) {
+		return errors.New("delivered without the queued pushes")
+	}
+	for _, u := range users {
+		f.delivered = append(f.delivered, u.ID)
+	}
+	return f.deliverErr
+}
+
+type fakeWebhook struct {
+	err       error
+	published []string
+}
+
+func (f *fakeWebhook) Publish(_ context.Context, event *entity.OutboxEvent) error {
+	f.published = append(f.published, event.ID)
+	return f.err
+}
+
+// newRelay returns a relay over one due event for a room of an actor, a member and a muted member
+func newRelay(t *testing.T, webhook service.WebhookPublisher) (*RelayOutboxUseCase, *fakeOutbox, *fakeNotifier, time.Time) {
+	t.Helper()
+	now := time.Now()
+	event := entity.NewRoomOutboxEvent("room-1", "actor", entity.Notification{Type: entity.NotificationPrayerAnswered}, now.Add(-time.Minute))
+	event.ID = "event-1"
+
+	outbox := &fakeOutbox{events: map[string]*entity.OutboxEvent{event.ID: event}}
+	tx := &fakeTransactor{outbox: outbox}
+	notifier := &fakeNotifier{tx: tx}
+	members := &fakeMembers{members: []*entity.RoomMember{
+		{RoomID: "room-1", UserID: "actor"},
+		{RoomID: "room-1", UserID: "member"},
+		{RoomID: "room-1", UserID: "muted", Muted: true},
+	}}
+	return NewRelayOutboxUseCase(outbox, members, notifier, webhook, tx), outbox, notifier, now
+}
+
+func TestRelayOutboxDeliversAfterCommit(t *testing.T) {
+	relay, outbox, notifier, now := newRelay(t, nil)
+	notifier.deliverErr = errors.New("smtp: connection refused")
+
+	if err := relay.Execute(context.Background(), now, now); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(outbox.events) != 0 {
+		t.Errorf("outbox has %d events, want the event claimed", len(outbox.events))
+	}
+	if !slices.Equal(notifier.recorded, []string{"member"}) {
+		t.Errorf("recorded for %v, want [member]", notifier.recorded)
+	}
+	if !slices.Equal(notifier.queued, []string{"member"}) || !notifier.queuedInTx {
+		t.Errorf("queued pushes for %v in transaction = %v, want [member] queued with the claim", notifier.queued, notifier.queuedInTx)
+	}
+	if !slices.Equal(notifier.delivered, []string{"member"}) {
+		t.Errorf("delivered to %v, want [member]", notifier.delivered)
+	}
+	if notifier.deliverInTx {
+		t.Error("delivered inside the transaction, want after the commit")
+	}
+}
+
+func TestRelayOutboxRollsBackWhenQueueingPushesFails(t *testing.T) {
+	relay, outbox, notifier, now := newRelay(t, nil)
+	notifier.queueErr = errors.New("database is locked")
+
+	if err := relay.Execute(context.Background(), now, now); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if event, ok := outbox.events["event-1"]; !ok || event.Attempts != 1 {
+		t.Fatalf("event = %+v, want the claim rolled back and the event rescheduled", event)
+	}
+	if len(notifier.delivered) != 0 {
+		t.Errorf("delivered to %v, want nothing delivered", notifier.delivered)
+	}
+}
+
+func TestRelayOutboxRollsBackWhenRecordFails(t *testing.T) {
+	relay, outbox, notifier, now := newRelay(t, nil)
+	notifier.recordErr = errors.New("database is locked")
+
+	if err := relay.Execute(context.Background(), now, now); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	event, ok := outbox.events["event-1"]
+	if !ok {
+		t.Fatal("event was claimed, want the claim rolled back")
+	}
+	if event.Attempts != 1 || event.LastError != "database is locked" || !event.NextAttemptAt.After(now) {
+		t.Errorf("event = %+v, want it rescheduled after one failed attempt", event)
+	}
+	if len(notifier.delivered) != 0 {
+		t.Errorf("delivered to %v, want nothing delivered", notifier.delivered)
+	}
+}
+
+func TestRelayOutboxPublishesToWebhook(t *testing.T) {
+	webhook := &fakeWebhook{err: errors.New("webhook returned 502")}
+	relay, outbox, _, now := newRelay(t, webhook)
+	ctx := context.Background()
+
+	// The member event queues a copy for the webhook, due on the next run
+	if err := relay.Execute(ctx, now, now); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(outbox.events) != 1 {
+		t.Fatalf("outbox has %d events, want the webhook copy", len(outbox.events))
+	}
+	var copied *entity.OutboxEvent
+	for _, e := range outbox.events {
+		copied = e
+	}
+	if copied.ID == "event-1" || copied.Destination != entity.OutboxToWebhook || copied.RoomID != "room-1" {
+		t.Fatalf("copy = %+v, want a webhook event of its own for room-1", copied)
+	}
+
+	// A failed post keeps the copy for a retry
+	later := now.Add(time.Minute)
+	if err := relay.Execute(ctx, later, later); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if e, ok := outbox.events[copied.ID]; !ok || e.Attempts != 1 {
+		t.Fatalf("copy = %+v, want it rescheduled after one failed attempt", e)
+	}
+
+	webhook.err = nil
+	later = later.Add(time.Hour)
+	if err := relay.Execute(ctx, later, later); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(outbox.events) != 0 {
+		t.Errorf("outbox has %d events, want the copy claimed", len(outbox.events))
+	}
+	if !slices.Equal(webhook.published, []string{copied.ID, copied.ID}) {
+		t.Errorf("published %v, want the copy posted twice under its own ID", webhook.published)
+	}
+}
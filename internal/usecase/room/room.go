@@ -0,0 +1,252 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+	"github.com/google/uuid"
+)
+
+type CreateRoomUseCase struct {
+	roomRepo repository.RoomRepository
+}
+
+func NewCreateRoomUseCase(roomRepo repository.RoomRepository) *CreateRoomUseCase {
+	return &CreateRoomUseCase{
+		roomRepo: roomRepo,
+	}
+}
+
+// Execute creates a room owned by the user, who becomes its first member
+func (uc *CreateRoomUseCase) Execute(
+	ctx context.Context,
+	userID, name, description string,
+	visibility entity.RoomVisibility,
+	category entity.RoomCategory,
+	tags []string,
+) (*entity.Room, error) {
+	room, err := entity.NewRoom(userID, name, description, visibility, category, tags)
+	if err != nil {
+		return nil, err
+	}
+	room.ID = uuid.New().String()
+
+	owner := &entity.RoomMember{
+		RoomID:   room.ID,
+		UserID:   userID,
+		Role:     entity.RoomRoleOwner,
+		JoinedAt: room.CreatedAt,
+	}
+	if err := uc.roomRepo.Create(ctx, room, owner); err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+type GetRoomUseCase struct {
+	announcementRepo repository.AnnouncementRepository
+	authz            *Authorizer
+}
+
+func NewGetRoomUseCase(announcementRepo repository.AnnouncementRepository, authz *Authorizer) *GetRoomUseCase {
+	return &GetRoomUseCase{
+		announcementRepo: announcementRepo,
+		authz:            authz,
+	}
+}
+
+// Execute returns a room the user may read: one they belong to, or any public room
+// The room's latest announcement comes with it; nil when nothing was announced yet
+func (uc *GetRoomUseCase) Execute(ctx context.Context, userID, roomID string) (*entity.Room, *entity.RoomAnnouncement, error) {
+	room, err := uc.authz.Readable(ctx, userID, roomID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	latest, err := uc.announcementRepo.Latest(ctx, room.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrAnnouncementNotFound) {
+			return room, nil, nil
+		}
+		return nil, nil, err
+	}
+	return room, latest, nil
+}
+
+type ListMyRoomsUseCase struct {
+	roomRepo repository.RoomRepository
+}
+
+func NewListMyRoomsUseCase(roomRepo repository.RoomRepository) *ListMyRoomsUseCase {
+	return &ListMyRoomsUseCase{
+		roomRepo: roomRepo,
+	}
+}
+
+// Execute returns a page of the rooms the user belongs to, newest first
+func (uc *ListMyRoomsUseCase) Execute(ctx context.Context, userID string, filter repository.RoomFilter, cursor string, limit int) ([]*entity.Room, pagination.Meta, error) {
+	filter, err := normalizeFilter(filter)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	rooms, err := uc.roomRepo.ListByMember(ctx, userID, filter, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	return pagination.Page(rooms, limit, roomCursor)
+}
+
+// normalizeFilter validates the category of a list filter and normalizes its tags
+// like room tags, so "#기도" finds rooms tagged "기도"
+func normalizeFilter(filter repository.RoomFilter) (repository.RoomFilter, error) {
+	if filter.Category != "" && !filter.Category.IsValid() {
+		return filter, entity.ErrInvalidRoomCategory
+	}
+	if len(filter.Tags) > 0 {
+		tags, err := entity.NormalizeRoomTags(filter.Tags)
+		if err != nil {
+			return filter, err
+		}
+		filter.Tags = tags
+	}
+	return filter, nil
+}
+
+// roomCursor points after the room in lists ordered by creation time
+func roomCursor(r *entity.Room) (string, error) {
+	return pagination.Encode(pagination.TimeKey{Time: r.CreatedAt, ID: r.ID})
+}
+
+type UpdateRoomUseCase struct {
+	roomRepo repository.RoomRepository
+	authz    *Authorizer
+}
+
+func NewUpdateRoomUseCase(roomRepo repository.RoomRepository, authz *Authorizer) *UpdateRoomUseCase {
+	return &UpdateRoomUseCase{
+		roomRepo: roomRepo,
+		authz:    authz,
+	}
+}
+
+// Execute applies a partial update; only the owner may change the room
+func (uc *UpdateRoomUseCase) Execute(ctx context.Context, userID, roomID string, update entity.RoomUpdate) (*entity.Room, error) {
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermUpdateRoom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := room.Update(update); err != nil {
+		return nil, err
+	}
+	if err := uc.roomRepo.Update(ctx, room); err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+type ArchiveRoomUseCase struct {
+	roomRepo repository.RoomRepository
+	authz    *Authorizer
+}
+
+func NewArchiveRoomUseCase(roomRepo repository.RoomRepository, authz *Authorizer) *ArchiveRoomUseCase {
+	return &ArchiveRoomUseCase{
+		roomRepo: roomRepo,
+		authz:    authz,
+	}
+}
+
+// Execute archives or restores the room; only the owner may do either
+// An archived room keeps its content but becomes read-only and drops out of the default lists
+func (uc *ArchiveRoomUseCase) Execute(ctx context.Context, userID, roomID string, archive bool) (*entity.Room, error) {
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermArchiveRoom)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if archive {
+		if err := uc.roomRepo.Archive(ctx, room.ID, now); err != nil {
+			return nil, err
+		}
+		room.ArchivedAt = &now
+	} else {
+		if err := uc.roomRepo.Unarchive(ctx, room.ID, now); err != nil {
+			return nil, err
+		}
+		room.ArchivedAt = nil
+	}
+	room.UpdatedAt = now
+	return room, nil
+}
+
+type DeleteRoomUseCase struct {
+	roomRepo   repository.RoomRepository
+	memberRepo repository.RoomMemberRepository
+	storage    service.Storage
+	authz      *Authorizer
+	auditor    service.Auditor
+	topics     *Topics
+}
+
+func NewDeleteRoomUseCase(
+	roomRepo repository.RoomRepository,
+	memberRepo repository.RoomMemberRepository,
+	storage service.Storage,
+	authz *Authorizer,
+	auditor service.Auditor,
+	topics *Topics,
+) *DeleteRoomUseCase {
+	return &DeleteRoomUseCase{
+		roomRepo:   roomRepo,
+		memberRepo: memberRepo,
+		storage:    storage,
+		authz:      authz,
+		auditor:    auditor,
+		topics:     topics,
+	}
+}
+
+// Execute deletes the room with everything in it; only the owner may delete
+// Every member leaves with it, so their devices are taken off the room's topic
+func (uc *DeleteRoomUseCase) Execute(ctx context.Context, userID, roomID string) error {
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermDeleteRoom)
+	if err != nil {
+		return err
+	}
+
+	members, err := uc.memberRepo.List(ctx, room.ID)
+	if err != nil {
+		return err
+	}
+	if err := uc.roomRepo.Delete(ctx, room.ID); err != nil {
+		return err
+	}
+	userIDs := make([]string, 0, len(members))
+	for _, m := range members {
+		userIDs = append(userIDs, m.UserID)
+	}
+	uc.topics.Left(ctx, room.ID, userIDs...)
+	uc.auditor.Record(ctx, entity.AuditEntry{
+		Action:     entity.AuditRoomDeleted,
+		ActorID:    userID,
+		TargetType: entity.AuditTargetRoom,
+		TargetID:   room.ID,
+		Details:    map[string]string{"name": room.Name},
+	})
+	deleteStoredFile(ctx, uc.storage, room.CoverImageKey)
+	return nil
+}
@@ -0,0 +1,59 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+const (
+	minSearchQueryLength = 2
+	maxSearchQueryLength = 50
+)
+
+var ErrInvalidSearchQuery = errors.New("search query must be between 2 and 50 characters")
+
+type SearchRoomsUseCase struct {
+	roomRepo repository.RoomRepository
+}
+
+func NewSearchRoomsUseCase(roomRepo repository.RoomRepository) *SearchRoomsUseCase {
+	return &SearchRoomsUseCase{
+		roomRepo: roomRepo,
+	}
+}
+
+// Execute finds rooms whose name or description contains query, newest first
+// Private rooms are only found by their members, so outsiders cannot learn they exist
+func (uc *SearchRoomsUseCase) Execute(ctx context.Context, userID, query string, filter repository.RoomFilter, cursor string, limit int) ([]*entity.Room, pagination.Meta, error) {
+	query = strings.TrimSpace(query)
+	if n := utf8.RuneCountInString(query); n < minSearchQueryLength || n > maxSearchQueryLength {
+		return nil, pagination.Meta{}, ErrInvalidSearchQuery
+	}
+	filter, err := normalizeFilter(filter)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	after, err := pagination.DecodeTimeKey(cursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	search := repository.RoomSearch{
+		Query:    query,
+		ViewerID: userID,
+		Filter:   filter,
+	}
+	rooms, err := uc.roomRepo.Search(ctx, search, after, limit+1)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	return pagination.Page(rooms, limit, roomCursor)
+}
@@ -0,0 +1,56 @@
+package room
+
+import (
+	"context"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+type GetRoomSettingsUseCase struct {
+	authz *Authorizer
+}
+
+func NewGetRoomSettingsUseCase(authz *Authorizer) *GetRoomSettingsUseCase {
+	return &GetRoomSettingsUseCase{
+		authz: authz,
+	}
+}
+
+// Execute returns the room with its settings; any member may read them
+func (uc *GetRoomSettingsUseCase) Execute(ctx context.Context, userID, roomID string) (*entity.Room, error) {
+	room, _, err := uc.authz.Member(ctx, userID, roomID)
+	if err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+type UpdateRoomSettingsUseCase struct {
+	roomRepo repository.RoomRepository
+	authz    *Authorizer
+}
+
+func NewUpdateRoomSettingsUseCase(roomRepo repository.RoomRepository, authz *Authorizer) *UpdateRoomSettingsUseCase {
+	return &UpdateRoomSettingsUseCase{
+		roomRepo: roomRepo,
+		authz:    authz,
+	}
+}
+
+// Execute applies a partial settings change; only the owner may change settings
+// The member cap is enforced when members join, and the post policy by Authorizer.Require
+func (uc *UpdateRoomSettingsUseCase) Execute(ctx context.Context, userID, roomID string, update entity.RoomSettingsUpdate) (*entity.Room, error) {
+	room, _, err := uc.authz.Require(ctx, userID, roomID, entity.PermUpdateRoom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := room.UpdateSettings(update); err != nil {
+		return nil, err
+	}
+	if err := uc.roomRepo.UpdateSettings(ctx, room); err != nil {
+		return nil, err
+	}
+	return room, nil
+}
@@ -0,0 +1,116 @@
+package room
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/service"
+)
+
+// Topics keeps each room's push topic subscribed by the devices of its members who have not muted it
+// Membership changes call Joined and Left, which only log failures: a missed subscription must
+// not undo a join, and the app can repair it through the subscribe endpoint
+type Topics struct {
+	deviceRepo repository.DeviceRepository
+	subscriber service.TopicSubscriber
+}
+
+func NewTopics(deviceRepo repository.DeviceRepository, subscriber service.TopicSubscriber) *Topics {
+	return &Topics{
+		deviceRepo: deviceRepo,
+		subscriber: subscriber,
+	}
+}
+
+// Joined subscribes the devices of users who joined the room
+func (t *Topics) Joined(ctx context.Context, roomID string, userIDs ...string) {
+	if err := t.subscribe(ctx, roomID, userIDs); err != nil {
+		slog.ErrorContext(ctx, "Failed to subscribe room topic", "room_id", roomID, "error", err)
+	}
+}
+
+// Left unsubscribes the devices of users who left the room
+func (t *Topics) Left(ctx context.Context, roomID string, userIDs ...string) {
+	if err := t.unsubscribe(ctx, roomID, userIDs); err != nil {
+		slog.ErrorContext(ctx, "Failed to unsubscribe room topic", "room_id", roomID, "error", err)
+	}
+}
+
+func (t *Topics) subscribe(ctx context.Context, roomID string, userIDs []string) error {
+	tokens, err := t.tokens(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	return t.subscriber.Subscribe(ctx, roomTopic(roomID), tokens)
+}
+
+func (t *Topics) unsubscribe(ctx context.Context, roomID string, userIDs []string) error {
+	tokens, err := t.tokens(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	return t.subscriber.Unsubscribe(ctx, roomTopic(roomID), tokens)
+}
+
+// tokens returns the push tokens of the users' devices
+func (t *Topics) tokens(ctx context.Context, userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	devices, err := t.deviceRepo.ListByUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]string, 0, len(devices))
+	for _, d := range devices {
+		tokens = append(tokens, d.Token)
+	}
+	return tokens, nil
+}
+
+// roomTopic is the push topic of the room; room IDs only use characters topic names allow
+func roomTopic(roomID string) string {
+	return "room-" + roomID
+}
+
+type SubscribeRoomUseCase struct {
+	topics *Topics
+	authz  *Authorizer
+}
+
+func NewSubscribeRoomUseCase(topics *Topics, authz *Authorizer) *SubscribeRoomUseCase {
+	return &SubscribeRoomUseCase{
+		topics: topics,
+		authz:  authz,
+	}
+}
+
+// Execute subscribes the user's devices to the room's topic, such as after registering a new device
+// Members who muted the room stay unsubscribed until they unmute it
+func (uc *SubscribeRoomUseCase) Execute(ctx context.Context, userID, roomID string) error {
+	_, member, err := uc.authz.Member(ctx, userID, roomID)
+	if err != nil {
+		return err
+	}
+	if member.Muted {
+		return nil
+	}
+	return uc.topics.subscribe(ctx, roomID, []string{userID})
+}
+
+type UnsubscribeRoomUseCase struct {
+	topics *Topics
+}
+
+func NewUnsubscribeRoomUseCase(topics *Topics) *UnsubscribeRoomUseCase {
+	return &UnsubscribeRoomUseCase{
+		topics: topics,
+	}
+}
+
+// Execute unsubscribes the user's devices from the room's topic
+// It only touches the user's own devices, so it needs no membership and works after leaving
+func (uc *UnsubscribeRoomUseCase) Execute(ctx context.Context, userID, roomID string) error {
+	return uc.topics.unsubscribe(ctx, roomID, []string{userID})
+}
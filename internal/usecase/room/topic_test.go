@@ -0,0 +1,163 @@
+package room
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+// fakeTopicClient keeps the tokens subscribed to each topic, as FCM would
+type fakeTopicClient struct {
+	topics map[string]map[string]bool
+}
+
+func (f *fakeTopicClient) Subscribe(_ context.Context, topic string, tokens []string) error {
+	if f.topics[topic] == nil {
+		f.topics[topic] = map[string]bool{}
+	}
+	for _, token := range tokens {
+		f.topics[topic][token] = true
+	}
+	return nil
+}
+
+func (f *fakeTopicClient) Unsubscribe(_ context.Context, topic string, tokens []string) error {
+	for _, token := range tokens {
+		delete(f.topics[topic], token)
+	}
+	return nil
+}
+
+// subscribed returns the tokens subscribed to the topic, sorted
+func (f *fakeTopicClient) subscribed(topic string) []string {
+	return slices.Sorted(maps.Keys(f.topics[topic]))
+}
+
+// userDevices knows the push tokens of each user
+type userDevices struct {
+	repository.DeviceRepository
+	tokens map[string][]string
+}
+
+func (f userDevices) ListByUsers(_ context.Context, userIDs []string) ([]*entity.Device, error) {
+	var devices []*entity.Device
+	for _, userID := range userIDs {
+		for _, token := range f.tokens[userID] {
+			devices = append(devices, &entity.Device{UserID: userID, Token: token})
+		}
+	}
+	return devices, nil
+}
+
+// roomMembers is a room's membership, with each member's mute setting
+type roomMembers struct {
+	repository.RoomMemberRepository
+	members map[string]*entity.RoomMember
+}
+
+func (f *roomMembers) Get(_ context.Context, roomID, userID string) (*entity.RoomMember, error) {
+	m, ok := f.members[userID]
+	if !ok || m.RoomID != roomID {
+		return nil, entity.ErrNotRoomMember
+	}
+	return m, nil
+}
+
+func (f *roomMembers) List(context.Context, string) ([]*entity.RoomMember, error) {
+	return slices.Collect(maps.Values(f.members)), nil
+}
+
+func (f *roomMembers) TouchLastSeen(context.Context, string, string, time.Time) error {
+	return nil
+}
+
+func (f *roomMembers) SetMuted(_ context.Context, _, userID string, muted bool) error {
+	f.members[userID].Muted = muted
+	return nil
+}
+
+type deletableRoom struct {
+	roomByID
+	deleted bool
+}
+
+func (f *deletableRoom) Delete(context.Context, string) error {
+	f.deleted = true
+	return nil
+}
+
+type noAudit struct{}
+
+func (noAudit) Record(context.Context, entity.AuditEntry) {}
+
+func TestRoomTopicFollowsMembership(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeTopicClient{topics: map[string]map[string]bool{}}
+	topics := NewTopics(userDevices{tokens: map[string][]string{
+		"owner":    {"owner-phone"},
+		"newcomer": {"newcomer-phone", "newcomer-tablet"},
+		"stranger": {"stranger-phone"},
+	}}, client)
+	const topic = "room-r1"
+
+	tx := &fakeTransactor{outbox: &fakeOutbox{}}
+	invites := &fakeInvites{invite: &entity.RoomInvite{ID: "i1", RoomID: "r1", Code: "ABCD2345", CreatedBy: "owner"}, tx: tx}
+	accept := NewAcceptInviteUseCase(invites, roomByID{room: &entity.Room{ID: "r1"}}, &memberCheck{userID: "owner", tx: tx}, tx, topics)
+	if _, err := accept.Execute(ctx, "newcomer", "ABCD2345"); err != nil {
+		t.Fatalf("accept invite: %v", err)
+	}
+	if got, want := client.subscribed(topic), []string{"newcomer-phone", "newcomer-tablet"}; !slices.Equal(got, want) {
+		t.Fatalf("after joining: subscribed = %v, want the newcomer's devices", got)
+	}
+
+	members := &roomMembers{members: map[string]*entity.RoomMember{
+		"owner":    {RoomID: "r1", UserID: "owner", Role: entity.RoomRoleOwner},
+		"newcomer": {RoomID: "r1", UserID: "newcomer", Role: entity.RoomRoleMember},
+	}}
+	rooms := &deletableRoom{roomByID: roomByID{room: &entity.Room{ID: "r1", OwnerID: "owner"}}}
+	authz := NewAuthorizer(rooms, members)
+
+	subscribe := NewSubscribeRoomUseCase(topics, authz)
+	if err := subscribe.Execute(ctx, "owner", "r1"); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	if err := subscribe.Execute(ctx, "stranger", "r1"); err == nil {
+		t.Error("subscribe by a non-member: err = nil, want it refused")
+	}
+	if got, want := client.subscribed(topic), []string{"newcomer-phone", "newcomer-tablet", "owner-phone"}; !slices.Equal(got, want) {
+		t.Fatalf("after subscribing: subscribed = %v, want %v", got, want)
+	}
+
+	mute := NewMuteRoomUseCase(members, authz, topics)
+	if _, err := mute.Execute(ctx, "newcomer", "r1", true); err != nil {
+		t.Fatalf("mute: %v", err)
+	}
+	if err := subscribe.Execute(ctx, "newcomer", "r1"); err != nil {
+		t.Fatalf("subscribe while muted: %v", err)
+	}
+	if got, want := client.subscribed(topic), []string{"owner-phone"}; !slices.Equal(got, want) {
+		t.Errorf("after muting: subscribed = %v, want the muted member's devices off the topic", got)
+	}
+	if _, err := mute.Execute(ctx, "newcomer", "r1", false); err != nil {
+		t.Fatalf("unmute: %v", err)
+	}
+
+	if err := NewUnsubscribeRoomUseCase(topics).Execute(ctx, "owner", "r1"); err != nil {
+		t.Fatalf("unsubscribe: %v", err)
+	}
+	if got, want := client.subscribed(topic), []string{"newcomer-phone", "newcomer-tablet"}; !slices.Equal(got, want) {
+		t.Errorf("after unsubscribing: subscribed = %v, want %v", got, want)
+	}
+
+	if err := NewDeleteRoomUseCase(rooms, members, nil, authz, noAudit{}, topics).Execute(ctx, "owner", "r1"); err != nil {
+		t.Fatalf("delete room: %v", err)
+	}
+	if got := client.subscribed(topic); !rooms.deleted || len(got) != 0 {
+		t.Errorf("after deleting the room: deleted = %v, subscribed = %v, want every member's devices removed", rooms.deleted, got)
+	}
+}
@@ -0,0 +1,97 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/pagination"
+)
+
+// maxTrendingRooms is how many of the top ranked rooms are paged through
+const maxTrendingRooms = 200
+
+// trendingKey is the keyset of the trending list, ordered by score with the room ID as tie-breaker
+type trendingKey struct {
+	Score int64  `json:"s"`
+	ID    string `json:"id"`
+}
+
+type TrendingRoomsUseCase struct {
+	roomRepo   repository.RoomRepository
+	memberRepo repository.RoomMemberRepository
+	window     time.Duration
+}
+
+func NewTrendingRoomsUseCase(roomRepo repository.RoomRepository, memberRepo repository.RoomMemberRepository, window time.Duration) *TrendingRoomsUseCase {
+	return &TrendingRoomsUseCase{
+		roomRepo:   roomRepo,
+		memberRepo: memberRepo,
+		window:     window,
+	}
+}
+
+// Execute returns a page of the public rooms with the most activity and new members in the
+// trending window, leaving out the rooms the user already belongs to
+func (uc *TrendingRoomsUseCase) Execute(ctx context.Context, userID, cursor string, limit int) ([]*entity.Room, pagination.Meta, error) {
+	var after trendingKey
+	paged, err := pagination.Decode(cursor, &after)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	if paged && after.ID == "" {
+		return nil, pagination.Meta{}, pagination.ErrInvalidCursor
+	}
+
+	ranked, err := uc.roomRepo.ListTrending(ctx, uc.window, maxTrendingRooms)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+	joined, err := uc.memberRepo.ListRoomIDs(ctx, userID)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	limit = pagination.ClampLimit(limit)
+	candidates := make([]repository.TrendingRoom, 0, limit+1)
+	for _, r := range ranked {
+		if len(candidates) > limit {
+			break
+		}
+		if paged && (r.Score > after.Score || r.Score == after.Score && r.RoomID <= after.ID) {
+			continue
+		}
+		if slices.Contains(joined, r.RoomID) {
+			continue
+		}
+		candidates = append(candidates, r)
+	}
+	candidates, meta, err := pagination.Page(candidates, limit, trendingCursor)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	rooms := make([]*entity.Room, 0, len(candidates))
+	for _, r := range candidates {
+		room, err := uc.roomRepo.GetByID(ctx, r.RoomID)
+		if errors.Is(err, entity.ErrRoomNotFound) {
+			continue
+		} else if err != nil {
+			return nil, pagination.Meta{}, err
+		}
+		// The ranking may be cached from before the room was made private, archived or deleted
+		if room.Visibility != entity.RoomPublic || room.IsArchived() {
+			continue
+		}
+		rooms = append(rooms, room)
+	}
+	return rooms, meta, nil
+}
+
+// trendingCursor points after the room in the trending list
+func trendingCursor(r repository.TrendingRoom) (string, error) {
+	return pagination.Encode(trendingKey{Score: r.Score, ID: r.RoomID})
+}
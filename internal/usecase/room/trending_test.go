@@ -0,0 +1,66 @@
+package room
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+)
+
+// rankedRooms serves a fixed trending ranking of public rooms
+type rankedRooms struct {
+	repository.RoomRepository
+	ranked []repository.TrendingRoom
+}
+
+func (f rankedRooms) ListTrending(context.Context, time.Duration, int) ([]repository.TrendingRoom, error) {
+	return f.ranked, nil
+}
+
+func (f rankedRooms) GetByID(_ context.Context, id string) (*entity.Room, error) {
+	return &entity.Room{ID: id, Visibility: entity.RoomPublic}, nil
+}
+
+// joinedRooms knows the rooms of one user
+type joinedRooms struct {
+	repository.RoomMemberRepository
+	roomIDs []string
+}
+
+func (f joinedRooms) ListRoomIDs(context.Context, string) ([]string, error) {
+	return f.roomIDs, nil
+}
+
+func TestTrendingRoomsExcludesJoinedRooms(t *testing.T) {
+	rooms := rankedRooms{ranked: []repository.TrendingRoom{
+		{RoomID: "busiest", Score: 9},
+		{RoomID: "busy", Score: 5},
+		{RoomID: "mine", Score: 4},
+		{RoomID: "quiet-a", Score: 1},
+		{RoomID: "quiet-b", Score: 1},
+	}}
+	uc := NewTrendingRoomsUseCase(rooms, joinedRooms{roomIDs: []string{"mine"}}, 7*24*time.Hour)
+
+	var got []string
+	cursor := ""
+	for page := 0; page < 5; page++ {
+		found, meta, err := uc.Execute(context.Background(), "grace", cursor, 2)
+		if err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+		for _, r := range found {
+			got = append(got, r.ID)
+		}
+		if !meta.HasMore {
+			break
+		}
+		cursor = meta.NextCursor
+	}
+
+	if want := []string{"busiest", "busy", "quiet-a", "quiet-b"}; !slices.Equal(got, want) {
+		t.Errorf("trending = %v, want %v, most active first without the user's own room", got, want)
+	}
+}
@@ -0,0 +1,127 @@
+package seed
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+)
+
+// defaultFixtures is the demo data shipped with the server
+//
+//go:embed fixtures/*.json
+var defaultFixtures embed.FS
+
+// Fixture file names; all but users.json are optional
+const (
+	usersFile       = "users.json"
+	roomsFile       = "rooms.json"
+	invitationsFile = "invitations.json"
+	prayersFile     = "prayers.json"
+)
+
+// ErrInvalidFixture means a fixture refers to a user or room that no fixture defines
+var ErrInvalidFixture = errors.New("invalid fixture")
+
+// Fixtures is the demo data to seed, with users and rooms referred to by email and key
+type Fixtures struct {
+	Users       []UserFixture
+	Rooms       []RoomFixture
+	Invitations []InvitationFixture
+	Prayers     []PrayerFixture
+}
+
+// UserFixture is a demo account, signed up with a verified email
+type UserFixture struct {
+	Email    string      `json:"email"`
+	Nickname string      `json:"nickname"`
+	Password string      `json:"password"`
+	Role     entity.Role `json:"role"` // empty for a regular user
+	Bio      string      `json:"bio"`
+}
+
+// RoomFixture is a room created by its owner
+type RoomFixture struct {
+	Key         string                `json:"key"` // how invitations and prayers refer to the room
+	Owner       string                `json:"owner"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Visibility  entity.RoomVisibility `json:"visibility"`
+	Category    entity.RoomCategory   `json:"category"`
+	Tags        []string              `json:"tags"`
+	// Moderators are promoted once they have joined through an invitation
+	Moderators []string `json:"moderators"`
+}
+
+// InvitationFixture is an invite code, and the users who have joined the room with it
+type InvitationFixture struct {
+	Room       string   `json:"room"`
+	CreatedBy  string   `json:"createdBy"`
+	Code       string   `json:"code"` // fixed, so the code can be typed into the app
+	MaxUses    int      `json:"maxUses"`
+	TTLDays    int      `json:"ttlDays"`
+	AcceptedBy []string `json:"acceptedBy"`
+}
+
+// PrayerFixture is a prayer topic with the prayers posted under it
+type PrayerFixture struct {
+	Room      string                 `json:"room"`
+	Author    string                 `json:"author"`
+	Title     string                 `json:"title"`
+	Tags      []string               `json:"tags"`
+	Private   bool                   `json:"private"`
+	DaysAgo   int                    `json:"daysAgo"` // backdates the topic so feeds and stats have history
+	Contents  []PrayerContentFixture `json:"contents"`
+	Answered  bool                   `json:"answered"`
+	Testimony string                 `json:"testimony"`
+}
+
+// PrayerContentFixture is a prayer posted under a topic
+type PrayerContentFixture struct {
+	Author string `json:"author"` // empty for the topic's author
+	Body   string `json:"body"`
+}
+
+// DefaultFixtures returns the demo data shipped with the server
+func DefaultFixtures() (*Fixtures, error) {
+	files, err := fs.Sub(defaultFixtures, "fixtures")
+	if err != nil {
+		return nil, err
+	}
+	return LoadFixtures(files)
+}
+
+// LoadFixtures reads the fixture files in files
+func LoadFixtures(files fs.FS) (*Fixtures, error) {
+	var f Fixtures
+	if err := readFixture(files, usersFile, &f.Users, true); err != nil {
+		return nil, err
+	}
+	if err := readFixture(files, roomsFile, &f.Rooms, false); err != nil {
+		return nil, err
+	}
+	if err := readFixture(files, invitationsFile, &f.Invitations, false); err != nil {
+		return nil, err
+	}
+	if err := readFixture(files, prayersFile, &f.Prayers, false); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func readFixture(files fs.FS, name string, v any, required bool) error {
+	data, err := fs.ReadFile(files, name)
+	if err != nil {
+		if !required && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read fixture %s: %w", name, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse fixture %s: %w", name, err)
+	}
+	return nil
+}
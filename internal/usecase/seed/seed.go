@@ -0,0 +1,305 @@
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/entity"
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrAlreadySeeded means a fixture user already exists, so the demo data was seeded before
+var ErrAlreadySeeded = errors.New("demo data has already been seeded")
+
+// Summary counts what was seeded
+type Summary struct {
+	Users       int
+	Rooms       int
+	Invitations int
+	Members     int
+	Prayers     int
+}
+
+type SeedUseCase struct {
+	userRepo    repository.UserRepository
+	roomRepo    repository.RoomRepository
+	memberRepo  repository.RoomMemberRepository
+	inviteRepo  repository.RoomInviteRepository
+	topicRepo   repository.PrayerTopicRepository
+	contentRepo repository.PrayerContentRepository
+	transactor  repository.Transactor
+}
+
+func NewSeedUseCase(
+	userRepo repository.UserRepository,
+	roomRepo repository.RoomRepository,
+	memberRepo repository.RoomMemberRepository,
+	inviteRepo repository.RoomInviteRepository,
+	topicRepo repository.PrayerTopicRepository,
+	contentRepo repository.PrayerContentRepository,
+	transactor repository.Transactor,
+) *SeedUseCase {
+	return &SeedUseCase{
+		userRepo:    userRepo,
+		roomRepo:    roomRepo,
+		memberRepo:  memberRepo,
+		inviteRepo:  inviteRepo,
+		topicRepo:   topicRepo,
+		contentRepo: contentRepo,
+		transactor:  transactor,
+	}
+}
+
+// Execute creates the users, rooms, invitations and prayers of the fixtures, in that order
+// Members join rooms by redeeming the invitations, as they would in the app
+// Seeding is refused once any fixture user exists, and nothing is kept when a fixture fails
+func (uc *SeedUseCase) Execute(ctx context.Context, f *Fixtures) (*Summary, error) {
+	for _, u := range f.Users {
+		if _, err := uc.userRepo.GetByEmail(ctx, entity.NormalizeEmail(u.Email)); err == nil {
+			return nil, fmt.Errorf("%w: %s exists", ErrAlreadySeeded, u.Email)
+		} else if !errors.Is(err, entity.ErrUserNotFound) {
+			return nil, err
+		}
+	}
+
+	s := &seeding{SeedUseCase: uc, users: make(map[string]*entity.User), rooms: make(map[string]*entity.Room), members: make(map[string]bool)}
+	steps := []func(context.Context, *Fixtures) error{s.createUsers, s.createRooms, s.createInvitations, s.promoteModerators, s.createPrayers}
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, step := range steps {
+			if err := step(ctx, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s.summary, nil
+}
+
+// seeding is the state of one Execute: the created users by email and rooms by key
+type seeding struct {
+	*SeedUseCase
+	users   map[string]*entity.User
+	rooms   map[string]*entity.Room
+	members map[string]bool // by room ID and user ID
+	summary Summary
+}
+
+func (s *seeding) createUsers(ctx context.Context, f *Fixtures) error {
+	for _, u := range f.Users {
+		user, err := entity.NewUser(u.Email, u.Nickname, u.Password)
+		if err != nil {
+			return fmt.Errorf("user %s: %w", u.Email, err)
+		}
+		if u.Role != "" {
+			if !u.Role.IsValid() {
+				return fmt.Errorf("%w: user %s has unknown role %q", ErrInvalidFixture, u.Email, u.Role)
+			}
+			user.Role = u.Role
+		}
+		user.Bio = u.Bio
+		hash, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return fmt.Errorf("failed to hash password: %w", err)
+		}
+		user.ID = uuid.New().String()
+		user.PasswordHash = string(hash)
+		// Demo accounts can use everything that needs a verified email right away
+		verifiedAt := user.CreatedAt
+		user.EmailVerifiedAt = &verifiedAt
+
+		if err := s.userRepo.Create(ctx, user); err != nil {
+			return fmt.Errorf("user %s: %w", u.Email, err)
+		}
+		s.users[user.Email] = user
+		s.summary.Users++
+	}
+	return nil
+}
+
+func (s *seeding) createRooms(ctx context.Context, f *Fixtures) error {
+	for _, r := range f.Rooms {
+		if _, ok := s.rooms[r.Key]; ok || r.Key == "" {
+			return fmt.Errorf("%w: room key %q is empty or repeated", ErrInvalidFixture, r.Key)
+		}
+		owner, err := s.user(r.Owner)
+		if err != nil {
+			return err
+		}
+		room, err := entity.NewRoom(owner.ID, r.Name, r.Description, r.Visibility, r.Category, r.Tags)
+		if err != nil {
+			return fmt.Errorf("room %s: %w", r.Key, err)
+		}
+		room.ID = uuid.New().String()
+
+		member := &entity.RoomMember{
+			RoomID:   room.ID,
+			UserID:   owner.ID,
+			Role:     entity.RoomRoleOwner,
+			JoinedAt: room.CreatedAt,
+		}
+		if err := s.roomRepo.Create(ctx, room, member); err != nil {
+			return fmt.Errorf("room %s: %w", r.Key, err)
+		}
+		s.rooms[r.Key] = room
+		s.members[room.ID+"/"+owner.ID] = true
+		s.summary.Rooms++
+	}
+	return nil
+}
+
+func (s *seeding) createInvitations(ctx context.Context, f *Fixtures) error {
+	for _, i := range f.Invitations {
+		room, err := s.room(i.Room)
+		if err != nil {
+			return err
+		}
+		creator, err := s.member(room, i.CreatedBy)
+		if err != nil {
+			return err
+		}
+		invite, err := entity.NewRoomInvite(room.ID, creator.ID, i.MaxUses, time.Duration(i.TTLDays)*24*time.Hour)
+		if err != nil {
+			return fmt.Errorf("invitation %s: %w", i.Code, err)
+		}
+		invite.ID = uuid.New().String()
+		// Upper case, as accepting an invite normalizes the code typed in
+		invite.Code = strings.ToUpper(strings.TrimSpace(i.Code))
+		if invite.Code == "" {
+			return fmt.Errorf("%w: invitation to room %q has no code", ErrInvalidFixture, i.Room)
+		}
+		if err := s.inviteRepo.Create(ctx, invite); err != nil {
+			return fmt.Errorf("invitation %s: %w", i.Code, err)
+		}
+		s.summary.Invitations++
+
+		for _, email := range i.AcceptedBy {
+			user, err := s.user(email)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			member := &entity.RoomMember{
+				RoomID:    room.ID,
+				UserID:    user.ID,
+				Role:      entity.RoomRoleMember,
+				InvitedBy: creator.ID,
+				JoinedAt:  now,
+			}
+			if err := s.inviteRepo.Redeem(ctx, invite.ID, member, now); err != nil {
+				return fmt.Errorf("invitation %s accepted by %s: %w", i.Code, email, err)
+			}
+			s.members[room.ID+"/"+user.ID] = true
+			s.summary.Members++
+		}
+	}
+	return nil
+}
+
+func (s *seeding) promoteModerators(ctx context.Context, f *Fixtures) error {
+	for _, r := range f.Rooms {
+		room := s.rooms[r.Key]
+		for _, email := range r.Moderators {
+			user, err := s.member(room, email)
+			if err != nil {
+				return err
+			}
+			if err := s.memberRepo.UpdateRole(ctx, room.ID, user.ID, entity.RoomRoleModerator); err != nil {
+				return fmt.Errorf("moderator %s of room %s: %w", email, r.Key, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *seeding) createPrayers(ctx context.Context, f *Fixtures) error {
+	for _, p := range f.Prayers {
+		room, err := s.room(p.Room)
+		if err != nil {
+			return err
+		}
+		author, err := s.member(room, p.Author)
+		if err != nil {
+			return err
+		}
+		topic, err := entity.NewPrayerTopic(room.ID, author.ID, p.Title, p.Tags, p.Private, "")
+		if err != nil {
+			return fmt.Errorf("prayer %q: %w", p.Title, err)
+		}
+		topic.ID = uuid.New().String()
+		postedAt := topic.CreatedAt.AddDate(0, 0, -p.DaysAgo)
+		topic.CreatedAt, topic.UpdatedAt = postedAt, postedAt
+		if err := s.topicRepo.Create(ctx, topic); err != nil {
+			return fmt.Errorf("prayer %q: %w", p.Title, err)
+		}
+
+		for n, c := range p.Contents {
+			contentAuthor := author
+			if c.Author != "" {
+				if contentAuthor, err = s.member(room, c.Author); err != nil {
+					return err
+				}
+			}
+			content, err := entity.NewPrayerContent(topic, contentAuthor.ID, c.Body)
+			if err != nil {
+				return fmt.Errorf("prayer %q: %w", p.Title, err)
+			}
+			content.ID = uuid.New().String()
+			// Spread the entries over the days since the topic was posted
+			at := postedAt.Add(time.Duration(n+1) * time.Duration(p.DaysAgo) * 24 * time.Hour / time.Duration(len(p.Contents)+1))
+			content.CreatedAt, content.UpdatedAt = at, at
+			if err := s.contentRepo.Create(ctx, content); err != nil {
+				return fmt.Errorf("prayer %q: %w", p.Title, err)
+			}
+		}
+
+		if p.Answered {
+			testimony, err := entity.NormalizeTestimony(p.Testimony)
+			if err != nil {
+				return fmt.Errorf("prayer %q: %w", p.Title, err)
+			}
+			if err := s.topicRepo.MarkAnswered(ctx, topic.ID, testimony, time.Now()); err != nil {
+				return fmt.Errorf("prayer %q: %w", p.Title, err)
+			}
+		}
+		s.summary.Prayers++
+	}
+	return nil
+}
+
+// user returns the fixture user with the email
+func (s *seeding) user(email string) (*entity.User, error) {
+	user, ok := s.users[entity.NormalizeEmail(email)]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown user %q", ErrInvalidFixture, email)
+	}
+	return user, nil
+}
+
+// room returns the fixture room with the key
+func (s *seeding) room(key string) (*entity.Room, error) {
+	room, ok := s.rooms[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown room %q", ErrInvalidFixture, key)
+	}
+	return room, nil
+}
+
+// member returns the fixture user with the email, who must have joined the room
+func (s *seeding) member(room *entity.Room, email string) (*entity.User, error) {
+	user, err := s.user(email)
+	if err != nil {
+		return nil, err
+	}
+	if !s.members[room.ID+"/"+user.ID] {
+		return nil, fmt.Errorf("%w: %s is not a member of room %q", ErrInvalidFixture, email, room.Name)
+	}
+	return user, nil
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// checkpointTimeout bounds how long cancelled runs get to record where they stopped
+const checkpointTimeout = 10 * time.Second
+
+// Lifecycle tracks the background jobs of the process so shutdown can wait for them
+// Once Stop is called no new runs start; runs in progress may finish until Stop's deadline,
+// after which their context is cancelled and they checkpoint: scheduled jobs release their lock
+// without advancing, claimed exports and queued pushes are picked up again by the next instance
+type Lifecycle struct {
+	stopping chan struct{}
+	stopOnce sync.Once
+	ctx      context.Context
+	cancel   context.CancelFunc
+	loops    sync.WaitGroup
+}
+
+func NewLifecycle() *Lifecycle {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Lifecycle{
+		stopping: make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Stop stops starting runs and waits for the runs in progress until ctx is done, then cancels
+// them and waits at most checkpointTimeout more
+func (l *Lifecycle) Stop(ctx context.Context) {
+	l.stopOnce.Do(func() { close(l.stopping) })
+
+	done := make(chan struct{})
+	go func() {
+		l.loops.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		l.cancel()
+		slog.Info("Background jobs stopped")
+		return
+	case <-ctx.Done():
+	}
+
+	slog.Warn("Background jobs still running at the drain deadline, cancelling them")
+	l.cancel()
+	select {
+	case <-done:
+	case <-time.After(checkpointTimeout):
+		slog.Error("Background jobs did not stop after cancellation")
+	}
+}
+
+// loop runs fn in a goroutine tracked until it returns
+// fn must return once stopping is closed, and runs work with the lifecycle's context
+func (l *Lifecycle) loop(fn func(ctx context.Context, stopping <-chan struct{})) {
+	l.loops.Add(1)
+	go func() {
+		defer l.loops.Done()
+		fn(l.ctx, l.stopping)
+	}()
+}
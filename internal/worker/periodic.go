@@ -0,0 +1,46 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Job is a unit of background work run on every tick
+type Job func(ctx context.Context) error
+
+// StartPeriodic runs job every interval until lc is stopped
+// It returns immediately; a non-positive interval disables the job
+func StartPeriodic(lc *Lifecycle, name string, interval time.Duration, job Job) {
+	if interval <= 0 {
+		slog.Info("Background job disabled", "job", name)
+		return
+	}
+
+	lc.loop(func(ctx context.Context, stopping <-chan struct{}) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stopping:
+				return
+			case <-ticker.C:
+				run(ctx, name, job)
+			}
+		}
+	})
+}
+
+// run executes one tick, recovering from panics so the loop keeps going
+func run(ctx context.Context, name string, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("Background job panicked", "job", name, "panic", r)
+		}
+	}()
+
+	if err := job(ctx); err != nil && ctx.Err() == nil {
+		slog.Error("Background job failed", "job", name, "error", err)
+	}
+}
@@ -0,0 +1,109 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// scheduleLockTTL is how long a run may hold its job's lock; an instance that dies mid-run
+// blocks the job at most this long
+const scheduleLockTTL = 5 * time.Minute
+
+// WindowJob handles everything that fell due in (from, to]
+type WindowJob func(ctx context.Context, from, to time.Time) error
+
+// Scheduler runs jobs on wall-clock boundaries, cron style, on one server instance at a time
+// Instances coordinate through DB-backed locks that also record where each job left off,
+// so every moment is handled exactly once even as instances come and go
+type Scheduler struct {
+	locks repository.JobLockRepository
+	owner string
+}
+
+func NewScheduler(locks repository.JobLockRepository) *Scheduler {
+	return &Scheduler{
+		locks: locks,
+		owner: uuid.New().String(),
+	}
+}
+
+// Start runs job at every multiple of interval, such as the top of every minute for time.Minute
+// Each run covers the time since the last successful run anywhere, but at most maxCatchUp,
+// so an outage does not flood users with late notifications
+// It returns immediately and runs until lc is stopped; a non-positive interval disables the job
+func (s *Scheduler) Start(lc *Lifecycle, name string, interval, maxCatchUp time.Duration, job WindowJob) {
+	if interval <= 0 {
+		slog.Info("Scheduled job disabled", "job", name)
+		return
+	}
+
+	lc.loop(func(ctx context.Context, stopping <-chan struct{}) {
+		for {
+			next := time.Now().Truncate(interval).Add(interval)
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-stopping:
+				timer.Stop()
+				return
+			case <-timer.C:
+				s.tick(ctx, name, next, interval, maxCatchUp, job)
+			}
+		}
+	})
+}
+
+// tick runs the job for the window ending at the boundary if this instance wins the lock
+func (s *Scheduler) tick(ctx context.Context, name string, boundary time.Time, interval, maxCatchUp time.Duration, job WindowJob) {
+	lock, ok, err := s.locks.Acquire(ctx, name, s.owner, time.Now(), time.Now().Add(scheduleLockTTL))
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Error("Failed to lock scheduled job", "job", name, "error", err)
+		}
+		return
+	}
+	if !ok {
+		return
+	}
+
+	from := boundary.Add(-interval)
+	if lock.LastRunAt != nil {
+		from = *lock.LastRunAt
+	}
+	if earliest := boundary.Add(-maxCatchUp); from.Before(earliest) {
+		slog.Warn("Scheduled job skipped a backlog", "job", name, "from", from, "resumed_at", earliest)
+		from = earliest
+	}
+
+	var done *time.Time
+	if from.Before(boundary) {
+		if err := runWindow(ctx, job, from, boundary); err != nil {
+			if ctx.Err() == nil {
+				slog.Error("Scheduled job failed", "job", name, "error", err)
+			}
+		} else {
+			done = &boundary
+		}
+	}
+
+	// Released on a fresh context so shutting down mid-run does not leave the job locked
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	if err := s.locks.Release(releaseCtx, name, s.owner, done); err != nil {
+		slog.Error("Failed to release scheduled job", "job", name, "error", err)
+	}
+}
+
+// runWindow executes one window, turning a panic into an error so the lock is still released
+func runWindow(ctx context.Context, job WindowJob, from, to time.Time) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return job(ctx, from, to)
+}
@@ -0,0 +1,138 @@
+// Package apierror defines the JSON error envelope every API error is returned in
+//
+//	{"code": "ROOM_NOT_FOUND", "message": "room not found", "request_id": "..."}
+//
+// Clients branch on the stable code; the message is for developers and may change
+// Messages are written in the language negotiated from Accept-Language, see Messages
+// Handlers abort with an *Error and the Middleware writes it once the handler chain returns
+package apierror
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+// StatusClientClosedRequest is the nginx-style status for requests the client abandoned
+// No body is written for it: nobody is waiting for the response
+const StatusClientClosedRequest = 499
+
+// Error is an error with the status and code it is returned to the client with
+type Error struct {
+	Status  int
+	Code    Code
+	Message string
+	// Key names the message in Messages, the code when empty; Message is the fallback for keys
+	// without a translation
+	Key    string
+	Params map[string]string
+	// Details carries structured context such as the rejected fields; omitted when nil
+	Details any
+	// Err is the underlying error, kept for logs and errors.Is but never shown to the client
+	Err error
+}
+
+// New returns an error with its own message
+func New(status int, code Code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// Wrap returns an error for err, shown to the client with err's message
+func Wrap(err error, status int, code Code) *Error {
+	return &Error{Status: status, Code: code, Message: err.Error(), Err: err}
+}
+
+// Internal returns a 500 for err that hides its message from the client
+func Internal(err error) *Error {
+	return &Error{Status: http.StatusInternalServerError, Code: CodeInternal, Message: "Internal server error", Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// WithDetails returns a copy of the error carrying details
+func (e *Error) WithDetails(details any) *Error {
+	copied := *e
+	copied.Details = details
+	return &copied
+}
+
+// WithMessage returns a copy of the error whose message is key in Messages, filled with params
+func (e *Error) WithMessage(key string, params map[string]string) *Error {
+	copied := *e
+	copied.Key = key
+	copied.Params = params
+	return &copied
+}
+
+// LocalizedMessage returns the message in lang, falling back to English and then to Message
+func (e *Error) LocalizedMessage(lang string) string {
+	key := e.Key
+	if key == "" {
+		key = string(e.Code)
+	}
+	if text, ok := Messages.Lookup(lang, key, e.Params); ok {
+		return text
+	}
+	return e.Message
+}
+
+// Response is the JSON body of an error response
+type Response struct {
+	Code      Code   `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Details   any    `json:"details,omitempty"`
+}
+
+// Abort stops the handler chain with err, leaving the response to the Middleware
+// Errors that are not an *Error are returned as internal errors
+func Abort(c *gin.Context, err error) {
+	_ = c.Error(err)
+	c.Abort()
+}
+
+// Respond writes err immediately, for middleware that rejects requests itself
+func Respond(c *gin.Context, err *Error, requestID string) {
+	if err.Status == StatusClientClosedRequest {
+		c.AbortWithStatus(err.Status)
+		return
+	}
+	lang := i18n.RequestLanguage(c)
+	c.Header("Content-Language", lang)
+	c.Writer.Header().Add("Vary", "Accept-Language")
+	c.AbortWithStatusJSON(err.Status, Response{
+		Code:      err.Code,
+		Message:   err.LocalizedMessage(lang),
+		RequestID: requestID,
+		Details:   err.Details,
+	})
+}
+
+// Middleware writes the last error a handler aborted with, unless a response was already written
+// requestID reads the request's ID for the envelope
+func Middleware(requestID func(*gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		err := c.Errors.Last().Err
+		var apiErr *Error
+		if !errors.As(err, &apiErr) {
+			apiErr = Internal(err)
+		}
+		Respond(c, apiErr, requestID(c))
+	}
+}
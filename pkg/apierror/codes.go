@@ -0,0 +1,116 @@
+package apierror
+
+// Code is the stable, machine-readable identifier of an API error
+type Code string
+
+// General codes, used when no more specific code applies
+const (
+	CodeBadRequest         Code = "BAD_REQUEST"
+	CodeValidationFailed   Code = "VALIDATION_FAILED"
+	CodeMalformedBody      Code = "MALFORMED_BODY"
+	CodeURITooLong         Code = "URI_TOO_LONG"
+	CodePayloadTooLarge    Code = "PAYLOAD_TOO_LARGE"
+	CodeUnauthorized       Code = "UNAUTHORIZED"
+	CodeForbidden          Code = "FORBIDDEN"
+	CodeNotFound           Code = "NOT_FOUND"
+	CodeConflict           Code = "CONFLICT"
+	CodeRequestTimeout     Code = "REQUEST_TIMEOUT"
+	CodeServiceUnavailable Code = "SERVICE_UNAVAILABLE"
+	CodeInternal           Code = "INTERNAL_ERROR"
+	CodeClientClosed       Code = "CLIENT_CLOSED_REQUEST"
+)
+
+// Request codes
+const (
+	CodeInvalidCursor            Code = "INVALID_CURSOR"
+	CodeWeakPassword             Code = "WEAK_PASSWORD"
+	CodeUnsupportedProvider      Code = "UNSUPPORTED_PROVIDER"
+	CodeInvalidResetToken        Code = "INVALID_RESET_TOKEN"
+	CodeInvalidVerificationToken Code = "INVALID_VERIFICATION_TOKEN"
+	CodeInvalidInvite            Code = "INVALID_INVITE"
+	CodeCannotBlockSelf          Code = "CANNOT_BLOCK_SELF"
+	CodeCannotSuspendSelf        Code = "CANNOT_SUSPEND_SELF"
+	CodeCannotInviteSelf         Code = "CANNOT_INVITE_SELF"
+	CodeInvalidIdempotencyKey    Code = "INVALID_IDEMPOTENCY_KEY"
+	CodeIdempotencyKeyReused     Code = "IDEMPOTENCY_KEY_REUSED"
+	CodeIdempotencyKeyInProgress Code = "IDEMPOTENCY_KEY_IN_PROGRESS"
+)
+
+// Authentication codes
+const (
+	CodeMissingToken         Code = "MISSING_TOKEN"
+	CodeInvalidToken         Code = "INVALID_TOKEN"
+	CodeTokenExpired         Code = "TOKEN_EXPIRED"
+	CodeTokenRevoked         Code = "TOKEN_REVOKED"
+	CodeInvalidCredentials   Code = "INVALID_CREDENTIALS"
+	CodeInvalidIDToken       Code = "INVALID_ID_TOKEN"
+	CodeInvalidRefreshToken  Code = "INVALID_REFRESH_TOKEN"
+	CodeRefreshTokenReused   Code = "REFRESH_TOKEN_REUSED"
+	CodeMissingAPIKey        Code = "MISSING_API_KEY"
+	CodeInvalidAPIKey        Code = "INVALID_API_KEY"
+	CodeAPIKeyScopeDenied    Code = "API_KEY_SCOPE_DENIED"
+	CodeAPIKeyNotAllowed     Code = "API_KEY_NOT_ALLOWED"
+	CodeInvalidTwoFactorCode Code = "INVALID_TWO_FACTOR_CODE"
+	CodeTwoFactorLocked      Code = "TWO_FACTOR_LOCKED"
+	CodeInvalidHealthToken   Code = "INVALID_HEALTH_TOKEN"
+)
+
+// Account codes
+const (
+	CodeEmailNotVerified     Code = "EMAIL_NOT_VERIFIED"
+	CodeAccountSuspended     Code = "ACCOUNT_SUSPENDED"
+	CodeInsufficientRole     Code = "INSUFFICIENT_ROLE"
+	CodeGuestReadOnly        Code = "GUEST_READ_ONLY"
+	CodeEmailAlreadyVerified Code = "EMAIL_ALREADY_VERIFIED"
+	CodeTwoFactorNotEnrolled Code = "TWO_FACTOR_NOT_ENROLLED"
+	CodeTwoFactorNotEnabled  Code = "TWO_FACTOR_NOT_ENABLED"
+	CodeTwoFactorEnabled     Code = "TWO_FACTOR_ALREADY_ENABLED"
+	CodeSocialAccountLinked  Code = "SOCIAL_ACCOUNT_ALREADY_LINKED"
+	CodeSocialLinkRequired   Code = "SOCIAL_LINK_REQUIRED"
+	CodeNotGuest             Code = "NOT_GUEST"
+	CodeDeletionNotScheduled Code = "DELETION_NOT_SCHEDULED"
+	CodeEmailTaken           Code = "EMAIL_TAKEN"
+	CodeNicknameTaken        Code = "NICKNAME_TAKEN"
+	CodeUserNotFound         Code = "USER_NOT_FOUND"
+	CodeSessionNotFound      Code = "SESSION_NOT_FOUND"
+	CodeAPIKeyNotFound       Code = "API_KEY_NOT_FOUND"
+	CodeDeviceNotFound       Code = "DEVICE_NOT_FOUND"
+	CodeNotificationNotFound Code = "NOTIFICATION_NOT_FOUND"
+)
+
+// Room codes
+const (
+	CodeRoomNotFound          Code = "ROOM_NOT_FOUND"
+	CodeNotRoomMember         Code = "NOT_A_MEMBER"
+	CodeRoomPermissionDenied  Code = "ROOM_PERMISSION_DENIED"
+	CodeAlreadyRoomMember     Code = "ALREADY_A_MEMBER"
+	CodeRoomArchived          Code = "ROOM_ARCHIVED"
+	CodeRoomNotArchived       Code = "ROOM_NOT_ARCHIVED"
+	CodeRoomFull              Code = "ROOM_FULL"
+	CodeInviteNotFound        Code = "INVITE_NOT_FOUND"
+	CodeInviteExpired         Code = "INVITE_EXPIRED"
+	CodeJoinRequestNotFound   Code = "JOIN_REQUEST_NOT_FOUND"
+	CodeJoinRequestPending    Code = "JOIN_REQUEST_ALREADY_PENDING"
+	CodeJoinRequestNotPending Code = "JOIN_REQUEST_NOT_PENDING"
+	CodeInvitationNotFound    Code = "INVITATION_NOT_FOUND"
+	CodeInvitationPending     Code = "INVITATION_ALREADY_PENDING"
+	CodeInvitationNotPending  Code = "INVITATION_NOT_PENDING"
+	CodeAnnouncementNotFound  Code = "ANNOUNCEMENT_NOT_FOUND"
+	CodeExportNotFound        Code = "EXPORT_NOT_FOUND"
+)
+
+// Prayer codes
+const (
+	CodePrayerTopicNotFound     Code = "PRAYER_TOPIC_NOT_FOUND"
+	CodePrayerTopicNotDeleted   Code = "PRAYER_TOPIC_NOT_DELETED"
+	CodePrayerTopicAnswered     Code = "PRAYER_TOPIC_ANSWERED"
+	CodePrayerTopicNotAnswered  Code = "PRAYER_TOPIC_NOT_ANSWERED"
+	CodePrayerTopicNotRecurring Code = "PRAYER_TOPIC_NOT_RECURRING"
+	CodePrayerTopicPinned       Code = "PRAYER_TOPIC_PINNED"
+	CodePrayerTopicNotPinned    Code = "PRAYER_TOPIC_NOT_PINNED"
+	CodePrayerTopicPrivate      Code = "PRAYER_TOPIC_PRIVATE"
+	CodePinLimitReached         Code = "PIN_LIMIT_REACHED"
+	CodePinOrderMismatch        Code = "PIN_ORDER_MISMATCH"
+	CodePrayerContentNotFound   Code = "PRAYER_CONTENT_NOT_FOUND"
+	CodePrayerCommentNotFound   Code = "PRAYER_COMMENT_NOT_FOUND"
+)
@@ -0,0 +1,619 @@
+package apierror
+
+import "github.com/changhyeonkim/pray-together/go-api-server/pkg/i18n"
+
+// Messages holds the client-facing text of every error, keyed by code
+// Codes whose message depends on the cause have keys of the form "CODE.cause"; codes whose English
+// message varies too much to list have a Korean entry only, so English falls back to the error's message
+// Field keys ("field.*") describe rejected request fields in the validation details
+var Messages = i18n.Catalog{
+	// General
+	"BAD_REQUEST": {
+		"ko": "잘못된 요청입니다",
+	},
+	"BAD_REQUEST.query_param_too_long": {
+		"en": "query parameter \"{name}\" exceeds {limit} characters",
+		"ko": "쿼리 파라미터 \"{name}\"은(는) {limit}자를 넘을 수 없습니다",
+	},
+	"VALIDATION_FAILED": {
+		"en": "Invalid request",
+		"ko": "요청 값이 올바르지 않습니다",
+	},
+	"MALFORMED_BODY": {
+		"en": "Malformed JSON body",
+		"ko": "JSON 본문 형식이 올바르지 않습니다",
+	},
+	"MALFORMED_BODY.missing": {
+		"en": "Request body is required",
+		"ko": "요청 본문이 필요합니다",
+	},
+	"URI_TOO_LONG": {
+		"en": "request URI exceeds {limit} characters",
+		"ko": "요청 URI는 {limit}자를 넘을 수 없습니다",
+	},
+	"PAYLOAD_TOO_LARGE": {
+		"en": "request body exceeds {limit} bytes",
+		"ko": "요청 본문은 {limit}바이트를 넘을 수 없습니다",
+	},
+	"UNAUTHORIZED": {
+		"en": "authentication required",
+		"ko": "인증이 필요합니다",
+	},
+	"FORBIDDEN": {
+		"en": "access denied",
+		"ko": "접근 권한이 없습니다",
+	},
+	"NOT_FOUND": {
+		"en": "route not found",
+		"ko": "요청한 경로를 찾을 수 없습니다",
+	},
+	"CONFLICT": {
+		"en": "request conflicts with the current state",
+		"ko": "현재 상태와 충돌하는 요청입니다",
+	},
+	"REQUEST_TIMEOUT": {
+		"en": "Request timed out",
+		"ko": "요청 처리 시간이 초과되었습니다",
+	},
+	"SERVICE_UNAVAILABLE": {
+		"ko": "일시적으로 요청을 처리할 수 없습니다. 잠시 후 다시 시도해 주세요",
+	},
+	"INTERNAL_ERROR": {
+		"en": "Internal server error",
+		"ko": "서버 오류가 발생했습니다",
+	},
+
+	// Request
+	"INVALID_CURSOR": {
+		"en": "invalid cursor",
+		"ko": "유효하지 않은 커서입니다",
+	},
+	"WEAK_PASSWORD": {
+		"en": "password must be between 8 and 72 characters",
+		"ko": "비밀번호는 8자 이상 72자 이하여야 합니다",
+	},
+	"UNSUPPORTED_PROVIDER": {
+		"en": "unsupported social provider",
+		"ko": "지원하지 않는 소셜 로그인입니다",
+	},
+	"INVALID_RESET_TOKEN": {
+		"en": "invalid or expired password reset token",
+		"ko": "비밀번호 재설정 링크가 유효하지 않거나 만료되었습니다",
+	},
+	"INVALID_VERIFICATION_TOKEN": {
+		"en": "invalid or expired email verification token",
+		"ko": "이메일 인증 링크가 유효하지 않거나 만료되었습니다",
+	},
+	"INVALID_INVITE": {
+		"en": "invite must allow at most 100 uses and expire within 30 days",
+		"ko": "초대는 최대 100회까지 사용할 수 있고 30일 이내에 만료되어야 합니다",
+	},
+	"CANNOT_BLOCK_SELF": {
+		"en": "cannot block yourself",
+		"ko": "자기 자신을 차단할 수 없습니다",
+	},
+	"CANNOT_INVITE_SELF": {
+		"en": "cannot invite yourself",
+		"ko": "자기 자신을 초대할 수 없습니다",
+	},
+	"CANNOT_SUSPEND_SELF": {
+		"en": "cannot suspend your own account",
+		"ko": "자신의 계정을 정지할 수 없습니다",
+	},
+	"INVALID_IDEMPOTENCY_KEY": {
+		"ko": "Idempotency-Key 헤더 값이 올바르지 않습니다",
+	},
+	"IDEMPOTENCY_KEY_REUSED": {
+		"ko": "이 Idempotency-Key는 다른 요청에 이미 사용되었습니다",
+	},
+	"IDEMPOTENCY_KEY_IN_PROGRESS": {
+		"ko": "같은 Idempotency-Key의 요청이 아직 처리 중입니다",
+	},
+
+	// Field validation
+	"VALIDATION_FAILED.email": {
+		"en": "invalid email",
+		"ko": "이메일 형식이 올바르지 않습니다",
+	},
+	"VALIDATION_FAILED.nickname": {
+		"en": "nickname must be between 2 and 20 characters",
+		"ko": "닉네임은 2자 이상 20자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.api_key_name": {
+		"en": "api key name must be between 1 and 100 characters",
+		"ko": "API 키 이름은 1자 이상 100자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.api_key_scopes": {
+		"en": "api key scopes must be read or write",
+		"ko": "API 키 권한은 read 또는 write여야 합니다",
+	},
+	"VALIDATION_FAILED.bio": {
+		"en": "bio must be at most 200 characters",
+		"ko": "소개는 200자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.profile_image_url": {
+		"en": "profile image URL must be an https URL of at most 500 characters",
+		"ko": "프로필 이미지 URL은 500자 이하의 https URL이어야 합니다",
+	},
+	"VALIDATION_FAILED.timezone": {
+		"en": "timezone must be an IANA time zone such as Asia/Seoul",
+		"ko": "시간대는 Asia/Seoul과 같은 IANA 시간대여야 합니다",
+	},
+	"VALIDATION_FAILED.locale": {
+		"en": "locale must be a BCP 47 language tag such as ko-KR",
+		"ko": "언어 설정은 ko-KR과 같은 BCP 47 언어 태그여야 합니다",
+	},
+	"VALIDATION_FAILED.search_query": {
+		"en": "search query must be between 2 and 50 characters",
+		"ko": "검색어는 2자 이상 50자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.suspension_reason": {
+		"en": "suspension reason must be between 1 and 500 characters",
+		"ko": "정지 사유는 1자 이상 500자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.room_name": {
+		"en": "room name must be between 1 and 50 characters",
+		"ko": "방 이름은 1자 이상 50자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.room_description": {
+		"en": "room description must be at most 500 characters",
+		"ko": "방 설명은 500자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.room_visibility": {
+		"en": "room visibility must be public or private",
+		"ko": "방 공개 범위는 public 또는 private이어야 합니다",
+	},
+	"VALIDATION_FAILED.room_category": {
+		"en": "unknown room category",
+		"ko": "알 수 없는 방 카테고리입니다",
+	},
+	"VALIDATION_FAILED.room_tags": {
+		"en": "a room can have at most 5 tags of 1 to 20 characters",
+		"ko": "방 태그는 1자 이상 20자 이하로 최대 5개까지 달 수 있습니다",
+	},
+	"VALIDATION_FAILED.member_cap": {
+		"en": "member cap must be 0 (unlimited) or between 2 and 1000",
+		"ko": "최대 인원은 0(제한 없음) 또는 2명 이상 1000명 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.reminder_time": {
+		"en": "reminder time must be HH:MM in 24-hour format",
+		"ko": "알림 시간은 24시간 형식의 HH:MM이어야 합니다",
+	},
+	"VALIDATION_FAILED.post_policy": {
+		"en": "post policy must be members or moderators",
+		"ko": "게시 권한은 members 또는 moderators여야 합니다",
+	},
+	"VALIDATION_FAILED.announcement": {
+		"en": "announcement must be between 1 and 1000 characters",
+		"ko": "공지는 1자 이상 1000자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.prayer_topic_title": {
+		"en": "prayer topic title must be between 1 and 100 characters",
+		"ko": "기도제목은 1자 이상 100자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.prayer_tags": {
+		"en": "a prayer topic can have at most 5 tags of 1 to 20 characters",
+		"ko": "기도제목 태그는 1자 이상 20자 이하로 최대 5개까지 달 수 있습니다",
+	},
+	"VALIDATION_FAILED.prayer_recurrence": {
+		"en": "prayer recurrence must be weekly or monthly",
+		"ko": "반복 주기는 weekly 또는 monthly여야 합니다",
+	},
+	"VALIDATION_FAILED.testimony": {
+		"en": "testimony must be at most 1000 characters",
+		"ko": "간증은 1000자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.prayer_content": {
+		"en": "prayer content must be between 1 and 1000 characters",
+		"ko": "기도 내용은 1자 이상 1000자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.prayer_comment": {
+		"en": "comment must be between 1 and 500 characters",
+		"ko": "댓글은 1자 이상 500자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.comment_parent": {
+		"en": "replies can only be made to top-level comments of the same prayer",
+		"ko": "답글은 같은 기도의 최상위 댓글에만 달 수 있습니다",
+	},
+	"VALIDATION_FAILED.cover_image": {
+		"en": "cover image must be a JPEG or PNG of at most 5 MB and 4096x4096 pixels",
+		"ko": "커버 이미지는 5MB, 4096x4096 픽셀 이하의 JPEG 또는 PNG여야 합니다",
+	},
+	"VALIDATION_FAILED.room_role": {
+		"en": "room role must be moderator or member",
+		"ko": "방 역할은 moderator 또는 member여야 합니다",
+	},
+	"VALIDATION_FAILED.join_request_message": {
+		"en": "join request message must be at most 200 characters",
+		"ko": "가입 요청 메시지는 200자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.invitation_status": {
+		"en": "invitation status must be pending, accepted, rejected or expired",
+		"ko": "초대 상태는 pending, accepted, rejected, expired 중 하나여야 합니다",
+	},
+	"VALIDATION_FAILED.export_format": {
+		"en": "export format must be csv or pdf",
+		"ko": "내보내기 형식은 csv 또는 pdf여야 합니다",
+	},
+	"VALIDATION_FAILED.device_id": {
+		"en": "device ID must be between 1 and 100 characters",
+		"ko": "기기 ID는 1자 이상 100자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.device_token": {
+		"en": "device token must be between 1 and 512 characters",
+		"ko": "기기 토큰은 1자 이상 512자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.platform": {
+		"en": "platform must be ios, android or web",
+		"ko": "플랫폼은 ios, android 또는 web이어야 합니다",
+	},
+	"VALIDATION_FAILED.app_version": {
+		"en": "app version must be at most 20 characters",
+		"ko": "앱 버전은 20자 이하여야 합니다",
+	},
+	"VALIDATION_FAILED.digest_frequency": {
+		"en": "digest frequency must be off, hourly or daily",
+		"ko": "요약 알림 주기는 off, hourly 또는 daily여야 합니다",
+	},
+	"VALIDATION_FAILED.snooze": {
+		"en": "snooze must end in the future and within 7 days",
+		"ko": "알림 일시 중지는 지금부터 7일 이내에 끝나야 합니다",
+	},
+	"VALIDATION_FAILED.prayer_order": {
+		"en": "prayer order must list only your own prayer topics, each once",
+		"ko": "기도제목 순서에는 내 기도제목만 한 번씩 넣을 수 있습니다",
+	},
+
+	// Authentication
+	"MISSING_TOKEN": {
+		"en": "missing authorization token",
+		"ko": "인증 토큰이 필요합니다",
+	},
+	"INVALID_TOKEN": {
+		"ko": "유효하지 않은 인증 토큰입니다",
+	},
+	"TOKEN_EXPIRED": {
+		"en": "token has expired",
+		"ko": "인증 토큰이 만료되었습니다",
+	},
+	"TOKEN_REVOKED": {
+		"en": "token has been revoked",
+		"ko": "인증 토큰이 폐기되었습니다",
+	},
+	"INVALID_CREDENTIALS": {
+		"en": "invalid email or password",
+		"ko": "이메일 또는 비밀번호가 올바르지 않습니다",
+	},
+	"INVALID_ID_TOKEN": {
+		"en": "invalid provider ID token",
+		"ko": "소셜 로그인 토큰이 유효하지 않습니다",
+	},
+	"INVALID_REFRESH_TOKEN": {
+		"en": "invalid refresh token",
+		"ko": "유효하지 않은 리프레시 토큰입니다",
+	},
+	"REFRESH_TOKEN_REUSED": {
+		"en": "refresh token reuse detected, please log in again",
+		"ko": "리프레시 토큰 재사용이 감지되었습니다. 다시 로그인해 주세요",
+	},
+	"MISSING_API_KEY": {
+		"en": "missing api key",
+		"ko": "API 키가 필요합니다",
+	},
+	"INVALID_API_KEY": {
+		"en": "invalid api key",
+		"ko": "유효하지 않은 API 키입니다",
+	},
+	"API_KEY_SCOPE_DENIED": {
+		"en": "api key scopes do not allow this request",
+		"ko": "API 키 권한으로 허용되지 않는 요청입니다",
+	},
+	"API_KEY_NOT_ALLOWED": {
+		"en": "this endpoint requires signing in, api keys are not accepted",
+		"ko": "로그인이 필요한 요청입니다. API 키로는 사용할 수 없습니다",
+	},
+	"INVALID_TWO_FACTOR_CODE": {
+		"en": "invalid two-factor code",
+		"ko": "2단계 인증 코드가 올바르지 않습니다",
+	},
+	"TWO_FACTOR_LOCKED": {
+		"en": "too many invalid two-factor codes, try again later",
+		"ko": "2단계 인증 코드를 너무 많이 틀렸습니다. 잠시 후 다시 시도해 주세요",
+	},
+	"INVALID_HEALTH_TOKEN": {
+		"en": "invalid health token",
+		"ko": "유효하지 않은 헬스 체크 토큰입니다",
+	},
+
+	// Account
+	"EMAIL_NOT_VERIFIED": {
+		"en": "email is not verified",
+		"ko": "이메일 인증이 필요합니다",
+	},
+	"ACCOUNT_SUSPENDED": {
+		"en": "account is suspended",
+		"ko": "정지된 계정입니다",
+	},
+	"INSUFFICIENT_ROLE": {
+		"en": "insufficient role",
+		"ko": "권한이 부족합니다",
+	},
+	"GUEST_READ_ONLY": {
+		"en": "guest accounts are read-only, please sign up",
+		"ko": "게스트 계정은 읽기만 할 수 있습니다. 회원가입해 주세요",
+	},
+	"EMAIL_ALREADY_VERIFIED": {
+		"en": "email is already verified",
+		"ko": "이미 인증된 이메일입니다",
+	},
+	"TWO_FACTOR_NOT_ENROLLED": {
+		"en": "two-factor authentication is not enrolled",
+		"ko": "2단계 인증이 등록되지 않았습니다",
+	},
+	"TWO_FACTOR_NOT_ENABLED": {
+		"en": "two-factor authentication is not enabled",
+		"ko": "2단계 인증이 켜져 있지 않습니다",
+	},
+	"TWO_FACTOR_ALREADY_ENABLED": {
+		"en": "two-factor authentication is already enabled",
+		"ko": "2단계 인증이 이미 켜져 있습니다",
+	},
+	"SOCIAL_ACCOUNT_ALREADY_LINKED": {
+		"en": "social account is already linked to another user",
+		"ko": "이미 다른 사용자와 연결된 소셜 계정입니다",
+	},
+	"SOCIAL_LINK_REQUIRED": {
+		"en": "an account with this email exists, sign in to it to link the provider",
+		"ko": "이 이메일로 가입된 계정이 있습니다. 해당 계정으로 로그인한 뒤 연결해 주세요",
+	},
+	"NOT_GUEST": {
+		"en": "account is not a guest account",
+		"ko": "게스트 계정이 아닙니다",
+	},
+	"DELETION_NOT_SCHEDULED": {
+		"en": "account deletion is not scheduled",
+		"ko": "예약된 계정 삭제가 없습니다",
+	},
+	"EMAIL_TAKEN": {
+		"en": "email already exists",
+		"ko": "이미 사용 중인 이메일입니다",
+	},
+	"NICKNAME_TAKEN": {
+		"en": "nickname is already taken",
+		"ko": "이미 사용 중인 닉네임입니다",
+	},
+	"USER_NOT_FOUND": {
+		"en": "user not found",
+		"ko": "사용자를 찾을 수 없습니다",
+	},
+	"SESSION_NOT_FOUND": {
+		"en": "session not found",
+		"ko": "세션을 찾을 수 없습니다",
+	},
+	"API_KEY_NOT_FOUND": {
+		"en": "api key not found",
+		"ko": "API 키를 찾을 수 없습니다",
+	},
+	"DEVICE_NOT_FOUND": {
+		"en": "device not found",
+		"ko": "기기를 찾을 수 없습니다",
+	},
+	"NOTIFICATION_NOT_FOUND": {
+		"en": "notification not found",
+		"ko": "알림을 찾을 수 없습니다",
+	},
+
+	// Room
+	"ROOM_NOT_FOUND": {
+		"en": "room not found",
+		"ko": "방을 찾을 수 없습니다",
+	},
+	"NOT_A_MEMBER": {
+		"en": "not a member of this room",
+		"ko": "이 방의 멤버가 아닙니다",
+	},
+	"ROOM_PERMISSION_DENIED": {
+		"en": "your room role does not allow this",
+		"ko": "방 역할 권한이 없습니다",
+	},
+	"ALREADY_A_MEMBER": {
+		"en": "already a member of this room",
+		"ko": "이미 이 방의 멤버입니다",
+	},
+	"ROOM_ARCHIVED": {
+		"en": "room is archived",
+		"ko": "보관된 방입니다",
+	},
+	"ROOM_NOT_ARCHIVED": {
+		"en": "room is not archived",
+		"ko": "보관된 방이 아닙니다",
+	},
+	"ROOM_FULL": {
+		"en": "room has reached its member cap",
+		"ko": "방 인원이 가득 찼습니다",
+	},
+	"INVITE_NOT_FOUND": {
+		"en": "invite not found",
+		"ko": "초대를 찾을 수 없습니다",
+	},
+	"INVITE_EXPIRED": {
+		"en": "invite has expired or has been used up",
+		"ko": "만료되었거나 사용 횟수를 모두 쓴 초대입니다",
+	},
+	"JOIN_REQUEST_NOT_FOUND": {
+		"en": "join request not found",
+		"ko": "가입 요청을 찾을 수 없습니다",
+	},
+	"JOIN_REQUEST_ALREADY_PENDING": {
+		"en": "a join request for this room is already pending",
+		"ko": "이 방에 대한 가입 요청이 이미 대기 중입니다",
+	},
+	"JOIN_REQUEST_NOT_PENDING": {
+		"en": "join request has already been decided",
+		"ko": "이미 처리된 가입 요청입니다",
+	},
+	"INVITATION_NOT_FOUND": {
+		"en": "invitation not found",
+		"ko": "초대를 찾을 수 없습니다",
+	},
+	"INVITATION_ALREADY_PENDING": {
+		"en": "the user already has a pending invitation to this room",
+		"ko": "이미 이 방에 초대되어 응답을 기다리는 사용자입니다",
+	},
+	"INVITATION_NOT_PENDING": {
+		"en": "invitation has already been answered or has expired",
+		"ko": "이미 응답했거나 만료된 초대입니다",
+	},
+	"ANNOUNCEMENT_NOT_FOUND": {
+		"en": "announcement not found",
+		"ko": "공지를 찾을 수 없습니다",
+	},
+	"EXPORT_NOT_FOUND": {
+		"en": "export not found",
+		"ko": "내보내기를 찾을 수 없습니다",
+	},
+
+	// Prayer
+	"PRAYER_TOPIC_NOT_FOUND": {
+		"en": "prayer topic not found",
+		"ko": "기도제목을 찾을 수 없습니다",
+	},
+	"PRAYER_TOPIC_NOT_DELETED": {
+		"en": "prayer topic is not deleted",
+		"ko": "삭제된 기도제목이 아닙니다",
+	},
+	"PRAYER_TOPIC_ANSWERED": {
+		"en": "prayer topic is already answered",
+		"ko": "이미 응답된 기도제목입니다",
+	},
+	"PRAYER_TOPIC_NOT_ANSWERED": {
+		"en": "prayer topic is not answered",
+		"ko": "응답되지 않은 기도제목입니다",
+	},
+	"PRAYER_TOPIC_NOT_RECURRING": {
+		"en": "prayer topic does not recur",
+		"ko": "반복되지 않는 기도제목입니다",
+	},
+	"PRAYER_TOPIC_PINNED": {
+		"en": "prayer topic is already pinned",
+		"ko": "이미 고정된 기도제목입니다",
+	},
+	"PRAYER_TOPIC_NOT_PINNED": {
+		"en": "prayer topic is not pinned",
+		"ko": "고정되지 않은 기도제목입니다",
+	},
+	"PRAYER_TOPIC_PRIVATE": {
+		"en": "private prayer topics cannot be pinned",
+		"ko": "개인 기도제목은 고정할 수 없습니다",
+	},
+	"PIN_LIMIT_REACHED": {
+		"en": "the room already has as many pinned prayer topics as allowed",
+		"ko": "더 이상 기도제목을 고정할 수 없습니다. 다른 기도제목의 고정을 먼저 해제해 주세요",
+	},
+	"PIN_ORDER_MISMATCH": {
+		"en": "the pinned prayer topics have changed; reload them and try again",
+		"ko": "고정된 기도제목이 변경되었습니다. 새로고침 후 다시 시도해 주세요",
+	},
+	"PRAYER_CONTENT_NOT_FOUND": {
+		"en": "prayer content not found",
+		"ko": "기도 내용을 찾을 수 없습니다",
+	},
+	"PRAYER_COMMENT_NOT_FOUND": {
+		"en": "prayer comment not found",
+		"ko": "댓글을 찾을 수 없습니다",
+	},
+
+	// Throttling, coded by the limiter that rejected the request
+	"LOGIN_RATE_LIMIT": {
+		"en": "Too many login attempts",
+		"ko": "로그인 시도가 너무 많습니다. 잠시 후 다시 시도해 주세요",
+	},
+	"SIGNUP_RATE_LIMIT": {
+		"en": "Too many accounts created",
+		"ko": "가입 요청이 너무 많습니다. 잠시 후 다시 시도해 주세요",
+	},
+	"USER_RATE_LIMIT": {
+		"en": "Too many requests",
+		"ko": "요청이 너무 많습니다. 잠시 후 다시 시도해 주세요",
+	},
+	"CONCURRENCY_LIMIT": {
+		"en": "Too many concurrent requests",
+		"ko": "동시 요청이 너무 많습니다. 잠시 후 다시 시도해 주세요",
+	},
+
+	// Request fields; {unit} is one of the unit.* texts
+	"field.required": {
+		"en": "is required",
+		"ko": "필수 항목입니다",
+	},
+	"field.min": {
+		"en": "must be at least {limit}{unit}",
+		"ko": "{limit}{unit} 이상이어야 합니다",
+	},
+	"field.max": {
+		"en": "must be at most {limit}{unit}",
+		"ko": "{limit}{unit} 이하여야 합니다",
+	},
+	"field.len": {
+		"en": "must be exactly {limit}{unit}",
+		"ko": "정확히 {limit}{unit}여야 합니다",
+	},
+	"field.oneof": {
+		"en": "must be one of: {values}",
+		"ko": "다음 중 하나여야 합니다: {values}",
+	},
+	"field.enum": {
+		"en": "is not an allowed value",
+		"ko": "허용되지 않는 값입니다",
+	},
+	"field.noprofanity": {
+		"en": "must not contain inappropriate language",
+		"ko": "부적절한 표현을 포함할 수 없습니다",
+	},
+	"field.invalid": {
+		"en": "is invalid",
+		"ko": "올바르지 않은 값입니다",
+	},
+	"field.type": {
+		"en": "must be a {type}",
+		"ko": "{type} 형식이어야 합니다",
+	},
+	"unit.characters": {
+		"en": " characters",
+		"ko": "자",
+	},
+	"unit.items": {
+		"en": " items",
+		"ko": "개",
+	},
+	"type.string": {
+		"en": "string",
+		"ko": "문자열",
+	},
+	"type.boolean": {
+		"en": "boolean",
+		"ko": "불리언",
+	},
+	"type.integer": {
+		"en": "integer",
+		"ko": "정수",
+	},
+	"type.number": {
+		"en": "number",
+		"ko": "숫자",
+	},
+	"type.array": {
+		"en": "array",
+		"ko": "배열",
+	},
+	"type.object": {
+		"en": "object",
+		"ko": "객체",
+	},
+}
+
+// Message returns the text of key in lang, empty when the catalog has no such key
+func Message(lang, key string, params map[string]string) string {
+	text, _ := Messages.Lookup(lang, key, params)
+	return text
+}
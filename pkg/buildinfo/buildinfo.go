@@ -0,0 +1,47 @@
+// Package buildinfo reports which build of the server is running
+//
+// Release builds set the version and commit at link time:
+//
+//	go build -ldflags "-X github.com/changhyeonkim/pray-together/go-api-server/pkg/buildinfo.version=v1.4.0 \
+//	  -X github.com/changhyeonkim/pray-together/go-api-server/pkg/buildinfo.commit=$(git rev-parse HEAD)"
+//
+// Without them the commit is read from the VCS stamp the go command embeds
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+var (
+	version = "dev"
+	commit  = ""
+)
+
+// Info identifies a build
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	GoVersion string `json:"goVersion"`
+}
+
+var get = sync.OnceValue(func() Info {
+	info := Info{Version: version, Commit: commit, GoVersion: runtime.Version()}
+	if info.Commit != "" {
+		return info
+	}
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range build.Settings {
+			if setting.Key == "vcs.revision" {
+				info.Commit = setting.Value
+			}
+		}
+	}
+	return info
+})
+
+// Get returns the running build
+func Get() Info {
+	return get()
+}
@@ -0,0 +1,83 @@
+// Package i18n picks the language of a response from the request's Accept-Language header
+// and looks messages up in a catalog of translations
+//
+// A message falls back from the negotiated language to DefaultLanguage; a catalog can leave a
+// message out of a language entirely so callers fall back to text of their own
+package i18n
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+// DefaultLanguage is used when the client accepts no supported language
+// Most members are Korean, so a client that does not ask gets Korean
+const DefaultLanguage = "ko"
+
+// LanguageKey caches the negotiated language of a request
+const LanguageKey = "language"
+
+// Supported languages, the first being DefaultLanguage
+var (
+	languages = []string{"ko", "en"}
+	matcher   = language.NewMatcher([]language.Tag{language.Korean, language.English})
+)
+
+// Negotiate returns the supported language that best matches an Accept-Language header,
+// DefaultLanguage when none does
+func Negotiate(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLanguage
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLanguage
+	}
+	return languages[index]
+}
+
+// RequestLanguage returns the language to answer the request in
+func RequestLanguage(c *gin.Context) string {
+	if lang := c.GetString(LanguageKey); lang != "" {
+		return lang
+	}
+	lang := Negotiate(c.GetHeader("Accept-Language"))
+	c.Set(LanguageKey, lang)
+	return lang
+}
+
+// Catalog holds message texts by key and language
+// Texts may hold {name} placeholders, filled from the params of a lookup
+type Catalog map[string]map[string]string
+
+// Lookup returns the message in lang, or in DefaultLanguage when it has no translation
+// ok is false when the catalog has the message in neither
+func (c Catalog) Lookup(lang, key string, params map[string]string) (string, bool) {
+	texts, ok := c[key]
+	if !ok {
+		return "", false
+	}
+	text, ok := texts[lang]
+	if !ok {
+		if text, ok = texts[DefaultLanguage]; !ok {
+			return "", false
+		}
+	}
+	return Format(text, params), true
+}
+
+// Format fills the {name} placeholders of text in a single pass,
+// so placeholders inside the values themselves are left alone
+func Format(text string, params map[string]string) string {
+	if len(params) == 0 {
+		return text
+	}
+	pairs := make([]string, 0, 2*len(params))
+	for k, v := range params {
+		pairs = append(pairs, "{"+k+"}", v)
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
@@ -0,0 +1,96 @@
+// Package pagination implements keyset (cursor) pagination for infinite-scroll lists
+// Cursors are opaque to clients: base64url-encoded JSON of the last item's sort keys
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Meta is the standard pagination block of list responses
+type Meta struct {
+	// NextCursor fetches the following page; empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+	HasMore    bool   `json:"hasMore"`
+	Limit      int    `json:"limit"`
+}
+
+// ClampLimit returns DefaultLimit for non-positive limits and caps the rest at MaxLimit
+func ClampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	return min(limit, MaxLimit)
+}
+
+// Encode returns the cursor for the given keyset values
+func Encode(keys any) (string, error) {
+	raw, err := json.Marshal(keys)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// Decode parses a cursor produced by Encode into keys
+// An empty cursor leaves keys untouched and reports false: the list starts from the top
+func Decode(cursor string, keys any) (bool, error) {
+	if cursor == "" {
+		return false, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return false, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(raw, keys); err != nil {
+		return false, ErrInvalidCursor
+	}
+	return true, nil
+}
+
+// TimeKey is the keyset of lists ordered by a timestamp with the ID as tie-breaker
+type TimeKey struct {
+	Time time.Time `json:"t"`
+	ID   string    `json:"id"`
+}
+
+// DecodeTimeKey parses a TimeKey cursor, returning nil for the first page
+func DecodeTimeKey(cursor string) (*TimeKey, error) {
+	var key TimeKey
+	ok, err := Decode(cursor, &key)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if key.ID == "" || key.Time.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &key, nil
+}
+
+// Page trims items fetched with limit+1 to limit and builds the meta block
+// cursorOf returns the cursor pointing after the given item
+func Page[T any](items []T, limit int, cursorOf func(T) (string, error)) ([]T, Meta, error) {
+	meta := Meta{Limit: limit}
+	if len(items) <= limit {
+		return items, meta, nil
+	}
+
+	items = items[:limit]
+	next, err := cursorOf(items[limit-1])
+	if err != nil {
+		return nil, Meta{}, err
+	}
+	meta.NextCursor = next
+	meta.HasMore = true
+	return items, meta, nil
+}
@@ -0,0 +1,198 @@
+// Package pdf writes simple text-only A4 documents
+// Text is set in HYSMyeongJo-Medium, one of the Adobe-Korea1 fonts PDF readers provide,
+// so Korean renders without embedding font files and documents stay small
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	pageWidth  = 595 // A4 in points
+	pageHeight = 842
+	margin     = 50
+
+	headingSize = 16
+	titleSize   = 12
+	bodySize    = 10
+	leading     = 1.5 // line height as a multiple of the font size
+)
+
+// Document is a sequence of pages of wrapped text lines, filled top to bottom
+type Document struct {
+	pages [][]line
+	y     float64 // baseline of the next line on the last page
+}
+
+type line struct {
+	size float64
+	y    float64
+	text string
+}
+
+// New returns an empty document with one blank page
+func New() *Document {
+	d := &Document{}
+	d.addPage()
+	return d
+}
+
+// Heading adds a large line of text, such as the document title
+func (d *Document) Heading(text string) {
+	d.paragraph(text, headingSize)
+}
+
+// Title adds a line of text slightly larger than the body, such as a section title
+func (d *Document) Title(text string) {
+	d.paragraph(text, titleSize)
+}
+
+// Text adds a paragraph of body text, wrapped to the page width
+func (d *Document) Text(text string) {
+	d.paragraph(text, bodySize)
+}
+
+// Space adds an empty body line
+func (d *Document) Space() {
+	d.advance(bodySize)
+}
+
+// Bytes renders the document
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1-5 are fixed; each page then takes a page object and a content stream
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type0 /BaseFont /HYSMyeongJo-Medium /Encoding /UniKS-UCS2-H /DescendantFonts [4 0 R] >>")
+	object("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /HYSMyeongJo-Medium " +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (Korea1) /Supplement 1 >> " +
+		"/FontDescriptor 5 0 R /DW 1000 /W [1 95 500] >>")
+	object("<< /Type /FontDescriptor /FontName /HYSMyeongJo-Medium /Flags 6 /FontBBox [0 -148 1001 880] " +
+		"/ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 59 >>")
+
+	for i, lines := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+			"/Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, 7+2*i))
+
+		var content bytes.Buffer
+		for _, l := range lines {
+			fmt.Fprintf(&content, "BT /F1 %g Tf %d %.2f Td <%s> Tj ET\n", l.size, margin, l.y, encode(l.text))
+		}
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.Bytes()
+}
+
+// paragraph wraps text at the page width and adds its lines; line breaks in text are kept
+func (d *Document) paragraph(text string, size float64) {
+	for _, part := range strings.Split(text, "\n") {
+		for _, l := range wrap(part, (pageWidth-2*margin)/size) {
+			d.advance(size)
+			page := len(d.pages) - 1
+			d.pages[page] = append(d.pages[page], line{size: size, y: d.y, text: l})
+		}
+	}
+}
+
+// advance moves to the baseline of the next line of the given size, starting a new page when full
+func (d *Document) advance(size float64) {
+	d.y -= size * leading
+	if d.y < margin {
+		d.addPage()
+		d.y -= size * leading
+	}
+}
+
+func (d *Document) addPage() {
+	d.pages = append(d.pages, nil)
+	d.y = pageHeight - margin
+}
+
+// wrap splits text into lines at most width ems wide, breaking between words where it can
+func wrap(text string, width float64) []string {
+	var lines []string
+	var current strings.Builder
+	used := 0.0
+	for _, word := range strings.Fields(text) {
+		w := textWidth(word)
+		if current.Len() > 0 && used+0.5+w <= width {
+			current.WriteByte(' ')
+			current.WriteString(word)
+			used += 0.5 + w
+			continue
+		}
+		if current.Len() > 0 {
+			lines = append(lines, current.String())
+			current.Reset()
+			used = 0
+		}
+		// A word longer than a line is broken between characters
+		for _, r := range word {
+			rw := runeWidth(r)
+			if used+rw > width && current.Len() > 0 {
+				lines = append(lines, current.String())
+				current.Reset()
+				used = 0
+			}
+			current.WriteRune(r)
+			used += rw
+		}
+	}
+	if current.Len() > 0 || len(lines) == 0 {
+		lines = append(lines, current.String())
+	}
+	return lines
+}
+
+func textWidth(s string) float64 {
+	w := 0.0
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+	return w
+}
+
+// runeWidth is the advance of r in ems: half for ASCII, whose glyphs the font declares
+// 500 units wide, and full for Hangul, Hanja and everything else
+func runeWidth(r rune) float64 {
+	if r < utf8.RuneSelf {
+		return 0.5
+	}
+	return 1
+}
+
+// encode returns s as hex UCS-2 for the UniKS-UCS2-H encoding
+// Characters outside the Basic Multilingual Plane, such as emoji, have no glyph and become '?'
+func encode(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r > 0xFFFF || !unicode.IsPrint(r) {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return b.String()
+}
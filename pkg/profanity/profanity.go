@@ -0,0 +1,53 @@
+// Package profanity detects common Korean and English swear words in short user-chosen text
+// such as nicknames and room names
+// Matching ignores case, spaces, digits and punctuation, so "씨 1 발" is caught like "씨발"
+package profanity
+
+import (
+	"strings"
+	"unicode"
+)
+
+// words are matched anywhere in the normalized text
+var words = []string{
+	// Korean, including the consonant abbreviations used to dodge filters
+	"씨발", "시발", "씨팔", "시팔", "씨빨", "쓰발", "ㅅㅂ", "ㅆㅂ",
+	"병신", "븅신", "빙신", "ㅂㅅ",
+	"개새끼", "개새기", "개색기", "개색히", "개세끼",
+	"좆", "존나", "지랄", "ㅈㄹ", "염병", "썅", "엠창",
+	"미친놈", "미친년", "느금마", "니애미", "니미럴", "엿먹어",
+	// English
+	"fuck", "shit", "bitch", "asshole",
+}
+
+// allowed are innocent words that contain a swear word, removed before matching
+var allowed = []string{
+	"시발점", "시발역", // 始發: starting point, departure station
+	"병신년", // 丙申年: a year of the sexagenary cycle
+}
+
+// Contains reports whether text contains a swear word
+func Contains(text string) bool {
+	normalized := normalize(text)
+	for _, word := range allowed {
+		normalized = strings.ReplaceAll(normalized, word, "")
+	}
+	for _, word := range words {
+		if strings.Contains(normalized, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize lower-cases the text and keeps only its letters
+func normalize(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,91 @@
+// Package ratelimit implements in-memory request limits
+// Limits are per instance: behind a load balancer each instance allows the full limit
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Window allows a number of events per key within a fixed window that starts with the first event
+type Window struct {
+	limit  int
+	window time.Duration
+
+	mu        sync.Mutex
+	counters  map[string]*counter
+	lastSweep time.Time
+}
+
+type counter struct {
+	count   int
+	resetAt time.Time
+}
+
+// NewWindow allows limit events per key every window
+func NewWindow(limit int, window time.Duration) *Window {
+	return &Window{
+		limit:    limit,
+		window:   window,
+		counters: make(map[string]*counter),
+	}
+}
+
+// Allow counts an event for key and reports whether it is within the limit
+// When it is not, retryAfter is how long until the key's window resets
+func (w *Window) Allow(key string, now time.Time) (allowed bool, retryAfter time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.sweep(now)
+
+	c, ok := w.counters[key]
+	if !ok || !now.Before(c.resetAt) {
+		c = &counter{resetAt: now.Add(w.window)}
+		w.counters[key] = c
+	}
+	if c.count >= w.limit {
+		return false, c.resetAt.Sub(now)
+	}
+	c.count++
+	return true, 0
+}
+
+// sweep drops expired windows once per window, so keys seen once do not accumulate
+func (w *Window) sweep(now time.Time) {
+	if now.Sub(w.lastSweep) < w.window {
+		return
+	}
+	for key, c := range w.counters {
+		if !now.Before(c.resetAt) {
+			delete(w.counters, key)
+		}
+	}
+	w.lastSweep = now
+}
+
+// Semaphore bounds the number of operations in progress
+type Semaphore struct {
+	slots chan struct{}
+}
+
+// NewSemaphore allows up to n operations at a time
+func NewSemaphore(n int) *Semaphore {
+	return &Semaphore{slots: make(chan struct{}, n)}
+}
+
+// TryAcquire takes a slot without waiting, reporting false when all are taken
+// Every successful call must be paired with Release
+func (s *Semaphore) TryAcquire() bool {
+	select {
+	case s.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Release frees a slot taken by TryAcquire
+func (s *Semaphore) Release() {
+	<-s.slots
+}
@@ -0,0 +1,24 @@
+// Package requestinfo carries facts about the HTTP request being served in its context,
+// for code below the handlers that must not depend on gin
+package requestinfo
+
+import "context"
+
+// Info identifies the request and where it came from
+type Info struct {
+	ID string
+	IP string
+}
+
+type contextKey struct{}
+
+// NewContext returns ctx carrying info
+func NewContext(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+// FromContext returns the request info of ctx, zero outside of a request
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(contextKey{}).(Info)
+	return info
+}
@@ -0,0 +1,65 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// AWSOptions configures access to AWS Secrets Manager
+// The credentials are those of an IAM user or of a role assumed outside the server
+type AWSOptions struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // only for temporary credentials
+	// Endpoint overrides the regional endpoint, e.g. for a VPC endpoint; empty uses the default
+	Endpoint string
+}
+
+// awsProvider calls the GetSecretValue action through the AWS SDK
+type awsProvider struct {
+	client *secretsmanager.Client
+}
+
+// NewAWS reads secrets from AWS Secrets Manager by name or ARN
+// Current and Previous are the AWSCURRENT and AWSPREVIOUS staging labels
+func NewAWS(opts AWSOptions) Provider {
+	client := secretsmanager.New(secretsmanager.Options{
+		Region:      opts.Region,
+		Credentials: credentials.NewStaticCredentialsProvider(opts.AccessKeyID, opts.SecretAccessKey, opts.SessionToken),
+		HTTPClient:  newHTTPClient(),
+	}, func(o *secretsmanager.Options) {
+		if opts.Endpoint != "" {
+			o.BaseEndpoint = aws.String(opts.Endpoint)
+		}
+	})
+	return &awsProvider{client: client}
+}
+
+func (p *awsProvider) Get(ctx context.Context, name string, version Version) (string, error) {
+	stage := "AWSCURRENT"
+	if version == Previous {
+		stage = "AWSPREVIOUS"
+	}
+	out, err := p.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(name),
+		VersionStage: aws.String(stage),
+	})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return "", fmt.Errorf("%w: %s (%s)", ErrNotFound, name, version)
+		}
+		return "", fmt.Errorf("failed to call AWS Secrets Manager: %w", err)
+	}
+	if out.SecretString != nil {
+		return *out.SecretString, nil
+	}
+	return string(out.SecretBinary), nil
+}
@@ -0,0 +1,92 @@
+package secrets
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	ocisecrets "github.com/oracle/oci-go-sdk/v65/secrets"
+)
+
+// OCIOptions configures access to OCI Vault with an API signing key
+type OCIOptions struct {
+	Region      string
+	TenancyOCID string
+	UserOCID    string
+	Fingerprint string // of the API key's public key
+	PrivateKey  *rsa.PrivateKey
+	// VaultID is the vault that secrets given by name are looked up in
+	VaultID string
+	// Endpoint overrides the regional endpoint; empty uses the default
+	Endpoint string
+}
+
+// ociProvider calls the secret bundle API through the OCI SDK
+type ociProvider struct {
+	client  ocisecrets.SecretsClient
+	vaultID string
+}
+
+// NewOCI reads secrets from OCI Vault by OCID, or by name within OCIOptions.VaultID
+// Current and Previous are the CURRENT and PREVIOUS rotation stages
+func NewOCI(opts OCIOptions) (Provider, error) {
+	key := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(opts.PrivateKey)})
+	provider := common.NewRawConfigurationProvider(opts.TenancyOCID, opts.UserOCID, opts.Region, opts.Fingerprint, string(key), nil)
+	client, err := ocisecrets.NewSecretsClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCI Vault client: %w", err)
+	}
+	client.HTTPClient = newHTTPClient()
+	if opts.Endpoint != "" {
+		client.Host = opts.Endpoint
+	}
+	return &ociProvider{client: client, vaultID: opts.VaultID}, nil
+}
+
+func (p *ociProvider) Get(ctx context.Context, name string, version Version) (string, error) {
+	var bundle ocisecrets.SecretBundle
+	var err error
+	if strings.HasPrefix(name, "ocid1.") {
+		stage := ocisecrets.GetSecretBundleStageCurrent
+		if version == Previous {
+			stage = ocisecrets.GetSecretBundleStagePrevious
+		}
+		var resp ocisecrets.GetSecretBundleResponse
+		resp, err = p.client.GetSecretBundle(ctx, ocisecrets.GetSecretBundleRequest{SecretId: common.String(name), Stage: stage})
+		bundle = resp.SecretBundle
+	} else {
+		stage := ocisecrets.GetSecretBundleByNameStageCurrent
+		if version == Previous {
+			stage = ocisecrets.GetSecretBundleByNameStagePrevious
+		}
+		var resp ocisecrets.GetSecretBundleByNameResponse
+		resp, err = p.client.GetSecretBundleByName(ctx, ocisecrets.GetSecretBundleByNameRequest{
+			SecretName: common.String(name),
+			VaultId:    common.String(p.vaultID),
+			Stage:      stage,
+		})
+		bundle = resp.SecretBundle
+	}
+	if err != nil {
+		if failure, ok := common.IsServiceError(err); ok && failure.GetHTTPStatusCode() == http.StatusNotFound {
+			return "", fmt.Errorf("%w: %s (%s)", ErrNotFound, name, version)
+		}
+		return "", fmt.Errorf("failed to call OCI Vault: %w", err)
+	}
+
+	content, ok := bundle.SecretBundleContent.(ocisecrets.Base64SecretBundleContentDetails)
+	if !ok || content.Content == nil {
+		return "", fmt.Errorf("unsupported OCI secret content %T", bundle.SecretBundleContent)
+	}
+	value, err := base64.StdEncoding.DecodeString(*content.Content)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode OCI secret content: %w", err)
+	}
+	return string(value), nil
+}
@@ -0,0 +1,108 @@
+// Package secrets fetches credentials such as database passwords and signing keys from a secret manager
+//
+// AWS Secrets Manager and OCI Vault are supported. Both keep the previous version of a rotated
+// secret, so callers can accept credentials issued before a rotation until they expire
+// Cache keeps fetched values for a while, and keeps serving them while the manager is unreachable
+package secrets
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// requestTimeout bounds one call to the secret manager when the context allows longer
+const requestTimeout = 10 * time.Second
+
+// ErrNotFound is returned when the secret, or the requested version of it, does not exist
+var ErrNotFound = errors.New("secret not found")
+
+// Version selects a version of a secret by its place in rotation
+type Version int
+
+const (
+	// Current is the version in use
+	Current Version = iota
+	// Previous is the version Current replaced at the last rotation
+	Previous
+)
+
+func (v Version) String() string {
+	if v == Previous {
+		return "previous"
+	}
+	return "current"
+}
+
+// Provider reads secret values from a secret manager
+type Provider interface {
+	Get(ctx context.Context, name string, version Version) (string, error)
+}
+
+// newHTTPClient is shared by the providers
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: requestTimeout}
+}
+
+// Cache serves secret values from memory for TTL after they are fetched
+// A value that cannot be refreshed keeps being served, so an outage of the secret manager
+// does not take the server down with it; rotated values are picked up within TTL
+type Cache struct {
+	provider Provider
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+}
+
+type cacheKey struct {
+	name    string
+	version Version
+}
+
+type cacheEntry struct {
+	value     string
+	fetchedAt time.Time
+}
+
+// NewCache caches the values read through provider; a zero ttl fetches every time
+func NewCache(provider Provider, ttl time.Duration) *Cache {
+	return &Cache{
+		provider: provider,
+		ttl:      ttl,
+		entries:  make(map[cacheKey]cacheEntry),
+	}
+}
+
+// Get returns the cached value of the secret, fetching it when missing or older than TTL
+func (c *Cache) Get(ctx context.Context, name string, version Version) (string, error) {
+	key := cacheKey{name: name, version: version}
+
+	c.mu.Lock()
+	entry, cached := c.entries[key]
+	c.mu.Unlock()
+	if cached && time.Since(entry.fetchedAt) < c.ttl {
+		return entry.value, nil
+	}
+
+	value, err := c.provider.Get(ctx, name, version)
+	if err != nil {
+		if cached && !errors.Is(err, ErrNotFound) {
+			slog.Warn("Failed to refresh secret, using cached value",
+				"secret", name,
+				"version", version.String(),
+				"error", err,
+			)
+			return entry.value, nil
+		}
+		return "", err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = cacheEntry{value: value, fetchedAt: time.Now()}
+	c.mu.Unlock()
+	return value, nil
+}
@@ -0,0 +1,109 @@
+package secrets
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAWSProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") {
+			t.Errorf("Authorization = %q, want a SigV4 signature", r.Header.Get("Authorization"))
+		}
+		if got := r.Header.Get("X-Amz-Security-Token"); got != "session" {
+			t.Errorf("X-Amz-Security-Token = %q, want the session token", got)
+		}
+		body, _ := io.ReadAll(r.Body)
+		var input struct{ SecretId, VersionStage string }
+		_ = json.Unmarshal(body, &input)
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		switch {
+		case input.SecretId != "db-password":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"__type":"ResourceNotFoundException","message":"Secrets Manager can't find the specified secret."}`)
+		case input.VersionStage == "AWSPREVIOUS":
+			_, _ = io.WriteString(w, `{"Name":"db-password","SecretString":"old"}`)
+		default:
+			_, _ = io.WriteString(w, `{"Name":"db-password","SecretString":"new"}`)
+		}
+	}))
+	defer server.Close()
+
+	p := NewAWS(AWSOptions{
+		Region:          "ap-northeast-2",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		SessionToken:    "session",
+		Endpoint:        server.URL,
+	})
+	assertVersions(t, p, "db-password", "new", "old")
+}
+
+func TestOCIProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); !strings.Contains(auth, `keyId="ocid1.tenancy.oc1..t/ocid1.user.oc1..u/20:3b:97"`) {
+			t.Errorf("Authorization = %q, want a signature with the API key", auth)
+		}
+		query := r.URL.Query()
+		if query.Get("secretName") != "db-password" || query.Get("vaultId") != "ocid1.vault.oc1..v" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"code":"NotAuthorizedOrNotFound","message":"Secret not found"}`)
+			return
+		}
+		value := "new"
+		if query.Get("stage") == "PREVIOUS" {
+			value = "old"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"secretId":            "ocid1.vaultsecret.oc1..s",
+			"versionNumber":       1,
+			"secretBundleContent": map[string]string{"contentType": "BASE64", "content": base64.StdEncoding.EncodeToString([]byte(value))},
+		})
+	}))
+	defer server.Close()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	p, err := NewOCI(OCIOptions{
+		Region:      "ap-seoul-1",
+		TenancyOCID: "ocid1.tenancy.oc1..t",
+		UserOCID:    "ocid1.user.oc1..u",
+		Fingerprint: "20:3b:97",
+		PrivateKey:  key,
+		VaultID:     "ocid1.vault.oc1..v",
+		Endpoint:    server.URL,
+	})
+	if err != nil {
+		t.Fatalf("NewOCI: %v", err)
+	}
+	assertVersions(t, p, "db-password", "new", "old")
+}
+
+// assertVersions checks that p reads both versions of name and reports other secrets as not found
+func assertVersions(t *testing.T, p Provider, name, current, previous string) {
+	t.Helper()
+	ctx := context.Background()
+	if got, err := p.Get(ctx, name, Current); err != nil || got != current {
+		t.Errorf("Get(Current) = %q, %v, want %q", got, err, current)
+	}
+	if got, err := p.Get(ctx, name, Previous); err != nil || got != previous {
+		t.Errorf("Get(Previous) = %q, %v, want %q", got, err, previous)
+	}
+	if _, err := p.Get(ctx, "missing", Current); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(missing) err = %v, want ErrNotFound", err)
+	}
+}
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"fmt"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
 	"github.com/gin-gonic/gin"
 	"io"
 	"log/slog"
@@ -23,7 +25,8 @@ func NewBootstrap(cfg *config.Config) *Bootstrap {
 
 // SetupEngine creates and configures a gin engine with common middleware
 // This is reusable across different projects
-func (b *Bootstrap) SetupEngine() *gin.Engine {
+// Returns an error when the middleware chain breaks an ordering invariant
+func (b *Bootstrap) SetupEngine() (*gin.Engine, error) {
 	// Set Gin mode based on environment
 	if b.cfg.IsProduction() {
 		gin.SetMode(gin.ReleaseMode)
@@ -39,16 +42,24 @@ func (b *Bootstrap) SetupEngine() *gin.Engine {
 	router := gin.New()
 
 	// Essential middleware (common for all projects)
-	router.Use(gin.CustomRecovery(b.recoveryHandler))
-	router.Use(middleware.RequestID())
-	router.Use(middleware.CORS(b.cfg))
-	router.Use(middleware.Timeout(middleware.DefaultTimeout)) // 30 second global timeout
-	router.Use(LoggerMiddleware(b.cfg))
+	// Order is declared in Middlewares() and verified against the ordering invariants
+	chain := b.Middlewares()
+	if err := ValidateMiddlewareOrder(chain); err != nil {
+		return nil, fmt.Errorf("invalid middleware order: %w", err)
+	}
+	for _, m := range chain {
+		router.Use(m.Handler)
+	}
+
+	// Unknown routes get the same error envelope as every other error
+	router.NoRoute(func(c *gin.Context) {
+		apierror.Respond(c, apierror.New(http.StatusNotFound, apierror.CodeNotFound, "route not found"), middleware.GetRequestID(c))
+	})
 
 	// Note: Health endpoints are now handled in routes.go following Clean Architecture
 	// This keeps the bootstrap focused on middleware setup only
 
-	return router
+	return router, nil
 }
 
 // recoveryHandler handles panics
@@ -61,8 +72,5 @@ func (b *Bootstrap) recoveryHandler(c *gin.Context, recovered interface{}) {
 			"request_id", middleware.GetRequestID(c),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-		"error":      "Internal server error",
-		"request_id": middleware.GetRequestID(c),
-	})
+	apierror.Respond(c, apierror.Internal(fmt.Errorf("panic: %v", recovered)), middleware.GetRequestID(c))
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
 	"log/slog"
 	"time"
 )
@@ -40,6 +41,11 @@ func LoggerMiddleware(cfg *config.Config) gin.HandlerFunc {
 			fields = append(fields, middleware.RequestIDKey, requestID)
 		}
 
+		// Correlate the log line with the request's trace
+		if sc := trace.SpanContextFromContext(c.Request.Context()); sc.IsValid() {
+			fields = append(fields, "trace_id", sc.TraceID().String())
+		}
+
 		if raw != "" {
 			fields = append(fields, "query", raw)
 		}
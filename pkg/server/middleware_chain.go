@@ -0,0 +1,98 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler/middleware"
+	"github.com/changhyeonkim/pray-together/go-api-server/pkg/apierror"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+// Names of the global middleware, used to verify ordering
+const (
+	MiddlewareRecovery    = "recovery"
+	MiddlewareRequestID   = "request_id"
+	MiddlewareTracing     = "tracing"
+	MiddlewareCORS        = "cors"
+	MiddlewareURILimit    = "uri_limit"
+	MiddlewareBodyLimit   = "body_limit"
+	MiddlewareTimeout     = "timeout"
+	MiddlewareLogger      = "logger"
+	MiddlewareConcurrency = "concurrency"
+	MiddlewareErrors      = "errors"
+)
+
+// NamedMiddleware pairs a middleware with a stable name so the chain can be inspected
+type NamedMiddleware struct {
+	Name    string
+	Handler gin.HandlerFunc
+}
+
+// orderRule states that Before must be registered earlier than After
+type orderRule struct {
+	Before string
+	After  string
+	Reason string
+}
+
+// middlewareOrderRules are the invariants enforced on the global chain
+var middlewareOrderRules = []orderRule{
+	{MiddlewareRecovery, MiddlewareRequestID, "panics in any middleware must be recovered"},
+	{MiddlewareRecovery, MiddlewareLogger, "panics in any middleware must be recovered"},
+	{MiddlewareRequestID, MiddlewareLogger, "access logs must carry the request ID"},
+	{MiddlewareRequestID, MiddlewareTimeout, "timeout logs must carry the request ID"},
+	{MiddlewareCORS, MiddlewareTimeout, "preflight requests must be answered before any other processing"},
+	{MiddlewareCORS, MiddlewareURILimit, "browsers must be able to read the rejection"},
+	{MiddlewareURILimit, MiddlewareLogger, "pathological query strings must never reach the access log"},
+	{MiddlewareCORS, MiddlewareBodyLimit, "browsers must be able to read the rejection"},
+	{MiddlewareLogger, MiddlewareErrors, "access logs must record the status of error responses"},
+	{MiddlewareLogger, MiddlewareConcurrency, "requests turned away under load must appear in the access log"},
+	{MiddlewareRequestID, MiddlewareErrors, "error responses must carry the request ID"},
+	{MiddlewareTracing, MiddlewareTimeout, "request spans must cover time spent waiting on the deadline"},
+	{MiddlewareTracing, MiddlewareLogger, "access logs must carry the trace ID"},
+	{MiddlewareTracing, MiddlewareErrors, "request spans must record the status of error responses"},
+}
+
+// Middlewares returns the global middleware chain in the order it is applied
+// Add new global middleware here (and a rule above if its position matters)
+func (b *Bootstrap) Middlewares() []NamedMiddleware {
+	return []NamedMiddleware{
+		{MiddlewareRecovery, gin.CustomRecovery(b.recoveryHandler)},
+		{MiddlewareRequestID, middleware.RequestID()},
+		{MiddlewareTracing, otelgin.Middleware(b.cfg.TracingServiceName())},
+		{MiddlewareCORS, middleware.CORS(b.cfg)},
+		{MiddlewareURILimit, middleware.URILimit(b.cfg)},
+		{MiddlewareBodyLimit, middleware.BodyLimit(b.cfg.Server.MaxBodySize)},
+		{MiddlewareTimeout, middleware.Timeout(middleware.DefaultTimeout)}, // 30 second global timeout
+		{MiddlewareLogger, LoggerMiddleware(b.cfg)},
+		{MiddlewareConcurrency, middleware.ConcurrencyLimit(b.cfg)},
+		{MiddlewareErrors, apierror.Middleware(middleware.GetRequestID)},
+	}
+}
+
+// ValidateMiddlewareOrder checks the chain against the ordering invariants
+// Recovery must always be first, and every rule whose middleware are both present must hold
+func ValidateMiddlewareOrder(chain []NamedMiddleware) error {
+	positions := make(map[string]int, len(chain))
+	for i, m := range chain {
+		if _, exists := positions[m.Name]; exists {
+			return fmt.Errorf("middleware %q registered twice", m.Name)
+		}
+		positions[m.Name] = i
+	}
+
+	if pos, ok := positions[MiddlewareRecovery]; ok && pos != 0 {
+		return fmt.Errorf("middleware %q must be first, found at position %d", MiddlewareRecovery, pos)
+	}
+
+	for _, rule := range middlewareOrderRules {
+		before, okBefore := positions[rule.Before]
+		after, okAfter := positions[rule.After]
+		if okBefore && okAfter && before > after {
+			return fmt.Errorf("middleware %q must run before %q: %s", rule.Before, rule.After, rule.Reason)
+		}
+	}
+
+	return nil
+}
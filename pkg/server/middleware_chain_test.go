@@ -0,0 +1,115 @@
+package server
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
+)
+
+// testConfig is the least configuration the global middleware accept
+func testConfig() *config.Config {
+	return &config.Config{CORS: config.CORSConfig{AllowedOrigins: []string{"https://app.example.com"}}}
+}
+
+func TestMiddlewaresOrder(t *testing.T) {
+	chain := NewBootstrap(testConfig()).Middlewares()
+	if err := ValidateMiddlewareOrder(chain); err != nil {
+		t.Fatalf("global chain breaks an ordering invariant: %v", err)
+	}
+
+	names := make([]string, 0, len(chain))
+	for _, m := range chain {
+		if m.Handler == nil {
+			t.Errorf("middleware %q has no handler", m.Name)
+		}
+		names = append(names, m.Name)
+	}
+	want := []string{
+		MiddlewareRecovery,
+		MiddlewareRequestID,
+		MiddlewareTracing,
+		MiddlewareCORS,
+		MiddlewareURILimit,
+		MiddlewareBodyLimit,
+		MiddlewareTimeout,
+		MiddlewareLogger,
+		MiddlewareConcurrency,
+		MiddlewareErrors,
+	}
+	if !slices.Equal(names, want) {
+		t.Errorf("chain = %v, want %v", names, want)
+	}
+}
+
+func TestValidateMiddlewareOrder(t *testing.T) {
+	named := func(names ...string) []NamedMiddleware {
+		chain := make([]NamedMiddleware, 0, len(names))
+		for _, name := range names {
+			chain = append(chain, NamedMiddleware{Name: name})
+		}
+		return chain
+	}
+
+	tests := []struct {
+		name    string
+		chain   []NamedMiddleware
+		wantErr string // empty when the chain is valid
+	}{
+		{
+			name:  "rules between absent middleware are skipped",
+			chain: named(MiddlewareRecovery, MiddlewareLogger),
+		},
+		{
+			name:    "recovery not first",
+			chain:   named(MiddlewareRequestID, MiddlewareRecovery),
+			wantErr: `"recovery" must be first`,
+		},
+		{
+			name:    "registered twice",
+			chain:   named(MiddlewareRecovery, MiddlewareLogger, MiddlewareLogger),
+			wantErr: `"logger" registered twice`,
+		},
+		{
+			name:    "logger before request ID",
+			chain:   named(MiddlewareRecovery, MiddlewareLogger, MiddlewareRequestID),
+			wantErr: `"request_id" must run before "logger": access logs must carry the request ID`,
+		},
+		{
+			name:    "URI limit after logger",
+			chain:   named(MiddlewareRecovery, MiddlewareCORS, MiddlewareLogger, MiddlewareURILimit),
+			wantErr: `"uri_limit" must run before "logger"`,
+		},
+		{
+			name:    "errors before logger",
+			chain:   named(MiddlewareRecovery, MiddlewareErrors, MiddlewareLogger),
+			wantErr: `"logger" must run before "errors"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMiddlewareOrder(tt.chain)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("err = %v, want the chain accepted", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want it to mention %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetupEngine(t *testing.T) {
+	engine, err := NewBootstrap(testConfig()).SetupEngine()
+	if err != nil {
+		t.Fatalf("SetupEngine: %v", err)
+	}
+	if engine == nil {
+		t.Fatal("SetupEngine returned no engine")
+	}
+}
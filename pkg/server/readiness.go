@@ -0,0 +1,38 @@
+package server
+
+import (
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// Readiness tracks whether the server should keep receiving new traffic
+// It is flipped on shutdown so load balancers stop routing before connections close
+type Readiness struct {
+	draining atomic.Bool
+}
+
+// NewReadiness creates a readiness state that starts out accepting traffic
+func NewReadiness() *Readiness {
+	return &Readiness{}
+}
+
+// StartDraining marks the server as not ready for new traffic
+func (r *Readiness) StartDraining() {
+	r.draining.Store(true)
+}
+
+// Drain fails readiness and waits for delay while the server keeps serving
+// Call it on the shutdown signal, before the server stops accepting connections
+func (r *Readiness) Drain(delay time.Duration) {
+	r.StartDraining()
+	if delay > 0 {
+		slog.Info("Readiness set to draining, waiting before shutdown", "delay", delay.String())
+		time.Sleep(delay)
+	}
+}
+
+// IsDraining reports whether the server is shutting down
+func (r *Readiness) IsDraining() bool {
+	return r.draining.Load()
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/changhyeonkim/pray-together/go-api-server/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func TestDrainFailsReadinessBeforeShutdown(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	readiness := NewReadiness()
+	engine := gin.New()
+	engine.GET("/ready", handler.NewHealthHandler(readiness).Ready)
+	engine.GET("/prayers", func(c *gin.Context) { c.Status(http.StatusOK) })
+	srv := httptest.NewServer(engine)
+	defer srv.Close()
+
+	get := func(path string) int {
+		t.Helper()
+		resp, err := srv.Client().Get(srv.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if status := get("/ready"); status != http.StatusOK {
+		t.Fatalf("ready before the signal = %d, want 200", status)
+	}
+
+	// What main does on SIGTERM: drain, then shut down
+	drained := make(chan struct{})
+	go func() {
+		readiness.Drain(200 * time.Millisecond)
+		close(drained)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for get("/ready") != http.StatusServiceUnavailable {
+		if time.Now().After(deadline) {
+			t.Fatal("readiness never failed after the signal")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	select {
+	case <-drained:
+		t.Fatal("the drain delay ended before readiness was observed failing")
+	default:
+	}
+	if status := get("/prayers"); status != http.StatusOK {
+		t.Errorf("request while draining = %d, want it still served", status)
+	}
+
+	<-drained
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := srv.Config.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+}
+
+func TestDrainWithoutDelay(t *testing.T) {
+	readiness := NewReadiness()
+	readiness.Drain(0)
+	if !readiness.IsDraining() {
+		t.Error("IsDraining = false after Drain")
+	}
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/changhyeonkim/pray-together/go-api-server/internal/config"
 	"log/slog"
@@ -12,11 +13,13 @@ import (
 type Server struct {
 	cfg    *config.Config
 	server *http.Server
+	// redirect sends plain HTTP requests to HTTPS when the server terminates TLS itself
+	redirect *http.Server
 }
 
 // New creates a new server instance with the provided handler
 func New(cfg *config.Config, handler http.Handler) *Server {
-	return &Server{
+	s := &Server{
 		cfg: cfg,
 		server: &http.Server{
 			Addr:           fmt.Sprintf(":%d", cfg.App.Port),
@@ -25,20 +28,56 @@ func New(cfg *config.Config, handler http.Handler) *Server {
 			WriteTimeout:   cfg.Server.WriteTimeout,
 			IdleTimeout:    cfg.Server.IdleTimeout,
 			MaxHeaderBytes: 1 << 20, // 1 MB
+			Protocols:      protocols(cfg),
+			HTTP2: &http.HTTP2Config{
+				MaxConcurrentStreams: cfg.Server.HTTP2MaxConcurrentStreams,
+			},
 		},
 	}
+	if cfg.TLS.Enabled() {
+		s.setupTLS()
+	}
+	return s
+}
+
+// protocols picks what the listener speaks: HTTP/1.1 always, HTTP/2 over TLS when enabled,
+// and cleartext HTTP/2 (h2c) when enabled for traffic that was decrypted upstream
+func protocols(cfg *config.Config) *http.Protocols {
+	p := new(http.Protocols)
+	p.SetHTTP1(true)
+	p.SetHTTP2(cfg.Server.HTTP2 && cfg.TLS.Enabled())
+	p.SetUnencryptedHTTP2(cfg.Server.H2C)
+	return p
 }
 
-// Start starts the HTTP server
+// Start starts the HTTP server, and the redirect listener when configured
+// It returns once either stops
 func (s *Server) Start() error {
 	slog.Info("Starting server",
 		"port", s.cfg.App.Port,
 		"env", s.cfg.App.Env,
+		"tls", s.cfg.TLS.Enabled(),
+		"protocols", s.server.Protocols.String(),
 		"read_timeout", s.cfg.Server.ReadTimeout,
 		"write_timeout", s.cfg.Server.WriteTimeout,
 	)
 
-	return s.server.ListenAndServe()
+	errs := make(chan error, 2)
+	if s.redirect != nil {
+		slog.Info("Redirecting HTTP to HTTPS", "port", s.cfg.TLS.RedirectPort)
+		go func() {
+			errs <- s.redirect.ListenAndServe()
+		}()
+	}
+	go func() {
+		if !s.cfg.TLS.Enabled() {
+			errs <- s.server.ListenAndServe()
+			return
+		}
+		// Certificate files are empty under autocert, which supplies certificates through TLSConfig
+		errs <- s.server.ListenAndServeTLS(s.cfg.TLS.CertFile, s.cfg.TLS.KeyFile)
+	}()
+	return <-errs
 }
 
 // Shutdown gracefully shuts down the server
@@ -48,5 +87,9 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	}
 
 	slog.Info("Shutting down server...")
-	return s.server.Shutdown(ctx)
+	var redirectErr error
+	if s.redirect != nil {
+		redirectErr = s.redirect.Shutdown(ctx)
+	}
+	return errors.Join(s.server.Shutdown(ctx), redirectErr)
 }
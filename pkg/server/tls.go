@@ -0,0 +1,73 @@
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// setupTLS configures the server to terminate TLS, with certificates from Let's Encrypt when
+// autocert hosts are set, and the listener redirecting plain HTTP when a redirect port is set
+func (s *Server) setupTLS() {
+	tlsCfg := s.cfg.TLS
+	redirect := http.Handler(httpsRedirect(s.cfg.App.Port))
+
+	s.server.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(tlsCfg.AutocertHosts) > 0 {
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(tlsCfg.AutocertHosts...),
+			Cache:      autocert.DirCache(tlsCfg.AutocertCacheDir),
+			Email:      tlsCfg.AutocertEmail,
+		}
+		// Answers the TLS-ALPN challenge on the HTTPS port itself
+		s.server.TLSConfig = manager.TLSConfig()
+		s.server.TLSConfig.MinVersion = tls.VersionTLS12
+		if !s.cfg.Server.HTTP2 {
+			// The manager offers h2 on its own; a client picking it would get HTTP/1.1 responses
+			s.server.TLSConfig.NextProtos = slices.DeleteFunc(s.server.TLSConfig.NextProtos, func(p string) bool { return p == "h2" })
+		}
+		// Answers the HTTP challenge on the redirect port, which must then be 80
+		redirect = manager.HTTPHandler(redirect)
+	}
+
+	if tlsCfg.RedirectPort != 0 {
+		s.redirect = &http.Server{
+			Addr:              fmt.Sprintf(":%d", tlsCfg.RedirectPort),
+			Handler:           redirect,
+			ReadHeaderTimeout: 5 * time.Second,
+			IdleTimeout:       s.cfg.Server.IdleTimeout,
+		}
+	}
+}
+
+// httpsRedirect sends every request to the same URL over HTTPS on httpsPort
+// Methods other than GET and HEAD get a 308 so clients resend the body
+func httpsRedirect(httpsPort int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		} else {
+			host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+		}
+		if httpsPort != 443 {
+			host = net.JoinHostPort(host, strconv.Itoa(httpsPort))
+		} else if strings.Contains(host, ":") {
+			host = "[" + host + "]" // IPv6 literal
+		}
+
+		status := http.StatusPermanentRedirect
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			status = http.StatusMovedPermanently
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), status)
+	}
+}
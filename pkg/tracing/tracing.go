@@ -0,0 +1,67 @@
+// Package tracing installs OpenTelemetry for the server: spans are exported to a collector over
+// OTLP/HTTP and trace context travels in the W3C traceparent header
+// Requests, queries and outgoing calls are traced by the otelgin, GORM and otelhttp instrumentation
+// through the global tracer provider; without a collector configured spans are not recorded, but
+// trace context is still passed on
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// Options configures the exported spans
+type Options struct {
+	ServiceName string
+	Environment string
+	// Endpoint is the OTLP/HTTP collector base URL; empty records no spans
+	Endpoint string
+	// Headers are sent with every export, e.g. for collector authentication
+	Headers map[string]string
+	// SampleRatio is the share of new traces recorded, from 0 to 1; requests joining a trace
+	// follow their caller's decision
+	SampleRatio float64
+}
+
+// Setup installs the traceparent propagator and, with an endpoint, a tracer provider exporting to it
+// The returned shutdown exports the spans still queued and must be called before the process exits
+func Setup(ctx context.Context, opts Options) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	if opts.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpointURL(strings.TrimSuffix(opts.Endpoint, "/")+"/v1/traces"),
+		otlptracehttp.WithHeaders(opts.Headers),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create span exporter: %w", err)
+	}
+
+	attributes := []attribute.KeyValue{semconv.ServiceName(opts.ServiceName)}
+	if opts.Environment != "" {
+		attributes = append(attributes, semconv.DeploymentEnvironmentName(opts.Environment))
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, attributes...)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	// A failed export is logged and dropped, as tracing must never hold up the server
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		slog.Warn("Failed to export spans", "error", err)
+	}))
+	return provider.Shutdown, nil
+}